@@ -0,0 +1,109 @@
+// Command billeval runs the golden receipt corpus through bill parsing and reports its accuracy
+// against the baseline of the prompt version, exiting with status 1 on regressions.
+//
+// By default the LLM replies recorded in -fixtures are used, so it runs offline. With -record, the
+// LLM configured by the LLM_* environment variables is called and its replies are recorded instead.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/itsLeonB/cashback/internal/core/config"
+	"github.com/itsLeonB/cashback/internal/core/logger"
+	"github.com/itsLeonB/cashback/internal/core/service/langfuse"
+	"github.com/itsLeonB/cashback/internal/core/service/llm"
+	"github.com/itsLeonB/cashback/internal/core/service/prompt"
+	"github.com/itsLeonB/cashback/internal/domain/service/expense/billeval"
+	"github.com/itsLeonB/cashback/internal/domain/service/expense/billparse"
+	_ "github.com/joho/godotenv/autoload"
+	"github.com/kelseyhightower/envconfig"
+)
+
+func main() {
+	logger.Init("BillEval")
+
+	corpusDir := flag.String("corpus", "testdata/bills/corpus", "directory of <name>.txt receipts and their expected <name>.json")
+	fixturesDir := flag.String("fixtures", "testdata/bills/llm", "directory of recorded LLM replies")
+	baselinePath := flag.String("baseline", "testdata/bills/baseline.json", "accepted scores per prompt version")
+	version := flag.Int("version", billparse.ActiveBillParsePrompt.Version, "version of the parse-bill prompt to evaluate")
+	record := flag.Bool("record", false, "call the configured LLM and record its replies into -fixtures")
+	update := flag.Bool("update", false, "accept the scores as the baseline of the prompt version")
+	tolerance := flag.Float64("tolerance", 0, "how far a score may drop below the baseline")
+	flag.Parse()
+
+	if err := run(*corpusDir, *fixturesDir, *baselinePath, *version, *record, *update, *tolerance); err != nil {
+		logger.Fatal(err)
+	}
+}
+
+func run(corpusDir, fixturesDir, baselinePath string, version int, record, update bool, tolerance float64) error {
+	cases, err := billeval.LoadCorpus(corpusDir)
+	if err != nil {
+		return err
+	}
+
+	llmService, err := newLLMService(fixturesDir, record)
+	if err != nil {
+		return err
+	}
+
+	var langfuseCfg config.Langfuse
+	if err := envconfig.Process(langfuseCfg.Prefix(), &langfuseCfg); err != nil {
+		return err
+	}
+
+	p := billparse.ActiveBillParsePrompt
+	p.Version = version
+	parser := billparse.NewParser(p, prompt.NewRegistry(langfuse.NewClient(langfuseCfg)), llmService)
+
+	report := billeval.Run(context.Background(), p, parser, cases)
+	if err := report.Print(os.Stdout); err != nil {
+		return err
+	}
+
+	baseline, err := billeval.LoadBaseline(baselinePath)
+	if err != nil {
+		return err
+	}
+
+	if update {
+		baseline.Accept(report)
+		fmt.Printf("\nbaseline of %s updated\n", report.Prompt)
+		return baseline.Save(baselinePath)
+	}
+
+	if _, ok := baseline[report.Prompt]; !ok {
+		fmt.Printf("\nno baseline for %s yet, run with -update to accept these scores\n", report.Prompt)
+		return nil
+	}
+
+	regressions := baseline.Regressions(report, tolerance)
+	if len(regressions) == 0 {
+		fmt.Printf("\nno regressions against the baseline of %s\n", report.Prompt)
+		return nil
+	}
+
+	fmt.Printf("\n%d regressions:\n", len(regressions))
+	for _, r := range regressions {
+		fmt.Println("  " + r.String())
+	}
+	os.Exit(1)
+	return nil
+}
+
+func newLLMService(fixturesDir string, record bool) (llm.LLMService, error) {
+	if !record {
+		return llm.NewLLMService(config.LLM{Provider: "fake", FixturesDir: fixturesDir})
+	}
+
+	var cfg config.LLM
+	if err := envconfig.Process(cfg.Prefix(), &cfg); err != nil {
+		return nil, err
+	}
+	cfg.RecordDir = fixturesDir
+
+	return llm.NewLLMService(cfg)
+}
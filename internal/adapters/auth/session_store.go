@@ -2,12 +2,16 @@ package authadapter
 
 import (
 	"context"
+	"database/sql"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/appconstant"
 	"github.com/itsLeonB/cashback/internal/domain/entity/users"
 	"github.com/itsLeonB/cashback/internal/domain/service/auth"
+	"github.com/itsLeonB/ezutil/v2"
 	"github.com/itsLeonB/go-crud"
+	"github.com/itsLeonB/ungerr"
 )
 
 type sessionStoreAdapter struct {
@@ -18,7 +22,7 @@ func NewSessionStore(repo crud.Repository[users.Session]) auth.SessionStore {
 	return &sessionStoreAdapter{repo}
 }
 
-func (a *sessionStoreAdapter) Create(ctx context.Context, userID string) (auth.Session, error) {
+func (a *sessionStoreAdapter) Create(ctx context.Context, userID string, device auth.Device) (auth.Session, error) {
 	uid, err := uuid.Parse(userID)
 	if err != nil {
 		return auth.Session{}, err
@@ -26,6 +30,8 @@ func (a *sessionStoreAdapter) Create(ctx context.Context, userID string) (auth.S
 
 	session, err := a.repo.Insert(ctx, users.Session{
 		UserID:     uid,
+		DeviceID:   sql.NullString{String: device.ID, Valid: device.ID != ""},
+		UserAgent:  sql.NullString{String: device.UserAgent, Valid: device.UserAgent != ""},
 		LastUsedAt: time.Now(),
 	})
 	if err != nil {
@@ -52,6 +58,31 @@ func (a *sessionStoreAdapter) GetByID(ctx context.Context, id string) (auth.Sess
 	return toAuthSession(session), nil
 }
 
+// FindActiveByUser skips sessions whose refresh tokens have all expired, since they can
+// no longer be resumed even though nothing deletes them.
+func (a *sessionStoreAdapter) FindActiveByUser(ctx context.Context, userID string) ([]auth.Session, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := a.repo.GetGormInstance(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var sessions []users.Session
+	err = db.
+		Where("user_id = ?", uid).
+		Where("EXISTS (SELECT 1 FROM refresh_tokens WHERE refresh_tokens.session_id = sessions.id AND refresh_tokens.expires_at > ?)", time.Now()).
+		Find(&sessions).
+		Error
+	if err != nil {
+		return nil, ungerr.Wrap(err, appconstant.ErrDataSelect)
+	}
+	return ezutil.MapSlice(sessions, toAuthSession), nil
+}
+
 func (a *sessionStoreAdapter) Delete(ctx context.Context, id string) error {
 	uid, err := uuid.Parse(id)
 	if err != nil {
@@ -93,7 +124,11 @@ func (a *sessionStoreAdapter) Touch(ctx context.Context, id string) error {
 
 func toAuthSession(s users.Session) auth.Session {
 	return auth.Session{
-		ID:     s.ID.String(),
-		UserID: s.UserID.String(),
+		ID:         s.ID.String(),
+		UserID:     s.UserID.String(),
+		DeviceID:   s.DeviceID.String,
+		UserAgent:  s.UserAgent.String,
+		CreatedAt:  s.CreatedAt,
+		LastUsedAt: s.LastUsedAt,
 	}
 }
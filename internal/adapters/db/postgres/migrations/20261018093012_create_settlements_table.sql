@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS settlements (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    payer_profile_id UUID NOT NULL REFERENCES user_profiles(id),
+    payee_profile_id UUID NOT NULL REFERENCES user_profiles(id),
+    creator_profile_id UUID NOT NULL REFERENCES user_profiles(id),
+    currency TEXT NOT NULL,
+    amount NUMERIC(20, 2) NOT NULL,
+    transfer_method_id UUID NOT NULL REFERENCES transfer_methods(id),
+    profile_transfer_method_id UUID REFERENCES profile_transfer_methods(id),
+    proof_image_name TEXT NOT NULL DEFAULT '',
+    note TEXT NOT NULL DEFAULT ''
+);
+CREATE INDEX IF NOT EXISTS settlements_payer_profile_id_idx ON settlements(payer_profile_id);
+CREATE INDEX IF NOT EXISTS settlements_payee_profile_id_idx ON settlements(payee_profile_id);
+
+ALTER TABLE debt_transactions
+ADD COLUMN settlement_id UUID REFERENCES settlements(id),
+ADD COLUMN settled_by_settlement_id UUID REFERENCES settlements(id);
+CREATE INDEX IF NOT EXISTS debt_transactions_settled_by_settlement_id_idx ON debt_transactions(settled_by_settlement_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS debt_transactions_settled_by_settlement_id_idx;
+ALTER TABLE debt_transactions
+DROP COLUMN IF EXISTS settled_by_settlement_id,
+DROP COLUMN IF EXISTS settlement_id;
+
+DROP INDEX IF EXISTS settlements_payee_profile_id_idx;
+DROP INDEX IF EXISTS settlements_payer_profile_id_idx;
+DROP TABLE IF EXISTS settlements;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS fx_rates (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    base_currency VARCHAR(3) NOT NULL,
+    quote_currency VARCHAR(3) NOT NULL,
+    rate NUMERIC(20, 6) NOT NULL,
+    effective_date DATE NOT NULL
+);
+CREATE UNIQUE INDEX IF NOT EXISTS fx_rates_pair_date_unique_idx ON fx_rates(base_currency, quote_currency, effective_date);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS fx_rates_pair_date_unique_idx;
+DROP TABLE IF EXISTS fx_rates;
+-- +goose StatementEnd
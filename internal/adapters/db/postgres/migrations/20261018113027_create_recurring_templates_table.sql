@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS recurring_templates (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    creator_profile_id UUID NOT NULL REFERENCES user_profiles(id),
+    kind TEXT NOT NULL,
+    name TEXT NOT NULL,
+    cron_spec TEXT NOT NULL,
+    auto_confirm BOOLEAN NOT NULL DEFAULT FALSE,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    next_run_at TIMESTAMPTZ NOT NULL,
+    last_run_at TIMESTAMPTZ,
+    payload JSONB NOT NULL
+);
+CREATE INDEX IF NOT EXISTS recurring_templates_creator_profile_id_idx ON recurring_templates(creator_profile_id);
+CREATE INDEX IF NOT EXISTS recurring_templates_next_run_at_idx ON recurring_templates(next_run_at) WHERE is_active;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS recurring_templates_next_run_at_idx;
+DROP INDEX IF EXISTS recurring_templates_creator_profile_id_idx;
+DROP TABLE IF EXISTS recurring_templates;
+-- +goose StatementEnd
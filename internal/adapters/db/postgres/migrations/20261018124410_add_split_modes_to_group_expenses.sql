@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE group_expense_items
+ADD COLUMN split_mode TEXT NOT NULL DEFAULT 'WEIGHT';
+
+ALTER TABLE group_expense_item_participants
+ADD COLUMN share NUMERIC(20, 4) NOT NULL DEFAULT 0;
+
+ALTER TABLE group_expense_other_fees
+ADD COLUMN participant_shares JSONB NOT NULL DEFAULT '{}';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE group_expense_other_fees
+DROP COLUMN participant_shares;
+
+ALTER TABLE group_expense_item_participants
+DROP COLUMN share;
+
+ALTER TABLE group_expense_items
+DROP COLUMN split_mode;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS group_expense_payers (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    group_expense_id UUID NOT NULL REFERENCES group_expenses(id) ON DELETE CASCADE,
+    profile_id UUID NOT NULL REFERENCES user_profiles(id),
+    paid_amount NUMERIC(20, 2) NOT NULL,
+    CONSTRAINT group_expense_payers_unique UNIQUE (group_expense_id, profile_id)
+);
+CREATE INDEX IF NOT EXISTS group_expense_payers_group_expense_id_idx ON group_expense_payers(group_expense_id);
+CREATE INDEX IF NOT EXISTS group_expense_payers_profile_id_idx ON group_expense_payers(profile_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS group_expense_payers_profile_id_idx;
+DROP INDEX IF EXISTS group_expense_payers_group_expense_id_idx;
+DROP TABLE IF EXISTS group_expense_payers;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS exports (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    profile_id UUID NOT NULL REFERENCES user_profiles(id),
+    kind TEXT NOT NULL,
+    format TEXT NOT NULL,
+    status TEXT NOT NULL,
+    params JSONB NOT NULL DEFAULT '{}',
+    object_key TEXT,
+    error_message TEXT,
+    completed_at TIMESTAMPTZ
+);
+CREATE INDEX IF NOT EXISTS exports_profile_id_idx ON exports(profile_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS exports_profile_id_idx;
+DROP TABLE IF EXISTS exports;
+-- +goose StatementEnd
@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS imports (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    profile_id UUID NOT NULL REFERENCES user_profiles(id),
+    source TEXT NOT NULL,
+    status TEXT NOT NULL,
+    dry_run BOOLEAN NOT NULL DEFAULT FALSE,
+    self_name TEXT NOT NULL,
+    currency TEXT NOT NULL,
+    content TEXT NOT NULL,
+    total_rows INT NOT NULL DEFAULT 0,
+    processed_rows INT NOT NULL DEFAULT 0,
+    preview JSONB NOT NULL DEFAULT '{}',
+    error_message TEXT,
+    completed_at TIMESTAMPTZ
+);
+CREATE INDEX IF NOT EXISTS imports_profile_id_idx ON imports(profile_id);
+
+INSERT INTO transfer_methods (name, display)
+VALUES ('IMPORT', 'Imported')
+ON CONFLICT (name) DO NOTHING;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM transfer_methods WHERE name = 'IMPORT';
+DROP INDEX IF EXISTS imports_profile_id_idx;
+DROP TABLE IF EXISTS imports;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE group_expense_bills
+ADD COLUMN proposal JSONB;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE group_expense_bills
+DROP COLUMN proposal;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE group_expense_other_fees
+ADD COLUMN kind TEXT NOT NULL DEFAULT 'OTHER';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE group_expense_other_fees
+DROP COLUMN kind;
+-- +goose StatementEnd
@@ -0,0 +1,44 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS group_expense_bill_pages (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    bill_id UUID NOT NULL REFERENCES group_expense_bills(id) ON DELETE CASCADE,
+    page_number INT NOT NULL,
+    image_name TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'NOT_UPLOADED',
+    extracted_text TEXT,
+    CONSTRAINT group_expense_bill_pages_bill_id_page_number_key UNIQUE (bill_id, page_number)
+);
+CREATE INDEX IF NOT EXISTS group_expense_bill_pages_created_at_idx ON group_expense_bill_pages(created_at);
+
+INSERT INTO group_expense_bill_pages (created_at, updated_at, bill_id, page_number, image_name, status, extracted_text)
+SELECT created_at, updated_at, id, 1, image_name,
+    CASE WHEN status IN ('NOT_UPLOADED', 'PENDING', 'FAILED_EXTRACTING') THEN status ELSE 'EXTRACTED' END,
+    extracted_text
+FROM group_expense_bills;
+
+ALTER TABLE group_expense_bills
+DROP COLUMN image_name;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE group_expense_bills
+ADD COLUMN image_name TEXT;
+
+UPDATE group_expense_bills
+SET image_name = COALESCE((
+    SELECT image_name FROM group_expense_bill_pages
+    WHERE bill_id = group_expense_bills.id
+    ORDER BY page_number
+    LIMIT 1
+), '');
+
+ALTER TABLE group_expense_bills
+ALTER COLUMN image_name SET NOT NULL;
+
+DROP INDEX IF EXISTS group_expense_bill_pages_created_at_idx;
+DROP TABLE IF EXISTS group_expense_bill_pages;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE group_expense_bills
+ADD COLUMN prompt_source TEXT NOT NULL DEFAULT '',
+ADD COLUMN prompt_version INTEGER NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE group_expense_bills
+DROP COLUMN prompt_source,
+DROP COLUMN prompt_version;
+-- +goose StatementEnd
@@ -0,0 +1,53 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS categories (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    profile_id UUID REFERENCES user_profiles(id) ON DELETE CASCADE,
+    name TEXT NOT NULL
+);
+CREATE UNIQUE INDEX IF NOT EXISTS categories_default_name_idx ON categories(LOWER(name)) WHERE profile_id IS NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS categories_profile_id_name_idx ON categories(profile_id, LOWER(name)) WHERE profile_id IS NOT NULL;
+
+INSERT INTO categories (name) VALUES
+    ('Food & Drink'),
+    ('Groceries'),
+    ('Transport'),
+    ('Travel'),
+    ('Accommodation'),
+    ('Entertainment'),
+    ('Shopping'),
+    ('Utilities'),
+    ('Rent'),
+    ('Health'),
+    ('Other')
+ON CONFLICT DO NOTHING;
+
+ALTER TABLE group_expenses
+ADD COLUMN category_id UUID REFERENCES categories(id) ON DELETE SET NULL,
+ADD COLUMN tags JSONB NOT NULL DEFAULT '[]';
+CREATE INDEX IF NOT EXISTS group_expenses_category_id_idx ON group_expenses(category_id);
+
+ALTER TABLE debt_transactions
+ADD COLUMN category_id UUID REFERENCES categories(id) ON DELETE SET NULL,
+ADD COLUMN tags JSONB NOT NULL DEFAULT '[]';
+CREATE INDEX IF NOT EXISTS debt_transactions_category_id_idx ON debt_transactions(category_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS debt_transactions_category_id_idx;
+ALTER TABLE debt_transactions
+DROP COLUMN tags,
+DROP COLUMN category_id;
+
+DROP INDEX IF EXISTS group_expenses_category_id_idx;
+ALTER TABLE group_expenses
+DROP COLUMN tags,
+DROP COLUMN category_id;
+
+DROP INDEX IF EXISTS categories_profile_id_name_idx;
+DROP INDEX IF EXISTS categories_default_name_idx;
+DROP TABLE IF EXISTS categories;
+-- +goose StatementEnd
@@ -0,0 +1,45 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS groups (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    creator_profile_id UUID NOT NULL REFERENCES user_profiles(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    default_currency TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS group_members (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    group_id UUID NOT NULL REFERENCES groups(id) ON DELETE CASCADE,
+    profile_id UUID NOT NULL REFERENCES user_profiles(id) ON DELETE CASCADE,
+    default_weight INT NOT NULL DEFAULT 1 CHECK (default_weight > 0),
+    UNIQUE (group_id, profile_id)
+);
+CREATE INDEX IF NOT EXISTS group_members_profile_id_idx ON group_members(profile_id);
+
+ALTER TABLE group_expenses
+ADD COLUMN group_id UUID REFERENCES groups(id) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS group_expenses_group_id_idx ON group_expenses(group_id);
+
+ALTER TABLE debt_transactions
+ADD COLUMN group_id UUID REFERENCES groups(id) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS debt_transactions_group_id_idx ON debt_transactions(group_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS debt_transactions_group_id_idx;
+ALTER TABLE debt_transactions
+DROP COLUMN group_id;
+
+DROP INDEX IF EXISTS group_expenses_group_id_idx;
+ALTER TABLE group_expenses
+DROP COLUMN group_id;
+
+DROP INDEX IF EXISTS group_members_profile_id_idx;
+DROP TABLE IF EXISTS group_members;
+DROP TABLE IF EXISTS groups;
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE admin_roles
+ADD COLUMN permissions JSONB NOT NULL DEFAULT '[]';
+
+-- superadmin is allowed everything, so existing admins keep their access
+INSERT INTO admin_roles (name) VALUES ('superadmin')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO admin_users_roles (user_id, role_id)
+SELECT admin_users.id, admin_roles.id
+FROM admin_users
+CROSS JOIN admin_roles
+WHERE admin_roles.name = 'superadmin'
+ON CONFLICT (user_id, role_id) DO NOTHING;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM admin_roles WHERE name = 'superadmin';
+
+ALTER TABLE admin_roles
+DROP COLUMN permissions;
+-- +goose StatementEnd
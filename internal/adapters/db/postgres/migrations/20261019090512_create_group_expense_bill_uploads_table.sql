@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Uploads are logged apart from pages, so pages deleted by a reupload still count against the upload limit
+CREATE TABLE IF NOT EXISTS group_expense_bill_uploads (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    profile_id UUID NOT NULL REFERENCES user_profiles(id) ON DELETE CASCADE,
+    bill_id UUID NOT NULL
+);
+CREATE INDEX IF NOT EXISTS group_expense_bill_uploads_profile_id_created_at_idx ON group_expense_bill_uploads(profile_id, created_at);
+
+INSERT INTO group_expense_bill_uploads (created_at, updated_at, profile_id, bill_id)
+SELECT group_expense_bill_pages.created_at, group_expense_bill_pages.created_at, group_expenses.creator_profile_id, group_expense_bills.id
+FROM group_expense_bill_pages
+JOIN group_expense_bills ON group_expense_bills.id = group_expense_bill_pages.bill_id
+JOIN group_expenses ON group_expenses.id = group_expense_bills.group_expense_id;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS group_expense_bill_uploads_profile_id_created_at_idx;
+DROP TABLE IF EXISTS group_expense_bill_uploads;
+-- +goose StatementEnd
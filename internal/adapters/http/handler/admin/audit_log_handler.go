@@ -0,0 +1,50 @@
+package admin
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/domain/dto"
+	"github.com/itsLeonB/cashback/internal/domain/service/audit"
+	"github.com/itsLeonB/ezutil/v2"
+	"github.com/itsLeonB/ginkgo/pkg/server"
+	"github.com/itsLeonB/ungerr"
+)
+
+type AuditLogHandler struct {
+	svc audit.Service
+}
+
+func (ah *AuditLogHandler) HandleGetList() gin.HandlerFunc {
+	return server.Handler("AuditLogHandler.HandleGetList", http.StatusOK, func(ctx *gin.Context) (any, error) {
+		req, err := server.BindRequest[dto.AuditLogQuery](ctx, binding.Query)
+		if err != nil {
+			return nil, err
+		}
+
+		if actorID := ctx.Query("actorId"); actorID != "" {
+			req.ActorID, err = ezutil.Parse[uuid.UUID](actorID)
+			if err != nil {
+				return nil, ungerr.BadRequestError("invalid actorId")
+			}
+		}
+		if entityID := ctx.Query("entityId"); entityID != "" {
+			req.EntityID, err = ezutil.Parse[uuid.UUID](entityID)
+			if err != nil {
+				return nil, ungerr.BadRequestError("invalid entityId")
+			}
+		}
+
+		logs, total, err := ah.svc.GetList(ctx.Request.Context(), req)
+		if err != nil {
+			return nil, err
+		}
+
+		ctx.Header("X-Total-Count", fmt.Sprint(total))
+
+		return logs, nil
+	})
+}
@@ -0,0 +1,45 @@
+package admin
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/itsLeonB/cashback/internal/domain/dto"
+	"github.com/itsLeonB/cashback/internal/domain/service"
+	"github.com/itsLeonB/ginkgo/pkg/server"
+)
+
+type FxRateHandler struct {
+	svc service.FxRateService
+}
+
+func (fh *FxRateHandler) HandleUpload() gin.HandlerFunc {
+	return server.Handler("FxRateHandler.HandleUpload", http.StatusCreated, func(ctx *gin.Context) (any, error) {
+		req, err := server.BindJSON[dto.UploadFxRatesRequest](ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return fh.svc.Upload(ctx.Request.Context(), req)
+	})
+}
+
+func (fh *FxRateHandler) HandleGetList() gin.HandlerFunc {
+	return server.Handler("FxRateHandler.HandleGetList", http.StatusOK, func(ctx *gin.Context) (any, error) {
+		req, err := server.BindRequest[dto.FxRateQuery](ctx, binding.Query)
+		if err != nil {
+			return nil, err
+		}
+
+		rates, total, err := fh.svc.GetList(ctx.Request.Context(), req)
+		if err != nil {
+			return nil, err
+		}
+
+		ctx.Header("X-Total-Count", fmt.Sprint(total))
+
+		return rates, nil
+	})
+}
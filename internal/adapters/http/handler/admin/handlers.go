@@ -12,6 +12,9 @@ type Handlers struct {
 	Subscription SubscriptionHandler
 	Profile      ProfileHandler
 	Payment      PaymentHandler
+	FxRate       FxRateHandler
+	Role         RoleHandler
+	AuditLog     AuditLogHandler
 }
 
 func ProvideHandlers(services *admin.Services, domainServices *provider.Services) *Handlers {
@@ -22,5 +25,8 @@ func ProvideHandlers(services *admin.Services, domainServices *provider.Services
 		SubscriptionHandler{domainServices.Subscription},
 		ProfileHandler{domainServices.Profile},
 		PaymentHandler{domainServices.Payment},
+		FxRateHandler{domainServices.FxRate},
+		RoleHandler{services.Role},
+		AuditLogHandler{domainServices.Audit},
 	}
 }
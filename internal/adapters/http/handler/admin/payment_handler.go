@@ -52,7 +52,13 @@ func (ph *PaymentHandler) HandleUpdate() gin.HandlerFunc {
 			return nil, err
 		}
 
+		adminID, err := getUserID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
 		req.ID = id
+		req.AdminID = adminID
 
 		return ph.svc.Update(ctx.Request.Context(), req)
 	})
@@ -65,6 +71,11 @@ func (ph *PaymentHandler) HandleDelete() gin.HandlerFunc {
 			return nil, err
 		}
 
-		return ph.svc.Delete(ctx.Request.Context(), id)
+		adminID, err := getUserID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return ph.svc.Delete(ctx.Request.Context(), adminID, id)
 	})
 }
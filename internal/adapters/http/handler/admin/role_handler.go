@@ -0,0 +1,131 @@
+package admin
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/appconstant"
+	dto "github.com/itsLeonB/cashback/internal/domain/dto/admin"
+	"github.com/itsLeonB/cashback/internal/domain/service/admin"
+	"github.com/itsLeonB/ginkgo/pkg/server"
+)
+
+type RoleHandler struct {
+	svc admin.RoleService
+}
+
+func (rh *RoleHandler) HandleGetPermissions() gin.HandlerFunc {
+	return server.Handler("RoleHandler.HandleGetPermissions", http.StatusOK, func(ctx *gin.Context) (any, error) {
+		return rh.svc.GetPermissions(), nil
+	})
+}
+
+func (rh *RoleHandler) HandleGetList() gin.HandlerFunc {
+	return server.Handler("RoleHandler.HandleGetList", http.StatusOK, func(ctx *gin.Context) (any, error) {
+		roles, err := rh.svc.GetList(ctx.Request.Context())
+		if err != nil {
+			return nil, err
+		}
+
+		ctx.Header("X-Total-Count", fmt.Sprint(len(roles)))
+
+		return roles, nil
+	})
+}
+
+func (rh *RoleHandler) HandleCreate() gin.HandlerFunc {
+	return server.Handler("RoleHandler.HandleCreate", http.StatusCreated, func(ctx *gin.Context) (any, error) {
+		req, err := server.BindJSON[dto.NewRoleRequest](ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		adminID, err := getUserID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		req.AdminID = adminID
+
+		return rh.svc.Create(ctx.Request.Context(), req)
+	})
+}
+
+func (rh *RoleHandler) HandleUpdate() gin.HandlerFunc {
+	return server.Handler("RoleHandler.HandleUpdate", http.StatusOK, func(ctx *gin.Context) (any, error) {
+		id, err := server.GetRequiredPathParam[uuid.UUID](ctx, appconstant.ContextRoleID.String())
+		if err != nil {
+			return nil, err
+		}
+
+		req, err := server.BindJSON[dto.UpdateRoleRequest](ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		adminID, err := getUserID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		req.ID = id
+		req.AdminID = adminID
+
+		return rh.svc.Update(ctx.Request.Context(), req)
+	})
+}
+
+func (rh *RoleHandler) HandleDelete() gin.HandlerFunc {
+	return server.Handler("RoleHandler.HandleDelete", http.StatusNoContent, func(ctx *gin.Context) (any, error) {
+		id, err := server.GetRequiredPathParam[uuid.UUID](ctx, appconstant.ContextRoleID.String())
+		if err != nil {
+			return nil, err
+		}
+
+		adminID, err := getUserID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, rh.svc.Delete(ctx.Request.Context(), adminID, id)
+	})
+}
+
+func (rh *RoleHandler) HandleGetUsers() gin.HandlerFunc {
+	return server.Handler("RoleHandler.HandleGetUsers", http.StatusOK, func(ctx *gin.Context) (any, error) {
+		users, err := rh.svc.GetUsers(ctx.Request.Context())
+		if err != nil {
+			return nil, err
+		}
+
+		ctx.Header("X-Total-Count", fmt.Sprint(len(users)))
+
+		return users, nil
+	})
+}
+
+func (rh *RoleHandler) HandleSetUserRoles() gin.HandlerFunc {
+	return server.Handler("RoleHandler.HandleSetUserRoles", http.StatusOK, func(ctx *gin.Context) (any, error) {
+		userID, err := server.GetRequiredPathParam[uuid.UUID](ctx, appconstant.ContextAdminUserID.String())
+		if err != nil {
+			return nil, err
+		}
+
+		req, err := server.BindJSON[dto.UserRolesRequest](ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		adminID, err := getUserID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		req.AdminID = adminID
+		req.UserID = userID
+
+		return rh.svc.SetUserRoles(ctx.Request.Context(), req)
+	})
+}
@@ -18,11 +18,18 @@ type SubscriptionHandler struct {
 
 func (sh *SubscriptionHandler) HandleCreate() gin.HandlerFunc {
 	return server.Handler("SubscriptionHandler.HandleCreate", http.StatusCreated, func(ctx *gin.Context) (any, error) {
+		adminID, err := getUserID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
 		req, err := server.BindJSON[dto.NewSubscriptionRequest](ctx)
 		if err != nil {
 			return nil, err
 		}
 
+		req.AdminID = adminID
+
 		return sh.svc.Create(ctx.Request.Context(), req)
 	})
 }
@@ -63,7 +70,13 @@ func (sh *SubscriptionHandler) HandleUpdate() gin.HandlerFunc {
 			return nil, err
 		}
 
+		adminID, err := getUserID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
 		req.ID = id
+		req.AdminID = adminID
 
 		return sh.svc.Update(ctx.Request.Context(), req)
 	})
@@ -76,6 +89,11 @@ func (sh *SubscriptionHandler) HandleDelete() gin.HandlerFunc {
 			return nil, err
 		}
 
-		return sh.svc.Delete(ctx.Request.Context(), id)
+		adminID, err := getUserID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return sh.svc.Delete(ctx.Request.Context(), adminID, id)
 	})
 }
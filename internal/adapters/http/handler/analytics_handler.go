@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/domain/dto"
+	"github.com/itsLeonB/cashback/internal/domain/service"
+	"github.com/itsLeonB/ezutil/v2"
+	_ "github.com/itsLeonB/ginkgo/pkg/response"
+	"github.com/itsLeonB/ginkgo/pkg/server"
+	"github.com/itsLeonB/ungerr"
+)
+
+type AnalyticsHandler struct {
+	analyticsService service.AnalyticsService
+}
+
+func NewAnalyticsHandler(analyticsService service.AnalyticsService) *AnalyticsHandler {
+	return &AnalyticsHandler{analyticsService}
+}
+
+// HandleGetSpending godoc
+// @Summary      Get the user's spending
+// @Description  Sums up the user's shares of confirmed group expenses by category, month, who paid, or currency. A share of an expense with several payers is split between them by what each paid.
+// @Description  Amounts in different currencies are never added up, so each group has one total per currency.
+// @Tags         analytics
+// @Security     BearerAuth
+// @Produce      json
+// @Param        groupBy query string true "CATEGORY, MONTH, FRIEND or CURRENCY"
+// @Param        from query string false "First day, as YYYY-MM-DD"
+// @Param        to query string false "Last day, as YYYY-MM-DD"
+// @Param        currency query string false "Only expenses in this currency"
+// @Param        categoryId query string false "Only expenses in this category"
+// @Param        tag query string false "Only expenses with this tag"
+// @Success      200  {object}  response.JSONResponse[dto.SpendingResponse]
+// @Failure      400  {object}  map[string]any
+// @Failure      401  {object}  map[string]any
+// @Router       /analytics/spending [get]
+func (ah *AnalyticsHandler) HandleGetSpending() gin.HandlerFunc {
+	return server.Handler("AnalyticsHandler.HandleGetSpending", http.StatusOK, func(ctx *gin.Context) (any, error) {
+		profileID, err := getProfileID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		request, err := server.BindRequest[dto.SpendingRequest](ctx, binding.Query)
+		if err != nil {
+			return nil, err
+		}
+
+		if categoryID := ctx.Query("categoryId"); categoryID != "" {
+			request.CategoryID, err = ezutil.Parse[uuid.UUID](categoryID)
+			if err != nil {
+				return nil, ungerr.BadRequestError("invalid categoryId")
+			}
+		}
+
+		request.ProfileID = profileID
+
+		return ah.analyticsService.GetSpending(ctx.Request.Context(), request)
+	})
+}
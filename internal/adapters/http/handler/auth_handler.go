@@ -99,6 +99,8 @@ func (ah *AuthHandler) HandleInternalLogin() gin.HandlerFunc {
 			return nil, err
 		}
 
+		request.Device = getDevice(ctx)
+
 		tokenResp, err := ah.authService.InternalLogin(ctx.Request.Context(), request)
 		if err != nil {
 			return nil, err
@@ -113,6 +115,7 @@ func (ah *AuthHandler) HandleInternalLogin() gin.HandlerFunc {
 // @Summary      Initiate OAuth2 login
 // @Tags         auth
 // @Param        provider path string true "OAuth provider (e.g. google)"
+// @Param        claimToken query string false "Profile claim token to redeem once signed in"
 // @Success      307
 // @Router       /auth/{provider} [get]
 func (ah *AuthHandler) HandleOAuth2Login() gin.HandlerFunc {
@@ -127,7 +130,7 @@ func (ah *AuthHandler) HandleOAuth2Login() gin.HandlerFunc {
 			return
 		}
 
-		url, err := ah.oAuthService.GetOAuthURL(c, provider)
+		url, err := ah.oAuthService.GetOAuthURL(c, provider, ctx.Query("claimToken"))
 		if err != nil {
 			_ = ctx.Error(err)
 			return
@@ -158,6 +161,7 @@ func (ah *AuthHandler) HandleOAuth2Callback() gin.HandlerFunc {
 			Provider: provider,
 			Code:     ctx.Query("code"),
 			State:    ctx.Query("state"),
+			Device:   getDevice(ctx),
 		}
 
 		tokenResp, err := ah.oAuthService.HandleOAuthCallback(ctx.Request.Context(), request)
@@ -185,7 +189,7 @@ func (ah *AuthHandler) HandleVerifyRegistration() gin.HandlerFunc {
 			return nil, ungerr.BadRequestError("missing token")
 		}
 
-		tokenResp, err := ah.authService.VerifyRegistration(ctx.Request.Context(), token)
+		tokenResp, err := ah.authService.VerifyRegistration(ctx.Request.Context(), token, getDevice(ctx))
 		if err != nil {
 			return nil, err
 		}
@@ -240,7 +244,7 @@ func (ah *AuthHandler) HandleResetPassword() gin.HandlerFunc {
 			return nil, err
 		}
 
-		tokenResp, err := ah.authService.ResetPassword(ctx.Request.Context(), request.Token, request.Password)
+		tokenResp, err := ah.authService.ResetPassword(ctx.Request.Context(), request.Token, request.Password, getDevice(ctx))
 		if err != nil {
 			return nil, err
 		}
@@ -304,3 +308,81 @@ func (ah *AuthHandler) HandleLogout() gin.HandlerFunc {
 		return nil, nil
 	})
 }
+
+// HandleGetSessions godoc
+// @Summary      List the user's sessions
+// @Description  The current session comes first, the others by when they were last used.
+// @Tags         auth
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.JSONResponse[[]dto.SessionResponse]
+// @Failure      401  {object}  map[string]any
+// @Router       /auth/sessions [get]
+func (ah *AuthHandler) HandleGetSessions() gin.HandlerFunc {
+	return server.Handler("AuthHandler.HandleGetSessions", http.StatusOK, func(ctx *gin.Context) (any, error) {
+		userID, sessionID, err := getUserSession(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return ah.sessionService.GetUserSessions(ctx.Request.Context(), userID.String(), sessionID.String())
+	})
+}
+
+// HandleRevokeSession godoc
+// @Summary      Sign out one of the user's other sessions
+// @Tags         auth
+// @Security     BearerAuth
+// @Param        sessionId path string true "Session ID"
+// @Success      204
+// @Failure      401  {object}  map[string]any
+// @Failure      404  {object}  map[string]any
+// @Failure      422  {object}  map[string]any
+// @Router       /auth/sessions/{sessionId} [delete]
+func (ah *AuthHandler) HandleRevokeSession() gin.HandlerFunc {
+	return server.Handler("AuthHandler.HandleRevokeSession", http.StatusNoContent, func(ctx *gin.Context) (any, error) {
+		userID, currentSessionID, err := getUserSession(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		sessionID, err := server.GetRequiredPathParam[uuid.UUID](ctx, appconstant.ContextSessionID.String())
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, ah.sessionService.RevokeUserSession(ctx.Request.Context(), userID.String(), currentSessionID.String(), sessionID.String())
+	})
+}
+
+// HandleRevokeOtherSessions godoc
+// @Summary      Sign out everywhere except the current session
+// @Tags         auth
+// @Security     BearerAuth
+// @Success      204
+// @Failure      401  {object}  map[string]any
+// @Router       /auth/sessions [delete]
+func (ah *AuthHandler) HandleRevokeOtherSessions() gin.HandlerFunc {
+	return server.Handler("AuthHandler.HandleRevokeOtherSessions", http.StatusNoContent, func(ctx *gin.Context) (any, error) {
+		userID, sessionID, err := getUserSession(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, ah.sessionService.RevokeOtherSessions(ctx.Request.Context(), userID.String(), sessionID.String())
+	})
+}
+
+func getUserSession(ctx *gin.Context) (uuid.UUID, uuid.UUID, error) {
+	userID, err := server.GetFromContext[uuid.UUID](ctx, appconstant.ContextUserID.String())
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+
+	sessionID, err := server.GetFromContext[uuid.UUID](ctx, appconstant.ContextSessionID.String())
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+
+	return userID, sessionID, nil
+}
@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/appconstant"
+	"github.com/itsLeonB/cashback/internal/domain/dto"
+	"github.com/itsLeonB/cashback/internal/domain/service"
+	_ "github.com/itsLeonB/ginkgo/pkg/response"
+	"github.com/itsLeonB/ginkgo/pkg/server"
+)
+
+type CategoryHandler struct {
+	categoryService service.CategoryService
+}
+
+func NewCategoryHandler(categoryService service.CategoryService) *CategoryHandler {
+	return &CategoryHandler{categoryService}
+}
+
+// HandleCreate godoc
+// @Summary      Create a category
+// @Description  Defines a category for the user, next to the default ones everybody can use.
+// @Tags         categories
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body body dto.NewCategoryRequest true "New category payload"
+// @Success      201  {object}  response.JSONResponse[dto.CategoryResponse]
+// @Failure      400  {object}  map[string]any
+// @Failure      401  {object}  map[string]any
+// @Failure      409  {object}  map[string]any
+// @Router       /categories [post]
+func (ch *CategoryHandler) HandleCreate() gin.HandlerFunc {
+	return server.Handler("CategoryHandler.HandleCreate", http.StatusCreated, func(ctx *gin.Context) (any, error) {
+		profileID, err := getProfileID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		request, err := server.BindJSON[dto.NewCategoryRequest](ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		request.ProfileID = profileID
+
+		return ch.categoryService.Create(ctx.Request.Context(), request)
+	})
+}
+
+// HandleGetAll godoc
+// @Summary      Get the categories usable by the user
+// @Description  Returns the default categories first, then the ones the user defined.
+// @Tags         categories
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.JSONResponse[[]dto.CategoryResponse]
+// @Failure      401  {object}  map[string]any
+// @Router       /categories [get]
+func (ch *CategoryHandler) HandleGetAll() gin.HandlerFunc {
+	return server.Handler("CategoryHandler.HandleGetAll", http.StatusOK, func(ctx *gin.Context) (any, error) {
+		profileID, err := getProfileID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return ch.categoryService.GetAll(ctx.Request.Context(), profileID)
+	})
+}
+
+// HandleDelete godoc
+// @Summary      Delete a category the user defined
+// @Description  Expenses and debts labeled with it become uncategorized. Default categories cannot be deleted.
+// @Tags         categories
+// @Security     BearerAuth
+// @Param        categoryId path string true "Category ID"
+// @Success      204
+// @Failure      401  {object}  map[string]any
+// @Failure      404  {object}  map[string]any
+// @Failure      422  {object}  map[string]any
+// @Router       /categories/{categoryId} [delete]
+func (ch *CategoryHandler) HandleDelete() gin.HandlerFunc {
+	return server.Handler("CategoryHandler.HandleDelete", http.StatusNoContent, func(ctx *gin.Context) (any, error) {
+		profileID, err := getProfileID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		categoryID, err := server.GetRequiredPathParam[uuid.UUID](ctx, appconstant.ContextCategoryID.String())
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, ch.categoryService.Delete(ctx.Request.Context(), profileID, categoryID)
+	})
+}
@@ -4,6 +4,8 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/appconstant"
 	"github.com/itsLeonB/cashback/internal/domain/dto"
 	"github.com/itsLeonB/cashback/internal/domain/service"
 	_ "github.com/itsLeonB/ginkgo/pkg/response"
@@ -71,8 +73,10 @@ func (dh *DebtHandler) HandleGetAll() gin.HandlerFunc {
 // @Tags         debts
 // @Security     BearerAuth
 // @Produce      json
+// @Param        inHomeCurrency query bool false "Convert all balances into the user's home currency at the rate on each transaction date"
 // @Success      200  {object}  response.JSONResponse[map[string]dto.FriendBalance]
 // @Failure      401  {object}  map[string]any
+// @Failure      422  {object}  map[string]any
 // @Router       /debts/summary [get]
 func (dh *DebtHandler) HandleGetTransactionSummary() gin.HandlerFunc {
 	return server.Handler("DebtHandler.HandleGetTransactionSummary", http.StatusOK, func(ctx *gin.Context) (any, error) {
@@ -81,7 +85,7 @@ func (dh *DebtHandler) HandleGetTransactionSummary() gin.HandlerFunc {
 			return nil, err
 		}
 
-		return dh.debtService.GetTransactionSummary(ctx.Request.Context(), profileID)
+		return dh.debtService.GetTransactionSummary(ctx.Request.Context(), profileID, ctx.Query("inHomeCurrency") == "true")
 	})
 }
 
@@ -103,3 +107,73 @@ func (dh *DebtHandler) HandleGetRecent() gin.HandlerFunc {
 		return dh.debtService.GetRecent(ctx.Request.Context(), profileID)
 	})
 }
+
+// HandleUpdateLabels godoc
+// @Summary      Set the category and tags of a debt transaction
+// @Description  Either party of the debt can set them.
+// @Tags         debts
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        debtId path string true "Debt transaction ID"
+// @Param        body body dto.LabelsRequest true "Labels payload"
+// @Success      200  {object}  response.JSONResponse[dto.DebtTransactionResponse]
+// @Failure      400  {object}  map[string]any
+// @Failure      401  {object}  map[string]any
+// @Failure      404  {object}  map[string]any
+// @Router       /debts/{debtId}/labels [put]
+func (dh *DebtHandler) HandleUpdateLabels() gin.HandlerFunc {
+	return server.Handler("DebtHandler.HandleUpdateLabels", http.StatusOK, func(ctx *gin.Context) (any, error) {
+		profileID, err := getProfileID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		debtID, err := server.GetRequiredPathParam[uuid.UUID](ctx, appconstant.ContextDebtID.String())
+		if err != nil {
+			return nil, err
+		}
+
+		request, err := server.BindJSON[dto.LabelsRequest](ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		request.ProfileID = profileID
+		request.ID = debtID
+
+		return dh.debtService.UpdateLabels(ctx.Request.Context(), request)
+	})
+}
+
+// HandleGetSettleUpPlan godoc
+// @Summary      Get a minimal settle-up plan
+// @Description  Suggests the fewest transfers that settle the user's balances per currency.
+// @Description  Pass groupExpenseIds to scope the plan to the participants of those confirmed group expenses.
+// @Tags         debts
+// @Security     BearerAuth
+// @Produce      json
+// @Param        groupExpenseIds query string false "Comma-separated confirmed group expense IDs"
+// @Success      200  {object}  response.JSONResponse[map[string][]dto.SettleUpTransfer]
+// @Failure      400  {object}  map[string]any
+// @Failure      401  {object}  map[string]any
+// @Failure      404  {object}  map[string]any
+// @Router       /debts/settle-up [get]
+func (dh *DebtHandler) HandleGetSettleUpPlan() gin.HandlerFunc {
+	return server.Handler("DebtHandler.HandleGetSettleUpPlan", http.StatusOK, func(ctx *gin.Context) (any, error) {
+		profileID, err := getProfileID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		groupExpenseIDs, err := parseUUIDList(ctx.Query("groupExpenseIds"))
+		if err != nil {
+			return nil, err
+		}
+
+		return dh.debtService.GetSettleUpPlan(ctx.Request.Context(), dto.SettleUpRequest{
+			UserProfileID:   profileID,
+			GroupExpenseIDs: groupExpenseIDs,
+		})
+	})
+}
@@ -21,7 +21,7 @@ func NewExpenseBillHandler(expenseBillService service.ExpenseBillService) *Expen
 }
 
 // HandlePresignedSave godoc
-// @Summary      Get a presigned URL to upload an expense bill
+// @Summary      Get a presigned URL to upload an expense bill, or another page of it
 // @Tags         expense-bills
 // @Security     BearerAuth
 // @Accept       json
@@ -82,3 +82,50 @@ func (geh *ExpenseBillHandler) HandleTriggerParsing() gin.HandlerFunc {
 		return nil, geh.expenseBillService.TriggerParsing(ctx.Request.Context(), expenseID, billID)
 	})
 }
+
+// HandleReview godoc
+// @Summary      Review a parsed expense bill
+// @Description  Accepts, edits or rejects the items and fees parsed from a bill in AWAITING_REVIEW, then applies the kept ones to the draft.
+// @Description  Lines left out of the request are accepted as proposed. Totals are recalculated from the kept lines.
+// @Tags         expense-bills
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        groupExpenseId path string true "Group expense ID"
+// @Param        expenseBillId  path string true "Expense bill ID"
+// @Param        body body dto.BillReviewRequest true "Review decisions"
+// @Success      200  {object}  response.JSONResponse[dto.ExpenseBillResponse]
+// @Failure      400  {object}  map[string]any
+// @Failure      401  {object}  map[string]any
+// @Failure      404  {object}  map[string]any
+// @Failure      422  {object}  map[string]any
+// @Router       /group-expenses/{groupExpenseId}/bills/{expenseBillId}/review [post]
+func (geh *ExpenseBillHandler) HandleReview() gin.HandlerFunc {
+	return server.Handler("ExpenseBillHandler.HandleReview", http.StatusOK, func(ctx *gin.Context) (any, error) {
+		profileID, err := getProfileID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		expenseID, err := server.GetRequiredPathParam[uuid.UUID](ctx, appconstant.ContextGroupExpenseID.String())
+		if err != nil {
+			return nil, err
+		}
+
+		billID, err := server.GetRequiredPathParam[uuid.UUID](ctx, appconstant.ContextExpenseBillID.String())
+		if err != nil {
+			return nil, err
+		}
+
+		req, err := server.BindJSON[dto.BillReviewRequest](ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		req.ProfileID = profileID
+		req.GroupExpenseID = expenseID
+		req.BillID = billID
+
+		return geh.expenseBillService.Review(ctx.Request.Context(), req)
+	})
+}
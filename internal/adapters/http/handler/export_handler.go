@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/appconstant"
+	"github.com/itsLeonB/cashback/internal/domain/dto"
+	"github.com/itsLeonB/cashback/internal/domain/service"
+	_ "github.com/itsLeonB/ginkgo/pkg/response"
+	"github.com/itsLeonB/ginkgo/pkg/server"
+)
+
+type ExportHandler struct {
+	exportService service.ExportService
+}
+
+func NewExportHandler(exportService service.ExportService) *ExportHandler {
+	return &ExportHandler{exportService}
+}
+
+// HandleCreate godoc
+// @Summary      Request a statement export
+// @Description  Queues a CSV, XLSX or PDF export of the user's debts, a friend statement or a group expense breakdown.
+// @Description  Poll the export until its status is COMPLETED to get a download link. Month (YYYY-MM) limits debts and friend statements to that month.
+// @Tags         exports
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body body dto.NewExportRequest true "New export payload"
+// @Success      202  {object}  response.JSONResponse[dto.ExportResponse]
+// @Failure      400  {object}  map[string]any
+// @Failure      401  {object}  map[string]any
+// @Router       /exports [post]
+func (eh *ExportHandler) HandleCreate() gin.HandlerFunc {
+	return server.Handler("ExportHandler.HandleCreate", http.StatusAccepted, func(ctx *gin.Context) (any, error) {
+		profileID, err := getProfileID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		request, err := server.BindJSON[dto.NewExportRequest](ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		request.ProfileID = profileID
+
+		return eh.exportService.Request(ctx.Request.Context(), request)
+	})
+}
+
+// HandleGetDetails godoc
+// @Summary      Get an export
+// @Description  Returns the export status, with a short-lived download URL once it is completed.
+// @Tags         exports
+// @Security     BearerAuth
+// @Produce      json
+// @Param        exportId path string true "Export ID"
+// @Success      200  {object}  response.JSONResponse[dto.ExportResponse]
+// @Failure      401  {object}  map[string]any
+// @Failure      404  {object}  map[string]any
+// @Router       /exports/{exportId} [get]
+func (eh *ExportHandler) HandleGetDetails() gin.HandlerFunc {
+	return server.Handler("ExportHandler.HandleGetDetails", http.StatusOK, func(ctx *gin.Context) (any, error) {
+		profileID, err := getProfileID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		exportID, err := server.GetRequiredPathParam[uuid.UUID](ctx, appconstant.ContextExportID.String())
+		if err != nil {
+			return nil, err
+		}
+
+		return eh.exportService.GetByID(ctx.Request.Context(), profileID, exportID)
+	})
+}
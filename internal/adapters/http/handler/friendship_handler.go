@@ -100,9 +100,11 @@ func (fh *FriendshipHandler) HandleGetAll() gin.HandlerFunc {
 // @Security     BearerAuth
 // @Produce      json
 // @Param        friendshipId path string true "Friendship ID"
+// @Param        inHomeCurrency query bool false "Convert all balances into the user's home currency at the rate on each transaction date"
 // @Success      200  {object}  response.JSONResponse[dto.FriendDetailsResponse]
 // @Failure      401  {object}  map[string]any
 // @Failure      404  {object}  map[string]any
+// @Failure      422  {object}  map[string]any
 // @Router       /friendships/{friendshipId} [get]
 func (fh *FriendshipHandler) HandleGetDetails() gin.HandlerFunc {
 	return server.Handler("FriendshipHandler.HandleGetDetails", http.StatusOK, func(ctx *gin.Context) (any, error) {
@@ -116,6 +118,6 @@ func (fh *FriendshipHandler) HandleGetDetails() gin.HandlerFunc {
 			return nil, err
 		}
 
-		return fh.friendDetailsSvc.GetDetails(ctx.Request.Context(), profileID, friendshipID)
+		return fh.friendDetailsSvc.GetDetails(ctx.Request.Context(), profileID, friendshipID, ctx.Query("inHomeCurrency") == "true")
 	})
 }
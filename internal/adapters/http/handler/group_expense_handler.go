@@ -170,6 +170,33 @@ func (geh *groupExpenseHandler) HandleDelete() gin.HandlerFunc {
 	})
 }
 
+// HandleAmend godoc
+// @Summary      Reopen a confirmed group expense as a new revision
+// @Tags         group-expenses
+// @Security     BearerAuth
+// @Produce      json
+// @Param        groupExpenseId path string true "Group expense ID"
+// @Success      201  {object}  response.JSONResponse[dto.GroupExpenseResponse]
+// @Failure      401  {object}  map[string]any
+// @Failure      404  {object}  map[string]any
+// @Failure      422  {object}  map[string]any
+// @Router       /group-expenses/{groupExpenseId}/revisions [post]
+func (geh *groupExpenseHandler) HandleAmend() gin.HandlerFunc {
+	return server.Handler("GroupExpenseHandler.HandleAmend", http.StatusCreated, func(ctx *gin.Context) (any, error) {
+		userProfileID, err := getProfileID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		expenseID, err := server.GetRequiredPathParam[uuid.UUID](ctx, appconstant.ContextGroupExpenseID.String())
+		if err != nil {
+			return nil, err
+		}
+
+		return geh.groupExpenseService.Amend(ctx.Request.Context(), expenseID, userProfileID)
+	})
+}
+
 // HandleSyncParticipants godoc
 // @Summary      Sync participants of a group expense
 // @Tags         group-expenses
@@ -206,6 +233,44 @@ func (geh *groupExpenseHandler) HandleSyncParticipants() gin.HandlerFunc {
 	})
 }
 
+// HandleUpdateLabels godoc
+// @Summary      Set the category and tags of a group expense
+// @Description  Labels do not change any amounts, so they can be set on confirmed expenses too. Only the creator can set them.
+// @Tags         group-expenses
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        groupExpenseId path string true "Group expense ID"
+// @Param        body body dto.LabelsRequest true "Labels payload"
+// @Success      200  {object}  response.JSONResponse[dto.GroupExpenseResponse]
+// @Failure      400  {object}  map[string]any
+// @Failure      401  {object}  map[string]any
+// @Failure      404  {object}  map[string]any
+// @Router       /group-expenses/{groupExpenseId}/labels [put]
+func (geh *groupExpenseHandler) HandleUpdateLabels() gin.HandlerFunc {
+	return server.Handler("GroupExpenseHandler.HandleUpdateLabels", http.StatusOK, func(ctx *gin.Context) (any, error) {
+		userProfileID, err := getProfileID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		expenseID, err := server.GetRequiredPathParam[uuid.UUID](ctx, appconstant.ContextGroupExpenseID.String())
+		if err != nil {
+			return nil, err
+		}
+
+		req, err := server.BindJSON[dto.LabelsRequest](ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		req.ProfileID = userProfileID
+		req.ID = expenseID
+
+		return geh.groupExpenseService.UpdateLabels(ctx.Request.Context(), req)
+	})
+}
+
 // HandleGetRecent godoc
 // @Summary      Get recent group expenses
 // @Tags         group-expenses
@@ -0,0 +1,240 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/appconstant"
+	"github.com/itsLeonB/cashback/internal/domain/dto"
+	"github.com/itsLeonB/cashback/internal/domain/service"
+	_ "github.com/itsLeonB/ginkgo/pkg/response"
+	"github.com/itsLeonB/ginkgo/pkg/server"
+)
+
+type GroupHandler struct {
+	groupService service.GroupService
+}
+
+func NewGroupHandler(groupService service.GroupService) *GroupHandler {
+	return &GroupHandler{groupService}
+}
+
+// HandleCreate godoc
+// @Summary      Create a group
+// @Description  Creates a lasting group, like a household or a trip, with the user as a member.
+// @Description  Members can be real or anonymous profiles, and must be the user's friends.
+// @Tags         groups
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body body dto.NewGroupRequest true "New group payload"
+// @Success      201  {object}  response.JSONResponse[dto.GroupResponse]
+// @Failure      400  {object}  map[string]any
+// @Failure      401  {object}  map[string]any
+// @Failure      422  {object}  map[string]any
+// @Router       /groups [post]
+func (gh *GroupHandler) HandleCreate() gin.HandlerFunc {
+	return server.Handler("GroupHandler.HandleCreate", http.StatusCreated, func(ctx *gin.Context) (any, error) {
+		profileID, err := getProfileID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		request, err := server.BindJSON[dto.NewGroupRequest](ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		request.ProfileID = profileID
+
+		return gh.groupService.Create(ctx.Request.Context(), request)
+	})
+}
+
+// HandleGetAll godoc
+// @Summary      Get the groups of the user
+// @Tags         groups
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.JSONResponse[[]dto.GroupResponse]
+// @Failure      401  {object}  map[string]any
+// @Router       /groups [get]
+func (gh *GroupHandler) HandleGetAll() gin.HandlerFunc {
+	return server.Handler("GroupHandler.HandleGetAll", http.StatusOK, func(ctx *gin.Context) (any, error) {
+		profileID, err := getProfileID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return gh.groupService.GetAll(ctx.Request.Context(), profileID)
+	})
+}
+
+// HandleGetDetails godoc
+// @Summary      Get a group
+// @Tags         groups
+// @Security     BearerAuth
+// @Produce      json
+// @Param        groupId path string true "Group ID"
+// @Success      200  {object}  response.JSONResponse[dto.GroupResponse]
+// @Failure      401  {object}  map[string]any
+// @Failure      404  {object}  map[string]any
+// @Router       /groups/{groupId} [get]
+func (gh *GroupHandler) HandleGetDetails() gin.HandlerFunc {
+	return server.Handler("GroupHandler.HandleGetDetails", http.StatusOK, func(ctx *gin.Context) (any, error) {
+		profileID, groupID, err := getProfileAndGroupID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return gh.groupService.GetDetails(ctx.Request.Context(), profileID, groupID)
+	})
+}
+
+// HandleUpdate godoc
+// @Summary      Update a group
+// @Tags         groups
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        groupId path string true "Group ID"
+// @Param        body body dto.UpdateGroupRequest true "Group payload"
+// @Success      200  {object}  response.JSONResponse[dto.GroupResponse]
+// @Failure      400  {object}  map[string]any
+// @Failure      401  {object}  map[string]any
+// @Failure      404  {object}  map[string]any
+// @Router       /groups/{groupId} [patch]
+func (gh *GroupHandler) HandleUpdate() gin.HandlerFunc {
+	return server.Handler("GroupHandler.HandleUpdate", http.StatusOK, func(ctx *gin.Context) (any, error) {
+		profileID, groupID, err := getProfileAndGroupID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		request, err := server.BindJSON[dto.UpdateGroupRequest](ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		request.ProfileID = profileID
+		request.ID = groupID
+
+		return gh.groupService.Update(ctx.Request.Context(), request)
+	})
+}
+
+// HandleSyncMembers godoc
+// @Summary      Sync members of a group
+// @Description  Replaces the members and their default split weights. Members can only add profiles they are friends with.
+// @Description  The creator always stays, and members with an unsettled balance in the group cannot be removed.
+// @Tags         groups
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        groupId path string true "Group ID"
+// @Param        body body dto.GroupMembersRequest true "Members payload"
+// @Success      200  {object}  response.JSONResponse[dto.GroupResponse]
+// @Failure      400  {object}  map[string]any
+// @Failure      401  {object}  map[string]any
+// @Failure      404  {object}  map[string]any
+// @Failure      422  {object}  map[string]any
+// @Router       /groups/{groupId}/members [put]
+func (gh *GroupHandler) HandleSyncMembers() gin.HandlerFunc {
+	return server.Handler("GroupHandler.HandleSyncMembers", http.StatusOK, func(ctx *gin.Context) (any, error) {
+		profileID, groupID, err := getProfileAndGroupID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		request, err := server.BindJSON[dto.GroupMembersRequest](ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		request.ProfileID = profileID
+		request.ID = groupID
+
+		return gh.groupService.SyncMembers(ctx.Request.Context(), request)
+	})
+}
+
+// HandleDelete godoc
+// @Summary      Delete a group
+// @Description  Only the creator can delete a group. Its expenses and debts are kept, without a group.
+// @Tags         groups
+// @Security     BearerAuth
+// @Param        groupId path string true "Group ID"
+// @Success      204
+// @Failure      401  {object}  map[string]any
+// @Failure      403  {object}  map[string]any
+// @Failure      404  {object}  map[string]any
+// @Router       /groups/{groupId} [delete]
+func (gh *GroupHandler) HandleDelete() gin.HandlerFunc {
+	return server.Handler("GroupHandler.HandleDelete", http.StatusNoContent, func(ctx *gin.Context) (any, error) {
+		profileID, groupID, err := getProfileAndGroupID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, gh.groupService.Delete(ctx.Request.Context(), profileID, groupID)
+	})
+}
+
+// HandleGetBalances godoc
+// @Summary      Get the balances of a group
+// @Description  Lists every member's net position per currency from the group's debts, and their balance with the user.
+// @Tags         groups
+// @Security     BearerAuth
+// @Produce      json
+// @Param        groupId path string true "Group ID"
+// @Success      200  {object}  response.JSONResponse[[]dto.GroupBalanceResponse]
+// @Failure      401  {object}  map[string]any
+// @Failure      404  {object}  map[string]any
+// @Router       /groups/{groupId}/balances [get]
+func (gh *GroupHandler) HandleGetBalances() gin.HandlerFunc {
+	return server.Handler("GroupHandler.HandleGetBalances", http.StatusOK, func(ctx *gin.Context) (any, error) {
+		profileID, groupID, err := getProfileAndGroupID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return gh.groupService.GetBalances(ctx.Request.Context(), profileID, groupID)
+	})
+}
+
+// HandleGetActivities godoc
+// @Summary      Get the activity feed of a group
+// @Description  Lists the group's expenses, debts and members joining, newest first.
+// @Tags         groups
+// @Security     BearerAuth
+// @Produce      json
+// @Param        groupId path string true "Group ID"
+// @Success      200  {object}  response.JSONResponse[[]dto.GroupActivityResponse]
+// @Failure      401  {object}  map[string]any
+// @Failure      404  {object}  map[string]any
+// @Router       /groups/{groupId}/activities [get]
+func (gh *GroupHandler) HandleGetActivities() gin.HandlerFunc {
+	return server.Handler("GroupHandler.HandleGetActivities", http.StatusOK, func(ctx *gin.Context) (any, error) {
+		profileID, groupID, err := getProfileAndGroupID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return gh.groupService.GetActivities(ctx.Request.Context(), profileID, groupID)
+	})
+}
+
+func getProfileAndGroupID(ctx *gin.Context) (uuid.UUID, uuid.UUID, error) {
+	profileID, err := getProfileID(ctx)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+
+	groupID, err := server.GetRequiredPathParam[uuid.UUID](ctx, appconstant.ContextGroupID.String())
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+
+	return profileID, groupID, nil
+}
@@ -1,13 +1,51 @@
 package handler
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"github.com/itsLeonB/cashback/internal/appconstant"
-	"github.com/itsLeonB/ginkgo/pkg/server"
+	"github.com/itsLeonB/cashback/internal/domain/service/auth"
+	"github.com/itsLeonB/ezutil/v2"
 	_ "github.com/itsLeonB/ginkgo/pkg/response"
+	"github.com/itsLeonB/ginkgo/pkg/server"
+	"github.com/itsLeonB/ungerr"
 )
 
 func getProfileID(ctx *gin.Context) (uuid.UUID, error) {
 	return server.GetFromContext[uuid.UUID](ctx, appconstant.ContextProfileID.String())
 }
+
+// getDevice describes the client opening a session, so users can tell their sessions apart.
+// Clients may send a stable X-Device-ID; the user agent comes from the request.
+func getDevice(ctx *gin.Context) auth.Device {
+	return auth.Device{
+		ID:        ctx.GetHeader("X-Device-ID"),
+		UserAgent: ctx.Request.UserAgent(),
+	}
+}
+
+// parseUUIDList parses a comma-separated list of UUIDs, ignoring empty entries.
+func parseUUIDList(value string) ([]uuid.UUID, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	parts := strings.Split(value, ",")
+	ids := make([]uuid.UUID, 0, len(parts))
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		id, err := ezutil.Parse[uuid.UUID](part)
+		if err != nil {
+			return nil, ungerr.BadRequestError(fmt.Sprintf("invalid ID: %s", part))
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
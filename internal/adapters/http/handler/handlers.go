@@ -15,10 +15,15 @@ type Handlers struct {
 	Profile               *ProfileHandler
 	TransferMethod        *TransferMethodHandler
 	Debt                  *DebtHandler
+	Settlement            *SettlementHandler
 	GroupExpense          *groupExpenseHandler
 	ExpenseItem           *ExpenseItemHandler
 	OtherFee              *OtherFeeHandler
 	ExpenseBill           *ExpenseBillHandler
+	InboundMail           *InboundMailHandler
+	Recurring             *RecurringHandler
+	Export                *ExportHandler
+	Import                *ImportHandler
 	ProfileTransferMethod *ProfileTransferMethodHandler
 	Notification          *NotificationHandler
 	PushSubscription      *PushSubscriptionHandler
@@ -26,13 +31,18 @@ type Handlers struct {
 	Payment               *PaymentHandler
 	Plan                  *PlanHandler
 	Public                *PublicHandler
+	Storage               *StorageHandler
+	Category              *CategoryHandler
+	Analytics             *AnalyticsHandler
+	Group                 *GroupHandler
+	ProfileClaim          *ProfileClaimHandler
 }
 
 func (h *Handlers) Shutdown() {
 	h.Auth.emailLimiter.Stop()
 }
 
-func ProvideHandlers(services *provider.Services, cookieCfg cookie.Config) *Handlers {
+func ProvideHandlers(services *provider.Services, cookieCfg cookie.Config, inboundMailSecret string) *Handlers {
 	return &Handlers{
 		NewAuthHandler(services.Auth, services.OAuth, services.Session, services.Captcha, cookieCfg, middlewares.NewValueLimiter(3.0/3600, 3, time.Hour)),
 		NewFriendshipHandler(services.Friendship, services.FriendDetails, services.Debt),
@@ -40,10 +50,15 @@ func ProvideHandlers(services *provider.Services, cookieCfg cookie.Config) *Hand
 		NewProfileHandler(services.Profile),
 		NewTransferMethodHandler(services.TransferMethod),
 		NewDebtHandler(services.Debt),
+		NewSettlementHandler(services.Settlement),
 		newGroupExpenseHandler(services.GroupExpense),
 		NewExpenseItemHandler(services.ExpenseItem),
 		NewOtherFeeHandler(services.OtherFee),
 		NewExpenseBillHandler(services.ExpenseBill),
+		NewInboundMailHandler(services.InboundMail, inboundMailSecret),
+		NewRecurringHandler(services.Recurring),
+		NewExportHandler(services.Export),
+		NewImportHandler(services.Import),
 		&ProfileTransferMethodHandler{services.ProfileTransferMethod},
 		NewNotificationHandler(services.Notification),
 		NewPushSubscriptionHandler(services.PushNotification),
@@ -51,5 +66,10 @@ func ProvideHandlers(services *provider.Services, cookieCfg cookie.Config) *Hand
 		&PaymentHandler{services.Payment},
 		&PlanHandler{services.PlanVersion},
 		NewPublicHandler(services.FriendDetails),
+		NewStorageHandler(services.Storage),
+		NewCategoryHandler(services.Category),
+		NewAnalyticsHandler(services.Analytics),
+		NewGroupHandler(services.Group),
+		NewProfileClaimHandler(services.ProfileClaim),
 	}
 }
@@ -0,0 +1,107 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/appconstant"
+	"github.com/itsLeonB/cashback/internal/domain/dto"
+	"github.com/itsLeonB/cashback/internal/domain/service"
+	_ "github.com/itsLeonB/ginkgo/pkg/response"
+	"github.com/itsLeonB/ginkgo/pkg/server"
+)
+
+type ImportHandler struct {
+	importService service.ImportService
+}
+
+func NewImportHandler(importService service.ImportService) *ImportHandler {
+	return &ImportHandler{importService}
+}
+
+// HandleCreate godoc
+// @Summary      Import transactions from a file
+// @Description  Queues a generic CSV (date, description, amount, paid_by, split_with and an optional currency) or a Splitwise export to be imported.
+// @Description  Names not matching a friend become anonymous friends. With dryRun, the import stops at a preview that can be confirmed afterwards.
+// @Tags         imports
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body body dto.NewImportRequest true "New import payload"
+// @Success      202  {object}  response.JSONResponse[dto.ImportResponse]
+// @Failure      400  {object}  map[string]any
+// @Failure      401  {object}  map[string]any
+// @Router       /imports [post]
+func (ih *ImportHandler) HandleCreate() gin.HandlerFunc {
+	return server.Handler("ImportHandler.HandleCreate", http.StatusAccepted, func(ctx *gin.Context) (any, error) {
+		profileID, err := getProfileID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		request, err := server.BindJSON[dto.NewImportRequest](ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		request.ProfileID = profileID
+
+		return ih.importService.Request(ctx.Request.Context(), request)
+	})
+}
+
+// HandleGetDetails godoc
+// @Summary      Get an import
+// @Description  Returns the import status and progress, with the preview once the file is parsed.
+// @Tags         imports
+// @Security     BearerAuth
+// @Produce      json
+// @Param        importId path string true "Import ID"
+// @Success      200  {object}  response.JSONResponse[dto.ImportResponse]
+// @Failure      401  {object}  map[string]any
+// @Failure      404  {object}  map[string]any
+// @Router       /imports/{importId} [get]
+func (ih *ImportHandler) HandleGetDetails() gin.HandlerFunc {
+	return server.Handler("ImportHandler.HandleGetDetails", http.StatusOK, func(ctx *gin.Context) (any, error) {
+		profileID, err := getProfileID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		importID, err := server.GetRequiredPathParam[uuid.UUID](ctx, appconstant.ContextImportID.String())
+		if err != nil {
+			return nil, err
+		}
+
+		return ih.importService.GetByID(ctx.Request.Context(), profileID, importID)
+	})
+}
+
+// HandleConfirm godoc
+// @Summary      Confirm a previewed import
+// @Description  Queues a dry run import to be recorded for real.
+// @Tags         imports
+// @Security     BearerAuth
+// @Produce      json
+// @Param        importId path string true "Import ID"
+// @Success      202  {object}  response.JSONResponse[dto.ImportResponse]
+// @Failure      401  {object}  map[string]any
+// @Failure      404  {object}  map[string]any
+// @Failure      422  {object}  map[string]any
+// @Router       /imports/{importId}/confirmation [post]
+func (ih *ImportHandler) HandleConfirm() gin.HandlerFunc {
+	return server.Handler("ImportHandler.HandleConfirm", http.StatusAccepted, func(ctx *gin.Context) (any, error) {
+		profileID, err := getProfileID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		importID, err := server.GetRequiredPathParam[uuid.UUID](ctx, appconstant.ContextImportID.String())
+		if err != nil {
+			return nil, err
+		}
+
+		return ih.importService.Confirm(ctx.Request.Context(), profileID, importID)
+	})
+}
@@ -0,0 +1,64 @@
+package handler
+
+import (
+	"crypto/subtle"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/itsLeonB/cashback/internal/core/service/storage"
+	_ "github.com/itsLeonB/cashback/internal/domain/dto"
+	"github.com/itsLeonB/cashback/internal/domain/service"
+	_ "github.com/itsLeonB/ginkgo/pkg/response"
+	"github.com/itsLeonB/ginkgo/pkg/server"
+	"github.com/itsLeonB/ungerr"
+)
+
+// inboundMailSecretHeader carries the shared secret configured on the mail provider's inbound webhook.
+const inboundMailSecretHeader = "X-Inbound-Mail-Secret"
+
+// maxInboundMailSize leaves room for a bill file of the maximum size encoded in base64.
+const maxInboundMailSize = 2 * storage.MaxFileSize
+
+type InboundMailHandler struct {
+	svc    service.InboundMailService
+	secret string
+}
+
+func NewInboundMailHandler(svc service.InboundMailService, secret string) *InboundMailHandler {
+	return &InboundMailHandler{svc, secret}
+}
+
+// HandleReceive godoc
+// @Summary      Receive a forwarded receipt
+// @Description  Webhook for the mail provider. The request body is the raw MIME message; its sender must be a verified user authenticated by the provider's Authentication-Results header, for whom a draft expense is created from the attached or inline receipt.
+// @Tags         inbound-mail
+// @Accept       message/rfc822
+// @Produce      json
+// @Param        X-Inbound-Mail-Secret header string true "Inbound mail secret"
+// @Success      201  {object}  response.JSONResponse[dto.InboundMailResponse]
+// @Failure      400  {object}  map[string]any
+// @Failure      403  {object}  map[string]any
+// @Failure      404  {object}  map[string]any
+// @Failure      422  {object}  map[string]any
+// @Router       /inbound-mail [post]
+func (imh *InboundMailHandler) HandleReceive() gin.HandlerFunc {
+	return server.Handler("InboundMailHandler.HandleReceive", http.StatusCreated, func(ctx *gin.Context) (any, error) {
+		if imh.secret == "" {
+			return nil, ungerr.NotFoundError("route not found")
+		}
+		if subtle.ConstantTimeCompare([]byte(ctx.GetHeader(inboundMailSecretHeader)), []byte(imh.secret)) != 1 {
+			return nil, ungerr.ForbiddenError("invalid inbound mail secret")
+		}
+
+		raw, err := io.ReadAll(io.LimitReader(ctx.Request.Body, maxInboundMailSize+1))
+		if err != nil {
+			return nil, ungerr.BadRequestError("failed to read request body")
+		}
+		if len(raw) > maxInboundMailSize {
+			return nil, ungerr.BadRequestError("mail is too large")
+		}
+
+		return imh.svc.Receive(ctx.Request.Context(), raw)
+	})
+}
@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/appconstant"
+	"github.com/itsLeonB/cashback/internal/domain/dto"
+	"github.com/itsLeonB/cashback/internal/domain/service"
+	_ "github.com/itsLeonB/ginkgo/pkg/response"
+	"github.com/itsLeonB/ginkgo/pkg/server"
+	"github.com/itsLeonB/ungerr"
+)
+
+type ProfileClaimHandler struct {
+	profileClaimService service.ProfileClaimService
+}
+
+func NewProfileClaimHandler(profileClaimService service.ProfileClaimService) *ProfileClaimHandler {
+	return &ProfileClaimHandler{profileClaimService}
+}
+
+// HandleCreateLink godoc
+// @Summary      Create a link for an anonymous friend to claim their profile
+// @Tags         profiles
+// @Security     BearerAuth
+// @Produce      json
+// @Param        profileId path string true "Anonymous friend's profile ID"
+// @Success      201  {object}  response.JSONResponse[dto.ProfileClaimLinkResponse]
+// @Failure      401  {object}  map[string]any
+// @Failure      404  {object}  map[string]any
+// @Failure      409  {object}  map[string]any
+// @Failure      422  {object}  map[string]any
+// @Router       /profiles/{profileId}/claim-links [post]
+func (pch *ProfileClaimHandler) HandleCreateLink() gin.HandlerFunc {
+	return server.Handler("ProfileClaimHandler.HandleCreateLink", http.StatusCreated, func(ctx *gin.Context) (any, error) {
+		profileID, err := getProfileID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		anonProfileID, err := server.GetRequiredPathParam[uuid.UUID](ctx, appconstant.ContextProfileID.String())
+		if err != nil {
+			return nil, err
+		}
+
+		return pch.profileClaimService.CreateLink(ctx.Request.Context(), profileID, anonProfileID)
+	})
+}
+
+// HandlePreview godoc
+// @Summary      Preview the profile a claim link is for
+// @Tags         public
+// @Produce      json
+// @Param        token query string true "Claim token"
+// @Success      200  {object}  response.JSONResponse[dto.ProfileClaimPreviewResponse]
+// @Failure      400  {object}  map[string]any
+// @Failure      409  {object}  map[string]any
+// @Failure      422  {object}  map[string]any
+// @Router       /public/profile-claims [get]
+func (pch *ProfileClaimHandler) HandlePreview() gin.HandlerFunc {
+	return server.Handler("ProfileClaimHandler.HandlePreview", http.StatusOK, func(ctx *gin.Context) (any, error) {
+		token := ctx.Query("token")
+		if token == "" {
+			return nil, ungerr.BadRequestError("token is required")
+		}
+		return pch.profileClaimService.Preview(ctx.Request.Context(), token)
+	})
+}
+
+// HandleClaim godoc
+// @Summary      Claim an anonymous profile with a claim link
+// @Tags         profile
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body body dto.ClaimProfileRequest true "Claim payload"
+// @Success      200  {object}  response.JSONResponse[dto.ClaimProfileResponse]
+// @Failure      400  {object}  map[string]any
+// @Failure      401  {object}  map[string]any
+// @Failure      409  {object}  map[string]any
+// @Failure      422  {object}  map[string]any
+// @Router       /profile/claim [post]
+func (pch *ProfileClaimHandler) HandleClaim() gin.HandlerFunc {
+	return server.Handler("ProfileClaimHandler.HandleClaim", http.StatusOK, func(ctx *gin.Context) (any, error) {
+		profileID, err := getProfileID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		request, err := server.BindJSON[dto.ClaimProfileRequest](ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return pch.profileClaimService.Claim(ctx.Request.Context(), profileID, request.Token)
+	})
+}
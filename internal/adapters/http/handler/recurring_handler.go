@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/appconstant"
+	"github.com/itsLeonB/cashback/internal/domain/dto"
+	"github.com/itsLeonB/cashback/internal/domain/service"
+	_ "github.com/itsLeonB/ginkgo/pkg/response"
+	"github.com/itsLeonB/ginkgo/pkg/server"
+)
+
+type RecurringHandler struct {
+	recurringService service.RecurringService
+}
+
+func NewRecurringHandler(recurringService service.RecurringService) *RecurringHandler {
+	return &RecurringHandler{recurringService}
+}
+
+// HandleCreate godoc
+// @Summary      Create a recurring template
+// @Description  Creates a template that stamps out a group expense or a debt transaction on a cron schedule (e.g. "0 9 1 * *").
+// @Description  Group expenses are created as drafts and the creator is notified, unless autoConfirm is set.
+// @Tags         recurring-templates
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body body dto.NewRecurringTemplateRequest true "New recurring template payload"
+// @Success      201  {object}  response.JSONResponse[dto.RecurringTemplateResponse]
+// @Failure      400  {object}  map[string]any
+// @Failure      401  {object}  map[string]any
+// @Failure      422  {object}  map[string]any
+// @Router       /recurring-templates [post]
+func (rh *RecurringHandler) HandleCreate() gin.HandlerFunc {
+	return server.Handler("RecurringHandler.HandleCreate", http.StatusCreated, func(ctx *gin.Context) (any, error) {
+		profileID, err := getProfileID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		request, err := server.BindJSON[dto.NewRecurringTemplateRequest](ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		request.CreatorProfileID = profileID
+
+		return rh.recurringService.Create(ctx.Request.Context(), request)
+	})
+}
+
+// HandleGetAll godoc
+// @Summary      Get all recurring templates of the user
+// @Tags         recurring-templates
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.JSONResponse[[]dto.RecurringTemplateResponse]
+// @Failure      401  {object}  map[string]any
+// @Router       /recurring-templates [get]
+func (rh *RecurringHandler) HandleGetAll() gin.HandlerFunc {
+	return server.Handler("RecurringHandler.HandleGetAll", http.StatusOK, func(ctx *gin.Context) (any, error) {
+		profileID, err := getProfileID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return rh.recurringService.GetAll(ctx.Request.Context(), profileID)
+	})
+}
+
+// HandleDelete godoc
+// @Summary      Delete a recurring template
+// @Tags         recurring-templates
+// @Security     BearerAuth
+// @Param        recurringTemplateId path string true "Recurring template ID"
+// @Success      204
+// @Failure      401  {object}  map[string]any
+// @Failure      404  {object}  map[string]any
+// @Router       /recurring-templates/{recurringTemplateId} [delete]
+func (rh *RecurringHandler) HandleDelete() gin.HandlerFunc {
+	return server.Handler("RecurringHandler.HandleDelete", http.StatusNoContent, func(ctx *gin.Context) (any, error) {
+		profileID, err := getProfileID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		templateID, err := server.GetRequiredPathParam[uuid.UUID](ctx, appconstant.ContextRecurringID.String())
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, rh.recurringService.Delete(ctx.Request.Context(), profileID, templateID)
+	})
+}
@@ -0,0 +1,80 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/appconstant"
+	"github.com/itsLeonB/cashback/internal/domain/dto"
+	"github.com/itsLeonB/cashback/internal/domain/service"
+	_ "github.com/itsLeonB/ginkgo/pkg/response"
+	"github.com/itsLeonB/ginkgo/pkg/server"
+)
+
+type SettlementHandler struct {
+	settlementService service.SettlementService
+}
+
+func NewSettlementHandler(settlementService service.SettlementService) *SettlementHandler {
+	return &SettlementHandler{settlementService}
+}
+
+// HandleCreate godoc
+// @Summary      Record a settlement
+// @Description  Records a repayment between the user and a friend and marks the covered debts as settled.
+// @Description  When proofFileName is given, the response contains a presigned URL to upload the proof of transfer.
+// @Tags         settlements
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body body dto.NewSettlementRequest true "New settlement payload"
+// @Success      201  {object}  response.JSONResponse[dto.SettlementResponse]
+// @Failure      400  {object}  map[string]any
+// @Failure      401  {object}  map[string]any
+// @Failure      409  {object}  map[string]any
+// @Failure      422  {object}  map[string]any
+// @Router       /settlements [post]
+func (sh *SettlementHandler) HandleCreate() gin.HandlerFunc {
+	return server.Handler("SettlementHandler.HandleCreate", http.StatusCreated, func(ctx *gin.Context) (any, error) {
+		profileID, err := getProfileID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		request, err := server.BindJSON[dto.NewSettlementRequest](ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		request.UserProfileID = profileID
+
+		return sh.settlementService.Record(ctx.Request.Context(), request)
+	})
+}
+
+// HandleGetDetails godoc
+// @Summary      Get settlement details
+// @Tags         settlements
+// @Security     BearerAuth
+// @Produce      json
+// @Param        settlementId path string true "Settlement ID"
+// @Success      200  {object}  response.JSONResponse[dto.SettlementResponse]
+// @Failure      401  {object}  map[string]any
+// @Failure      404  {object}  map[string]any
+// @Router       /settlements/{settlementId} [get]
+func (sh *SettlementHandler) HandleGetDetails() gin.HandlerFunc {
+	return server.Handler("SettlementHandler.HandleGetDetails", http.StatusOK, func(ctx *gin.Context) (any, error) {
+		profileID, err := getProfileID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		settlementID, err := server.GetRequiredPathParam[uuid.UUID](ctx, appconstant.ContextSettlementID.String())
+		if err != nil {
+			return nil, err
+		}
+
+		return sh.settlementService.GetByID(ctx.Request.Context(), profileID, settlementID)
+	})
+}
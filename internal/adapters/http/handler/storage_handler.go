@@ -0,0 +1,144 @@
+package handler
+
+import (
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/itsLeonB/cashback/internal/appconstant"
+	"github.com/itsLeonB/cashback/internal/core/service/storage"
+	"github.com/itsLeonB/ungerr"
+)
+
+// inlineContentTypes are the stored file types safe to render in the browser.
+var inlineContentTypes = []string{
+	"application/pdf",
+	"image/gif",
+	"image/jpeg",
+	"image/png",
+	"image/webp",
+}
+
+// StorageHandler serves the signed URLs of storage backends that do not have a server of their own,
+// i.e. the local disk backend. With any other backend the routes answer 404.
+type StorageHandler struct {
+	storageRepo storage.StorageRepository
+	verifier    storage.SignedURLVerifier
+}
+
+func NewStorageHandler(storageRepo storage.StorageRepository) *StorageHandler {
+	verifier, _ := storageRepo.(storage.SignedURLVerifier)
+	return &StorageHandler{storageRepo, verifier}
+}
+
+// HandleDownload godoc
+// @Summary      Download a stored file
+// @Description  Target of the signed download URLs handed out when files are stored on local disk.
+// @Tags         storage
+// @Produce      octet-stream
+// @Param        bucketName path string true "Bucket name"
+// @Param        objectKey path string true "Object key"
+// @Param        expires query int true "Expiry as a Unix timestamp"
+// @Param        signature query string true "URL signature"
+// @Success      200
+// @Failure      403  {object}  map[string]any
+// @Failure      404  {object}  map[string]any
+// @Router       /storage/{bucketName}/{objectKey} [get]
+func (sh *StorageHandler) HandleDownload() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		fileID, err := sh.verify(ctx)
+		if err != nil {
+			_ = ctx.Error(err)
+			return
+		}
+
+		data, err := sh.storageRepo.Download(ctx.Request.Context(), fileID)
+		if err != nil {
+			_ = ctx.Error(err)
+			return
+		}
+
+		contentType := mime.TypeByExtension(path.Ext(fileID.ObjectKey))
+		if contentType == "" {
+			contentType = http.DetectContentType(data)
+		}
+
+		// Files come from users and are served from the API origin, so anything that is not
+		// a plain image or PDF is only ever downloaded, never rendered
+		disposition := "inline"
+		if mediaType, _, _ := mime.ParseMediaType(contentType); !slices.Contains(inlineContentTypes, mediaType) {
+			contentType = "application/octet-stream"
+			disposition = "attachment"
+		}
+
+		ctx.Header("Cache-Control", "private, max-age=3600")
+		ctx.Header("X-Content-Type-Options", "nosniff")
+		ctx.Header("Content-Disposition", mime.FormatMediaType(disposition, map[string]string{"filename": path.Base(fileID.ObjectKey)}))
+		ctx.Data(http.StatusOK, contentType, data)
+	}
+}
+
+// HandleUpload godoc
+// @Summary      Upload a file
+// @Description  Target of the signed upload URLs handed out when files are stored on local disk. The request body is the file.
+// @Tags         storage
+// @Accept       octet-stream
+// @Param        bucketName path string true "Bucket name"
+// @Param        objectKey path string true "Object key"
+// @Param        expires query int true "Expiry as a Unix timestamp"
+// @Param        signature query string true "URL signature"
+// @Success      200
+// @Failure      403  {object}  map[string]any
+// @Failure      413  {object}  map[string]any
+// @Router       /storage/{bucketName}/{objectKey} [put]
+func (sh *StorageHandler) HandleUpload() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		fileID, err := sh.verify(ctx)
+		if err != nil {
+			_ = ctx.Error(err)
+			return
+		}
+
+		data, err := io.ReadAll(io.LimitReader(ctx.Request.Body, storage.MaxFileSize+1))
+		if err != nil {
+			_ = ctx.Error(ungerr.BadRequestError("failed to read request body"))
+			return
+		}
+		if len(data) > storage.MaxFileSize {
+			ctx.AbortWithStatus(http.StatusRequestEntityTooLarge)
+			return
+		}
+
+		if err = sh.storageRepo.Upload(ctx.Request.Context(), &storage.StorageUploadRequest{
+			Data:           data,
+			ContentType:    ctx.ContentType(),
+			FileIdentifier: fileID,
+		}); err != nil {
+			_ = ctx.Error(err)
+			return
+		}
+
+		ctx.Status(http.StatusOK)
+	}
+}
+
+func (sh *StorageHandler) verify(ctx *gin.Context) (storage.FileIdentifier, error) {
+	if sh.verifier == nil {
+		return storage.FileIdentifier{}, ungerr.NotFoundError("route not found")
+	}
+
+	fileID := storage.FileIdentifier{
+		BucketName: ctx.Param(appconstant.ContextBucketName.String()),
+		ObjectKey:  strings.TrimPrefix(ctx.Param(appconstant.ContextObjectKey.String()), "/"),
+	}
+
+	if err := sh.verifier.VerifySignedURL(ctx.Request.Method, fileID, ctx.Request.URL.Query()); err != nil {
+		return storage.FileIdentifier{}, err
+	}
+
+	return fileID, nil
+}
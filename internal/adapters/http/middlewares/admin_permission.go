@@ -0,0 +1,43 @@
+package middlewares
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+	"github.com/itsLeonB/cashback/internal/appconstant"
+	"github.com/itsLeonB/cashback/internal/domain/entity/admin"
+	"github.com/itsLeonB/ungerr"
+)
+
+// AdminPermission lets the request through only if the admin's roles allow the action on
+// the resource, taking the action from the HTTP method. It runs after the admin auth middleware.
+func AdminPermission(resource admin.Resource) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		permission := admin.NewPermission(resource, actionOf(c.Request.Method))
+
+		value, _ := c.Get(appconstant.ContextAdminPermissions.String())
+		permissions, _ := value.([]admin.Permission)
+		if !slices.Contains(permissions, permission) {
+			_ = c.Error(ungerr.ForbiddenError(fmt.Sprintf("missing permission %s", permission)))
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+func actionOf(method string) admin.Action {
+	switch method {
+	case http.MethodPost:
+		return admin.CreateAction
+	case http.MethodPut, http.MethodPatch:
+		return admin.UpdateAction
+	case http.MethodDelete:
+		return admin.DeleteAction
+	default:
+		return admin.ReadAction
+	}
+}
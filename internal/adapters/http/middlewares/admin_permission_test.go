@@ -0,0 +1,67 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/itsLeonB/cashback/internal/appconstant"
+	"github.com/itsLeonB/cashback/internal/domain/entity/admin"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupAdminPermissionRouter(permissions []admin.Permission) *gin.Engine {
+	r := gin.New()
+	r.Use(func(c *gin.Context) {
+		if permissions != nil {
+			c.Set(appconstant.ContextAdminPermissions.String(), permissions)
+		}
+		c.Next()
+	})
+	r.Use(AdminPermission(admin.PlanResource))
+	r.GET("/plans", func(c *gin.Context) { c.String(http.StatusOK, "executed") })
+	r.DELETE("/plans", func(c *gin.Context) { c.String(http.StatusOK, "executed") })
+	return r
+}
+
+func TestAdminPermission_Allowed(t *testing.T) {
+	r := setupAdminPermissionRouter([]admin.Permission{admin.NewPermission(admin.PlanResource, admin.ReadAction)})
+	req := httptest.NewRequest(http.MethodGet, "/plans", nil)
+	w := httptest.NewRecorder()
+
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "executed", w.Body.String())
+}
+
+func TestAdminPermission_MissingAction(t *testing.T) {
+	r := setupAdminPermissionRouter([]admin.Permission{admin.NewPermission(admin.PlanResource, admin.ReadAction)})
+	req := httptest.NewRequest(http.MethodDelete, "/plans", nil)
+	w := httptest.NewRecorder()
+
+	r.ServeHTTP(w, req)
+
+	assert.NotContains(t, w.Body.String(), "executed")
+}
+
+func TestAdminPermission_OtherResource(t *testing.T) {
+	r := setupAdminPermissionRouter([]admin.Permission{admin.NewPermission(admin.PaymentResource, admin.ReadAction)})
+	req := httptest.NewRequest(http.MethodGet, "/plans", nil)
+	w := httptest.NewRecorder()
+
+	r.ServeHTTP(w, req)
+
+	assert.NotContains(t, w.Body.String(), "executed")
+}
+
+func TestAdminPermission_NoPermissions(t *testing.T) {
+	r := setupAdminPermissionRouter(nil)
+	req := httptest.NewRequest(http.MethodGet, "/plans", nil)
+	w := httptest.NewRecorder()
+
+	r.ServeHTTP(w, req)
+
+	assert.NotContains(t, w.Body.String(), "executed")
+}
@@ -28,7 +28,7 @@ func RegisterRoutes(router *gin.Engine, configs config.Config, services *provide
 		RefreshTTL: configs.RefreshTokenDuration,
 	}
 
-	handlers := handler.ProvideHandlers(services, cookieCfg)
+	handlers := handler.ProvideHandlers(services, cookieCfg, configs.Mail.InboundSecret)
 	adminHandlers := adminHandler.ProvideHandlers(adminServices, services)
 	mw := middlewares.Provide(configs.App, services.Auth, adminServices.Auth)
 
@@ -5,7 +5,9 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/itsLeonB/cashback/internal/adapters/http/handler/admin"
+	"github.com/itsLeonB/cashback/internal/adapters/http/middlewares"
 	"github.com/itsLeonB/cashback/internal/appconstant"
+	entity "github.com/itsLeonB/cashback/internal/domain/entity/admin"
 )
 
 func RegisterAdminRoutes(router *gin.Engine, handlers *admin.Handlers, authMiddleware gin.HandlerFunc) {
@@ -23,7 +25,7 @@ func RegisterAdminRoutes(router *gin.Engine, handlers *admin.Handlers, authMiddl
 			{
 				protectedRoutes.GET("/auth/me", handlers.Auth.HandleMe())
 
-				planRoutes := protectedRoutes.Group("/plans")
+				planRoutes := protectedRoutes.Group("/plans", middlewares.AdminPermission(entity.PlanResource))
 				{
 					planRoutes.POST("", handlers.Plan.HandleCreate())
 					planRoutes.GET("", handlers.Plan.HandleGetList())
@@ -32,7 +34,7 @@ func RegisterAdminRoutes(router *gin.Engine, handlers *admin.Handlers, authMiddl
 					planRoutes.DELETE(fmt.Sprintf("/:%s", appconstant.ContextPlanID.String()), handlers.Plan.HandleDelete())
 				}
 
-				planVersionRoutes := protectedRoutes.Group("/plan-versions")
+				planVersionRoutes := protectedRoutes.Group("/plan-versions", middlewares.AdminPermission(entity.PlanVersionResource))
 				{
 					planVersionRoutes.POST("", handlers.PlanVersion.HandleCreate())
 					planVersionRoutes.GET("", handlers.PlanVersion.HandleGetList())
@@ -41,7 +43,7 @@ func RegisterAdminRoutes(router *gin.Engine, handlers *admin.Handlers, authMiddl
 					planVersionRoutes.DELETE(fmt.Sprintf("/:%s", appconstant.ContextPlanVersionID.String()), handlers.PlanVersion.HandleDelete())
 				}
 
-				subscriptionRoutes := protectedRoutes.Group("/subscriptions")
+				subscriptionRoutes := protectedRoutes.Group("/subscriptions", middlewares.AdminPermission(entity.SubscriptionResource))
 				{
 					subscriptionRoutes.POST("", handlers.Subscription.HandleCreate())
 					subscriptionRoutes.GET("", handlers.Subscription.HandleGetList())
@@ -50,7 +52,7 @@ func RegisterAdminRoutes(router *gin.Engine, handlers *admin.Handlers, authMiddl
 					subscriptionRoutes.DELETE(fmt.Sprintf("/:%s", appconstant.ContextSubscriptionID.String()), handlers.Subscription.HandleDelete())
 				}
 
-				paymentRoutes := protectedRoutes.Group("/payments")
+				paymentRoutes := protectedRoutes.Group("/payments", middlewares.AdminPermission(entity.PaymentResource))
 				{
 					paymentRoutes.GET("", handlers.Payment.HandleGetList())
 					paymentRoutes.GET(fmt.Sprintf("/:%s", appconstant.ContextPaymentID.String()), handlers.Payment.HandleGetOne())
@@ -58,11 +60,37 @@ func RegisterAdminRoutes(router *gin.Engine, handlers *admin.Handlers, authMiddl
 					paymentRoutes.DELETE(fmt.Sprintf("/:%s", appconstant.ContextPaymentID.String()), handlers.Payment.HandleDelete())
 				}
 
-				profileRoutes := protectedRoutes.Group("/profiles")
+				profileRoutes := protectedRoutes.Group("/profiles", middlewares.AdminPermission(entity.ProfileResource))
 				{
 					profileRoutes.GET("", handlers.Profile.HandleGetList())
 					profileRoutes.GET(fmt.Sprintf("/:%s", appconstant.ContextProfileID.String()), handlers.Profile.HandleGetOne())
 				}
+
+				fxRateRoutes := protectedRoutes.Group("/fx-rates", middlewares.AdminPermission(entity.FxRateResource))
+				{
+					fxRateRoutes.POST("", handlers.FxRate.HandleUpload())
+					fxRateRoutes.GET("", handlers.FxRate.HandleGetList())
+				}
+
+				roleRoutes := protectedRoutes.Group("/roles", middlewares.AdminPermission(entity.RoleResource))
+				{
+					roleRoutes.GET("/permissions", handlers.Role.HandleGetPermissions())
+					roleRoutes.POST("", handlers.Role.HandleCreate())
+					roleRoutes.GET("", handlers.Role.HandleGetList())
+					roleRoutes.PUT(fmt.Sprintf("/:%s", appconstant.ContextRoleID.String()), handlers.Role.HandleUpdate())
+					roleRoutes.DELETE(fmt.Sprintf("/:%s", appconstant.ContextRoleID.String()), handlers.Role.HandleDelete())
+				}
+
+				userRoutes := protectedRoutes.Group("/users", middlewares.AdminPermission(entity.RoleResource))
+				{
+					userRoutes.GET("", handlers.Role.HandleGetUsers())
+					userRoutes.PUT(fmt.Sprintf("/:%s/roles", appconstant.ContextAdminUserID.String()), handlers.Role.HandleSetUserRoles())
+				}
+
+				auditLogRoutes := protectedRoutes.Group("/audit-logs", middlewares.AdminPermission(entity.AuditLogResource))
+				{
+					auditLogRoutes.GET("", handlers.AuditLog.HandleGetList())
+				}
 			}
 		}
 	}
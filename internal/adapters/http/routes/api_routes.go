@@ -7,19 +7,29 @@ import (
 	"github.com/itsLeonB/cashback/internal/adapters/http/handler"
 	"github.com/itsLeonB/cashback/internal/adapters/http/middlewares"
 	"github.com/itsLeonB/cashback/internal/appconstant"
+	"github.com/itsLeonB/cashback/internal/core/service/storage"
 	"github.com/kroma-labs/sentinel-go/httpserver"
 	sentinelGin "github.com/kroma-labs/sentinel-go/httpserver/adapters/gin"
 	"golang.org/x/time/rate"
 )
 
 func RegisterAPIRoutes(router *gin.Engine, handlers *handler.Handlers, authMiddleware gin.HandlerFunc) {
+	storageRoutes := router.Group(storage.LocalURLPath)
+	{
+		objectPath := fmt.Sprintf("/:%s/*%s", appconstant.ContextBucketName, appconstant.ContextObjectKey)
+		storageRoutes.GET(objectPath, handlers.Storage.HandleDownload())
+		storageRoutes.PUT(objectPath, handlers.Storage.HandleUpload())
+	}
+
 	apiRoutes := router.Group("/api")
 	{
 		v1 := apiRoutes.Group("/v1")
 		{
 			v1.POST("/payments/midtrans/notifications", handlers.Payment.HandleNotification())
+			v1.POST("/inbound-mail", handlers.InboundMail.HandleReceive())
 			v1.GET("/plans", handlers.Plan.HandleGetActive())
 			v1.GET("/public/profiles/:slug", handlers.Public.HandleGetPublicProfile())
+			v1.GET("/public/profile-claims", handlers.ProfileClaim.HandlePreview())
 
 			authRoutes := v1.Group("/auth")
 			authRoutes.Use(sentinelGin.RateLimit(httpserver.RateLimitConfig{
@@ -56,12 +66,20 @@ func RegisterAPIRoutes(router *gin.Engine, handlers *handler.Handlers, authMiddl
 			{
 				protectedRoutes.DELETE("/auth/logout", handlers.Auth.HandleLogout())
 
+				sessionRoutes := protectedRoutes.Group("/auth/sessions")
+				{
+					sessionRoutes.GET("", handlers.Auth.HandleGetSessions())
+					sessionRoutes.DELETE("", handlers.Auth.HandleRevokeOtherSessions())
+					sessionRoutes.DELETE(fmt.Sprintf("/:%s", appconstant.ContextSessionID.String()), handlers.Auth.HandleRevokeSession())
+				}
+
 				transferMethodsRoute := "/transfer-methods"
 				profileRoutes := protectedRoutes.Group("/profile")
 				{
 					profileRoutes.GET("", handlers.Profile.HandleProfile())
 					profileRoutes.PATCH("", handlers.Profile.HandleUpdate())
 					profileRoutes.POST("/associate", handlers.Profile.HandleAssociate())
+					profileRoutes.POST("/claim", handlers.ProfileClaim.HandleClaim())
 					profileRoutes.POST(transferMethodsRoute, handlers.ProfileTransferMethod.HandleAdd())
 					profileRoutes.GET(transferMethodsRoute, handlers.ProfileTransferMethod.HandleGetAllOwned())
 					profileRoutes.GET("/subscription", handlers.Subscription.HandleGetSubscribedDetails())
@@ -79,6 +97,7 @@ func RegisterAPIRoutes(router *gin.Engine, handlers *handler.Handlers, authMiddl
 				{
 					profilesRoutes.GET("", handlers.Profile.HandleSearch())
 					profilesRoutes.POST(fmt.Sprintf("/:%s/friend-requests", appconstant.ContextProfileID.String()), handlers.FriendshipRequest.HandleSend())
+					profilesRoutes.POST(fmt.Sprintf("/:%s/claim-links", appconstant.ContextProfileID.String()), handlers.ProfileClaim.HandleCreateLink())
 					profilesRoutes.GET(fmt.Sprintf("/:%s%s", appconstant.ContextProfileID.String(), transferMethodsRoute), handlers.ProfileTransferMethod.HandleGetAllByFriendProfileID())
 				}
 
@@ -107,6 +126,14 @@ func RegisterAPIRoutes(router *gin.Engine, handlers *handler.Handlers, authMiddl
 					debtsRoutes.GET("", handlers.Debt.HandleGetAll())
 					debtsRoutes.GET("/summary", handlers.Debt.HandleGetTransactionSummary())
 					debtsRoutes.GET("/recent", handlers.Debt.HandleGetRecent())
+					debtsRoutes.GET("/settle-up", handlers.Debt.HandleGetSettleUpPlan())
+					debtsRoutes.PUT(fmt.Sprintf("/:%s/labels", appconstant.ContextDebtID), handlers.Debt.HandleUpdateLabels())
+				}
+
+				settlementRoutes := protectedRoutes.Group("/settlements")
+				{
+					settlementRoutes.POST("", handlers.Settlement.HandleCreate())
+					settlementRoutes.GET(fmt.Sprintf("/:%s", appconstant.ContextSettlementID), handlers.Settlement.HandleGetDetails())
 				}
 
 				groupExpenseRoutes := protectedRoutes.Group("/group-expenses")
@@ -116,10 +143,13 @@ func RegisterAPIRoutes(router *gin.Engine, handlers *handler.Handlers, authMiddl
 					groupExpenseRoutes.GET(fmt.Sprintf("/:%s", appconstant.ContextGroupExpenseID), handlers.GroupExpense.HandleGetDetails())
 					groupExpenseRoutes.PATCH(fmt.Sprintf("/:%s/confirmed", appconstant.ContextGroupExpenseID), handlers.GroupExpense.HandleConfirmDraft())
 					groupExpenseRoutes.DELETE(fmt.Sprintf("/:%s", appconstant.ContextGroupExpenseID), handlers.GroupExpense.HandleDelete())
+					groupExpenseRoutes.POST(fmt.Sprintf("/:%s/revisions", appconstant.ContextGroupExpenseID), handlers.GroupExpense.HandleAmend())
 					groupExpenseRoutes.GET("/fee-calculation-methods", handlers.OtherFee.HandleGetFeeCalculationMethods())
 					groupExpenseRoutes.PUT(fmt.Sprintf("/:%s/participants", appconstant.ContextGroupExpenseID.String()), handlers.GroupExpense.HandleSyncParticipants())
 					groupExpenseRoutes.POST(fmt.Sprintf("/:%s/bills", appconstant.ContextGroupExpenseID.String()), handlers.ExpenseBill.HandlePresignedSave())
 					groupExpenseRoutes.PUT(fmt.Sprintf("/:%s/bills/:%s", appconstant.ContextGroupExpenseID.String(), appconstant.ContextExpenseBillID.String()), handlers.ExpenseBill.HandleTriggerParsing())
+					groupExpenseRoutes.POST(fmt.Sprintf("/:%s/bills/:%s/review", appconstant.ContextGroupExpenseID.String(), appconstant.ContextExpenseBillID.String()), handlers.ExpenseBill.HandleReview())
+					groupExpenseRoutes.PUT(fmt.Sprintf("/:%s/labels", appconstant.ContextGroupExpenseID), handlers.GroupExpense.HandleUpdateLabels())
 					groupExpenseRoutes.GET("/recent", handlers.GroupExpense.HandleGetRecent())
 				}
 
@@ -139,6 +169,48 @@ func RegisterAPIRoutes(router *gin.Engine, handlers *handler.Handlers, authMiddl
 					otherFeeRoutes.DELETE(fmt.Sprintf("/:%s", appconstant.ContextOtherFeeID), handlers.OtherFee.HandleRemove())
 				}
 
+				recurringRoutes := protectedRoutes.Group("/recurring-templates")
+				{
+					recurringRoutes.POST("", handlers.Recurring.HandleCreate())
+					recurringRoutes.GET("", handlers.Recurring.HandleGetAll())
+					recurringRoutes.DELETE(fmt.Sprintf("/:%s", appconstant.ContextRecurringID), handlers.Recurring.HandleDelete())
+				}
+
+				groupRoutes := protectedRoutes.Group("/groups")
+				{
+					groupRoute := fmt.Sprintf("/:%s", appconstant.ContextGroupID)
+					groupRoutes.POST("", handlers.Group.HandleCreate())
+					groupRoutes.GET("", handlers.Group.HandleGetAll())
+					groupRoutes.GET(groupRoute, handlers.Group.HandleGetDetails())
+					groupRoutes.PATCH(groupRoute, handlers.Group.HandleUpdate())
+					groupRoutes.DELETE(groupRoute, handlers.Group.HandleDelete())
+					groupRoutes.PUT(groupRoute+"/members", handlers.Group.HandleSyncMembers())
+					groupRoutes.GET(groupRoute+"/balances", handlers.Group.HandleGetBalances())
+					groupRoutes.GET(groupRoute+"/activities", handlers.Group.HandleGetActivities())
+				}
+
+				categoryRoutes := protectedRoutes.Group("/categories")
+				{
+					categoryRoutes.POST("", handlers.Category.HandleCreate())
+					categoryRoutes.GET("", handlers.Category.HandleGetAll())
+					categoryRoutes.DELETE(fmt.Sprintf("/:%s", appconstant.ContextCategoryID), handlers.Category.HandleDelete())
+				}
+
+				protectedRoutes.GET("/analytics/spending", handlers.Analytics.HandleGetSpending())
+
+				exportRoutes := protectedRoutes.Group("/exports")
+				{
+					exportRoutes.POST("", handlers.Export.HandleCreate())
+					exportRoutes.GET(fmt.Sprintf("/:%s", appconstant.ContextExportID), handlers.Export.HandleGetDetails())
+				}
+
+				importRoutes := protectedRoutes.Group("/imports")
+				{
+					importRoutes.POST("", handlers.Import.HandleCreate())
+					importRoutes.GET(fmt.Sprintf("/:%s", appconstant.ContextImportID), handlers.Import.HandleGetDetails())
+					importRoutes.POST(fmt.Sprintf("/:%s/confirmation", appconstant.ContextImportID), handlers.Import.HandleConfirm())
+				}
+
 				notificationRoutes := protectedRoutes.Group("/notifications")
 				{
 					notificationRoutes.GET("", handlers.Notification.HandleGetUnread())
@@ -22,6 +22,7 @@ func setupSentinel(router *gin.Engine, skipPaths []string, logger zerolog.Logger
 			"Content-Length",
 			"Accept-Encoding",
 			"X-CSRF-Token",
+			"X-Device-ID",
 			"Cache-Control",
 			"Referer",
 			"User-Agent",
@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/appconstant"
+	"github.com/itsLeonB/cashback/internal/core/otel"
+	"github.com/itsLeonB/cashback/internal/domain/entity"
+	"github.com/itsLeonB/go-crud"
+	"github.com/itsLeonB/ungerr"
+	"gorm.io/gorm"
+)
+
+type auditLogRepositoryGorm struct {
+	crud.Repository[entity.AuditLog]
+}
+
+func NewAuditLogRepository(db *gorm.DB) *auditLogRepositoryGorm {
+	return &auditLogRepositoryGorm{
+		crud.NewRepository[entity.AuditLog](db),
+	}
+}
+
+func (alr *auditLogRepositoryGorm) FindAllBySpec(ctx context.Context, spec entity.AuditLogSpecification) ([]entity.AuditLog, int64, error) {
+	ctx, span := otel.Tracer.Start(ctx, "AuditLogRepository.FindAllBySpec")
+	defer span.End()
+
+	db, err := alr.GetGormInstance(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	query := db.Model(&entity.AuditLog{})
+	if spec.ActorType != "" {
+		query = query.Where("actor_type = ?", spec.ActorType)
+	}
+	if spec.ActorID != uuid.Nil {
+		query = query.Where("actor_id = ?", spec.ActorID)
+	}
+	if spec.Action != "" {
+		query = query.Where("action = ?", spec.Action)
+	}
+	if spec.EntityType != "" {
+		query = query.Where("entity_type = ?", spec.EntityType)
+	}
+	if spec.EntityID != uuid.Nil {
+		query = query.Where("entity_id = ?", spec.EntityID)
+	}
+	if !spec.From.IsZero() {
+		query = query.Where("created_at >= ?", spec.From)
+	}
+	if !spec.To.IsZero() {
+		query = query.Where("created_at < ?", spec.To)
+	}
+
+	var total int64
+	if err = query.Count(&total).Error; err != nil {
+		return nil, 0, ungerr.Wrap(err, appconstant.ErrDataSelect)
+	}
+
+	var logs []entity.AuditLog
+	err = query.
+		Order("created_at DESC, id DESC").
+		Limit(spec.Limit).
+		Offset(spec.Offset).
+		Find(&logs).
+		Error
+
+	if err != nil {
+		return nil, 0, ungerr.Wrap(err, appconstant.ErrDataSelect)
+	}
+
+	return logs, total, nil
+}
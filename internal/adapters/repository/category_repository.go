@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/appconstant"
+	"github.com/itsLeonB/cashback/internal/core/otel"
+	"github.com/itsLeonB/cashback/internal/domain/entity"
+	"github.com/itsLeonB/go-crud"
+	"github.com/itsLeonB/ungerr"
+	"gorm.io/gorm"
+)
+
+type categoryRepositoryGorm struct {
+	crud.Repository[entity.Category]
+}
+
+func NewCategoryRepository(db *gorm.DB) *categoryRepositoryGorm {
+	return &categoryRepositoryGorm{
+		crud.NewRepository[entity.Category](db),
+	}
+}
+
+func (cr *categoryRepositoryGorm) FindAllUsable(ctx context.Context, profileID uuid.UUID) ([]entity.Category, error) {
+	ctx, span := otel.Tracer.Start(ctx, "CategoryRepository.FindAllUsable")
+	defer span.End()
+
+	db, err := cr.GetGormInstance(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var categories []entity.Category
+	err = db.
+		Where("profile_id IS NULL OR profile_id = ?", profileID).
+		Order("profile_id NULLS FIRST, name ASC").
+		Find(&categories).
+		Error
+
+	if err != nil {
+		return nil, ungerr.Wrap(err, appconstant.ErrDataSelect)
+	}
+
+	return categories, nil
+}
+
+func (cr *categoryRepositoryGorm) FindDefaultByName(ctx context.Context, name string) (entity.Category, error) {
+	ctx, span := otel.Tracer.Start(ctx, "CategoryRepository.FindDefaultByName")
+	defer span.End()
+
+	db, err := cr.GetGormInstance(ctx)
+	if err != nil {
+		return entity.Category{}, err
+	}
+
+	var category entity.Category
+	err = db.
+		Where("profile_id IS NULL AND LOWER(name) = LOWER(?)", name).
+		Take(&category).
+		Error
+
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return entity.Category{}, nil
+		}
+		return entity.Category{}, ungerr.Wrap(err, appconstant.ErrDataSelect)
+	}
+
+	return category, nil
+}
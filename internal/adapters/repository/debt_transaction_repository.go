@@ -11,6 +11,7 @@ import (
 	"github.com/itsLeonB/go-crud"
 	"github.com/itsLeonB/ungerr"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type debtTransactionRepositoryGorm struct {
@@ -42,6 +43,7 @@ func (dtr *debtTransactionRepositoryGorm) FindAllByMultipleProfileIDs(ctx contex
 		Where("lender_profile_id IN ? AND borrower_profile_id IN ?", userProfileIDs, friendProfileIDs).
 		Or("lender_profile_id IN ? AND borrower_profile_id IN ?", friendProfileIDs, userProfileIDs).
 		Preload("TransferMethod").
+		Preload("Category").
 		Order("created_at DESC").
 		Find(&transactions).
 		Error
@@ -77,6 +79,7 @@ func (dtr *debtTransactionRepositoryGorm) FindAllByProfileIDs(
 		Where(db.Where("lender_profile_id IN ?", profileIDs).
 			Or("borrower_profile_id IN ?", profileIDs)).
 		Preload("TransferMethod").
+		Preload("Category").
 		Scopes(crud.DefaultOrder())
 
 	if limit > 0 {
@@ -93,3 +96,114 @@ func (dtr *debtTransactionRepositoryGorm) FindAllByProfileIDs(
 
 	return transactions, nil
 }
+
+func (dtr *debtTransactionRepositoryGorm) FindAllByGroupExpenseIDs(ctx context.Context, groupExpenseIDs []uuid.UUID) ([]debts.DebtTransaction, error) {
+	ctx, span := otel.Tracer.Start(ctx, "DebtTransactionRepository.FindAllByGroupExpenseIDs")
+	defer span.End()
+
+	if len(groupExpenseIDs) < 1 {
+		return []debts.DebtTransaction{}, nil
+	}
+
+	var transactions []debts.DebtTransaction
+
+	db, err := dtr.GetGormInstance(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.
+		Where("group_expense_id IN ?", groupExpenseIDs).
+		Preload("TransferMethod").
+		Preload("Category").
+		Scopes(crud.DefaultOrder()).
+		Find(&transactions).
+		Error
+
+	if err != nil {
+		return nil, ungerr.Wrap(err, appconstant.ErrDataSelect)
+	}
+
+	return transactions, nil
+}
+
+func (dtr *debtTransactionRepositoryGorm) FindAllByGroupID(ctx context.Context, groupID uuid.UUID, limit int) ([]debts.DebtTransaction, error) {
+	ctx, span := otel.Tracer.Start(ctx, "DebtTransactionRepository.FindAllByGroupID")
+	defer span.End()
+
+	var transactions []debts.DebtTransaction
+
+	db, err := dtr.GetGormInstance(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := db.
+		Where("group_id = ?", groupID).
+		Preload("TransferMethod").
+		Preload("Category").
+		Order("created_at DESC")
+
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	if err = query.Find(&transactions).Error; err != nil {
+		return nil, ungerr.Wrap(err, appconstant.ErrDataSelect)
+	}
+
+	return transactions, nil
+}
+
+func (dtr *debtTransactionRepositoryGorm) FindAllByIDs(ctx context.Context, ids []uuid.UUID, forUpdate bool) ([]debts.DebtTransaction, error) {
+	ctx, span := otel.Tracer.Start(ctx, "DebtTransactionRepository.FindAllByIDs")
+	defer span.End()
+
+	if len(ids) < 1 {
+		return []debts.DebtTransaction{}, nil
+	}
+
+	var transactions []debts.DebtTransaction
+
+	db, err := dtr.GetGormInstance(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := db.Where("id IN ?", ids)
+	if forUpdate {
+		query = query.Clauses(clause.Locking{Strength: "UPDATE"})
+	}
+
+	if err = query.Scopes(crud.DefaultOrder()).Find(&transactions).Error; err != nil {
+		return nil, ungerr.Wrap(err, appconstant.ErrDataSelect)
+	}
+
+	return transactions, nil
+}
+
+func (dtr *debtTransactionRepositoryGorm) MarkSettled(ctx context.Context, ids []uuid.UUID, settlementID uuid.UUID) error {
+	ctx, span := otel.Tracer.Start(ctx, "DebtTransactionRepository.MarkSettled")
+	defer span.End()
+
+	if len(ids) < 1 {
+		return nil
+	}
+
+	db, err := dtr.GetGormInstance(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = db.
+		Model(&debts.DebtTransaction{}).
+		Where("id IN ? AND settled_by_settlement_id IS NULL", ids).
+		Update("settled_by_settlement_id", settlementID).
+		Error
+
+	if err != nil {
+		return ungerr.Wrap(err, appconstant.ErrDataUpdate)
+	}
+
+	return nil
+}
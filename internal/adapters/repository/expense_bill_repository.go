@@ -32,11 +32,11 @@ func (sr *expenseBillRepo) CountUploadedByDateRange(ctx context.Context, profile
 		return 0, err
 	}
 
+	// Every new page is logged as an upload, while reuploading over a page reuses it and is not logged again
 	var count int64
-	err = db.Model(&expenses.ExpenseBill{}).
-		Joins("JOIN group_expenses ON group_expenses.id = group_expense_bills.group_expense_id").
-		Where("group_expenses.creator_profile_id = ?", profileID).
-		Where("group_expense_bills.created_at >= ? AND group_expense_bills.created_at <= ?", start, end).
+	err = db.Model(&expenses.ExpenseBillUpload{}).
+		Where("profile_id = ?", profileID).
+		Where("created_at >= ? AND created_at <= ?", start, end).
 		Count(&count).Error
 
 	if err != nil {
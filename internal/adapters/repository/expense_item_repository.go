@@ -42,7 +42,7 @@ func (ger *expenseItemRepositoryGorm) SyncParticipants(ctx context.Context, expe
 		// For PostgreSQL
 		if err := db.Clauses(clause.OnConflict{
 			Columns:   []clause.Column{{Name: "expense_item_id"}, {Name: "profile_id"}},
-			DoUpdates: clause.AssignmentColumns([]string{"weight", "allocated_amount"}),
+			DoUpdates: clause.AssignmentColumns([]string{"weight", "share", "allocated_amount"}),
 		}).Create(&participants).Error; err != nil {
 			return ungerr.Wrap(err, appconstant.ErrDataUpdate)
 		}
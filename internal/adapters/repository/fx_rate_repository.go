@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/itsLeonB/cashback/internal/appconstant"
+	"github.com/itsLeonB/cashback/internal/core/otel"
+	"github.com/itsLeonB/cashback/internal/domain/entity"
+	"github.com/itsLeonB/go-crud"
+	"github.com/itsLeonB/ungerr"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type fxRateRepositoryGorm struct {
+	crud.Repository[entity.FxRate]
+}
+
+func NewFxRateRepository(db *gorm.DB) *fxRateRepositoryGorm {
+	return &fxRateRepositoryGorm{
+		crud.NewRepository[entity.FxRate](db),
+	}
+}
+
+func (frr *fxRateRepositoryGorm) FindLatest(ctx context.Context, baseCurrency, quoteCurrency string, date time.Time) (entity.FxRate, error) {
+	ctx, span := otel.Tracer.Start(ctx, "FxRateRepository.FindLatest")
+	defer span.End()
+
+	db, err := frr.GetGormInstance(ctx)
+	if err != nil {
+		return entity.FxRate{}, err
+	}
+
+	var rates []entity.FxRate
+	err = db.
+		Where("base_currency = ? AND quote_currency = ? AND effective_date <= ?", baseCurrency, quoteCurrency, date).
+		Order("effective_date DESC").
+		Limit(1).
+		Find(&rates).
+		Error
+
+	if err != nil {
+		return entity.FxRate{}, ungerr.Wrap(err, appconstant.ErrDataSelect)
+	}
+	if len(rates) == 0 {
+		return entity.FxRate{}, nil
+	}
+
+	return rates[0], nil
+}
+
+func (frr *fxRateRepositoryGorm) Upsert(ctx context.Context, rates []entity.FxRate) ([]entity.FxRate, error) {
+	ctx, span := otel.Tracer.Start(ctx, "FxRateRepository.Upsert")
+	defer span.End()
+
+	if len(rates) == 0 {
+		return []entity.FxRate{}, nil
+	}
+
+	db, err := frr.GetGormInstance(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "base_currency"}, {Name: "quote_currency"}, {Name: "effective_date"}},
+		DoUpdates: clause.AssignmentColumns([]string{"rate", "updated_at"}),
+	}).Create(&rates).Error
+	if err != nil {
+		return nil, ungerr.Wrap(err, appconstant.ErrDataInsert)
+	}
+
+	return rates, nil
+}
+
+// FindPage lists rates newest first, along with the total count for paging.
+func (frr *fxRateRepositoryGorm) FindPage(ctx context.Context, limit, offset int) ([]entity.FxRate, int64, error) {
+	ctx, span := otel.Tracer.Start(ctx, "FxRateRepository.FindPage")
+	defer span.End()
+
+	db, err := frr.GetGormInstance(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	query := db.Model(&entity.FxRate{})
+
+	var total int64
+	if err = query.Count(&total).Error; err != nil {
+		return nil, 0, ungerr.Wrap(err, appconstant.ErrDataSelect)
+	}
+
+	var rates []entity.FxRate
+	err = query.
+		Order("effective_date DESC, base_currency, quote_currency").
+		Limit(limit).
+		Offset(offset).
+		Find(&rates).
+		Error
+
+	if err != nil {
+		return nil, 0, ungerr.Wrap(err, appconstant.ErrDataSelect)
+	}
+
+	return rates, total, nil
+}
@@ -65,6 +65,42 @@ func (ger *groupExpenseRepositoryGorm) SyncParticipants(ctx context.Context, gro
 	return nil
 }
 
+func (ger *groupExpenseRepositoryGorm) SyncPayers(ctx context.Context, groupExpenseID uuid.UUID, payers []expenses.ExpensePayer) error {
+	ctx, span := otel.Tracer.Start(ctx, "GroupExpenseRepository.SyncPayers")
+	defer span.End()
+
+	db, err := ger.GetGormInstance(ctx)
+	if err != nil {
+		return err
+	}
+
+	profileIDs := make([]uuid.UUID, len(payers))
+	for i, p := range payers {
+		payers[i].GroupExpenseID = groupExpenseID
+		profileIDs[i] = p.ProfileID
+	}
+
+	if len(payers) > 0 {
+		if err := db.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "group_expense_id"}, {Name: "profile_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"paid_amount"}),
+		}).Create(&payers).Error; err != nil {
+			return ungerr.Wrap(err, appconstant.ErrDataUpdate)
+		}
+	}
+
+	query := db.Where("group_expense_id = ?", groupExpenseID)
+	if len(profileIDs) > 0 {
+		query = query.Where("profile_id NOT IN ?", profileIDs)
+	}
+
+	if err := query.Delete(&expenses.ExpensePayer{}).Error; err != nil {
+		return ungerr.Wrap(err, "error deleting removed payers")
+	}
+
+	return nil
+}
+
 func (ger *groupExpenseRepositoryGorm) DeleteItemParticipants(ctx context.Context, expenseID uuid.UUID, newParticipantProfileIDs []uuid.UUID) error {
 	ctx, span := otel.Tracer.Start(ctx, "GroupExpenseRepository.DeleteItemParticipants")
 	defer span.End()
@@ -102,7 +138,7 @@ func (ger *groupExpenseRepositoryGorm) FindAllByOwnership(ctx context.Context, p
 	}
 
 	var groupExpenses []expenses.GroupExpense
-	query := db.Preload("Items").Preload("OtherFees").Preload("Participants").Preload("Payer").Preload("Creator")
+	query := db.Preload("Items").Preload("OtherFees").Preload("Participants").Preload("Payer").Preload("Payers.Profile").Preload("Creator")
 
 	switch ownership {
 	case expenses.OwnedExpense:
@@ -163,3 +199,126 @@ func (ger *groupExpenseRepositoryGorm) FindRecentByProfileID(ctx context.Context
 
 	return groupExpenses, nil
 }
+
+func (ger *groupExpenseRepositoryGorm) FindAllByGroupID(ctx context.Context, groupID uuid.UUID, limit int) ([]expenses.GroupExpense, error) {
+	ctx, span := otel.Tracer.Start(ctx, "GroupExpenseRepository.FindAllByGroupID")
+	defer span.End()
+
+	db, err := ger.GetGormInstance(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var groupExpenses []expenses.GroupExpense
+
+	query := db.Preload("Creator").
+		Where("group_id = ?", groupID).
+		Order("created_at DESC")
+
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	if err = query.Find(&groupExpenses).Error; err != nil {
+		return nil, ungerr.Wrap(err, appconstant.ErrDataSelect)
+	}
+
+	return groupExpenses, nil
+}
+
+func (ger *groupExpenseRepositoryGorm) FindAllByIDs(ctx context.Context, ids []uuid.UUID) ([]expenses.GroupExpense, error) {
+	ctx, span := otel.Tracer.Start(ctx, "GroupExpenseRepository.FindAllByIDs")
+	defer span.End()
+
+	if len(ids) < 1 {
+		return []expenses.GroupExpense{}, nil
+	}
+
+	db, err := ger.GetGormInstance(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var groupExpenses []expenses.GroupExpense
+	if err = db.Preload("Participants").Where("id IN ?", ids).Find(&groupExpenses).Error; err != nil {
+		return nil, ungerr.Wrap(err, appconstant.ErrDataSelect)
+	}
+
+	return groupExpenses, nil
+}
+
+// expensePayersRatio is each payer's part of what was paid for an expense with several payers.
+// Expenses with a single payer have no payer rows and are credited to group_expenses.payer_profile_id.
+const expensePayersRatio = `(
+	SELECT group_expense_id, profile_id, paid_amount / SUM(paid_amount) OVER (PARTITION BY group_expense_id) AS ratio
+	FROM group_expense_payers
+) AS expense_payers`
+
+// spendingKeys are the key and label each spending grouping is summed up by.
+var spendingKeys = map[expenses.SpendingGrouping][2]string{
+	expenses.SpendingByCategory: {"COALESCE(group_expenses.category_id::text, '')", "COALESCE(categories.name, '')"},
+	expenses.SpendingByMonth:    {"to_char(date_trunc('month', group_expenses.created_at), 'YYYY-MM')", "to_char(date_trunc('month', group_expenses.created_at), 'YYYY-MM')"},
+	expenses.SpendingByFriend:   {"COALESCE(COALESCE(expense_payers.profile_id, group_expenses.payer_profile_id)::text, '')", "COALESCE(user_profiles.name, '')"},
+	expenses.SpendingByCurrency: {"group_expenses.currency", "group_expenses.currency"},
+}
+
+func (ger *groupExpenseRepositoryGorm) SumShares(ctx context.Context, spec expenses.SpendingSpecification) ([]expenses.SpendingTotal, error) {
+	ctx, span := otel.Tracer.Start(ctx, "GroupExpenseRepository.SumShares")
+	defer span.End()
+
+	keys, ok := spendingKeys[spec.GroupBy]
+	if !ok {
+		return nil, ungerr.BadRequestError("invalid spending grouping")
+	}
+
+	db, err := ger.GetGormInstance(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	total := "SUM(group_expense_participants.share_amount)"
+	query := db.Table("group_expense_participants").
+		Joins("JOIN group_expenses ON group_expenses.id = group_expense_participants.group_expense_id").
+		Where("group_expense_participants.participant_profile_id IN ? AND group_expenses.status = ?", spec.ProfileIDs, expenses.ConfirmedExpense)
+
+	switch spec.GroupBy {
+	case expenses.SpendingByCategory:
+		query = query.Joins("LEFT JOIN categories ON categories.id = group_expenses.category_id")
+	case expenses.SpendingByFriend:
+		// A share of an expense with several payers is split between them by what each paid
+		total = "ROUND(SUM(group_expense_participants.share_amount * COALESCE(expense_payers.ratio, 1)), 2)"
+		query = query.
+			Joins("LEFT JOIN " + expensePayersRatio + " ON expense_payers.group_expense_id = group_expenses.id").
+			Joins("LEFT JOIN user_profiles ON user_profiles.id = COALESCE(expense_payers.profile_id, group_expenses.payer_profile_id)")
+	}
+	query = query.Select(keys[0] + " AS key, " + keys[1] + " AS label, group_expenses.currency AS currency, " + total + " AS total, COUNT(DISTINCT group_expenses.id) AS expense_count")
+
+	if !spec.From.IsZero() {
+		query = query.Where("group_expenses.created_at >= ?", spec.From)
+	}
+	if !spec.To.IsZero() {
+		query = query.Where("group_expenses.created_at < ?", spec.To)
+	}
+	if spec.Currency != "" {
+		query = query.Where("group_expenses.currency = ?", spec.Currency)
+	}
+	if spec.CategoryID.Valid {
+		query = query.Where("group_expenses.category_id = ?", spec.CategoryID.UUID)
+	}
+	if spec.Tag != "" {
+		query = query.Where("EXISTS (SELECT 1 FROM jsonb_array_elements_text(group_expenses.tags) AS tag WHERE LOWER(tag) = LOWER(?))", spec.Tag)
+	}
+
+	var totals []expenses.SpendingTotal
+	err = query.
+		Group("key, label, group_expenses.currency").
+		Order("key ASC, currency ASC").
+		Scan(&totals).
+		Error
+
+	if err != nil {
+		return nil, ungerr.Wrap(err, appconstant.ErrDataSelect)
+	}
+
+	return totals, nil
+}
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/appconstant"
+	"github.com/itsLeonB/cashback/internal/core/otel"
+	"github.com/itsLeonB/cashback/internal/domain/entity/expenses"
+	"github.com/itsLeonB/go-crud"
+	"github.com/itsLeonB/ungerr"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type groupRepositoryGorm struct {
+	crud.Repository[expenses.Group]
+}
+
+func NewGroupRepository(db *gorm.DB) *groupRepositoryGorm {
+	return &groupRepositoryGorm{
+		crud.NewRepository[expenses.Group](db),
+	}
+}
+
+func (gr *groupRepositoryGorm) FindAllByProfileID(ctx context.Context, profileID uuid.UUID) ([]expenses.Group, error) {
+	ctx, span := otel.Tracer.Start(ctx, "GroupRepository.FindAllByProfileID")
+	defer span.End()
+
+	db, err := gr.GetGormInstance(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var groups []expenses.Group
+	err = db.
+		Preload("Creator").
+		Preload("Members", func(db *gorm.DB) *gorm.DB { return db.Order("created_at ASC") }).
+		Preload("Members.Profile").
+		Where("id IN (SELECT group_id FROM group_members WHERE profile_id = ?)", profileID).
+		Order("updated_at DESC").
+		Find(&groups).
+		Error
+
+	if err != nil {
+		return nil, ungerr.Wrap(err, appconstant.ErrDataSelect)
+	}
+
+	return groups, nil
+}
+
+func (gr *groupRepositoryGorm) SyncMembers(ctx context.Context, groupID uuid.UUID, members []expenses.GroupMember) error {
+	ctx, span := otel.Tracer.Start(ctx, "GroupRepository.SyncMembers")
+	defer span.End()
+
+	db, err := gr.GetGormInstance(ctx)
+	if err != nil {
+		return err
+	}
+
+	profileIDs := make([]uuid.UUID, len(members))
+	for i, m := range members {
+		members[i].GroupID = groupID
+		profileIDs[i] = m.ProfileID
+	}
+
+	if len(members) > 0 {
+		if err := db.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "group_id"}, {Name: "profile_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"default_weight", "updated_at"}),
+		}).Create(&members).Error; err != nil {
+			return ungerr.Wrap(err, appconstant.ErrDataUpdate)
+		}
+	}
+
+	query := db.Where("group_id = ?", groupID)
+	if len(profileIDs) > 0 {
+		query = query.Where("profile_id NOT IN ?", profileIDs)
+	}
+
+	if err := query.Delete(&expenses.GroupMember{}).Error; err != nil {
+		return ungerr.Wrap(err, "error deleting removed members")
+	}
+
+	return nil
+}
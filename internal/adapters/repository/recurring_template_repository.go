@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/itsLeonB/cashback/internal/appconstant"
+	"github.com/itsLeonB/cashback/internal/core/otel"
+	"github.com/itsLeonB/cashback/internal/domain/entity"
+	"github.com/itsLeonB/go-crud"
+	"github.com/itsLeonB/ungerr"
+	"gorm.io/gorm"
+)
+
+type recurringTemplateRepositoryGorm struct {
+	crud.Repository[entity.RecurringTemplate]
+}
+
+func NewRecurringTemplateRepository(db *gorm.DB) *recurringTemplateRepositoryGorm {
+	return &recurringTemplateRepositoryGorm{
+		crud.NewRepository[entity.RecurringTemplate](db),
+	}
+}
+
+func (rtr *recurringTemplateRepositoryGorm) FindAllDue(ctx context.Context, now time.Time) ([]entity.RecurringTemplate, error) {
+	ctx, span := otel.Tracer.Start(ctx, "RecurringTemplateRepository.FindAllDue")
+	defer span.End()
+
+	db, err := rtr.GetGormInstance(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var templates []entity.RecurringTemplate
+	err = db.
+		Where("is_active AND next_run_at <= ?", now).
+		Order("next_run_at ASC").
+		Find(&templates).
+		Error
+
+	if err != nil {
+		return nil, ungerr.Wrap(err, appconstant.ErrDataSelect)
+	}
+
+	return templates, nil
+}
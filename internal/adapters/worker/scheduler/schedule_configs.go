@@ -25,5 +25,10 @@ func (s *Scheduler) getSchedules() []scheduleConfig {
 			jobFn:    s.subscriptionSvc.PublishSubscriptionDueNotifications,
 			jobName:  "publish nearing due-date subscription notification",
 		},
+		{
+			cronSpec: "*/15 * * * *",
+			jobFn:    s.recurringSvc.RunDue,
+			jobName:  "recurring templates run",
+		},
 	}
 }
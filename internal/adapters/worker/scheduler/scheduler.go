@@ -19,11 +19,12 @@ import (
 type Scheduler struct {
 	billSvc         service.ExpenseBillService
 	subscriptionSvc monetization.SubscriptionService
+	recurringSvc    service.RecurringService
 	cron            *cron.Cron
 }
 
 func Setup(providers *provider.Providers) (*Scheduler, error) {
-	s := &Scheduler{providers.Services.ExpenseBill, providers.Services.Subscription, cron.New()}
+	s := &Scheduler{providers.Services.ExpenseBill, providers.Services.Subscription, providers.Services.Recurring, cron.New()}
 	schedules := s.getSchedules()
 
 	var err error
@@ -44,10 +44,26 @@ func configureQueues(providers *provider.Providers) []queueConfig {
 			message.FriendRequestAccepted{}.Type(),
 			withLogging(message.FriendRequestAccepted{}.Type(), providers.Services.Notification.HandleFriendRequestAccepted),
 		},
+		{
+			message.RecurringExpenseCreated{}.Type(),
+			withLogging(message.RecurringExpenseCreated{}.Type(), providers.Services.Notification.HandleRecurringExpenseCreated),
+		},
+		{
+			message.SessionsRevoked{}.Type(),
+			withLogging(message.SessionsRevoked{}.Type(), providers.Services.Notification.HandleSessionsRevoked),
+		},
 		{
 			message.NotificationCreated{}.Type(),
 			withLogging(message.NotificationCreated{}.Type(), providers.PushNotification.Deliver),
 		},
+		{
+			message.ExportRequested{}.Type(),
+			withLogging(message.ExportRequested{}.Type(), providers.Services.Export.Generate),
+		},
+		{
+			message.ImportRequested{}.Type(),
+			withLogging(message.ImportRequested{}.Type(), providers.Services.Import.Process),
+		},
 		{
 			message.SubscriptionNearingDue{}.Type(),
 			withLogging(message.SubscriptionNearingDue{}.Type(), providers.Services.User.SendSubscriptionNearingDueDateMail),
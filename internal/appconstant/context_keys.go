@@ -13,16 +13,30 @@ const (
 	ContextProvider        ctxKey = "provider"
 	ContextFriendRequestID ctxKey = "friendRequestID"
 	ContextNotificationID  ctxKey = "notificationID"
+	ContextSettlementID    ctxKey = "settlementID"
+	ContextRecurringID     ctxKey = "recurringTemplateID"
+	ContextExportID        ctxKey = "exportID"
+	ContextImportID        ctxKey = "importID"
+	ContextBucketName      ctxKey = "bucketName"
+	ContextObjectKey       ctxKey = "objectKey"
+	ContextCategoryID      ctxKey = "categoryID"
+	ContextDebtID          ctxKey = "debtID"
+	ContextGroupID         ctxKey = "groupID"
 
 	ContextPlanID         ctxKey = "planID"
 	ContextPlanVersionID  ctxKey = "planVersionID"
 	ContextSubscriptionID ctxKey = "subscriptionID"
 	ContextPaymentID      ctxKey = "paymentID"
+	ContextRoleID         ctxKey = "roleID"
+	ContextAdminUserID    ctxKey = "adminUserID"
 
-	ContextSessionID    ctxKey = "sessionID"
-	ContextFingerprint  ctxKey = "fgp"
-	ContextExp          ctxKey = "exp"
-	ContextIat          ctxKey = "iat"
+	ContextAdminRoles       ctxKey = "roles"
+	ContextAdminPermissions ctxKey = "permissions"
+
+	ContextSessionID   ctxKey = "sessionID"
+	ContextFingerprint ctxKey = "fgp"
+	ContextExp         ctxKey = "exp"
+	ContextIat         ctxKey = "iat"
 )
 
 func (c ctxKey) String() string {
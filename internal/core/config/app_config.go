@@ -11,8 +11,11 @@ type App struct {
 	ClientUrls                []string      `split_words:"true"`
 	RegisterVerificationUrl   string        `split_words:"true"`
 	ResetPasswordUrl          string        `split_words:"true"`
+	ProfileClaimUrl           string        `split_words:"true"`
 	BucketNameExpenseBill     string        `split_words:"true" required:"true"`
 	BucketNameTransferMethods string        `split_words:"true" default:"transfer-methods"`
+	BucketNameSettlementProof string        `split_words:"true" default:"settlement-proofs"`
+	BucketNameExports         string        `split_words:"true" default:"exports"`
 }
 
 func (App) Prefix() string {
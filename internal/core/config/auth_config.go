@@ -16,6 +16,7 @@ type Auth struct {
 	CookieSecure          bool          `split_words:"true" default:"false"`
 	CookieSameSite        string        `split_words:"true" default:"strict"`
 	TurnstileSecretKey    string        `split_words:"true"`
+	ClaimLinkDuration     time.Duration `split_words:"true" default:"168h"`
 }
 
 func (a Auth) ParsedSameSite() http.SameSite {
@@ -27,6 +27,9 @@ type Config struct {
 	Flag
 	OTel
 	Langfuse
+	FX
+	OCR
+	Storage
 }
 
 var Global *Config
@@ -107,6 +110,21 @@ func Load() error {
 		errs = errors.Join(errs, err)
 	}
 
+	var fx FX
+	if err = envconfig.Process(fx.Prefix(), &fx); err != nil {
+		errs = errors.Join(errs, err)
+	}
+
+	var ocr OCR
+	if err = envconfig.Process(ocr.Prefix(), &ocr); err != nil {
+		errs = errors.Join(errs, err)
+	}
+
+	var storage Storage
+	if err = envconfig.Process(storage.Prefix(), &storage); err != nil {
+		errs = errors.Join(errs, err)
+	}
+
 	if errs != nil {
 		return ungerr.Wrap(errs, "error loading config")
 	}
@@ -125,6 +143,9 @@ func Load() error {
 		flag,
 		otel,
 		langfuse,
+		fx,
+		ocr,
+		storage,
 	}
 
 	return nil
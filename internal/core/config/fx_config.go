@@ -0,0 +1,10 @@
+package config
+
+type FX struct {
+	RateProvider string `split_words:"true" default:"file"`
+	RatesFile    string `split_words:"true" default:"fx_rates.json"`
+}
+
+func (FX) Prefix() string {
+	return "FX"
+}
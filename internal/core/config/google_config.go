@@ -10,7 +10,7 @@ import (
 )
 
 type Google struct {
-	ServiceAccount string `split_words:"true" required:"true"`
+	ServiceAccount string `split_words:"true"` // only needed for the GCS storage and Cloud Vision OCR providers
 }
 
 func (Google) Prefix() string {
@@ -18,6 +18,9 @@ func (Google) Prefix() string {
 }
 
 func (g *Google) LoadCredentials() (*google.Credentials, error) {
+	if g.ServiceAccount == "" {
+		return nil, nil
+	}
 	scopes := append(vision.DefaultAuthScopes(), storage.ScopeFullControl)
 	creds, err := google.CredentialsFromJSON(context.Background(), []byte(g.ServiceAccount), scopes...)
 	if err != nil {
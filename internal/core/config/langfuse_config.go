@@ -1,8 +1,9 @@
 package config
 
 type Langfuse struct {
-	PublicKey string `required:"true" split_words:"true"`
-	SecretKey string `required:"true" split_words:"true"`
+	// Without keys, prompts are only read from the embedded registry
+	PublicKey string `split_words:"true"`
+	SecretKey string `split_words:"true"`
 	BaseUrl   string `split_words:"true" default:"https://cloud.langfuse.com"`
 }
 
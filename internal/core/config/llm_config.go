@@ -1,9 +1,14 @@
 package config
 
 type LLM struct {
-	ApiKey  string `split_words:"true"`
-	BaseUrl string `split_words:"true"`
-	Model   string
+	Provider       string `default:"openai"` // openai, ollama or fake
+	ApiKey         string `split_words:"true"`
+	BaseUrl        string `split_words:"true"` // defaults to http://localhost:11434 for ollama
+	Model          string
+	FallbackModels []string `split_words:"true"`                        // tried in order when Model fails
+	MaxRetries     int      `split_words:"true" default:"2"`            // schema violations retried per model
+	FixturesDir    string   `split_words:"true" default:"testdata/llm"` // read by the fake provider
+	RecordDir      string   `split_words:"true"`                        // when set, replies are saved as fake provider fixtures
 }
 
 func (LLM) Prefix() string {
@@ -4,6 +4,11 @@ type Mail struct {
 	SenderMail string `split_words:"true" required:"true"`
 	SenderName string `split_words:"true" required:"true"`
 	ApiKey     string `split_words:"true" required:"true"`
+	// InboundSecret authenticates the mail provider posting forwarded receipts. Inbound mail is off when empty.
+	InboundSecret string `split_words:"true"`
+	// InboundAuthservID is the authserv-id the mail provider stamps its Authentication-Results headers
+	// with, usually its hostname. Inbound mail without a header from it is not authenticated.
+	InboundAuthservID string `split_words:"true"`
 }
 
 func (Mail) Prefix() string {
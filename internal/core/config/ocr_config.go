@@ -0,0 +1,12 @@
+package config
+
+type OCR struct {
+	Provider      string `default:"cloud-vision"` // cloud-vision, tesseract or fake
+	TesseractPath string `split_words:"true" default:"tesseract"`
+	TesseractLang string `split_words:"true" default:"eng"`
+	FixturesDir   string `split_words:"true" default:"testdata/ocr"` // read by the fake provider
+}
+
+func (OCR) Prefix() string {
+	return "OCR"
+}
@@ -0,0 +1,17 @@
+package config
+
+type Storage struct {
+	Provider       string `default:"gcs"`                                      // gcs, local or s3
+	LocalRoot      string `split_words:"true" default:"data/storage"`          // where the local provider keeps files
+	PublicUrl      string `split_words:"true" default:"http://localhost:8080"` // base URL of this server, used in local signed URLs
+	SigningKey     string `split_words:"true"`                                 // HMAC key for local signed URLs
+	S3Endpoint     string `split_words:"true"`                                 // e.g. http://localhost:9000 for MinIO
+	S3Region       string `split_words:"true" default:"us-east-1"`
+	S3AccessKey    string `split_words:"true"`
+	S3SecretKey    string `split_words:"true"`
+	S3UsePathStyle bool   `split_words:"true" default:"true"`
+}
+
+func (Storage) Prefix() string {
+	return "STORAGE"
+}
@@ -4,11 +4,13 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"regexp"
+	"slices"
 	"strconv"
 	"time"
 
@@ -92,6 +94,24 @@ func (p *Prompt) Compile(vars map[string]any) ([]ChatMessage, error) {
 
 var placeholderRe = regexp.MustCompile(`\{\{\s*([a-zA-Z0-9_]+)\s*\}\}`)
 
+// Variables lists the placeholders of the prompt in order of first use.
+func (p *Prompt) Variables() ([]string, error) {
+	msgs, err := p.Compile(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var vars []string
+	for _, msg := range msgs {
+		for _, match := range placeholderRe.FindAllStringSubmatch(msg.Content, -1) {
+			if !slices.Contains(vars, match[1]) {
+				vars = append(vars, match[1])
+			}
+		}
+	}
+	return vars, nil
+}
+
 func injectVariables(template string, vars map[string]any) string {
 	return placeholderRe.ReplaceAllStringFunc(template, func(match string) string {
 		key := placeholderRe.FindStringSubmatch(match)[1]
@@ -113,6 +133,9 @@ type Client interface {
 	Shutdown() error
 }
 
+// ErrNotConfigured is returned by GetPrompt when LANGFUSE_PUBLIC_KEY or LANGFUSE_SECRET_KEY is not set.
+var ErrNotConfigured = errors.New("langfuse is not configured")
+
 type langfuseClient struct {
 	publicKey  string
 	secretKey  string
@@ -121,6 +144,10 @@ type langfuseClient struct {
 }
 
 func NewClient(cfg config.Langfuse) Client {
+	if cfg.PublicKey == "" || cfg.SecretKey == "" {
+		return unconfiguredClient{}
+	}
+
 	return &langfuseClient{
 		publicKey: cfg.PublicKey,
 		secretKey: cfg.SecretKey,
@@ -191,3 +218,13 @@ func (c *langfuseClient) Shutdown() error {
 	c.httpClient.CloseIdleConnections()
 	return nil
 }
+
+type unconfiguredClient struct{}
+
+func (unconfiguredClient) GetPrompt(context.Context, string, ...GetPromptOptions) (*Prompt, error) {
+	return nil, ErrNotConfigured
+}
+
+func (unconfiguredClient) Shutdown() error {
+	return nil
+}
@@ -0,0 +1,107 @@
+package llm
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/itsLeonB/cashback/internal/core/config"
+	"github.com/itsLeonB/ungerr"
+)
+
+// DefaultFixture is the reply of the fake provider for conversations without a fixture of their own.
+const DefaultFixture = "default"
+
+const fixtureExt = ".txt"
+
+// FixtureKey names the fixture of a conversation. It depends on the messages only, so recordings
+// survive a model change, and a retry after a schema violation gets a fixture of its own.
+func FixtureKey(msgs []ChatMessage) string {
+	hash := sha256.New()
+	for _, msg := range msgs {
+		hash.Write([]byte(msg.Role))
+		hash.Write([]byte{0})
+		hash.Write([]byte(msg.Content))
+		hash.Write([]byte{0})
+	}
+	return hex.EncodeToString(hash.Sum(nil))[:16]
+}
+
+// fakeProvider replies with recorded text instead of calling a model, so prompts can be tested offline.
+// A fixture is looked up by FixtureKey of the messages, then DefaultFixture.
+type fakeProvider struct {
+	fixtures map[string]string
+}
+
+func NewFakeProvider(fixtures map[string]string) Provider {
+	return &fakeProvider{fixtures}
+}
+
+// newFakeProviderFromDir loads every .txt file in cfg.FixturesDir as a fixture named after the file.
+func newFakeProviderFromDir(cfg config.LLM) (Provider, error) {
+	fixtures, err := LoadFixtures(cfg.FixturesDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewFakeProvider(fixtures), nil
+}
+
+// LoadFixtures reads the fixtures saved by LLM_RECORD_DIR, or written by hand, from dir.
+func LoadFixtures(dir string) (map[string]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, ungerr.Wrap(err, "error reading llm fixtures directory")
+	}
+
+	fixtures := make(map[string]string)
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != fixtureExt {
+			continue
+		}
+
+		text, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, ungerr.Wrap(err, "error reading llm fixture")
+		}
+		fixtures[strings.TrimSuffix(entry.Name(), fixtureExt)] = string(text)
+	}
+
+	return fixtures, nil
+}
+
+func (fp *fakeProvider) Complete(ctx context.Context, req CompletionRequest) (string, error) {
+	key := FixtureKey(req.Messages)
+	for _, name := range []string{key, DefaultFixture} {
+		if text, ok := fp.fixtures[name]; ok {
+			return text, nil
+		}
+	}
+
+	return "", ungerr.Unknownf("no llm fixture %s", key)
+}
+
+// recordingProvider saves every reply of the wrapped provider as a fixture for the fake provider.
+type recordingProvider struct {
+	provider Provider
+	dir      string
+}
+
+func (rp *recordingProvider) Complete(ctx context.Context, req CompletionRequest) (string, error) {
+	reply, err := rp.provider.Complete(ctx, req)
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(rp.dir, 0o755); err != nil {
+		return "", ungerr.Wrap(err, "error creating llm record directory")
+	}
+	if err := os.WriteFile(filepath.Join(rp.dir, FixtureKey(req.Messages)+fixtureExt), []byte(reply), 0o644); err != nil {
+		return "", ungerr.Wrap(err, "error recording llm fixture")
+	}
+
+	return reply, nil
+}
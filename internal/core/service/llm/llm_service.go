@@ -2,102 +2,182 @@ package llm
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/itsLeonB/cashback/internal/core/config"
+	"github.com/itsLeonB/cashback/internal/core/logger"
 	"github.com/itsLeonB/cashback/internal/core/otel"
 	"github.com/itsLeonB/ungerr"
-	"github.com/openai/openai-go/v2"
-	"github.com/openai/openai-go/v2/option"
 )
 
 type ChatMessage struct {
-	Role    string
-	Content string
+	Role    string `json:"role"`
+	Content string `json:"content"`
 }
 
 type LLMService interface {
 	Prompt(ctx context.Context, systemMsg, userMsg string) (string, error)
 	Chat(ctx context.Context, msgs []ChatMessage) (string, error)
+	// ChatJSON asks for a reply matching format.Schema. Replies that do not match are fed back to the
+	// model with the violations, up to LLM_MAX_RETRIES times, before moving on to the next model.
+	ChatJSON(ctx context.Context, msgs []ChatMessage, format ResponseFormat) (string, error)
 }
 
-type openAILLMService struct {
-	client openai.Client
-	model  string
+type llmService struct {
+	provider   Provider
+	models     []string
+	maxRetries int
 }
 
-func NewLLMService(cfg config.LLM) LLMService {
-	client := openai.NewClient(option.WithAPIKey(cfg.ApiKey), option.WithBaseURL(cfg.BaseUrl))
-	return &openAILLMService{client, cfg.Model}
+// ErrNotConfigured is returned by every call when the provider lacks its settings, e.g. LLM_API_KEY or LLM_MODEL, so callers can fall back.
+var ErrNotConfigured = errors.New("llm is not configured")
+
+func NewLLMService(cfg config.LLM) (LLMService, error) {
+	provider, err := newProvider(cfg)
+	if errors.Is(err, ErrNotConfigured) {
+		return unconfiguredLLMService{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return NewLLMServiceWithProvider(provider, append([]string{cfg.Model}, cfg.FallbackModels...), cfg.MaxRetries), nil
 }
 
-func (llm *openAILLMService) Prompt(ctx context.Context, systemMsg, userMsg string) (string, error) {
-	ctx, span := otel.Tracer.Start(ctx, "openAILLMService.Prompt")
+// NewLLMServiceWithProvider tries models in order on the given provider, e.g. the fake provider in tests.
+func NewLLMServiceWithProvider(provider Provider, models []string, maxRetries int) LLMService {
+	if len(models) == 0 {
+		models = []string{""}
+	}
+	return &llmService{provider, models, maxRetries}
+}
+
+func (ls *llmService) Prompt(ctx context.Context, systemMsg, userMsg string) (string, error) {
+	ctx, span := otel.Tracer.Start(ctx, "llmService.Prompt")
 	defer span.End()
 
 	if userMsg == "" {
 		return "", ungerr.Unknown("empty user message")
 	}
 
-	msgs := make([]openai.ChatCompletionMessageParamUnion, 0, 2)
+	msgs := make([]ChatMessage, 0, 2)
 
 	if systemMsg != "" {
-		msgs = append(msgs, openai.SystemMessage(systemMsg))
+		msgs = append(msgs, ChatMessage{Role: "system", Content: systemMsg})
 	}
 
-	msgs = append(msgs, openai.UserMessage(userMsg))
+	msgs = append(msgs, ChatMessage{Role: "user", Content: userMsg})
 
-	params := openai.ChatCompletionNewParams{
-		Model:    llm.model,
-		Messages: msgs,
-	}
+	return ls.complete(ctx, msgs, nil)
+}
 
-	response, err := llm.client.Chat.Completions.New(ctx, params)
-	if err != nil {
-		return "", ungerr.Wrap(err, "error getting LLM response")
-	}
+func (ls *llmService) Chat(ctx context.Context, msgs []ChatMessage) (string, error) {
+	ctx, span := otel.Tracer.Start(ctx, "llmService.Chat")
+	defer span.End()
 
-	if len(response.Choices) < 1 {
-		return "", ungerr.Unknown("no response from LLM")
+	if len(msgs) == 0 {
+		return "", ungerr.Unknown("empty messages")
 	}
 
-	return response.Choices[0].Message.Content, nil
+	return ls.complete(ctx, msgs, nil)
 }
 
-func (llm *openAILLMService) Chat(ctx context.Context, msgs []ChatMessage) (string, error) {
-	ctx, span := otel.Tracer.Start(ctx, "openAILLMService.Chat")
+func (ls *llmService) ChatJSON(ctx context.Context, msgs []ChatMessage, format ResponseFormat) (string, error) {
+	ctx, span := otel.Tracer.Start(ctx, "llmService.ChatJSON")
 	defer span.End()
 
 	if len(msgs) == 0 {
 		return "", ungerr.Unknown("empty messages")
 	}
+	if format.Schema == nil {
+		return "", ungerr.Unknown("response format has no schema")
+	}
+
+	return ls.complete(ctx, msgs, &format)
+}
 
-	openaiMsgs := make([]openai.ChatCompletionMessageParamUnion, 0, len(msgs))
-	for _, msg := range msgs {
-		switch msg.Role {
-		case "system":
-			openaiMsgs = append(openaiMsgs, openai.SystemMessage(msg.Content))
-		case "user":
-			openaiMsgs = append(openaiMsgs, openai.UserMessage(msg.Content))
-		case "assistant":
-			openaiMsgs = append(openaiMsgs, openai.AssistantMessage(msg.Content))
-		default:
-			return "", ungerr.Unknownf("unhandled message role: %s", msg.Role)
+// complete fails over to the next model when a model errors or keeps breaking the schema.
+func (ls *llmService) complete(ctx context.Context, msgs []ChatMessage, format *ResponseFormat) (string, error) {
+	var errs error
+	for _, model := range ls.models {
+		reply, err := ls.completeWithModel(ctx, model, msgs, format)
+		if err == nil {
+			return reply, nil
 		}
+		if ctx.Err() != nil {
+			return "", err
+		}
+
+		logger.Warnf("LLM model %s failed: %v", model, err)
+		errs = errors.Join(errs, err)
 	}
 
-	params := openai.ChatCompletionNewParams{
-		Model:    llm.model,
-		Messages: openaiMsgs,
+	return "", errs
+}
+
+func (ls *llmService) completeWithModel(ctx context.Context, model string, msgs []ChatMessage, format *ResponseFormat) (string, error) {
+	msgs = slices.Clone(msgs)
+	for attempt := 0; ; attempt++ {
+		reply, err := ls.provider.Complete(ctx, CompletionRequest{model, msgs, format})
+		if err != nil {
+			return "", err
+		}
+		if format == nil {
+			return reply, nil
+		}
+
+		reply = strings.TrimSpace(reply)
+		if slices.Contains(format.Accept, reply) {
+			return reply, nil
+		}
+
+		reply = stripCodeFence(reply)
+		err = format.Schema.Validate([]byte(reply))
+		if err == nil {
+			return reply, nil
+		}
+		if attempt >= ls.maxRetries {
+			return "", err
+		}
+
+		logger.Debugf("LLM reply violates schema, retrying: %v", err)
+		msgs = append(msgs,
+			ChatMessage{Role: "assistant", Content: reply},
+			ChatMessage{Role: "user", Content: schemaFeedback(err)},
+		)
 	}
+}
 
-	response, err := llm.client.Chat.Completions.New(ctx, params)
-	if err != nil {
-		return "", ungerr.Wrap(err, "error getting LLM response")
+func schemaFeedback(err error) string {
+	return fmt.Sprintf("Your reply does not match the required JSON schema: %v. Reply again with only the corrected JSON.", err)
+}
+
+// stripCodeFence removes the markdown fence some models put around JSON despite being told not to.
+func stripCodeFence(reply string) string {
+	if !strings.HasPrefix(reply, "```") {
+		return reply
 	}
 
-	if len(response.Choices) < 1 {
-		return "", ungerr.Unknown("no response from LLM")
+	_, body, ok := strings.Cut(reply, "\n")
+	if !ok {
+		return reply
 	}
+	return strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(body), "```"))
+}
+
+type unconfiguredLLMService struct{}
+
+func (unconfiguredLLMService) Prompt(context.Context, string, string) (string, error) {
+	return "", ErrNotConfigured
+}
+
+func (unconfiguredLLMService) Chat(context.Context, []ChatMessage) (string, error) {
+	return "", ErrNotConfigured
+}
 
-	return response.Choices[0].Message.Content, nil
+func (unconfiguredLLMService) ChatJSON(context.Context, []ChatMessage, ResponseFormat) (string, error) {
+	return "", ErrNotConfigured
 }
@@ -0,0 +1,130 @@
+package llm_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/itsLeonB/cashback/internal/core/config"
+	"github.com/itsLeonB/cashback/internal/core/logger"
+	"github.com/itsLeonB/cashback/internal/core/service/llm"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMain(m *testing.M) {
+	logger.Init("test")
+	os.Exit(m.Run())
+}
+
+// scriptedProvider replies per model in order and keeps the requests it got.
+type scriptedProvider struct {
+	replies  map[string][]string
+	requests []llm.CompletionRequest
+}
+
+func (sp *scriptedProvider) Complete(_ context.Context, req llm.CompletionRequest) (string, error) {
+	sp.requests = append(sp.requests, req)
+	replies := sp.replies[req.Model]
+	if len(replies) == 0 {
+		return "", errors.New("model unavailable")
+	}
+	sp.replies[req.Model] = replies[1:]
+	return replies[0], nil
+}
+
+var testFormat = llm.ResponseFormat{
+	Name:   "test",
+	Schema: llm.SchemaFor[testRequest](),
+	Accept: []string{"NOT_DETECTED"},
+}
+
+var testMsgs = []llm.ChatMessage{{Role: "user", Content: "parse this"}}
+
+const validReply = `{"total": 10, "quantity": 1, "fees": [{"name": "Tax", "amount": 1}]}`
+
+func TestChatJSON_RetriesWithFeedback(t *testing.T) {
+	provider := &scriptedProvider{replies: map[string][]string{
+		"primary": {`{"total": 10}`, "```json\n" + validReply + "\n```"},
+	}}
+	svc := llm.NewLLMServiceWithProvider(provider, []string{"primary"}, 2)
+
+	reply, err := svc.ChatJSON(context.Background(), testMsgs, testFormat)
+
+	assert.NoError(t, err)
+	assert.Equal(t, validReply, reply)
+	assert.Len(t, provider.requests, 2)
+
+	retry := provider.requests[1].Messages
+	assert.Len(t, retry, 3)
+	assert.Equal(t, "assistant", retry[1].Role)
+	assert.Equal(t, `{"total": 10}`, retry[1].Content)
+	assert.Contains(t, retry[2].Content, `missing required property "quantity"`)
+	assert.Len(t, testMsgs, 1)
+}
+
+func TestChatJSON_FailsOverToNextModel(t *testing.T) {
+	provider := &scriptedProvider{replies: map[string][]string{
+		"primary":  {`{}`, `{}`},
+		"fallback": {validReply},
+	}}
+	svc := llm.NewLLMServiceWithProvider(provider, []string{"down", "primary", "fallback"}, 1)
+
+	reply, err := svc.ChatJSON(context.Background(), testMsgs, testFormat)
+
+	assert.NoError(t, err)
+	assert.Equal(t, validReply, reply)
+	assert.Len(t, provider.requests, 4)
+}
+
+func TestChatJSON_GivesUp(t *testing.T) {
+	provider := &scriptedProvider{replies: map[string][]string{
+		"primary": {`{}`, `{}`},
+	}}
+	svc := llm.NewLLMServiceWithProvider(provider, []string{"primary"}, 1)
+
+	_, err := svc.ChatJSON(context.Background(), testMsgs, testFormat)
+
+	assert.ErrorIs(t, err, llm.ErrSchemaViolation)
+}
+
+func TestChatJSON_AcceptsMarker(t *testing.T) {
+	provider := &scriptedProvider{replies: map[string][]string{
+		"primary": {" NOT_DETECTED\n"},
+	}}
+	svc := llm.NewLLMServiceWithProvider(provider, []string{"primary"}, 0)
+
+	reply, err := svc.ChatJSON(context.Background(), testMsgs, testFormat)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "NOT_DETECTED", reply)
+}
+
+func TestNewLLMService_Fake(t *testing.T) {
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, llm.FixtureKey(testMsgs)+".txt"), []byte(validReply), 0o600))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "default.txt"), []byte("default"), 0o600))
+
+	svc, err := llm.NewLLMService(config.LLM{Provider: "fake", FixturesDir: dir})
+	assert.NoError(t, err)
+
+	reply, err := svc.ChatJSON(context.Background(), testMsgs, testFormat)
+	assert.NoError(t, err)
+	assert.Equal(t, validReply, reply)
+
+	reply, err = svc.Chat(context.Background(), []llm.ChatMessage{{Role: "user", Content: "other"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "default", reply)
+}
+
+func TestNewLLMService_Unconfigured(t *testing.T) {
+	svc, err := llm.NewLLMService(config.LLM{Provider: "openai"})
+	assert.NoError(t, err)
+
+	_, err = svc.ChatJSON(context.Background(), testMsgs, testFormat)
+	assert.ErrorIs(t, err, llm.ErrNotConfigured)
+
+	_, err = llm.NewLLMService(config.LLM{Provider: "unknown"})
+	assert.Error(t, err)
+}
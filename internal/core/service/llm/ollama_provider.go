@@ -0,0 +1,101 @@
+package llm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/itsLeonB/cashback/internal/core/config"
+	"github.com/itsLeonB/cashback/internal/core/otel"
+	"github.com/itsLeonB/ungerr"
+)
+
+const defaultOllamaUrl = "http://localhost:11434"
+
+// ollamaProvider uses the native chat API of a local Ollama server, which constrains
+// the reply to the JSON schema passed as format.
+type ollamaProvider struct {
+	baseUrl    string
+	httpClient *http.Client
+}
+
+type ollamaChatRequest struct {
+	Model    string        `json:"model"`
+	Messages []ChatMessage `json:"messages"`
+	Stream   bool          `json:"stream"`
+	Format   *Schema       `json:"format,omitempty"`
+}
+
+type ollamaChatResponse struct {
+	Message ChatMessage `json:"message"`
+	Error   string      `json:"error"`
+}
+
+func newOllamaProvider(cfg config.LLM) (Provider, error) {
+	if cfg.Model == "" {
+		return nil, ErrNotConfigured
+	}
+
+	baseUrl := cfg.BaseUrl
+	if baseUrl == "" {
+		baseUrl = defaultOllamaUrl
+	}
+
+	return &ollamaProvider{
+		baseUrl: strings.TrimSuffix(baseUrl, "/"),
+		httpClient: &http.Client{
+			// Local models are slow on CPU
+			Timeout: 5 * time.Minute,
+		},
+	}, nil
+}
+
+func (op *ollamaProvider) Complete(ctx context.Context, req CompletionRequest) (string, error) {
+	ctx, span := otel.Tracer.Start(ctx, "ollamaProvider.Complete")
+	defer span.End()
+
+	chatReq := ollamaChatRequest{
+		Model:    req.Model,
+		Messages: req.Messages,
+	}
+	if req.Format != nil {
+		chatReq.Format = req.Format.Schema
+	}
+
+	body, err := json.Marshal(chatReq)
+	if err != nil {
+		return "", ungerr.Wrap(err, "error marshaling ollama request")
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, op.baseUrl+"/api/chat", bytes.NewReader(body))
+	if err != nil {
+		return "", ungerr.Wrap(err, "error creating ollama request")
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := op.httpClient.Do(httpReq)
+	if err != nil {
+		return "", ungerr.Wrap(err, "error getting LLM response")
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", ungerr.Wrap(err, "error reading ollama response")
+	}
+
+	var chatResp ollamaChatResponse
+	if err := json.Unmarshal(respBody, &chatResp); err != nil {
+		return "", ungerr.Wrap(err, fmt.Sprintf("unexpected ollama response with status %d", resp.StatusCode))
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", ungerr.Unknownf("ollama returned status %d: %s", resp.StatusCode, chatResp.Error)
+	}
+
+	return chatResp.Message.Content, nil
+}
@@ -0,0 +1,72 @@
+package llm
+
+import (
+	"context"
+
+	"github.com/itsLeonB/cashback/internal/core/config"
+	"github.com/itsLeonB/cashback/internal/core/otel"
+	"github.com/itsLeonB/ungerr"
+	"github.com/openai/openai-go/v2"
+	"github.com/openai/openai-go/v2/option"
+	"github.com/openai/openai-go/v2/shared"
+)
+
+// openAIProvider talks to any OpenAI-compatible chat completions API, such as OpenAI itself or an AI gateway.
+type openAIProvider struct {
+	client openai.Client
+}
+
+func newOpenAIProvider(cfg config.LLM) (Provider, error) {
+	if cfg.ApiKey == "" || cfg.Model == "" {
+		return nil, ErrNotConfigured
+	}
+
+	client := openai.NewClient(option.WithAPIKey(cfg.ApiKey), option.WithBaseURL(cfg.BaseUrl))
+	return &openAIProvider{client}, nil
+}
+
+func (op *openAIProvider) Complete(ctx context.Context, req CompletionRequest) (string, error) {
+	ctx, span := otel.Tracer.Start(ctx, "openAIProvider.Complete")
+	defer span.End()
+
+	openaiMsgs := make([]openai.ChatCompletionMessageParamUnion, 0, len(req.Messages))
+	for _, msg := range req.Messages {
+		switch msg.Role {
+		case "system":
+			openaiMsgs = append(openaiMsgs, openai.SystemMessage(msg.Content))
+		case "user":
+			openaiMsgs = append(openaiMsgs, openai.UserMessage(msg.Content))
+		case "assistant":
+			openaiMsgs = append(openaiMsgs, openai.AssistantMessage(msg.Content))
+		default:
+			return "", ungerr.Unknownf("unhandled message role: %s", msg.Role)
+		}
+	}
+
+	params := openai.ChatCompletionNewParams{
+		Model:    req.Model,
+		Messages: openaiMsgs,
+	}
+
+	if req.Format != nil {
+		params.ResponseFormat = openai.ChatCompletionNewParamsResponseFormatUnion{
+			OfJSONSchema: &shared.ResponseFormatJSONSchemaParam{
+				JSONSchema: shared.ResponseFormatJSONSchemaJSONSchemaParam{
+					Name:   req.Format.Name,
+					Schema: req.Format.Schema,
+				},
+			},
+		}
+	}
+
+	response, err := op.client.Chat.Completions.New(ctx, params)
+	if err != nil {
+		return "", ungerr.Wrap(err, "error getting LLM response")
+	}
+
+	if len(response.Choices) < 1 {
+		return "", ungerr.Unknown("no response from LLM")
+	}
+
+	return response.Choices[0].Message.Content, nil
+}
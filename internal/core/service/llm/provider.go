@@ -0,0 +1,52 @@
+package llm
+
+import (
+	"context"
+
+	"github.com/itsLeonB/cashback/internal/core/config"
+	"github.com/itsLeonB/ungerr"
+)
+
+// Provider sends a single chat completion to one model. Retries and model failover are done by LLMService.
+type Provider interface {
+	Complete(ctx context.Context, req CompletionRequest) (string, error)
+}
+
+type CompletionRequest struct {
+	Model    string
+	Messages []ChatMessage
+	// Format is nil for free-form replies
+	Format *ResponseFormat
+}
+
+// ProviderFactory builds a provider from the LLM config. It returns ErrNotConfigured when
+// settings the provider cannot do without are missing.
+type ProviderFactory func(cfg config.LLM) (Provider, error)
+
+var providers = map[string]ProviderFactory{
+	"openai": newOpenAIProvider,
+	"ollama": newOllamaProvider,
+	"fake":   newFakeProviderFromDir,
+}
+
+// RegisterProvider makes a provider selectable with LLM_PROVIDER. It is meant to be called from init.
+func RegisterProvider(name string, factory ProviderFactory) {
+	providers[name] = factory
+}
+
+func newProvider(cfg config.LLM) (Provider, error) {
+	factory, ok := providers[cfg.Provider]
+	if !ok {
+		return nil, ungerr.Unknownf("unsupported llm provider: %s", cfg.Provider)
+	}
+
+	provider, err := factory(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.RecordDir != "" {
+		return &recordingProvider{provider, cfg.RecordDir}, nil
+	}
+	return provider, nil
+}
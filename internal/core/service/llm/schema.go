@@ -0,0 +1,321 @@
+package llm
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ErrSchemaViolation is matched by errors.Is on every *SchemaError.
+var ErrSchemaViolation = errors.New("llm reply does not match the schema")
+
+// Schema is the subset of JSON Schema that is derived from request DTOs and checked by Validate.
+type Schema struct {
+	Type                 string             `json:"type"`
+	Format               string             `json:"format,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Enum                 []string           `json:"enum,omitempty"`
+	MinItems             *int               `json:"minItems,omitempty"`
+	MinLength            *int               `json:"minLength,omitempty"`
+	MaxLength            *int               `json:"maxLength,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+}
+
+// ResponseFormat asks for a reply that is JSON matching Schema.
+type ResponseFormat struct {
+	Name   string
+	Schema *Schema
+	// Accept lists replies taken verbatim without validation, e.g. a prompt's not-detected marker.
+	Accept []string
+}
+
+var (
+	decimalType = reflect.TypeFor[decimal.Decimal]()
+	uuidType    = reflect.TypeFor[uuid.UUID]()
+	timeType    = reflect.TypeFor[time.Time]()
+)
+
+// SchemaFor derives the schema of T from its json tags. Its binding tags are read for
+// required, min, len and oneof, and rules after dive apply to the elements of a slice.
+func SchemaFor[T any]() *Schema {
+	return schemaOf(reflect.TypeFor[T](), nil)
+}
+
+func schemaOf(t reflect.Type, rules []string) *Schema {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	var schema *Schema
+	switch {
+	case t == decimalType:
+		schema = &Schema{Type: "number"}
+	case t == uuidType:
+		schema = &Schema{Type: "string", Format: "uuid"}
+	case t == timeType:
+		schema = &Schema{Type: "string", Format: "date-time"}
+	default:
+		switch t.Kind() {
+		case reflect.String:
+			schema = &Schema{Type: "string"}
+		case reflect.Bool:
+			schema = &Schema{Type: "boolean"}
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			schema = &Schema{Type: "integer"}
+		case reflect.Float32, reflect.Float64:
+			schema = &Schema{Type: "number"}
+		case reflect.Slice, reflect.Array:
+			fieldRules, elemRules := splitDive(rules)
+			schema = &Schema{Type: "array", Items: schemaOf(t.Elem(), elemRules)}
+			rules = fieldRules
+		case reflect.Map:
+			schema = &Schema{Type: "object", AdditionalProperties: schemaOf(t.Elem(), nil)}
+		case reflect.Struct:
+			schema = &Schema{Type: "object", Properties: make(map[string]*Schema)}
+			addFields(schema, t)
+		default:
+			schema = &Schema{}
+		}
+	}
+
+	applyRules(schema, rules)
+	return schema
+}
+
+func addFields(schema *Schema, t reflect.Type) {
+	for field := range fieldsOf(t) {
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			addFields(schema, field.Type)
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		rules := strings.Split(field.Tag.Get("binding"), ",")
+		schema.Properties[name] = schemaOf(field.Type, rules)
+		if fieldRules, _ := splitDive(rules); slices.Contains(fieldRules, "required") {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+}
+
+func fieldsOf(t reflect.Type) func(yield func(reflect.StructField) bool) {
+	return func(yield func(reflect.StructField) bool) {
+		for i := range t.NumField() {
+			if field := t.Field(i); field.IsExported() && !yield(field) {
+				return
+			}
+		}
+	}
+}
+
+func splitDive(rules []string) ([]string, []string) {
+	if i := slices.Index(rules, "dive"); i >= 0 {
+		return rules[:i], rules[i+1:]
+	}
+	return rules, nil
+}
+
+func applyRules(schema *Schema, rules []string) {
+	for _, rule := range rules {
+		name, arg, _ := strings.Cut(rule, "=")
+		switch name {
+		case "oneof":
+			schema.Enum = strings.Fields(arg)
+		case "min", "len":
+			n, err := strconv.Atoi(arg)
+			if err != nil {
+				continue
+			}
+			switch schema.Type {
+			case "array":
+				schema.MinItems = &n
+			case "string":
+				schema.MinLength = &n
+				if name == "len" {
+					schema.MaxLength = &n
+				}
+			case "integer", "number":
+				minimum := float64(n)
+				schema.Minimum = &minimum
+			}
+		}
+	}
+}
+
+// Omit returns a copy of the schema without the properties at the given dotted paths,
+// so fields the model should not fill in are not asked for. Arrays are stepped through.
+func (s *Schema) Omit(paths ...string) *Schema {
+	clone := s.clone()
+	for _, path := range paths {
+		clone.omit(strings.Split(path, "."))
+	}
+	return clone
+}
+
+func (s *Schema) omit(path []string) {
+	for s.Type == "array" && s.Items != nil {
+		s = s.Items
+	}
+	if len(path) == 1 {
+		delete(s.Properties, path[0])
+		s.Required = slices.DeleteFunc(s.Required, func(name string) bool { return name == path[0] })
+		return
+	}
+	if next, ok := s.Properties[path[0]]; ok {
+		next.omit(path[1:])
+	}
+}
+
+func (s *Schema) clone() *Schema {
+	if s == nil {
+		return nil
+	}
+
+	clone := *s
+	clone.Items = s.Items.clone()
+	clone.AdditionalProperties = s.AdditionalProperties.clone()
+	clone.Required = slices.Clone(s.Required)
+	if s.Properties != nil {
+		clone.Properties = make(map[string]*Schema, len(s.Properties))
+		for name, property := range s.Properties {
+			clone.Properties[name] = property.clone()
+		}
+	}
+	return &clone
+}
+
+// SchemaError lists every place a reply breaks the schema, so they can all be fed back at once.
+type SchemaError struct {
+	Violations []string
+}
+
+func (e *SchemaError) Error() string {
+	return strings.Join(e.Violations, "; ")
+}
+
+func (e *SchemaError) Is(target error) bool {
+	return target == ErrSchemaViolation
+}
+
+// Validate checks raw against the schema and returns a *SchemaError when it does not match.
+func (s *Schema) Validate(raw []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return &SchemaError{[]string{fmt.Sprintf("reply is not valid JSON: %v", err)}}
+	}
+	if decoder.More() {
+		return &SchemaError{[]string{"reply has more than one JSON value"}}
+	}
+
+	var violations []string
+	s.validate("$", value, &violations)
+	if len(violations) > 0 {
+		return &SchemaError{violations}
+	}
+	return nil
+}
+
+func (s *Schema) validate(path string, value any, violations *[]string) {
+	fail := func(format string, args ...any) {
+		*violations = append(*violations, path+": "+fmt.Sprintf(format, args...))
+	}
+
+	switch s.Type {
+	case "object":
+		object, ok := value.(map[string]any)
+		if !ok {
+			fail("expected an object")
+			return
+		}
+		for _, name := range s.Required {
+			if object[name] == nil {
+				fail("missing required property %q", name)
+			}
+		}
+		for name, property := range object {
+			// null is read as absent, which the required check above already covers
+			if property == nil {
+				continue
+			}
+			if schema, ok := s.Properties[name]; ok {
+				schema.validate(path+"."+name, property, violations)
+			} else if s.AdditionalProperties != nil {
+				s.AdditionalProperties.validate(path+"."+name, property, violations)
+			}
+		}
+	case "array":
+		array, ok := value.([]any)
+		if !ok {
+			fail("expected an array")
+			return
+		}
+		if s.MinItems != nil && len(array) < *s.MinItems {
+			fail("expected at least %d items", *s.MinItems)
+		}
+		for i, item := range array {
+			s.Items.validate(fmt.Sprintf("%s[%d]", path, i), item, violations)
+		}
+	case "string":
+		str, ok := value.(string)
+		if !ok {
+			fail("expected a string")
+			return
+		}
+		if s.MinLength != nil && len([]rune(str)) < *s.MinLength {
+			fail("expected at least %d characters", *s.MinLength)
+		}
+		if s.MaxLength != nil && len([]rune(str)) > *s.MaxLength {
+			fail("expected at most %d characters", *s.MaxLength)
+		}
+		if len(s.Enum) > 0 && !slices.Contains(s.Enum, str) {
+			fail("expected one of %s", strings.Join(s.Enum, ", "))
+		}
+		if s.Format == "uuid" && uuid.Validate(str) != nil {
+			fail("expected a uuid")
+		}
+	case "integer", "number":
+		number, ok := value.(json.Number)
+		if !ok {
+			fail("expected a %s", s.Type)
+			return
+		}
+		if _, err := number.Int64(); s.Type == "integer" && err != nil {
+			fail("expected an integer")
+			return
+		}
+		f, err := number.Float64()
+		if err != nil {
+			fail("expected a %s", s.Type)
+			return
+		}
+		if s.Minimum != nil && f < *s.Minimum {
+			fail("expected at least %v", *s.Minimum)
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			fail("expected a boolean")
+		}
+	}
+}
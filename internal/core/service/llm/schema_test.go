@@ -0,0 +1,113 @@
+package llm_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/core/service/llm"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+type testFee struct {
+	Name   string          `json:"name" binding:"required,min=3"`
+	Amount decimal.Decimal `json:"amount" binding:"required"`
+	Kind   string          `json:"kind" binding:"omitempty,oneof=TAX SERVICE"`
+}
+
+type testRequest struct {
+	Internal uuid.UUID                  `json:"-"`
+	PayerID  uuid.UUID                  `json:"payerId"`
+	Total    decimal.Decimal            `json:"total" binding:"required"`
+	Quantity int                        `json:"quantity" binding:"required,min=1"`
+	Fees     []testFee                  `json:"fees" binding:"required,min=1,dive"`
+	Shares   map[string]decimal.Decimal `json:"shares"`
+}
+
+func TestSchemaFor(t *testing.T) {
+	schema := llm.SchemaFor[testRequest]()
+
+	assert.Equal(t, "object", schema.Type)
+	assert.NotContains(t, schema.Properties, "Internal")
+	assert.Equal(t, "uuid", schema.Properties["payerId"].Format)
+	assert.Equal(t, "number", schema.Properties["total"].Type)
+	assert.Equal(t, "integer", schema.Properties["quantity"].Type)
+	assert.Equal(t, 1.0, *schema.Properties["quantity"].Minimum)
+	assert.ElementsMatch(t, []string{"total", "quantity", "fees"}, schema.Required)
+
+	fees := schema.Properties["fees"]
+	assert.Equal(t, 1, *fees.MinItems)
+	assert.Equal(t, []string{"name", "amount"}, fees.Items.Required)
+	assert.Equal(t, 3, *fees.Items.Properties["name"].MinLength)
+	assert.Equal(t, []string{"TAX", "SERVICE"}, fees.Items.Properties["kind"].Enum)
+
+	assert.Equal(t, "number", schema.Properties["shares"].AdditionalProperties.Type)
+}
+
+func TestSchema_Omit(t *testing.T) {
+	schema := llm.SchemaFor[testRequest]()
+	omitted := schema.Omit("payerId", "fees.amount")
+
+	assert.NotContains(t, omitted.Properties, "payerId")
+	assert.NotContains(t, omitted.Properties["fees"].Items.Properties, "amount")
+	assert.Equal(t, []string{"name"}, omitted.Properties["fees"].Items.Required)
+
+	// The original is left as it was
+	assert.Contains(t, schema.Properties, "payerId")
+	assert.Contains(t, schema.Properties["fees"].Items.Properties, "amount")
+}
+
+func TestSchema_Validate(t *testing.T) {
+	schema := llm.SchemaFor[testRequest]()
+
+	tests := []struct {
+		name       string
+		raw        string
+		violations []string
+	}{
+		{
+			name: "valid",
+			raw:  `{"total": 12.5, "quantity": 2, "fees": [{"name": "Tax", "amount": 1.5, "kind": "TAX"}], "kind": null}`,
+		},
+		{
+			name:       "not json",
+			raw:        `NOT_DETECTED`,
+			violations: []string{"reply is not valid JSON: invalid character 'N' looking for beginning of value"},
+		},
+		{
+			name: "wrong types and missing fields",
+			raw:  `{"total": "12.5", "quantity": 1.5, "fees": []}`,
+			violations: []string{
+				"$.total: expected a number",
+				"$.quantity: expected an integer",
+				"$.fees: expected at least 1 items",
+			},
+		},
+		{
+			name: "nested violations",
+			raw:  `{"total": 1, "quantity": 0, "payerId": "bob", "fees": [{"name": "PB", "kind": "TIP"}]}`,
+			violations: []string{
+				"$.quantity: expected at least 1",
+				"$.payerId: expected a uuid",
+				"$.fees[0]: missing required property \"amount\"",
+				"$.fees[0].name: expected at least 3 characters",
+				"$.fees[0].kind: expected one of TAX, SERVICE",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := schema.Validate([]byte(tt.raw))
+			if tt.violations == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			var schemaErr *llm.SchemaError
+			assert.ErrorAs(t, err, &schemaErr)
+			assert.ErrorIs(t, err, llm.ErrSchemaViolation)
+			assert.ElementsMatch(t, tt.violations, schemaErr.Violations)
+		})
+	}
+}
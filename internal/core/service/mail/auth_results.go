@@ -0,0 +1,86 @@
+package mail
+
+import (
+	"net/mail"
+	"regexp"
+	"strings"
+)
+
+// authResultsComment matches the comments allowed in an Authentication-Results header, e.g. "(2048-bit key)".
+var authResultsComment = regexp.MustCompile(`\([^()]*\)`)
+
+// senderAuthenticated reports whether the receiving provider authenticated the sender's domain: DMARC
+// passed for the From domain, or DKIM or SPF passed for a domain aligned with it. Only the topmost
+// Authentication-Results header with the provider's authserv-id is read, as it is the one the provider
+// prepended on receipt. Headers from other servers, and the ones below it, came with the message and
+// can be forged by the sender.
+func senderAuthenticated(header mail.Header, authservID, fromDomain string) bool {
+	if authservID == "" || fromDomain == "" {
+		return false
+	}
+
+	for _, result := range header["Authentication-Results"] {
+		resinfos := strings.Split(authResultsComment.ReplaceAllString(result, " "), ";")
+		// The first part is the authserv-id, optionally followed by a version
+		if fields := strings.Fields(resinfos[0]); len(fields) > 0 && strings.EqualFold(fields[0], authservID) {
+			return resultsPass(resinfos[1:], fromDomain)
+		}
+	}
+
+	return false
+}
+
+// resultsPass reports whether one of the results of an Authentication-Results header authenticates fromDomain.
+func resultsPass(resinfos []string, fromDomain string) bool {
+	for _, resinfo := range resinfos {
+		fields := strings.Fields(strings.ToLower(resinfo))
+		if len(fields) == 0 {
+			continue
+		}
+
+		method, result, _ := strings.Cut(fields[0], "=")
+		if result != "pass" {
+			continue
+		}
+
+		props := make(map[string]string, len(fields)-1)
+		for _, field := range fields[1:] {
+			if key, value, ok := strings.Cut(field, "="); ok {
+				props[key] = strings.Trim(value, `"`)
+			}
+		}
+
+		switch method {
+		case "dmarc":
+			if domainsAligned(fromDomain, props["header.from"]) {
+				return true
+			}
+		case "dkim":
+			if domainsAligned(fromDomain, props["header.d"]) || domainsAligned(fromDomain, addressDomain(props["header.i"])) {
+				return true
+			}
+		case "spf":
+			if domainsAligned(fromDomain, addressDomain(props["smtp.mailfrom"])) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// domainsAligned follows DMARC's relaxed alignment, where either domain may be a subdomain of the other.
+func domainsAligned(a, b string) bool {
+	if a == "" || b == "" {
+		return false
+	}
+	return a == b || strings.HasSuffix(a, "."+b) || strings.HasSuffix(b, "."+a)
+}
+
+// addressDomain returns the domain of an address, or the value itself if it is already a domain.
+func addressDomain(address string) string {
+	if _, domain, ok := strings.Cut(address, "@"); ok {
+		return domain
+	}
+	return address
+}
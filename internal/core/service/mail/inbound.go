@@ -0,0 +1,226 @@
+package mail
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"path"
+	"strings"
+
+	"github.com/itsLeonB/ungerr"
+	"golang.org/x/text/encoding/htmlindex"
+)
+
+// InboundMail is a message received by the app, such as a receipt forwarded by a user.
+type InboundMail struct {
+	// From is the sender's address, lower-cased and without the display name.
+	From string
+	// SenderAuthenticated is set when the receiving provider authenticated From's domain with DMARC,
+	// DKIM or SPF. Without it, From can be forged by anyone who can send mail to the app.
+	SenderAuthenticated bool
+	Subject             string
+	Text                string
+	HTML                string
+	Attachments         []Attachment
+}
+
+type Attachment struct {
+	Filename    string
+	ContentType string
+	// ContentID is set on parts the HTML body can embed, such as logos.
+	ContentID string
+	Data      []byte
+}
+
+// maxPartDepth bounds the nesting of multiparts and forwarded messages.
+const maxPartDepth = 10
+
+var wordDecoder = mime.WordDecoder{CharsetReader: charsetReader}
+
+// ParseInbound reads a raw MIME message. Forwarded messages attached as message/rfc822 are read as
+// part of the message, so a receipt forwarded as an attachment is found like an inline one. The sender
+// is authenticated from the Authentication-Results header of the provider identified by authservID.
+func ParseInbound(raw []byte, authservID string) (InboundMail, error) {
+	msg, err := mail.ReadMessage(bytes.NewReader(raw))
+	if err != nil {
+		return InboundMail{}, ungerr.ValidationError("invalid mail message")
+	}
+
+	from, err := mail.ParseAddress(msg.Header.Get("From"))
+	if err != nil {
+		return InboundMail{}, ungerr.ValidationError("invalid mail sender")
+	}
+
+	subject, err := wordDecoder.DecodeHeader(msg.Header.Get("Subject"))
+	if err != nil {
+		subject = msg.Header.Get("Subject")
+	}
+
+	address := strings.ToLower(from.Address)
+	_, domain, _ := strings.Cut(address, "@")
+
+	inbound := InboundMail{
+		From:                address,
+		SenderAuthenticated: senderAuthenticated(msg.Header, authservID, domain),
+		Subject:             strings.TrimSpace(subject),
+	}
+	if err = inbound.readPart(textproto.MIMEHeader(msg.Header), msg.Body, 0); err != nil {
+		return InboundMail{}, err
+	}
+
+	return inbound, nil
+}
+
+func (im *InboundMail) readPart(header textproto.MIMEHeader, body io.Reader, depth int) error {
+	if depth > maxPartDepth {
+		return ungerr.ValidationError("mail message is nested too deeply")
+	}
+
+	mediaType, params, err := mime.ParseMediaType(header.Get("Content-Type"))
+	if err != nil {
+		mediaType, params = "text/plain", nil
+	}
+
+	if strings.HasPrefix(mediaType, "multipart/") {
+		reader := multipart.NewReader(body, params["boundary"])
+		for {
+			part, err := reader.NextRawPart()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return ungerr.ValidationError("invalid multipart mail message")
+			}
+			if err = im.readPart(part.Header, part, depth+1); err != nil {
+				return err
+			}
+		}
+	}
+
+	data, err := io.ReadAll(decodeTransfer(header.Get("Content-Transfer-Encoding"), body))
+	if err != nil {
+		return ungerr.ValidationError("invalid mail message encoding")
+	}
+
+	if mediaType == "message/rfc822" {
+		forwarded, err := mail.ReadMessage(bytes.NewReader(data))
+		if err != nil {
+			return nil
+		}
+		return im.readPart(textproto.MIMEHeader(forwarded.Header), forwarded.Body, depth+1)
+	}
+
+	filename := partFilename(header, params)
+	if filename == "" && (mediaType == "text/plain" || mediaType == "text/html") {
+		text := decodeCharset(params["charset"], data)
+		if mediaType == "text/html" {
+			im.HTML += text
+		} else {
+			im.Text += text
+		}
+		return nil
+	}
+
+	if mediaType == "application/octet-stream" || mediaType == "" {
+		if byExt, _, err := mime.ParseMediaType(mime.TypeByExtension(path.Ext(filename))); err == nil {
+			mediaType = byExt
+		}
+	}
+
+	contentID := strings.Trim(header.Get("Content-ID"), "<> ")
+	im.Attachments = append(im.Attachments, Attachment{filename, mediaType, contentID, data})
+	return nil
+}
+
+// receiptTypes are the attachments that can be a receipt, by preference, with their file extension.
+var receiptTypes = []struct{ contentType, ext string }{
+	{"application/pdf", ".pdf"},
+	{"image/jpeg", ".jpg"},
+	{"image/png", ".png"},
+	{"image/webp", ".webp"},
+}
+
+// Receipt picks the part of the mail most likely to be the receipt: a PDF attachment, then a photo,
+// then the HTML body and then the text body. Images embedded in the HTML body are decoration.
+// The returned file name always has the extension of its content type.
+func (im InboundMail) Receipt() (Attachment, bool) {
+	for _, receiptType := range receiptTypes {
+		for _, attachment := range im.Attachments {
+			if attachment.ContentType != receiptType.contentType || len(attachment.Data) == 0 {
+				continue
+			}
+			if attachment.ContentID != "" && strings.Contains(im.HTML, "cid:"+attachment.ContentID) {
+				continue
+			}
+			name := strings.TrimSuffix(attachment.Filename, path.Ext(attachment.Filename))
+			if name == "" {
+				name = "receipt"
+			}
+			attachment.Filename = name + receiptType.ext
+			return attachment, true
+		}
+	}
+
+	if strings.TrimSpace(im.HTML) != "" {
+		return Attachment{Filename: "receipt.html", ContentType: "text/html", Data: []byte(im.HTML)}, true
+	}
+	if strings.TrimSpace(im.Text) != "" {
+		return Attachment{Filename: "receipt.txt", ContentType: "text/plain", Data: []byte(im.Text)}, true
+	}
+	return Attachment{}, false
+}
+
+func partFilename(header textproto.MIMEHeader, params map[string]string) string {
+	filename := params["name"]
+	if _, dispositionParams, err := mime.ParseMediaType(header.Get("Content-Disposition")); err == nil && dispositionParams["filename"] != "" {
+		filename = dispositionParams["filename"]
+	}
+	if decoded, err := wordDecoder.DecodeHeader(filename); err == nil {
+		filename = decoded
+	}
+	if filename == "" {
+		return ""
+	}
+	return path.Base(strings.ReplaceAll(filename, "\\", "/"))
+}
+
+func decodeTransfer(encoding string, body io.Reader) io.Reader {
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "base64":
+		return base64.NewDecoder(base64.StdEncoding, body)
+	case "quoted-printable":
+		return quotedprintable.NewReader(body)
+	default:
+		return body
+	}
+}
+
+func decodeCharset(charset string, data []byte) string {
+	reader, err := charsetReader(charset, bytes.NewReader(data))
+	if err != nil {
+		return string(data)
+	}
+	decoded, err := io.ReadAll(reader)
+	if err != nil {
+		return string(data)
+	}
+	return string(decoded)
+}
+
+func charsetReader(charset string, input io.Reader) (io.Reader, error) {
+	switch strings.ToLower(charset) {
+	case "", "utf-8", "us-ascii":
+		return input, nil
+	}
+
+	encoding, err := htmlindex.Get(charset)
+	if err != nil {
+		return nil, err
+	}
+	return encoding.NewDecoder().Reader(input), nil
+}
@@ -0,0 +1,105 @@
+package mail_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/itsLeonB/cashback/internal/core/service/mail"
+	"github.com/itsLeonB/cashback/internal/core/service/pdftext"
+	"github.com/stretchr/testify/assert"
+)
+
+func readFixture(t *testing.T, name string) []byte {
+	data, err := os.ReadFile(filepath.Join("..", "..", "..", "..", "testdata", "mail", name))
+	assert.NoError(t, err)
+	return data
+}
+
+func TestParseInboundPDFAttachment(t *testing.T) {
+	inbound, err := mail.ParseInbound(readFixture(t, "pdf-invoice.eml"), "mx.example.net")
+	assert.NoError(t, err)
+	assert.Equal(t, "budi@example.com", inbound.From)
+	assert.Equal(t, "Fwd: Invoice Kopi Kenangan", inbound.Subject)
+
+	receipt, ok := inbound.Receipt()
+	assert.True(t, ok)
+	assert.Equal(t, "invoice.pdf", receipt.Filename)
+	assert.Equal(t, "application/pdf", receipt.ContentType)
+
+	text, err := pdftext.ExtractText(receipt.Data)
+	assert.NoError(t, err)
+	assert.Contains(t, text, "Kopi Susu Kenangan x2  48.000")
+}
+
+func TestParseInboundHTMLReceipt(t *testing.T) {
+	inbound, err := mail.ParseInbound(readFixture(t, "html-ereceipt.eml"), "mx.example.net")
+	assert.NoError(t, err)
+	assert.Equal(t, "Fwd: Your GoFood order — Warung Padang", inbound.Subject)
+	assert.Len(t, inbound.Attachments, 1)
+
+	// The logo is embedded in the HTML, so the HTML body is the receipt
+	receipt, ok := inbound.Receipt()
+	assert.True(t, ok)
+	assert.Equal(t, "receipt.html", receipt.Filename)
+	assert.Contains(t, string(receipt.Data), `<td>Rendang x2</td><td>Rp&nbsp;60.000</td>`)
+}
+
+func TestParseInboundForwardedMessage(t *testing.T) {
+	raw := "From: budi@example.com\r\nSubject: receipt\r\nContent-Type: multipart/mixed; boundary=b\r\n\r\n" +
+		"--b\r\nContent-Type: message/rfc822\r\n\r\n" +
+		"From: shop@example.com\r\nContent-Type: text/plain; charset=iso-8859-1\r\nContent-Transfer-Encoding: quoted-printable\r\n\r\n" +
+		"Cr=E8me br=FBl=E9e 25.000\r\n--b--\r\n"
+
+	inbound, err := mail.ParseInbound([]byte(raw), "mx.example.net")
+	assert.NoError(t, err)
+	assert.Equal(t, "budi@example.com", inbound.From)
+
+	receipt, ok := inbound.Receipt()
+	assert.True(t, ok)
+	assert.Equal(t, "receipt.txt", receipt.Filename)
+	assert.Equal(t, "Crème brûlée 25.000", string(receipt.Data))
+}
+
+func TestParseInboundInvalid(t *testing.T) {
+	_, err := mail.ParseInbound([]byte("not a mail"), "mx.example.net")
+	assert.Error(t, err)
+
+	inbound, err := mail.ParseInbound([]byte("From: budi@example.com\r\n\r\n \r\n"), "mx.example.net")
+	assert.NoError(t, err)
+	_, ok := inbound.Receipt()
+	assert.False(t, ok)
+}
+
+func TestParseInboundSenderAuthenticated(t *testing.T) {
+	cases := []struct {
+		name    string
+		results []string
+		want    bool
+	}{
+		{"dmarc pass", []string{"mx.example.net; dkim=pass header.d=example.com; dmarc=pass (p=NONE) header.from=example.com"}, true},
+		{"aligned dkim", []string{"mx.example.net; dkim=pass (2048-bit key) header.d=mail.example.com; dmarc=none"}, true},
+		{"aligned spf", []string{"mx.example.net; spf=pass smtp.mailfrom=bounce@example.com"}, true},
+		{"unaligned dkim", []string{"mx.example.net; dkim=pass header.d=attacker.test; spf=fail smtp.mailfrom=budi@example.com; dmarc=fail header.from=example.com"}, false},
+		{"dmarc for another domain", []string{"mx.example.net; dmarc=pass header.from=attacker.test"}, false},
+		{"dmarc without header.from", []string{"mx.example.net; dmarc=pass"}, false},
+		{"header from another server", []string{"mx.attacker.test; dmarc=pass header.from=example.com"}, false},
+		{"provider's header below another server's", []string{"mx.attacker.test; dmarc=pass header.from=example.com", "MX.example.net 1; dkim=pass header.d=example.com"}, true},
+		{"forged header below the provider's", []string{"mx.example.net; spf=softfail smtp.mailfrom=example.com", "mx.example.net; dmarc=pass header.from=example.com"}, false},
+		{"no header", nil, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			raw := ""
+			for _, result := range tc.results {
+				raw += "Authentication-Results: " + result + "\r\n"
+			}
+			raw += "From: Budi <budi@example.com>\r\nSubject: receipt\r\n\r\nKopi 25.000\r\n"
+
+			inbound, err := mail.ParseInbound([]byte(raw), "mx.example.net")
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, inbound.SenderAuthenticated)
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package ocr
+
+import (
+	"context"
+	"errors"
+	"html"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/itsLeonB/cashback/internal/core/otel"
+	"github.com/itsLeonB/cashback/internal/core/service/pdftext"
+	"github.com/itsLeonB/cashback/internal/core/service/storage"
+	"github.com/itsLeonB/ungerr"
+)
+
+// documentClient reads files that already carry their text straight from storage, and hands images
+// to the OCR provider.
+type documentClient struct {
+	imageClient OCRService
+	storageRepo storage.StorageRepository
+}
+
+func isDocument(uri string) bool {
+	switch strings.ToLower(path.Ext(uri)) {
+	case ".pdf", ".html", ".htm", ".txt":
+		return true
+	default:
+		return false
+	}
+}
+
+func (dc *documentClient) ExtractFromURI(ctx context.Context, uri string) (string, error) {
+	if !isDocument(uri) {
+		return dc.imageClient.ExtractFromURI(ctx, uri)
+	}
+
+	ctx, span := otel.Tracer.Start(ctx, "documentClient.ExtractFromURI")
+	defer span.End()
+
+	if dc.storageRepo == nil {
+		return "", ungerr.Unknownf("no storage to read %s from", uri)
+	}
+
+	fileID, err := dc.storageRepo.FromURI(uri)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := dc.storageRepo.Download(ctx, fileID)
+	if err != nil {
+		return "", err
+	}
+
+	switch strings.ToLower(path.Ext(uri)) {
+	case ".pdf":
+		text, err := pdftext.ExtractText(data)
+		if errors.Is(err, pdftext.ErrNoTextLayer) {
+			return "", ungerr.UnprocessableEntityError("pdf has no text layer, upload a photo of the receipt instead")
+		}
+		if err != nil {
+			return "", ungerr.Wrap(err, "error reading pdf text")
+		}
+		return text, nil
+	case ".html", ".htm":
+		return HTMLToText(string(data)), nil
+	default:
+		return strings.TrimSpace(string(data)), nil
+	}
+}
+
+func (dc *documentClient) Shutdown() error {
+	return dc.imageClient.Shutdown()
+}
+
+var (
+	htmlComment  = regexp.MustCompile(`(?s)<!--.*?-->`)
+	htmlHidden   = regexp.MustCompile(`(?is)<(head|style|script)\b.*?</(head|style|script)\s*>`)
+	htmlSpace    = regexp.MustCompile(`\s+`)
+	htmlLineEnd  = regexp.MustCompile(`(?i)<\s*(br|hr|/p|/div|/tr|/li|/table|/h[1-6])\b[^>]*>`)
+	htmlCellEnd  = regexp.MustCompile(`(?i)<\s*/t[dh]\s*>`)
+	htmlTag      = regexp.MustCompile(`<[^>]*>`)
+	inlineSpaces = regexp.MustCompile(`[ \t\x{00a0}]+`)
+)
+
+// HTMLToText lays an HTML e-receipt out as text lines: a line per block or table row, with the
+// cells of a row separated by spaces so item names and amounts stay on one line.
+func HTMLToText(s string) string {
+	s = htmlComment.ReplaceAllString(s, "")
+	s = htmlHidden.ReplaceAllString(s, "")
+	s = htmlSpace.ReplaceAllString(s, " ")
+	s = htmlLineEnd.ReplaceAllString(s, "\n")
+	s = htmlCellEnd.ReplaceAllString(s, " ")
+	s = htmlTag.ReplaceAllString(s, "")
+	s = html.UnescapeString(s)
+
+	var lines []string
+	for _, line := range strings.Split(s, "\n") {
+		if line = strings.TrimSpace(inlineSpaces.ReplaceAllString(line, " ")); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
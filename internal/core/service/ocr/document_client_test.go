@@ -0,0 +1,47 @@
+package ocr_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/itsLeonB/cashback/internal/core/config"
+	"github.com/itsLeonB/cashback/internal/core/service/ocr"
+	"github.com/itsLeonB/cashback/internal/core/service/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDocumentClient(t *testing.T) {
+	ctx := context.Background()
+	storageRepo, err := storage.NewStorageRepository(config.Storage{Provider: "local", LocalRoot: t.TempDir(), SigningKey: "secret"})
+	assert.NoError(t, err)
+
+	fixtures := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(fixtures, "default.txt"), []byte("from ocr"), 0o600))
+	client, err := ocr.NewOCRService(config.OCR{Provider: "fake", FixturesDir: fixtures}, storageRepo)
+	assert.NoError(t, err)
+
+	upload := func(name, content string) string {
+		fileID := storage.FileIdentifier{BucketName: "bills", ObjectKey: name}
+		assert.NoError(t, storageRepo.Upload(ctx, &storage.StorageUploadRequest{Data: []byte(content), FileIdentifier: fileID}))
+		return storageRepo.ToURI(fileID)
+	}
+
+	text, err := client.ExtractFromURI(ctx, upload("receipt.html", `<html><head><style>td { color: red }</style></head><body>
+<table><tr><td>Nasi Goreng</td>
+<td>Rp&nbsp;25.000</td></tr><tr><td>Total</td><td>Rp 25.000</td></tr></table></body></html>`))
+	assert.NoError(t, err)
+	assert.Equal(t, "Nasi Goreng Rp 25.000\nTotal Rp 25.000", text)
+
+	text, err = client.ExtractFromURI(ctx, upload("receipt.txt", "TOTAL 10.00\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, "TOTAL 10.00", text)
+
+	_, err = client.ExtractFromURI(ctx, upload("scan.pdf", "%PDF-1.7\n%%EOF"))
+	assert.Error(t, err)
+
+	text, err = client.ExtractFromURI(ctx, upload("photo.jpg", "jpeg"))
+	assert.NoError(t, err)
+	assert.Equal(t, "from ocr", text)
+}
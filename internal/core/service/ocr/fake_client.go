@@ -0,0 +1,66 @@
+package ocr
+
+import (
+	"context"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/itsLeonB/ungerr"
+)
+
+// DefaultFixture is the fixture returned for images without a fixture of their own.
+const DefaultFixture = "default"
+
+const fixtureExt = ".txt"
+
+// fakeClient returns canned text instead of reading the image, so the bill pipeline runs the same way every time.
+// A fixture is looked up by the full URI, then by the image's file name without extension, then DefaultFixture.
+type fakeClient struct {
+	fixtures map[string]string
+}
+
+func NewFakeClient(fixtures map[string]string) OCRService {
+	return &fakeClient{fixtures}
+}
+
+// newFakeClientFromDir loads every .txt file in dir as a fixture named after the file.
+func newFakeClientFromDir(dir string) (OCRService, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, ungerr.Wrap(err, "error reading ocr fixtures directory")
+	}
+
+	fixtures := make(map[string]string)
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != fixtureExt {
+			continue
+		}
+
+		text, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, ungerr.Wrap(err, "error reading ocr fixture")
+		}
+		fixtures[strings.TrimSuffix(entry.Name(), fixtureExt)] = string(text)
+	}
+
+	return NewFakeClient(fixtures), nil
+}
+
+func (fc *fakeClient) ExtractFromURI(ctx context.Context, uri string) (string, error) {
+	name := path.Base(uri)
+	name = strings.TrimSuffix(name, path.Ext(name))
+
+	for _, key := range []string{uri, name, DefaultFixture} {
+		if text, ok := fc.fixtures[key]; ok {
+			return text, nil
+		}
+	}
+
+	return "", ungerr.Unknownf("no ocr fixture for %s", uri)
+}
+
+func (fc *fakeClient) Shutdown() error {
+	return nil
+}
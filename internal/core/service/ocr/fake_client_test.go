@@ -0,0 +1,48 @@
+package ocr_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/itsLeonB/cashback/internal/core/config"
+	"github.com/itsLeonB/cashback/internal/core/service/ocr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFakeClient(t *testing.T) {
+	client := ocr.NewFakeClient(map[string]string{
+		"gs://bills/2026/10/18/exact.jpg": "exact",
+		"receipt":                         "by name",
+		ocr.DefaultFixture:                "default",
+	})
+
+	for uri, want := range map[string]string{
+		"gs://bills/2026/10/18/exact.jpg":   "exact",
+		"gs://bills/2026/10/18/receipt.png": "by name",
+		"file:///tmp/bills/other.jpg":       "default",
+	} {
+		text, err := client.ExtractFromURI(context.Background(), uri)
+		assert.NoError(t, err)
+		assert.Equal(t, want, text)
+	}
+
+	_, err := ocr.NewFakeClient(nil).ExtractFromURI(context.Background(), "gs://bills/missing.jpg")
+	assert.Error(t, err)
+}
+
+func TestNewOCRService_Fake(t *testing.T) {
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "default.txt"), []byte("TOTAL 10.00"), 0o600))
+
+	client, err := ocr.NewOCRService(config.OCR{Provider: "fake", FixturesDir: dir}, nil)
+	assert.NoError(t, err)
+
+	text, err := client.ExtractFromURI(context.Background(), "gs://bills/any.jpg")
+	assert.NoError(t, err)
+	assert.Equal(t, "TOTAL 10.00", text)
+
+	_, err = ocr.NewOCRService(config.OCR{Provider: "unknown"}, nil)
+	assert.Error(t, err)
+}
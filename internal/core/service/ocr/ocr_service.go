@@ -7,20 +7,51 @@ import (
 	"cloud.google.com/go/vision/v2/apiv1/visionpb"
 	"github.com/itsLeonB/cashback/internal/core/config"
 	"github.com/itsLeonB/cashback/internal/core/otel"
+	"github.com/itsLeonB/cashback/internal/core/service/storage"
 	"github.com/itsLeonB/ungerr"
 	"google.golang.org/api/option"
 )
 
 type OCRService interface {
+	// ExtractFromURI reads the text of the image or document stored at uri, as given by storage.StorageRepository.ToURI.
 	ExtractFromURI(ctx context.Context, uri string) (string, error)
 	Shutdown() error
 }
 
+// NewOCRService returns the configured OCR provider. PDFs with a text layer and e-receipts saved as
+// HTML or plain text are read directly, whatever the provider.
+func NewOCRService(cfg config.OCR, storageRepo storage.StorageRepository) (OCRService, error) {
+	client, err := newImageClient(cfg, storageRepo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &documentClient{client, storageRepo}, nil
+}
+
+func newImageClient(cfg config.OCR, storageRepo storage.StorageRepository) (OCRService, error) {
+	switch cfg.Provider {
+	case "cloud-vision":
+		return NewOCRClient()
+	case "tesseract":
+		return newTesseractClient(cfg, storageRepo)
+	case "fake":
+		return newFakeClientFromDir(cfg.FixturesDir)
+	default:
+		return nil, ungerr.Unknownf("unsupported ocr provider: %s", cfg.Provider)
+	}
+}
+
+// cloudVisionClient has Cloud Vision read the image straight from GCS, so it only works with gs:// URIs.
 type cloudVisionClient struct {
 	client *vision.ImageAnnotatorClient
 }
 
 func NewOCRClient() (*cloudVisionClient, error) {
+	if config.Global.GoogleCreds == nil {
+		return nil, ungerr.Unknown("GOOGLE_SERVICE_ACCOUNT is required for the cloud-vision ocr provider")
+	}
+
 	client, err := vision.NewImageAnnotatorClient(context.Background(), option.WithCredentials(config.Global.GoogleCreds))
 	if err != nil {
 		return nil, ungerr.Wrap(err, "error initializing vision client")
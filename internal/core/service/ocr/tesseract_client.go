@@ -0,0 +1,62 @@
+package ocr
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/itsLeonB/cashback/internal/core/config"
+	"github.com/itsLeonB/cashback/internal/core/otel"
+	"github.com/itsLeonB/cashback/internal/core/service/storage"
+	"github.com/itsLeonB/ungerr"
+)
+
+// tesseractClient runs the local tesseract executable on images downloaded through the storage repository,
+// so bills can be extracted without a cloud OCR service.
+type tesseractClient struct {
+	storageRepo storage.StorageRepository
+	path        string
+	lang        string
+}
+
+func newTesseractClient(cfg config.OCR, storageRepo storage.StorageRepository) (*tesseractClient, error) {
+	path, err := exec.LookPath(cfg.TesseractPath)
+	if err != nil {
+		return nil, ungerr.Wrap(err, fmt.Sprintf("tesseract executable %s is not found", cfg.TesseractPath))
+	}
+
+	return &tesseractClient{storageRepo, path, cfg.TesseractLang}, nil
+}
+
+func (tc *tesseractClient) ExtractFromURI(ctx context.Context, uri string) (string, error) {
+	ctx, span := otel.Tracer.Start(ctx, "tesseractClient.ExtractFromURI")
+	defer span.End()
+
+	fileID, err := tc.storageRepo.FromURI(uri)
+	if err != nil {
+		return "", err
+	}
+
+	image, err := tc.storageRepo.Download(ctx, fileID)
+	if err != nil {
+		return "", err
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, tc.path, "stdin", "stdout", "-l", tc.lang)
+	cmd.Stdin = bytes.NewReader(image)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err = cmd.Run(); err != nil {
+		return "", ungerr.Wrap(err, fmt.Sprintf("error running tesseract: %s", strings.TrimSpace(stderr.String())))
+	}
+
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+func (tc *tesseractClient) Shutdown() error {
+	return nil
+}
@@ -0,0 +1,346 @@
+package pdftext
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math"
+	"strconv"
+	"strings"
+)
+
+type tokenKind int
+
+const (
+	numberKind tokenKind = iota
+	stringKind
+	nameKind
+	arrayKind
+	operatorKind
+	delimiterKind
+)
+
+type token struct {
+	kind   tokenKind
+	text   string
+	number float64
+	raw    []byte
+	elems  []token
+}
+
+// lexer splits a content stream into the operands and operators of the PDF content syntax.
+type lexer struct {
+	data []byte
+	pos  int
+}
+
+func isWhitespace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\r' || c == '\n' || c == '\f' || c == 0
+}
+
+func isDelimiter(c byte) bool {
+	return strings.IndexByte("()<>[]{}/%", c) >= 0
+}
+
+func (l *lexer) skipSpace() {
+	for l.pos < len(l.data) {
+		c := l.data[l.pos]
+		if c == '%' {
+			for l.pos < len(l.data) && l.data[l.pos] != '\n' && l.data[l.pos] != '\r' {
+				l.pos++
+			}
+			continue
+		}
+		if !isWhitespace(c) {
+			return
+		}
+		l.pos++
+	}
+}
+
+func (l *lexer) readRegular() string {
+	start := l.pos
+	for l.pos < len(l.data) && !isWhitespace(l.data[l.pos]) && !isDelimiter(l.data[l.pos]) {
+		l.pos++
+	}
+	return string(l.data[start:l.pos])
+}
+
+func (l *lexer) next() (token, bool) {
+	l.skipSpace()
+	if l.pos >= len(l.data) {
+		return token{}, false
+	}
+
+	c := l.data[l.pos]
+	switch {
+	case c == '(':
+		l.pos++
+		return token{kind: stringKind, raw: l.readLiteral()}, true
+	case c == '<' && l.pos+1 < len(l.data) && l.data[l.pos+1] == '<':
+		l.pos += 2
+		return token{kind: delimiterKind, text: "<<"}, true
+	case c == '>' && l.pos+1 < len(l.data) && l.data[l.pos+1] == '>':
+		l.pos += 2
+		return token{kind: delimiterKind, text: ">>"}, true
+	case c == '<':
+		l.pos++
+		return token{kind: stringKind, raw: l.readHex()}, true
+	case c == '[':
+		l.pos++
+		var elems []token
+		for {
+			elem, ok := l.next()
+			if !ok || (elem.kind == delimiterKind && elem.text == "]") {
+				break
+			}
+			elems = append(elems, elem)
+		}
+		return token{kind: arrayKind, elems: elems}, true
+	case c == '/':
+		l.pos++
+		return token{kind: nameKind, text: l.readRegular()}, true
+	case isDelimiter(c):
+		l.pos++
+		return token{kind: delimiterKind, text: string(c)}, true
+	}
+
+	word := l.readRegular()
+	if number, err := strconv.ParseFloat(word, 64); err == nil {
+		return token{kind: numberKind, number: number}, true
+	}
+	if word == "ID" {
+		l.skipInlineImage()
+	}
+	return token{kind: operatorKind, text: word}, true
+}
+
+func (l *lexer) readLiteral() []byte {
+	var out []byte
+	depth := 1
+	for l.pos < len(l.data) {
+		c := l.data[l.pos]
+		l.pos++
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return out
+			}
+		case '\\':
+			if l.pos >= len(l.data) {
+				return out
+			}
+			c = l.data[l.pos]
+			l.pos++
+			switch c {
+			case 'n':
+				c = '\n'
+			case 'r':
+				c = '\r'
+			case 't':
+				c = '\t'
+			case 'b':
+				c = '\b'
+			case 'f':
+				c = '\f'
+			case '\r', '\n':
+				// Line continuation
+				if c == '\r' && l.pos < len(l.data) && l.data[l.pos] == '\n' {
+					l.pos++
+				}
+				continue
+			default:
+				if c >= '0' && c <= '7' {
+					value := int(c - '0')
+					for i := 0; i < 2 && l.pos < len(l.data) && l.data[l.pos] >= '0' && l.data[l.pos] <= '7'; i++ {
+						value = value*8 + int(l.data[l.pos]-'0')
+						l.pos++
+					}
+					c = byte(value)
+				}
+			}
+		}
+		out = append(out, c)
+	}
+	return out
+}
+
+func (l *lexer) readHex() []byte {
+	end := bytes.IndexByte(l.data[l.pos:], '>')
+	if end < 0 {
+		end = len(l.data) - l.pos
+	}
+	digits := strings.Map(func(r rune) rune {
+		if isWhitespace(byte(r)) {
+			return -1
+		}
+		return r
+	}, string(l.data[l.pos:l.pos+end]))
+	l.pos += end + 1
+
+	if len(digits)%2 == 1 {
+		digits += "0"
+	}
+	decoded, err := hex.DecodeString(digits)
+	if err != nil {
+		return nil
+	}
+	return decoded
+}
+
+// skipInlineImage jumps over the binary data of an inline image, up to its EI operator.
+func (l *lexer) skipInlineImage() {
+	for i := l.pos; i+2 < len(l.data); i++ {
+		if isWhitespace(l.data[i]) && l.data[i+1] == 'E' && l.data[i+2] == 'I' &&
+			(i+3 == len(l.data) || isWhitespace(l.data[i+3])) {
+			l.pos = i + 3
+			return
+		}
+	}
+	l.pos = len(l.data)
+}
+
+// textWriter follows the text position through the content stream to lay out the shown strings
+// as lines: strings on the same baseline are one line, with a gap between separately placed ones.
+type textWriter struct {
+	sb      strings.Builder
+	fonts   map[string]font
+	font    font
+	lineY   float64
+	y       float64
+	leading float64
+	lastY   float64
+	written bool
+	moved   bool
+	newLine bool
+}
+
+// kerningGap is how far a TJ adjustment, in thousandths of an em, moves back before it reads as a space.
+const kerningGap = -200
+
+// sameLine is how far apart two baselines can be and still be one line.
+const sameLine = 1.0
+
+func readContent(content []byte, fonts map[string]font) string {
+	w := textWriter{fonts: fonts, font: font{codeLength: 1}}
+	l := lexer{data: content}
+
+	var operands []token
+	for {
+		tok, ok := l.next()
+		if !ok {
+			break
+		}
+		if tok.kind != operatorKind {
+			operands = append(operands, tok)
+			continue
+		}
+		w.apply(tok.text, operands)
+		operands = operands[:0]
+	}
+
+	return w.sb.String()
+}
+
+func (w *textWriter) apply(operator string, operands []token) {
+	number := func(i int) float64 {
+		if i < len(operands) && operands[i].kind == numberKind {
+			return operands[i].number
+		}
+		return 0
+	}
+
+	switch operator {
+	case "BT":
+		w.lineY, w.y = 0, 0
+		w.moved = true
+	case "Tf":
+		if len(operands) > 0 && operands[0].kind == nameKind {
+			if f, ok := w.fonts[operands[0].text]; ok {
+				w.font = f
+			} else {
+				w.font = font{codeLength: 1}
+			}
+		}
+	case "Tm":
+		w.lineY = number(5)
+		w.y = w.lineY
+		w.moved = true
+	case "Td", "TD":
+		w.lineY += number(1)
+		w.y = w.lineY
+		if operator == "TD" {
+			w.leading = -number(1)
+		}
+		w.moved = true
+	case "TL":
+		w.leading = number(0)
+	case "T*":
+		w.nextLine()
+	case "Tj":
+		if len(operands) > 0 {
+			w.show(operands[0].raw)
+		}
+	case "'":
+		w.nextLine()
+		if len(operands) > 0 {
+			w.show(operands[0].raw)
+		}
+	case "\"":
+		w.nextLine()
+		if len(operands) > 2 {
+			w.show(operands[2].raw)
+		}
+	case "TJ":
+		if len(operands) == 0 {
+			return
+		}
+		for _, elem := range operands[0].elems {
+			switch elem.kind {
+			case stringKind:
+				w.show(elem.raw)
+			case numberKind:
+				if elem.number < kerningGap {
+					w.space(" ")
+				}
+			}
+		}
+	}
+}
+
+func (w *textWriter) nextLine() {
+	w.lineY -= w.leading
+	w.y = w.lineY
+	w.moved = true
+	w.newLine = true
+}
+
+func (w *textWriter) show(raw []byte) {
+	text := w.font.decode(raw)
+	if text == "" {
+		return
+	}
+
+	if w.written {
+		if w.newLine || math.Abs(w.y-w.lastY) > sameLine {
+			w.sb.WriteByte('\n')
+		} else if w.moved {
+			w.space("  ")
+		}
+	}
+
+	w.sb.WriteString(text)
+	w.lastY = w.y
+	w.written = true
+	w.moved = false
+	w.newLine = false
+}
+
+func (w *textWriter) space(gap string) {
+	text := w.sb.String()
+	if text != "" && !strings.HasSuffix(text, " ") && !strings.HasSuffix(text, "\n") {
+		w.sb.WriteString(gap)
+	}
+}
@@ -0,0 +1,133 @@
+package pdftext
+
+import (
+	"encoding/hex"
+	"regexp"
+	"strings"
+	"unicode/utf16"
+)
+
+// font decodes the bytes of a shown string. Without a ToUnicode map, each byte is read as
+// Latin-1, which covers the standard encodings for the characters found on receipts.
+type font struct {
+	codeLength int
+	toUnicode  map[string]string
+}
+
+func (f font) decode(raw []byte) string {
+	if f.toUnicode == nil {
+		if f.codeLength == 2 {
+			// Glyph IDs without a map cannot be read
+			return ""
+		}
+		runes := make([]rune, len(raw))
+		for i, b := range raw {
+			runes[i] = rune(b)
+		}
+		return string(runes)
+	}
+
+	var sb strings.Builder
+	for i := 0; i+f.codeLength <= len(raw); i += f.codeLength {
+		if text, ok := f.toUnicode[string(raw[i:i+f.codeLength])]; ok {
+			sb.WriteString(text)
+		}
+	}
+	return sb.String()
+}
+
+var (
+	codespaceRegex = regexp.MustCompile(`(?s)begincodespacerange\s*<([0-9A-Fa-f]+)>`)
+	bfcharBlock    = regexp.MustCompile(`(?s)beginbfchar(.*?)endbfchar`)
+	bfrangeBlock   = regexp.MustCompile(`(?s)beginbfrange(.*?)endbfrange`)
+	bfcharEntry    = regexp.MustCompile(`<([0-9A-Fa-f]+)>\s*<([0-9A-Fa-f]*)>`)
+	bfrangeEntry   = regexp.MustCompile(`(?s)<([0-9A-Fa-f]+)>\s*<([0-9A-Fa-f]+)>\s*(<[0-9A-Fa-f]*>|\[[^\]]*\])`)
+	hexItem        = regexp.MustCompile(`<([0-9A-Fa-f]*)>`)
+)
+
+// maxRangeSize stops a malformed bfrange from mapping the whole code space.
+const maxRangeSize = 1 << 16
+
+// parseCMap reads a ToUnicode CMap into a map from character code to text.
+func parseCMap(data []byte, codeLength int) (map[string]string, int) {
+	cmap := string(data)
+	if matches := codespaceRegex.FindStringSubmatch(cmap); matches != nil {
+		codeLength = len(matches[1]) / 2
+	}
+
+	toUnicode := make(map[string]string)
+	for _, block := range bfcharBlock.FindAllStringSubmatch(cmap, -1) {
+		for _, entry := range bfcharEntry.FindAllStringSubmatch(block[1], -1) {
+			if code, err := hex.DecodeString(entry[1]); err == nil {
+				toUnicode[string(code)] = utf16Hex(entry[2])
+			}
+		}
+	}
+
+	for _, block := range bfrangeBlock.FindAllStringSubmatch(cmap, -1) {
+		for _, entry := range bfrangeEntry.FindAllStringSubmatch(block[1], -1) {
+			lo, loErr := hex.DecodeString(entry[1])
+			hi, hiErr := hex.DecodeString(entry[2])
+			if loErr != nil || hiErr != nil || len(lo) != len(hi) {
+				continue
+			}
+			low, high := bytesToInt(lo), bytesToInt(hi)
+			if high < low || high-low > maxRangeSize {
+				continue
+			}
+
+			if strings.HasPrefix(entry[3], "[") {
+				for i, item := range hexItem.FindAllStringSubmatch(entry[3], -1) {
+					if low+i > high {
+						break
+					}
+					toUnicode[string(intToBytes(low+i, len(lo)))] = utf16Hex(item[1])
+				}
+				continue
+			}
+
+			dst := hexItem.FindStringSubmatch(entry[3])[1]
+			dstBytes, err := hex.DecodeString(dst)
+			if err != nil || len(dstBytes) == 0 {
+				continue
+			}
+			for i := 0; i <= high-low; i++ {
+				// The last byte of the destination is incremented along the range
+				next := append([]byte{}, dstBytes...)
+				next[len(next)-1] += byte(i)
+				toUnicode[string(intToBytes(low+i, len(lo)))] = utf16Hex(hex.EncodeToString(next))
+			}
+		}
+	}
+
+	return toUnicode, codeLength
+}
+
+func utf16Hex(s string) string {
+	data, err := hex.DecodeString(s)
+	if err != nil || len(data)%2 != 0 {
+		return ""
+	}
+	units := make([]uint16, len(data)/2)
+	for i := range units {
+		units[i] = uint16(data[2*i])<<8 | uint16(data[2*i+1])
+	}
+	return string(utf16.Decode(units))
+}
+
+func bytesToInt(b []byte) int {
+	n := 0
+	for _, c := range b {
+		n = n<<8 | int(c)
+	}
+	return n
+}
+
+func intToBytes(n, length int) []byte {
+	b := make([]byte, length)
+	for i := length - 1; i >= 0; i-- {
+		b[i] = byte(n)
+		n >>= 8
+	}
+	return b
+}
@@ -0,0 +1,338 @@
+// Package pdftext reads the text layer of a PDF, so invoices and e-receipts that were generated
+// rather than scanned can be parsed without OCR. It only supports what such documents use:
+// FlateDecode streams, object streams, and simple or Type0 fonts with a ToUnicode map.
+package pdftext
+
+import (
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"io"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrNoTextLayer is returned for PDFs with no text to read, such as scanned receipts.
+	ErrNoTextLayer = errors.New("pdf has no text layer")
+	ErrEncrypted   = errors.New("encrypted pdf is not supported")
+	ErrNotPDF      = errors.New("file is not a pdf")
+)
+
+var (
+	objectHeader    = regexp.MustCompile(`(\d+)\s+\d+\s+obj\b`)
+	refRegex        = regexp.MustCompile(`(\d+)\s+\d+\s+R\b`)
+	lengthRegex     = regexp.MustCompile(`/Length\s+(\d+)(\s+\d+\s+R)?`)
+	typeCatalog     = regexp.MustCompile(`/Type\s*/Catalog\b`)
+	typePages       = regexp.MustCompile(`/Type\s*/Pages\b`)
+	typePage        = regexp.MustCompile(`/Type\s*/Page\b`)
+	typeObjStm      = regexp.MustCompile(`/Type\s*/ObjStm\b`)
+	pagesRef        = regexp.MustCompile(`/Pages\s+(\d+)\s+\d+\s+R`)
+	kidsArray       = regexp.MustCompile(`/Kids\s*\[([^\]]*)\]`)
+	parentRef       = regexp.MustCompile(`/Parent\s+(\d+)\s+\d+\s+R`)
+	contentsRef     = regexp.MustCompile(`/Contents\s+(\d+)\s+\d+\s+R`)
+	contentsArray   = regexp.MustCompile(`/Contents\s*\[([^\]]*)\]`)
+	resourcesRef    = regexp.MustCompile(`/Resources\s+(\d+)\s+\d+\s+R`)
+	fontDictRef     = regexp.MustCompile(`/Font\s+(\d+)\s+\d+\s+R`)
+	fontDictInline  = regexp.MustCompile(`/Font\s*<<((?:\s*/[^\s/<>\[\]()]+\s+\d+\s+\d+\s+R)*)\s*>>`)
+	fontEntry       = regexp.MustCompile(`/([^\s/<>\[\]()]+)\s+(\d+)\s+\d+\s+R`)
+	toUnicodeRef    = regexp.MustCompile(`/ToUnicode\s+(\d+)\s+\d+\s+R`)
+	type0Font       = regexp.MustCompile(`/Subtype\s*/Type0\b`)
+	intRegex        = regexp.MustCompile(`/(N|First)\s+(\d+)`)
+	readableContent = regexp.MustCompile(`[\p{L}\d]`)
+)
+
+// object is an indirect object, its dictionary and its decoded stream if it has one.
+type object struct {
+	dict   string
+	stream []byte
+}
+
+type document struct {
+	objects map[int]object
+}
+
+// ExtractText returns the text of every page, in page order, one line per line of text.
+func ExtractText(data []byte) (string, error) {
+	if !bytes.HasPrefix(bytes.TrimLeft(data, " \t\r\n"), []byte("%PDF")) {
+		return "", ErrNotPDF
+	}
+	if bytes.Contains(data, []byte("/Encrypt")) {
+		return "", ErrEncrypted
+	}
+
+	doc := document{parseObjects(data)}
+
+	var pages []string
+	for _, page := range doc.pages() {
+		fonts := doc.pageFonts(page)
+		var content []byte
+		for _, id := range doc.pageContents(page) {
+			content = append(content, doc.objects[id].stream...)
+			content = append(content, '\n')
+		}
+		if text := strings.TrimSpace(readContent(content, fonts)); text != "" {
+			pages = append(pages, text)
+		}
+	}
+
+	text := strings.Join(pages, "\n\n")
+	if !readableContent.MatchString(text) {
+		return "", ErrNoTextLayer
+	}
+	return text, nil
+}
+
+func parseObjects(data []byte) map[int]object {
+	objects := make(map[int]object)
+	var streams []object
+
+	for _, match := range objectHeader.FindAllSubmatchIndex(data, -1) {
+		id, err := strconv.Atoi(string(data[match[2]:match[3]]))
+		if err != nil {
+			continue
+		}
+		obj, ok := parseObject(data[match[1]:])
+		if !ok {
+			continue
+		}
+		// Later definitions win, as incremental updates are appended to the file
+		objects[id] = obj
+		if typeObjStm.MatchString(obj.dict) {
+			streams = append(streams, obj)
+		}
+	}
+
+	for _, stream := range streams {
+		for id, obj := range parseObjectStream(stream) {
+			if _, exists := objects[id]; !exists {
+				objects[id] = obj
+			}
+		}
+	}
+
+	return objects
+}
+
+func parseObject(rest []byte) (object, bool) {
+	endObj := bytes.Index(rest, []byte("endobj"))
+	streamIdx := bytes.Index(rest, []byte("stream"))
+	if streamIdx < 0 || (endObj >= 0 && streamIdx > endObj) {
+		if endObj < 0 {
+			return object{}, false
+		}
+		return object{dict: string(rest[:endObj])}, true
+	}
+
+	dict := string(rest[:streamIdx])
+	start := streamIdx + len("stream")
+	if start < len(rest) && rest[start] == '\r' {
+		start++
+	}
+	if start < len(rest) && rest[start] == '\n' {
+		start++
+	}
+
+	var raw []byte
+	if matches := lengthRegex.FindStringSubmatch(dict); matches != nil && matches[2] == "" {
+		if length, err := strconv.Atoi(matches[1]); err == nil && start+length <= len(rest) {
+			raw = rest[start : start+length]
+		}
+	}
+	if raw == nil {
+		end := bytes.Index(rest[start:], []byte("endstream"))
+		if end < 0 {
+			return object{}, false
+		}
+		raw = bytes.TrimRight(rest[start:start+end], "\r\n")
+	}
+
+	return object{dict: dict, stream: decodeStream(dict, raw)}, true
+}
+
+// decodeStream inflates FlateDecode streams. Streams in other encodings are images or fonts, not text.
+func decodeStream(dict string, raw []byte) []byte {
+	if !strings.Contains(dict, "/Filter") {
+		return raw
+	}
+	if !strings.Contains(dict, "/FlateDecode") {
+		return nil
+	}
+
+	reader, err := zlib.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil
+	}
+	defer reader.Close()
+
+	// A truncated stream still has readable text up to where it ends
+	decoded, _ := io.ReadAll(reader)
+	return decoded
+}
+
+func parseObjectStream(stream object) map[int]object {
+	var n, first int
+	for _, matches := range intRegex.FindAllStringSubmatch(stream.dict, -1) {
+		value, _ := strconv.Atoi(matches[2])
+		if matches[1] == "N" {
+			n = value
+		} else {
+			first = value
+		}
+	}
+	if first > len(stream.stream) {
+		return nil
+	}
+
+	header := strings.Fields(string(stream.stream[:first]))
+	objects := make(map[int]object, n)
+	for i := 0; i+1 < len(header) && i/2 < n; i += 2 {
+		id, idErr := strconv.Atoi(header[i])
+		offset, offsetErr := strconv.Atoi(header[i+1])
+		if idErr != nil || offsetErr != nil || first+offset > len(stream.stream) {
+			continue
+		}
+		end := len(stream.stream)
+		if i+3 < len(header) {
+			if next, err := strconv.Atoi(header[i+3]); err == nil && first+next <= end && next >= offset {
+				end = first + next
+			}
+		}
+		objects[id] = object{dict: string(stream.stream[first+offset : end])}
+	}
+	return objects
+}
+
+// pages walks the page tree from the catalog, falling back to every page object in ID order.
+func (d document) pages() []int {
+	var pages []int
+	visited := make(map[int]bool)
+
+	var walk func(id int)
+	walk = func(id int) {
+		if visited[id] {
+			return
+		}
+		visited[id] = true
+
+		dict := d.objects[id].dict
+		switch {
+		case typePages.MatchString(dict):
+			if kids := kidsArray.FindStringSubmatch(dict); kids != nil {
+				for _, kid := range refs(kids[1]) {
+					walk(kid)
+				}
+			}
+		case typePage.MatchString(dict):
+			pages = append(pages, id)
+		}
+	}
+
+	for _, id := range d.sortedIDs() {
+		if dict := d.objects[id].dict; typeCatalog.MatchString(dict) {
+			if root := pagesRef.FindStringSubmatch(dict); root != nil {
+				rootID, _ := strconv.Atoi(root[1])
+				walk(rootID)
+			}
+			break
+		}
+	}
+	if len(pages) > 0 {
+		return pages
+	}
+
+	for _, id := range d.sortedIDs() {
+		if typePage.MatchString(d.objects[id].dict) {
+			pages = append(pages, id)
+		}
+	}
+	return pages
+}
+
+func (d document) sortedIDs() []int {
+	ids := make([]int, 0, len(d.objects))
+	for id := range d.objects {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+func (d document) pageContents(page int) []int {
+	dict := d.objects[page].dict
+	if matches := contentsArray.FindStringSubmatch(dict); matches != nil {
+		return refs(matches[1])
+	}
+	if matches := contentsRef.FindStringSubmatch(dict); matches != nil {
+		id, _ := strconv.Atoi(matches[1])
+		return []int{id}
+	}
+	return nil
+}
+
+// pageFonts maps the font names used by the page's content to their fonts. Resources are
+// inherited from the page tree, so the parents are looked at when the page has none.
+func (d document) pageFonts(page int) map[string]font {
+	for id, depth := page, 0; id != 0 && depth < 32; depth++ {
+		dict := d.objects[id].dict
+		if entries := d.fontEntries(dict); len(entries) > 0 {
+			fonts := make(map[string]font, len(entries))
+			for name, fontID := range entries {
+				fonts[name] = d.loadFont(fontID)
+			}
+			return fonts
+		}
+
+		id = 0
+		if parent := parentRef.FindStringSubmatch(dict); parent != nil {
+			id, _ = strconv.Atoi(parent[1])
+		}
+	}
+	return nil
+}
+
+func (d document) fontEntries(dict string) map[string]int {
+	if resources := resourcesRef.FindStringSubmatch(dict); resources != nil {
+		id, _ := strconv.Atoi(resources[1])
+		dict = d.objects[id].dict
+	}
+
+	fontDict := ""
+	if matches := fontDictInline.FindStringSubmatch(dict); matches != nil {
+		fontDict = matches[1]
+	} else if matches := fontDictRef.FindStringSubmatch(dict); matches != nil {
+		id, _ := strconv.Atoi(matches[1])
+		fontDict = d.objects[id].dict
+	}
+
+	entries := make(map[string]int)
+	for _, matches := range fontEntry.FindAllStringSubmatch(fontDict, -1) {
+		id, _ := strconv.Atoi(matches[2])
+		entries[matches[1]] = id
+	}
+	return entries
+}
+
+func (d document) loadFont(id int) font {
+	dict := d.objects[id].dict
+	f := font{codeLength: 1}
+	if type0Font.MatchString(dict) {
+		f.codeLength = 2
+	}
+	if matches := toUnicodeRef.FindStringSubmatch(dict); matches != nil {
+		cmapID, _ := strconv.Atoi(matches[1])
+		f.toUnicode, f.codeLength = parseCMap(d.objects[cmapID].stream, f.codeLength)
+	}
+	return f
+}
+
+func refs(s string) []int {
+	var ids []int
+	for _, matches := range refRegex.FindAllStringSubmatch(s, -1) {
+		id, _ := strconv.Atoi(matches[1])
+		ids = append(ids, id)
+	}
+	return ids
+}
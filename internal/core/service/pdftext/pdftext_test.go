@@ -0,0 +1,103 @@
+package pdftext
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// buildPDF writes the objects numbered from 1, with object 1 being the catalog.
+func buildPDF(objects ...string) []byte {
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.7\n")
+	for i, obj := range objects {
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+	buf.WriteString("trailer\n<< /Root 1 0 R >>\n%%EOF\n")
+	return buf.Bytes()
+}
+
+func stream(dict string, data []byte) string {
+	return fmt.Sprintf("<< %s /Length %d >>\nstream\n%s\nendstream", dict, len(data), data)
+}
+
+func deflate(data string) []byte {
+	var buf bytes.Buffer
+	w := zlib.NewWriter(&buf)
+	_, _ = w.Write([]byte(data))
+	_ = w.Close()
+	return buf.Bytes()
+}
+
+func TestExtractText(t *testing.T) {
+	content := `BT /F1 10 Tf 50 750 Td (GoFood Receipt) Tj
+0 -20 Td (Nasi Goreng x2) Tj 300 0 Td (50.000) Tj
+-300 -20 Td [(Es T) 20 (eh)] TJ 300 0 Td (8.000) Tj
+-300 -20 Td (Total) Tj 300 0 Td (58.000) Tj ET`
+
+	data := buildPDF(
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R /Resources << /Font << /F1 5 0 R >> >> /Contents 4 0 R >>",
+		stream("", []byte(content)),
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica >>",
+	)
+
+	text, err := ExtractText(data)
+	assert.NoError(t, err)
+	assert.Equal(t, "GoFood Receipt\nNasi Goreng x2  50.000\nEs Teh  8.000\nTotal  58.000", text)
+}
+
+func TestExtractTextType0Font(t *testing.T) {
+	// Glyph IDs 1-4 map to "R", "p", "1", "0" and 5 to a space
+	cmap := `/CIDInit /ProcSet findresource begin
+begincmap
+1 begincodespacerange <0000> <FFFF> endcodespacerange
+2 beginbfchar <0001> <0052> <0002> <0070> endbfchar
+1 beginbfrange <0003> <0004> [<0031> <0030>] endbfrange
+1 beginbfrange <0005> <0005> <0020> endbfrange
+endcmap`
+	content := `BT /C0 12 Tf 1 0 0 -1 40 100 Tm <000100020005000300040004> Tj
+1 0 0 -1 40 120 Tm <00030004> Tj ET`
+
+	data := buildPDF(
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 /Resources 6 0 R >>",
+		"<< /Type /Page /Parent 2 0 R /Contents [4 0 R] >>",
+		stream("/Filter /FlateDecode", deflate(content)),
+		stream("/Filter /FlateDecode", deflate(cmap)),
+		"<< /Font << /C0 7 0 R >> >>",
+		"<< /Type /Font /Subtype /Type0 /BaseFont /Roboto /Encoding /Identity-H /ToUnicode 5 0 R >>",
+	)
+
+	text, err := ExtractText(data)
+	assert.NoError(t, err)
+	assert.Equal(t, "Rp 100\n10", text)
+}
+
+func TestExtractTextErrors(t *testing.T) {
+	_, err := ExtractText([]byte("GIF89a"))
+	assert.ErrorIs(t, err, ErrNotPDF)
+
+	scanned := buildPDF(
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R /Contents 4 0 R >>",
+		stream("", []byte("q 600 0 0 800 0 0 cm /Im0 Do Q")),
+	)
+	_, err = ExtractText(scanned)
+	assert.ErrorIs(t, err, ErrNoTextLayer)
+
+	_, err = ExtractText([]byte("%PDF-1.7\ntrailer << /Encrypt 9 0 R >>"))
+	assert.ErrorIs(t, err, ErrEncrypted)
+}
+
+func TestReadLiteralEscapes(t *testing.T) {
+	l := lexer{data: []byte(`(a\(b\) \101\nc)`)}
+	tok, ok := l.next()
+	assert.True(t, ok)
+	assert.Equal(t, "a(b) A\nc", string(tok.raw))
+}
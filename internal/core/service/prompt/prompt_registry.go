@@ -0,0 +1,114 @@
+// Package prompt resolves versioned LLM prompts from Langfuse, falling back to the copies
+// embedded in the binary when Langfuse is unavailable or not configured.
+package prompt
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/itsLeonB/cashback/internal/core/logger"
+	"github.com/itsLeonB/cashback/internal/core/otel"
+	"github.com/itsLeonB/cashback/internal/core/service/langfuse"
+	"github.com/itsLeonB/ungerr"
+)
+
+// Source says where a prompt was read from, and is stored with what it produced.
+type Source string
+
+const (
+	LangfuseSource Source = "LANGFUSE"
+	EmbeddedSource Source = "EMBEDDED"
+)
+
+// templates holds templates/<name>/v<version>.json, each a prompt as returned by the Langfuse API.
+//
+//go:embed templates
+var templates embed.FS
+
+// Resolved is a prompt along with where it was read from.
+type Resolved struct {
+	Prompt  *langfuse.Prompt
+	Source  Source
+	Version int
+}
+
+type Registry interface {
+	// GetPrompt reads the pinned version of a prompt from Langfuse, or from the embedded copy when Langfuse fails.
+	GetPrompt(ctx context.Context, name string, version int) (Resolved, error)
+	// Validate checks that the embedded copy of a prompt exists and uses exactly the given variables.
+	Validate(name string, version int, variables []string) error
+}
+
+type registry struct {
+	langfuseClient langfuse.Client
+}
+
+func NewRegistry(langfuseClient langfuse.Client) Registry {
+	return &registry{langfuseClient}
+}
+
+func (r *registry) GetPrompt(ctx context.Context, name string, version int) (Resolved, error) {
+	ctx, span := otel.Tracer.Start(ctx, "promptRegistry.GetPrompt")
+	defer span.End()
+
+	p, err := r.langfuseClient.GetPrompt(ctx, name, langfuse.GetPromptOptions{Version: &version})
+	if err == nil {
+		return Resolved{p, LangfuseSource, version}, nil
+	}
+	if !errors.Is(err, langfuse.ErrNotConfigured) {
+		logger.Warnf("error getting prompt %s v%d from langfuse, using embedded copy: %v", name, version, err)
+	}
+
+	p, embeddedErr := embedded(name, version)
+	if embeddedErr != nil {
+		return Resolved{}, errors.Join(err, embeddedErr)
+	}
+
+	return Resolved{p, EmbeddedSource, version}, nil
+}
+
+func (r *registry) Validate(name string, version int, variables []string) error {
+	p, err := embedded(name, version)
+	if err != nil {
+		return err
+	}
+
+	used, err := p.Variables()
+	if err != nil {
+		return err
+	}
+
+	for _, v := range used {
+		if !slices.Contains(variables, v) {
+			return ungerr.Unknownf("prompt %s v%d uses undeclared variable %s", name, version, v)
+		}
+	}
+	for _, v := range variables {
+		if !slices.Contains(used, v) {
+			return ungerr.Unknownf("prompt %s v%d does not use declared variable %s", name, version, v)
+		}
+	}
+
+	return nil
+}
+
+func embedded(name string, version int) (*langfuse.Prompt, error) {
+	raw, err := templates.ReadFile(fmt.Sprintf("templates/%s/v%d.json", name, version))
+	if err != nil {
+		return nil, ungerr.Wrap(err, fmt.Sprintf("prompt %s v%d is not embedded", name, version))
+	}
+
+	var p langfuse.Prompt
+	if err := json.Unmarshal(raw, &p); err != nil {
+		return nil, ungerr.Wrap(err, fmt.Sprintf("error reading embedded prompt %s v%d", name, version))
+	}
+	if p.Name != name || p.Version != version {
+		return nil, ungerr.Unknownf("embedded prompt %s v%d is labelled %s v%d", name, version, p.Name, p.Version)
+	}
+
+	return &p, nil
+}
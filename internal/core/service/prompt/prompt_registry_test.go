@@ -0,0 +1,85 @@
+package prompt_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/itsLeonB/cashback/internal/core/config"
+	"github.com/itsLeonB/cashback/internal/core/logger"
+	"github.com/itsLeonB/cashback/internal/core/service/langfuse"
+	"github.com/itsLeonB/cashback/internal/core/service/prompt"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMain(m *testing.M) {
+	logger.Init("test")
+	os.Exit(m.Run())
+}
+
+var parseBillVariables = []string{"not_detected_bill_string", "text_to_parse"}
+
+func TestRegistry_GetPrompt(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("langfuse", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "1", r.URL.Query().Get("version"))
+			_ = json.NewEncoder(w).Encode(langfuse.Prompt{
+				Type:      langfuse.PromptTypeText,
+				Name:      "parse-bill",
+				Version:   1,
+				RawPrompt: json.RawMessage(`"from langfuse"`),
+			})
+		}))
+		defer server.Close()
+
+		registry := prompt.NewRegistry(langfuse.NewClient(config.Langfuse{PublicKey: "pk", SecretKey: "sk", BaseUrl: server.URL}))
+
+		resolved, err := registry.GetPrompt(ctx, "parse-bill", 1)
+		assert.NoError(t, err)
+		assert.Equal(t, prompt.LangfuseSource, resolved.Source)
+		assert.Equal(t, 1, resolved.Version)
+	})
+
+	t.Run("langfuse down", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		registry := prompt.NewRegistry(langfuse.NewClient(config.Langfuse{PublicKey: "pk", SecretKey: "sk", BaseUrl: server.URL}))
+
+		resolved, err := registry.GetPrompt(ctx, "parse-bill", 1)
+		assert.NoError(t, err)
+		assert.Equal(t, prompt.EmbeddedSource, resolved.Source)
+		assert.Equal(t, "parse-bill", resolved.Prompt.Name)
+
+		msgs, err := resolved.Prompt.Compile(map[string]any{"not_detected_bill_string": "NOT_DETECTED", "text_to_parse": "TOTAL 10"})
+		assert.NoError(t, err)
+		assert.Equal(t, "TOTAL 10", msgs[len(msgs)-1].Content)
+	})
+
+	t.Run("langfuse not configured", func(t *testing.T) {
+		registry := prompt.NewRegistry(langfuse.NewClient(config.Langfuse{}))
+
+		resolved, err := registry.GetPrompt(ctx, "parse-bill", 1)
+		assert.NoError(t, err)
+		assert.Equal(t, prompt.EmbeddedSource, resolved.Source)
+
+		_, err = registry.GetPrompt(ctx, "parse-bill", 99)
+		assert.Error(t, err)
+	})
+}
+
+func TestRegistry_Validate(t *testing.T) {
+	registry := prompt.NewRegistry(langfuse.NewClient(config.Langfuse{}))
+
+	assert.NoError(t, registry.Validate("parse-bill", 1, parseBillVariables))
+	assert.Error(t, registry.Validate("parse-bill", 1, parseBillVariables[:1]))
+	assert.Error(t, registry.Validate("parse-bill", 1, append(parseBillVariables, "currency")))
+	assert.Error(t, registry.Validate("parse-bill", 99, parseBillVariables))
+}
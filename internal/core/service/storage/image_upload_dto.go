@@ -2,7 +2,7 @@ package storage
 
 type ImageUploadRequest struct {
 	ImageData   []byte `validate:"required"`
-	ContentType string `validate:"required,oneof=image/jpeg image/png image/jpg image/webp"`
+	ContentType string `validate:"required,oneof=image/jpeg image/png image/jpg image/webp application/pdf text/html text/plain"`
 	FileSize    int64  `validate:"required,min=1"`
 	FileIdentifier
 }
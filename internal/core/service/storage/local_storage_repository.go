@@ -0,0 +1,277 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/itsLeonB/cashback/internal/core/config"
+	"github.com/itsLeonB/cashback/internal/core/otel"
+	"github.com/itsLeonB/ungerr"
+)
+
+// LocalURLPath is where this server serves the signed URLs of the local backend, see SignedURLVerifier.
+const LocalURLPath = "/storage"
+
+const tempFilePrefix = ".upload-"
+
+// SignedURLVerifier is implemented by backends whose signed URLs point at this server
+// instead of at a storage provider, so the HTTP layer can check them before reading or writing files.
+type SignedURLVerifier interface {
+	VerifySignedURL(method string, fileID FileIdentifier, query url.Values) error
+}
+
+// localStorageRepository keeps files on disk under root/bucket/key, for self-hosting and tests.
+type localStorageRepository struct {
+	root       string
+	publicURL  string
+	signingKey []byte
+}
+
+func newLocalStorageRepository(cfg config.Storage) (*localStorageRepository, error) {
+	if cfg.SigningKey == "" {
+		return nil, ungerr.Unknown("STORAGE_SIGNING_KEY is required for the local storage provider")
+	}
+
+	root, err := filepath.Abs(cfg.LocalRoot)
+	if err != nil {
+		return nil, ungerr.Wrap(err, "invalid local storage root")
+	}
+	if err = os.MkdirAll(root, 0o750); err != nil {
+		return nil, ungerr.Wrap(err, "failed to create local storage root")
+	}
+
+	return &localStorageRepository{
+		root,
+		strings.TrimSuffix(cfg.PublicUrl, "/"),
+		[]byte(cfg.SigningKey),
+	}, nil
+}
+
+func (r *localStorageRepository) Upload(ctx context.Context, req *StorageUploadRequest) error {
+	_, span := otel.Tracer.Start(ctx, "localStorageRepository.Upload")
+	defer span.End()
+
+	filePath, err := r.toPath(req.FileIdentifier)
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(filePath), 0o750); err != nil {
+		return ungerr.Wrap(err, "failed to create local storage directory")
+	}
+
+	var reader io.Reader
+	if req.Reader != nil {
+		reader = req.Reader
+	} else {
+		reader = bytes.NewReader(req.Data)
+	}
+
+	// Write to a temporary file first so readers never see a partial upload
+	tmp, err := os.CreateTemp(filepath.Dir(filePath), tempFilePrefix+"*")
+	if err != nil {
+		return ungerr.Wrap(err, "failed to create local file")
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	if _, err = io.Copy(tmp, reader); err != nil {
+		_ = tmp.Close()
+		return ungerr.Wrap(err, "failed to write local file")
+	}
+	if err = tmp.Close(); err != nil {
+		return ungerr.Wrap(err, "failed to finalize local file")
+	}
+	if err = os.Rename(tmp.Name(), filePath); err != nil {
+		return ungerr.Wrap(err, "failed to move local file into place")
+	}
+
+	return nil
+}
+
+func (r *localStorageRepository) Download(ctx context.Context, fileID FileIdentifier) ([]byte, error) {
+	_, span := otel.Tracer.Start(ctx, "localStorageRepository.Download")
+	defer span.End()
+
+	filePath, err := r.toPath(fileID)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, ungerr.NotFoundError("file not found")
+		}
+		return nil, ungerr.Wrap(err, "failed to read local file")
+	}
+
+	return data, nil
+}
+
+func (r *localStorageRepository) Delete(ctx context.Context, fileID FileIdentifier) error {
+	_, span := otel.Tracer.Start(ctx, "localStorageRepository.Delete")
+	defer span.End()
+
+	filePath, err := r.toPath(fileID)
+	if err != nil {
+		return err
+	}
+
+	if err = os.Remove(filePath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return ungerr.Wrap(err, "failed to delete local file")
+	}
+
+	return nil
+}
+
+func (r *localStorageRepository) GetUploadURL(fileID FileIdentifier, expiration time.Duration) (string, error) {
+	return r.signedURL(http.MethodPut, fileID, time.Now().Add(expiration))
+}
+
+func (r *localStorageRepository) GetSignedURL(fileID FileIdentifier, expiration time.Duration) (string, error) {
+	return r.signedURL(http.MethodGet, fileID, time.Now().Add(expiration))
+}
+
+func (r *localStorageRepository) signedURL(method string, fileID FileIdentifier, expires time.Time) (string, error) {
+	if _, err := r.toPath(fileID); err != nil {
+		return "", err
+	}
+
+	query := url.Values{}
+	query.Set("expires", strconv.FormatInt(expires.Unix(), 10))
+	query.Set("signature", r.sign(method, fileID, expires.Unix()))
+
+	escapedKey := (&url.URL{Path: fileID.ObjectKey}).EscapedPath()
+	return fmt.Sprintf("%s%s/%s/%s?%s", r.publicURL, LocalURLPath, url.PathEscape(fileID.BucketName), escapedKey, query.Encode()), nil
+}
+
+func (r *localStorageRepository) VerifySignedURL(method string, fileID FileIdentifier, query url.Values) error {
+	expires, err := strconv.ParseInt(query.Get("expires"), 10, 64)
+	if err != nil {
+		return ungerr.ForbiddenError("invalid signed URL")
+	}
+	if time.Now().Unix() > expires {
+		return ungerr.ForbiddenError("signed URL has expired")
+	}
+
+	signature, err := hex.DecodeString(query.Get("signature"))
+	if err != nil {
+		return ungerr.ForbiddenError("invalid signed URL")
+	}
+	expected, _ := hex.DecodeString(r.sign(method, fileID, expires))
+	if !hmac.Equal(signature, expected) {
+		return ungerr.ForbiddenError("invalid signed URL")
+	}
+
+	return nil
+}
+
+// sign binds the method into the signature, so a download URL cannot be used to upload.
+func (r *localStorageRepository) sign(method string, fileID FileIdentifier, expires int64) string {
+	mac := hmac.New(sha256.New, r.signingKey)
+	_, _ = fmt.Fprintf(mac, "%s\n%s\n%s\n%d", method, fileID.BucketName, fileID.ObjectKey, expires)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (r *localStorageRepository) GetAllObjectKeys(ctx context.Context, bucketName string) ([]string, error) {
+	_, span := otel.Tracer.Start(ctx, "localStorageRepository.GetAllObjectKeys")
+	defer span.End()
+
+	bucketPath, err := r.toPath(FileIdentifier{BucketName: bucketName})
+	if err != nil {
+		return nil, err
+	}
+
+	objectKeys := make([]string, 0)
+	err = filepath.WalkDir(bucketPath, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return fs.SkipAll
+			}
+			return err
+		}
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), tempFilePrefix) {
+			return nil
+		}
+		rel, err := filepath.Rel(bucketPath, filePath)
+		if err != nil {
+			return err
+		}
+		objectKeys = append(objectKeys, filepath.ToSlash(rel))
+		return nil
+	})
+	if err != nil {
+		return nil, ungerr.Wrap(err, "error listing objects in local bucket")
+	}
+
+	return objectKeys, nil
+}
+
+func (r *localStorageRepository) Exists(ctx context.Context, fileID FileIdentifier) (bool, error) {
+	_, span := otel.Tracer.Start(ctx, "localStorageRepository.Exists")
+	defer span.End()
+
+	filePath, err := r.toPath(fileID)
+	if err != nil {
+		return false, err
+	}
+
+	info, err := os.Stat(filePath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return info.Mode().IsRegular() && info.Size() > 0, nil
+}
+
+func (r *localStorageRepository) ToURI(fi FileIdentifier) string {
+	return fmt.Sprintf("local://%s/%s", fi.BucketName, fi.ObjectKey)
+}
+
+func (r *localStorageRepository) FromURI(uri string) (FileIdentifier, error) {
+	bucketName, objectKey, ok := strings.Cut(strings.TrimPrefix(uri, "local://"), "/")
+	if !strings.HasPrefix(uri, "local://") || !ok || bucketName == "" || objectKey == "" {
+		return FileIdentifier{}, ungerr.Unknownf("invalid local storage URI: %s", uri)
+	}
+	return FileIdentifier{BucketName: bucketName, ObjectKey: objectKey}, nil
+}
+
+func (r *localStorageRepository) Close() error {
+	return nil
+}
+
+// toPath maps a file to its path on disk, refusing anything that would resolve outside its bucket.
+func (r *localStorageRepository) toPath(fi FileIdentifier) (string, error) {
+	if fi.BucketName == "" || strings.ContainsAny(fi.BucketName, `/\`) || fi.BucketName == "." || fi.BucketName == ".." {
+		return "", ungerr.BadRequestError("invalid bucket name")
+	}
+
+	if fi.ObjectKey == "" {
+		return filepath.Join(r.root, fi.BucketName), nil
+	}
+
+	cleaned := path.Clean("/" + fi.ObjectKey)
+	if cleaned != "/"+fi.ObjectKey || strings.Contains(fi.ObjectKey, `\`) {
+		return "", ungerr.BadRequestError("invalid object key")
+	}
+
+	return filepath.Join(r.root, fi.BucketName, filepath.FromSlash(cleaned)), nil
+}
@@ -0,0 +1,252 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/itsLeonB/cashback/internal/core/config"
+	"github.com/itsLeonB/cashback/internal/core/otel"
+	"github.com/itsLeonB/ungerr"
+)
+
+// s3StorageRepository talks to S3 or an S3-compatible store such as MinIO over its REST API.
+type s3StorageRepository struct {
+	endpoint     *url.URL
+	usePathStyle bool
+	signer       *s3Signer
+	client       *http.Client
+}
+
+func newS3StorageRepository(cfg config.Storage) (*s3StorageRepository, error) {
+	if cfg.S3Endpoint == "" || cfg.S3AccessKey == "" || cfg.S3SecretKey == "" {
+		return nil, ungerr.Unknown("STORAGE_S3_ENDPOINT, STORAGE_S3_ACCESS_KEY and STORAGE_S3_SECRET_KEY are required for the s3 storage provider")
+	}
+
+	endpoint, err := url.Parse(strings.TrimSuffix(cfg.S3Endpoint, "/"))
+	if err != nil || endpoint.Scheme == "" || endpoint.Host == "" {
+		return nil, ungerr.Unknownf("invalid S3 endpoint: %s", cfg.S3Endpoint)
+	}
+
+	return &s3StorageRepository{
+		endpoint,
+		cfg.S3UsePathStyle,
+		&s3Signer{cfg.S3AccessKey, cfg.S3SecretKey, cfg.S3Region},
+		&http.Client{Timeout: time.Minute},
+	}, nil
+}
+
+func (r *s3StorageRepository) Upload(ctx context.Context, req *StorageUploadRequest) error {
+	ctx, span := otel.Tracer.Start(ctx, "s3StorageRepository.Upload")
+	defer span.End()
+
+	data := req.Data
+	if req.Reader != nil {
+		var err error
+		if data, err = io.ReadAll(req.Reader); err != nil {
+			return ungerr.Wrap(err, "failed to read file for S3 upload")
+		}
+	}
+
+	cacheControl := "public, max-age=3600"
+	if req.CacheControl != "" {
+		cacheControl = req.CacheControl
+	}
+
+	resp, err := r.do(ctx, http.MethodPut, r.objectURL(req.FileIdentifier), data, map[string]string{
+		"Content-Type":  req.ContentType,
+		"Cache-Control": cacheControl,
+	})
+	if err != nil {
+		return ungerr.Wrap(err, "failed to upload file to S3")
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return r.responseError(resp, "failed to upload file to S3")
+	}
+
+	return nil
+}
+
+func (r *s3StorageRepository) Download(ctx context.Context, fileID FileIdentifier) ([]byte, error) {
+	ctx, span := otel.Tracer.Start(ctx, "s3StorageRepository.Download")
+	defer span.End()
+
+	resp, err := r.do(ctx, http.MethodGet, r.objectURL(fileID), nil, nil)
+	if err != nil {
+		return nil, ungerr.Wrap(err, "failed to open file from S3")
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, r.responseError(resp, "failed to open file from S3")
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, ungerr.Wrap(err, "failed to download file from S3")
+	}
+
+	return data, nil
+}
+
+func (r *s3StorageRepository) Delete(ctx context.Context, fileID FileIdentifier) error {
+	ctx, span := otel.Tracer.Start(ctx, "s3StorageRepository.Delete")
+	defer span.End()
+
+	resp, err := r.do(ctx, http.MethodDelete, r.objectURL(fileID), nil, nil)
+	if err != nil {
+		return ungerr.Wrap(err, "failed to delete file from S3")
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	// S3 answers 204 whether or not the object existed
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
+		return r.responseError(resp, "failed to delete file from S3")
+	}
+
+	return nil
+}
+
+func (r *s3StorageRepository) GetUploadURL(fileID FileIdentifier, expiration time.Duration) (string, error) {
+	return r.signer.presign(http.MethodPut, r.objectURL(fileID), expiration, time.Now()), nil
+}
+
+func (r *s3StorageRepository) GetSignedURL(fileID FileIdentifier, expiration time.Duration) (string, error) {
+	return r.signer.presign(http.MethodGet, r.objectURL(fileID), expiration, time.Now()), nil
+}
+
+type listBucketResult struct {
+	Contents []struct {
+		Key string `xml:"Key"`
+	} `xml:"Contents"`
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+}
+
+func (r *s3StorageRepository) GetAllObjectKeys(ctx context.Context, bucketName string) ([]string, error) {
+	ctx, span := otel.Tracer.Start(ctx, "s3StorageRepository.GetAllObjectKeys")
+	defer span.End()
+
+	objectKeys := make([]string, 0)
+	continuationToken := ""
+	for {
+		listURL := r.objectURL(FileIdentifier{BucketName: bucketName})
+		query := url.Values{"list-type": {"2"}}
+		if continuationToken != "" {
+			query.Set("continuation-token", continuationToken)
+		}
+		listURL.RawQuery = canonicalQuery(query)
+
+		result, err := r.listObjects(ctx, listURL)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, content := range result.Contents {
+			objectKeys = append(objectKeys, content.Key)
+		}
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			return objectKeys, nil
+		}
+		continuationToken = result.NextContinuationToken
+	}
+}
+
+func (r *s3StorageRepository) listObjects(ctx context.Context, listURL *url.URL) (listBucketResult, error) {
+	resp, err := r.do(ctx, http.MethodGet, listURL, nil, nil)
+	if err != nil {
+		return listBucketResult{}, ungerr.Wrap(err, "error listing objects in bucket")
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return listBucketResult{}, r.responseError(resp, "error listing objects in bucket")
+	}
+
+	var result listBucketResult
+	if err = xml.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return listBucketResult{}, ungerr.Wrap(err, "error decoding object listing")
+	}
+
+	return result, nil
+}
+
+func (r *s3StorageRepository) Exists(ctx context.Context, fileID FileIdentifier) (bool, error) {
+	ctx, span := otel.Tracer.Start(ctx, "s3StorageRepository.Exists")
+	defer span.End()
+
+	resp, err := r.do(ctx, http.MethodHead, r.objectURL(fileID), nil, nil)
+	if err != nil {
+		return false, ungerr.Wrap(err, "failed to check file in S3")
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return resp.ContentLength > 0, nil
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		return false, r.responseError(resp, "failed to check file in S3")
+	}
+}
+
+func (r *s3StorageRepository) ToURI(fi FileIdentifier) string {
+	return fmt.Sprintf("s3://%s/%s", fi.BucketName, fi.ObjectKey)
+}
+
+func (r *s3StorageRepository) FromURI(uri string) (FileIdentifier, error) {
+	bucketName, objectKey, ok := strings.Cut(strings.TrimPrefix(uri, "s3://"), "/")
+	if !strings.HasPrefix(uri, "s3://") || !ok || bucketName == "" || objectKey == "" {
+		return FileIdentifier{}, ungerr.Unknownf("invalid S3 URI: %s", uri)
+	}
+	return FileIdentifier{BucketName: bucketName, ObjectKey: objectKey}, nil
+}
+
+func (r *s3StorageRepository) Close() error {
+	r.client.CloseIdleConnections()
+	return nil
+}
+
+// objectURL addresses the object, or the bucket itself when ObjectKey is empty.
+// Path style (endpoint/bucket/key) is what MinIO expects; virtual-hosted style is bucket.endpoint/key.
+func (r *s3StorageRepository) objectURL(fi FileIdentifier) *url.URL {
+	u := *r.endpoint
+	if r.usePathStyle {
+		u.Path = strings.TrimSuffix(u.Path, "/") + "/" + fi.BucketName + "/" + fi.ObjectKey
+	} else {
+		u.Host = fi.BucketName + "." + u.Host
+		u.Path = strings.TrimSuffix(u.Path, "/") + "/" + fi.ObjectKey
+	}
+	u.RawPath = canonicalURI(u.Path)
+	return &u
+}
+
+func (r *s3StorageRepository) do(ctx context.Context, method string, u *url.URL, body []byte, headers map[string]string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	for name, value := range headers {
+		if value != "" {
+			req.Header.Set(name, value)
+		}
+	}
+
+	r.signer.signRequest(req, hashHex(body), time.Now())
+
+	return r.client.Do(req)
+}
+
+func (r *s3StorageRepository) responseError(resp *http.Response, msg string) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	return ungerr.Unknownf("%s: status %d: %s", msg, resp.StatusCode, strings.TrimSpace(string(body)))
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 	"time"
 
 	"cloud.google.com/go/storage"
@@ -20,12 +21,14 @@ import (
 
 type StorageRepository interface {
 	Upload(ctx context.Context, req *StorageUploadRequest) error
+	Download(ctx context.Context, fileID FileIdentifier) ([]byte, error)
 	Delete(ctx context.Context, fileID FileIdentifier) error
 	GetUploadURL(fileID FileIdentifier, expiration time.Duration) (string, error)
 	GetSignedURL(fileID FileIdentifier, expiration time.Duration) (string, error)
 	GetAllObjectKeys(ctx context.Context, bucketName string) ([]string, error)
 	Exists(ctx context.Context, fileID FileIdentifier) (bool, error)
 	ToURI(fi FileIdentifier) string
+	FromURI(uri string) (FileIdentifier, error)
 	Close() error
 }
 
@@ -33,7 +36,25 @@ type gcsStorageRepository struct {
 	client *storage.Client
 }
 
+// NewStorageRepository picks the backend named by cfg.Provider.
+func NewStorageRepository(cfg config.Storage) (StorageRepository, error) {
+	switch cfg.Provider {
+	case "gcs":
+		return NewGCSStorageRepository()
+	case "local":
+		return newLocalStorageRepository(cfg)
+	case "s3":
+		return newS3StorageRepository(cfg)
+	default:
+		return nil, ungerr.Unknownf("unsupported storage provider: %s", cfg.Provider)
+	}
+}
+
 func NewGCSStorageRepository() (StorageRepository, error) {
+	if config.Global.GoogleCreds == nil {
+		return nil, ungerr.Unknown("GOOGLE_SERVICE_ACCOUNT is required for the gcs storage provider")
+	}
+
 	client, err := storage.NewClient(context.Background(), option.WithCredentials(config.Global.GoogleCreds))
 	if err != nil {
 		return nil, ungerr.Unknownf("failed to create GCS client: %v", err)
@@ -91,6 +112,24 @@ func (r *gcsStorageRepository) Upload(ctx context.Context, req *StorageUploadReq
 	return nil
 }
 
+func (r *gcsStorageRepository) Download(ctx context.Context, fileID FileIdentifier) ([]byte, error) {
+	ctx, span := otel.Tracer.Start(ctx, "gcsStorageRepository.Download")
+	defer span.End()
+
+	reader, err := r.toObject(fileID).NewReader(ctx)
+	if err != nil {
+		return nil, ungerr.Wrap(err, "failed to open file from GCS")
+	}
+	defer func() { _ = reader.Close() }()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, ungerr.Wrap(err, "failed to download file from GCS")
+	}
+
+	return data, nil
+}
+
 func (r *gcsStorageRepository) Delete(ctx context.Context, fileID FileIdentifier) error {
 	ctx, span := otel.Tracer.Start(ctx, "gcsStorageRepository.Delete")
 	defer span.End()
@@ -181,6 +220,14 @@ func (r *gcsStorageRepository) ToURI(fi FileIdentifier) string {
 	return fmt.Sprintf("gs://%s/%s", fi.BucketName, fi.ObjectKey)
 }
 
+func (r *gcsStorageRepository) FromURI(uri string) (FileIdentifier, error) {
+	bucketName, objectKey, ok := strings.Cut(strings.TrimPrefix(uri, "gs://"), "/")
+	if !strings.HasPrefix(uri, "gs://") || !ok || bucketName == "" || objectKey == "" {
+		return FileIdentifier{}, ungerr.Unknownf("invalid GCS URI: %s", uri)
+	}
+	return FileIdentifier{BucketName: bucketName, ObjectKey: objectKey}, nil
+}
+
 func (r *gcsStorageRepository) Close() error {
 	return r.client.Close()
 }
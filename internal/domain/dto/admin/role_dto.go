@@ -0,0 +1,38 @@
+package admin
+
+import (
+	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/domain/dto"
+)
+
+type NewRoleRequest struct {
+	AdminID     uuid.UUID `json:"-"`
+	Name        string    `json:"name" binding:"required,min=3"`
+	Permissions []string  `json:"permissions"`
+}
+
+type UpdateRoleRequest struct {
+	ID          uuid.UUID `json:"-"`
+	AdminID     uuid.UUID `json:"-"`
+	Name        string    `json:"name" binding:"required,min=3"`
+	Permissions []string  `json:"permissions"`
+}
+
+type RoleResponse struct {
+	dto.BaseDTO
+	Name         string   `json:"name"`
+	Permissions  []string `json:"permissions"`
+	IsSuperAdmin bool     `json:"isSuperAdmin"`
+}
+
+type UserRolesRequest struct {
+	AdminID uuid.UUID   `json:"-"`
+	UserID  uuid.UUID   `json:"-"`
+	RoleIDs []uuid.UUID `json:"roleIds"`
+}
+
+type UserResponse struct {
+	dto.BaseDTO
+	Email string         `json:"email"`
+	Roles []RoleResponse `json:"roles"`
+}
@@ -0,0 +1,32 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AuditLogQuery struct {
+	ActorType  string    `form:"actorType" binding:"omitempty,oneof=ADMIN PROFILE SYSTEM"`
+	ActorID    uuid.UUID `form:"-"`
+	Action     string    `form:"action" binding:"omitempty,oneof=CREATE UPDATE DELETE CONFIRM"`
+	EntityType string    `form:"entityType"`
+	EntityID   uuid.UUID `form:"-"`
+	From       time.Time `form:"from" time_format:"2006-01-02"`
+	To         time.Time `form:"to" time_format:"2006-01-02"` // inclusive day
+	Limit      int       `form:"limit" binding:"omitempty,min=1,max=500"`
+	Offset     int       `form:"offset" binding:"omitempty,min=0"`
+}
+
+type AuditLogResponse struct {
+	ID         uuid.UUID       `json:"id"`
+	CreatedAt  time.Time       `json:"createdAt"`
+	ActorType  string          `json:"actorType"`
+	ActorID    uuid.UUID       `json:"actorId,omitzero"`
+	Action     string          `json:"action"`
+	EntityType string          `json:"entityType"`
+	EntityID   uuid.UUID       `json:"entityId"`
+	Before     json.RawMessage `json:"before"`
+	After      json.RawMessage `json:"after"`
+}
@@ -1,17 +1,24 @@
 package dto
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/domain/service/auth"
+)
 
 type RegisterRequest struct {
 	Email                string `json:"email" binding:"required,email,min=3"`
 	Password             string `json:"password" binding:"required,eqfield=PasswordConfirmation"`
 	PasswordConfirmation string `json:"passwordConfirmation" binding:"required"`
 	Slug                 string `json:"slug"`
+	ClaimToken           string `json:"claimToken"`
 }
 
 type InternalLoginRequest struct {
-	Email    string `json:"email" binding:"required,email,min=3"`
-	Password string `json:"password" binding:"required"`
+	Email    string      `json:"email" binding:"required,email,min=3"`
+	Password string      `json:"password" binding:"required"`
+	Device   auth.Device `json:"-"`
 }
 
 type RefreshTokenRequest struct {
@@ -44,6 +51,16 @@ type OAuthCallbackData struct {
 	Provider string `validate:"required,min=1"`
 	Code     string `validate:"required,min=1"`
 	State    string `validate:"required,min=1"`
+	Device   auth.Device
+}
+
+type SessionResponse struct {
+	ID         string    `json:"id"`
+	DeviceID   string    `json:"deviceId,omitempty"`
+	UserAgent  string    `json:"userAgent,omitempty"`
+	CreatedAt  time.Time `json:"createdAt"`
+	LastUsedAt time.Time `json:"lastUsedAt"`
+	IsCurrent  bool      `json:"isCurrent"`
 }
 
 func NewTokenResp(token, refreshToken, fingerprint string) TokenResponse {
@@ -56,6 +73,8 @@ func NewTokenResp(token, refreshToken, fingerprint string) TokenResponse {
 }
 
 type AdminMe struct {
-	ID       uuid.UUID `json:"id"`
-	FullName string    `json:"fullName"`
+	ID          uuid.UUID `json:"id"`
+	FullName    string    `json:"fullName"`
+	Roles       []string  `json:"roles"`
+	Permissions []string  `json:"permissions"`
 }
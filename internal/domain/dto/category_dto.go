@@ -0,0 +1,55 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/domain/entity/expenses"
+	"github.com/shopspring/decimal"
+)
+
+type NewCategoryRequest struct {
+	ProfileID uuid.UUID `json:"-"`
+	Name      string    `json:"name" binding:"required,min=2,max=50"`
+}
+
+type CategoryResponse struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	IsDefault bool      `json:"isDefault"`
+}
+
+// LabelsRequest sets the category and tags of a group expense or a debt. They only label it,
+// so unlike its amounts they can be changed after confirmation. A nil CategoryID clears the category.
+type LabelsRequest struct {
+	ProfileID  uuid.UUID `json:"-"`
+	ID         uuid.UUID `json:"-"`
+	CategoryID uuid.UUID `json:"categoryId"`
+	Tags       []string  `json:"tags" binding:"max=20,dive,max=50"`
+}
+
+type SpendingRequest struct {
+	ProfileID  uuid.UUID                 `form:"-"`
+	GroupBy    expenses.SpendingGrouping `form:"groupBy" binding:"required,oneof=CATEGORY MONTH FRIEND CURRENCY"`
+	From       time.Time                 `form:"from" time_format:"2006-01-02"`
+	To         time.Time                 `form:"to" time_format:"2006-01-02"`
+	Currency   string                    `form:"currency" binding:"omitempty,len=3"`
+	CategoryID uuid.UUID                 `form:"-"`
+	Tag        string                    `form:"tag"`
+}
+
+// SpendingResponse sums up the user's shares of confirmed group expenses, one total per group and currency.
+type SpendingResponse struct {
+	GroupBy expenses.SpendingGrouping `json:"groupBy"`
+	Totals  []SpendingTotal           `json:"totals"`
+}
+
+type SpendingTotal struct {
+	// Key is the category ID, the month as YYYY-MM, the profile ID of who paid, or the currency.
+	// It is empty for expenses without a category.
+	Key          string          `json:"key"`
+	Label        string          `json:"label"`
+	Currency     string          `json:"currency"`
+	Total        decimal.Decimal `json:"total"`
+	ExpenseCount int             `json:"expenseCount"`
+}
@@ -16,20 +16,38 @@ type NewDebtTransactionRequest struct {
 	UserProfileID    uuid.UUID                `json:"-"`
 	FriendProfileID  uuid.UUID                `json:"friendProfileId" binding:"required"`
 	Direction        DebtTransactionDirection `json:"direction" binding:"oneof=INCOMING OUTGOING"`
-	Currency         string                   `json:"currency" binding:"len=3"`
+	Currency         string                   `json:"currency" binding:"omitempty,len=3"`
 	Amount           decimal.Decimal          `json:"amount" binding:"required"`
 	TransferMethodID uuid.UUID                `json:"transferMethodId" binding:"required"`
 	Description      string                   `json:"description"`
+	CategoryID       uuid.UUID                `json:"categoryId"`
+	Tags             []string                 `json:"tags" binding:"max=20,dive,max=50"`
+	GroupID          uuid.UUID                `json:"groupId"`
 }
 
 type DebtTransactionResponse struct {
 	BaseDTO
-	Profile        SimpleProfile   `json:"profile"`
-	Type           string          `json:"type"` // "LENT" or "BORROWED"
-	Currency       string          `json:"currency"`
-	Amount         decimal.Decimal `json:"amount"`
-	TransferMethod string          `json:"transferMethod"`
-	Description    string          `json:"description"`
-	GroupExpenseID uuid.UUID       `json:"groupExpenseId"`
-	IsFromExpense  bool            `json:"isFromExpense"`
+	Profile        SimpleProfile    `json:"profile"`
+	Type           string           `json:"type"` // "LENT" or "BORROWED"
+	Currency       string           `json:"currency"`
+	Amount         decimal.Decimal  `json:"amount"`
+	TransferMethod string           `json:"transferMethod"`
+	Description    string           `json:"description"`
+	GroupExpenseID uuid.UUID        `json:"groupExpenseId"`
+	IsFromExpense  bool             `json:"isFromExpense"`
+	Category       CategoryResponse `json:"category,omitzero"`
+	Tags           []string         `json:"tags"`
+	GroupID        uuid.UUID        `json:"groupId"`
+}
+
+type SettleUpRequest struct {
+	UserProfileID   uuid.UUID
+	GroupExpenseIDs []uuid.UUID
+}
+
+type SettleUpTransfer struct {
+	From     SimpleProfile   `json:"from"`
+	To       SimpleProfile   `json:"to"`
+	Currency string          `json:"currency"`
+	Amount   decimal.Decimal `json:"amount"`
 }
@@ -2,6 +2,8 @@ package dto
 
 import (
 	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/domain/entity/expenses"
+	"github.com/shopspring/decimal"
 )
 
 type NewExpenseBillRequest struct {
@@ -15,17 +17,95 @@ type NewExpenseBillRequest struct {
 
 type ExpenseBillResponse struct {
 	BaseDTO
-	ImageURL string `json:"imageUrl"`
-	Status   string `json:"status"`
+	ImageURL string                    `json:"imageUrl"` // First page, kept for single image clients
+	Status   string                    `json:"status"`
+	Pages    []ExpenseBillPageResponse `json:"pages"`
+	Proposal *BillProposal             `json:"proposal,omitempty"`
 }
 
+type ExpenseBillPageResponse struct {
+	BaseDTO
+	PageNumber int    `json:"pageNumber"`
+	ImageURL   string `json:"imageUrl"`
+	Status     string `json:"status"`
+}
+
+// PresignedExpenseBillRequest uploads a bill image. By default it replaces the bill, AddPage
+// adds the image as the next page instead, e.g. for a long receipt or a service charge slip.
 type PresignedExpenseBillRequest struct {
 	ProfileID      uuid.UUID `json:"-"`
 	GroupExpenseID uuid.UUID `json:"-"`
 	Filename       string    `json:"fileName" binding:"required,min=3"`
+	AddPage        bool      `json:"addPage"`
 }
 
 type PresignedExpenseBillResponse struct {
-	BillID    uuid.UUID `json:"billId"`
-	UploadURL string    `json:"uploadUrl"`
+	BillID     uuid.UUID `json:"billId"`
+	PageID     uuid.UUID `json:"pageId"`
+	PageNumber int       `json:"pageNumber"`
+	UploadURL  string    `json:"uploadUrl"`
+}
+
+// BillProposal is what was read off a bill, kept for review before it lands in the draft.
+type BillProposal struct {
+	Parser      string             `json:"parser"`
+	Warnings    []string           `json:"warnings,omitempty"`
+	Description string             `json:"description"`
+	TotalAmount decimal.Decimal    `json:"totalAmount"`
+	Subtotal    decimal.Decimal    `json:"subtotal"`
+	Items       []ProposedBillLine `json:"items"`
+	OtherFees   []ProposedBillLine `json:"otherFees"`
+	// Reconciliation compares the proposed lines with the total printed on the receipt
+	Reconciliation BillReconciliation `json:"reconciliation"`
+	// SuggestedCategory names the default category the receipt seems to belong to
+	SuggestedCategory string `json:"suggestedCategory,omitempty"`
+}
+
+// BillReconciliation checks that items and fees add up to the receipt's printed total.
+// Difference is PrintedTotal minus ItemsTotal and FeesTotal, so a positive one means something is missing.
+type BillReconciliation struct {
+	ItemsTotal   decimal.Decimal `json:"itemsTotal"`
+	FeesTotal    decimal.Decimal `json:"feesTotal"`
+	PrintedTotal decimal.Decimal `json:"printedTotal"`
+	Difference   decimal.Decimal `json:"difference"`
+	Balanced     bool            `json:"balanced"`
+}
+
+// ProposedBillLine is an item or fee of a BillProposal. Confidence runs from 0 to 1, and
+// SourceLineNumber is the 1-based line of the extracted text it came from, 0 when not found.
+type ProposedBillLine struct {
+	Index             int                           `json:"index"`
+	Name              string                        `json:"name"`
+	Amount            decimal.Decimal               `json:"amount"`
+	Quantity          int                           `json:"quantity,omitempty"`
+	Kind              expenses.FeeKind              `json:"kind,omitempty"`
+	CalculationMethod expenses.FeeCalculationMethod `json:"calculationMethod,omitempty"`
+	Confidence        float64                       `json:"confidence"`
+	SourceLine        string                        `json:"sourceLine"`
+	SourceLineNumber  int                           `json:"sourceLineNumber"`
+	Decision          expenses.BillLineDecision     `json:"decision"`
+}
+
+// BillReviewRequest applies a proposal to the draft. Lines left out are accepted as proposed.
+type BillReviewRequest struct {
+	ProfileID      uuid.UUID        `json:"-"`
+	GroupExpenseID uuid.UUID        `json:"-"`
+	BillID         uuid.UUID        `json:"-"`
+	Description    string           `json:"description"`
+	Items          []BillLineReview `json:"items" binding:"dive"`
+	OtherFees      []BillLineReview `json:"otherFees" binding:"dive"`
+	// CategoryID labels the draft. When not set, the suggested category is used if the draft has none yet.
+	CategoryID uuid.UUID `json:"categoryId"`
+}
+
+// BillLineReview decides on the proposed line at Index. The other fields are only read when
+// Decision is EDITED, and blank ones keep the proposed value.
+type BillLineReview struct {
+	Index             int                           `json:"index" binding:"min=0"`
+	Decision          expenses.BillLineDecision     `json:"decision" binding:"required,oneof=ACCEPTED EDITED REJECTED"`
+	Name              string                        `json:"name"`
+	Amount            decimal.Decimal               `json:"amount"`
+	Quantity          int                           `json:"quantity" binding:"min=0"`
+	Kind              expenses.FeeKind              `json:"kind" binding:"omitempty,oneof=TAX SERVICE ROUNDING OTHER"`
+	CalculationMethod expenses.FeeCalculationMethod `json:"calculationMethod" binding:"omitempty,oneof=EQUAL_SPLIT ITEMIZED_SPLIT PERCENTAGE_SPLIT FIXED_PER_PERSON"`
 }
@@ -2,12 +2,14 @@ package dto
 
 import (
 	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/domain/entity/expenses"
 	"github.com/shopspring/decimal"
 )
 
 type ItemParticipantResponse struct {
 	Profile         SimpleProfile   `json:"profile"`
 	Weight          int             `json:"weight"`
+	Share           decimal.Decimal `json:"share"`
 	AllocatedAmount decimal.Decimal `json:"allocatedAmount"`
 }
 
@@ -17,6 +19,7 @@ type ExpenseItemResponse struct {
 	Name           string                    `json:"name"`
 	Amount         decimal.Decimal           `json:"amount"`
 	Quantity       int                       `json:"quantity"`
+	SplitMode      expenses.SplitMode        `json:"splitMode"`
 	Participants   []ItemParticipantResponse `json:"participants,omitempty"`
 }
 
@@ -30,8 +33,9 @@ type UpdateExpenseItemRequest struct {
 }
 
 type ItemParticipantRequest struct {
-	ProfileID uuid.UUID `json:"profileId" binding:"required"`
-	Weight    int       `json:"weight"`
+	ProfileID uuid.UUID       `json:"profileId" binding:"required"`
+	Weight    int             `json:"weight"`
+	Share     decimal.Decimal `json:"share"` // exact amount or percentage, depending on the item's split mode
 }
 
 type NewExpenseItemRequest struct {
@@ -46,5 +50,6 @@ type SyncItemParticipantsRequest struct {
 	ProfileID      uuid.UUID                `json:"-"`
 	ID             uuid.UUID                `json:"-"`
 	GroupExpenseID uuid.UUID                `json:"-"`
+	SplitMode      expenses.SplitMode       `json:"splitMode" binding:"omitempty,oneof=WEIGHT EXACT PERCENTAGE"`
 	Participants   []ItemParticipantRequest `json:"participants" binding:"dive"`
 }
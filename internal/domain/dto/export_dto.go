@@ -0,0 +1,36 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/domain/entity"
+)
+
+type NewExportRequest struct {
+	ProfileID      uuid.UUID           `json:"-"`
+	Kind           entity.ExportKind   `json:"kind" binding:"required,oneof=DEBTS FRIEND_STATEMENT GROUP_EXPENSE"`
+	Format         entity.ExportFormat `json:"format" binding:"required,oneof=CSV XLSX PDF"`
+	FriendshipID   uuid.UUID           `json:"friendshipId"`   // required for FRIEND_STATEMENT
+	GroupExpenseID uuid.UUID           `json:"groupExpenseId"` // required for GROUP_EXPENSE
+	Month          string              `json:"month"`          // YYYY-MM, all time if empty
+	InHomeCurrency bool                `json:"inHomeCurrency"`
+}
+
+// ExportParams is what an export job needs to render the statement later on.
+type ExportParams struct {
+	FriendshipID   uuid.UUID `json:"friendshipId,omitzero"`
+	GroupExpenseID uuid.UUID `json:"groupExpenseId,omitzero"`
+	Month          string    `json:"month,omitempty"`
+	InHomeCurrency bool      `json:"inHomeCurrency,omitempty"`
+}
+
+type ExportResponse struct {
+	BaseDTO
+	Kind         entity.ExportKind   `json:"kind"`
+	Format       entity.ExportFormat `json:"format"`
+	Status       entity.ExportStatus `json:"status"`
+	URL          string              `json:"url,omitempty"`
+	ErrorMessage string              `json:"errorMessage,omitempty"`
+	CompletedAt  time.Time           `json:"completedAt,omitzero"`
+}
@@ -38,10 +38,14 @@ type FriendDetails struct {
 	ProfileID2 uuid.UUID `json:"profileId2"`
 }
 
+// FriendBalance is from the user's point of view. TotalSettled and TotalOutstanding net the settled
+// and unsettled debts like NetBalance: positive is owed to the user, negative is owed by the user.
 type FriendBalance struct {
 	NetBalance              decimal.Decimal         `json:"netBalance"`
 	TotalLentToFriend       decimal.Decimal         `json:"totalLentToFriend"`
 	TotalBorrowedFromFriend decimal.Decimal         `json:"totalBorrowedFromFriend"`
+	TotalSettled            decimal.Decimal         `json:"totalSettled"`
+	TotalOutstanding        decimal.Decimal         `json:"totalOutstanding"`
 	TransactionHistory      []FriendTransactionItem `json:"transactionHistory"`
 }
 
@@ -51,6 +55,9 @@ type FriendTransactionItem struct {
 	Amount         decimal.Decimal `json:"amount"`
 	TransferMethod string          `json:"transferMethod"`
 	Description    string          `json:"description"`
+	IsSettlement   bool            `json:"isSettlement"`
+	IsSettled      bool            `json:"isSettled"`
+	SettlementID   uuid.UUID       `json:"settlementId,omitzero"`
 }
 
 type FriendDetailsResponse struct {
@@ -0,0 +1,29 @@
+package dto
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+type UploadFxRatesRequest struct {
+	Rates []NewFxRateRequest `json:"rates" binding:"required,min=1,dive"`
+}
+
+type NewFxRateRequest struct {
+	BaseCurrency  string          `json:"baseCurrency" binding:"required,len=3"`
+	QuoteCurrency string          `json:"quoteCurrency" binding:"required,len=3,nefield=BaseCurrency"`
+	Rate          decimal.Decimal `json:"rate" binding:"required"`
+	EffectiveDate string          `json:"effectiveDate" binding:"required,datetime=2006-01-02"`
+}
+
+type FxRateQuery struct {
+	Limit  int `form:"limit" binding:"omitempty,min=1,max=500"`
+	Offset int `form:"offset" binding:"omitempty,min=0"`
+}
+
+type FxRateResponse struct {
+	BaseDTO
+	BaseCurrency  string          `json:"baseCurrency"`
+	QuoteCurrency string          `json:"quoteCurrency"`
+	Rate          decimal.Decimal `json:"rate"`
+	EffectiveDate string          `json:"effectiveDate"`
+}
@@ -0,0 +1,72 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/domain/entity/expenses"
+	"github.com/shopspring/decimal"
+)
+
+type GroupMemberRequest struct {
+	ProfileID     uuid.UUID `json:"profileId" binding:"required"`
+	DefaultWeight int       `json:"defaultWeight" binding:"omitempty,min=1"` // 1 when not given
+}
+
+type NewGroupRequest struct {
+	ProfileID       uuid.UUID            `json:"-"`
+	Name            string               `json:"name" binding:"required,min=2,max=100"`
+	DefaultCurrency string               `json:"defaultCurrency" binding:"omitempty,len=3"`
+	Members         []GroupMemberRequest `json:"members" binding:"dive"`
+}
+
+type UpdateGroupRequest struct {
+	ProfileID       uuid.UUID `json:"-"`
+	ID              uuid.UUID `json:"-"`
+	Name            string    `json:"name" binding:"required,min=2,max=100"`
+	DefaultCurrency string    `json:"defaultCurrency" binding:"required,len=3"`
+}
+
+// GroupMembersRequest replaces the members of a group. Members left out are removed.
+type GroupMembersRequest struct {
+	ProfileID uuid.UUID            `json:"-"`
+	ID        uuid.UUID            `json:"-"`
+	Members   []GroupMemberRequest `json:"members" binding:"required,min=1,dive"`
+}
+
+type GroupResponse struct {
+	BaseDTO
+	Name            string                `json:"name"`
+	DefaultCurrency string                `json:"defaultCurrency"`
+	Creator         SimpleProfile         `json:"creator"`
+	Members         []GroupMemberResponse `json:"members"`
+}
+
+type GroupMemberResponse struct {
+	Profile       SimpleProfile `json:"profile"`
+	IsAnonymous   bool          `json:"isAnonymous"`
+	DefaultWeight int           `json:"defaultWeight"`
+	JoinedAt      time.Time     `json:"joinedAt"`
+}
+
+type GroupBalanceResponse struct {
+	Profile SimpleProfile `json:"profile"`
+	// NetPositions per currency is positive when the member is owed by the group, and negative when they owe it.
+	NetPositions map[string]decimal.Decimal `json:"netPositions"`
+	// WithUser per currency is positive when the member owes the user, and negative when the user owes them.
+	WithUser map[string]decimal.Decimal `json:"withUser"`
+}
+
+// GroupActivityResponse is an entry of a group's activity feed: an expense, a debt between two members,
+// or a member joining. Counterparty is only set for debts, as the borrower.
+type GroupActivityResponse struct {
+	Type         expenses.GroupActivityType `json:"type"`
+	ID           uuid.UUID                  `json:"id"`
+	Profile      SimpleProfile              `json:"profile"`
+	Counterparty SimpleProfile              `json:"counterparty,omitzero"`
+	Description  string                     `json:"description,omitempty"`
+	Currency     string                     `json:"currency,omitempty"`
+	Amount       decimal.Decimal            `json:"amount,omitzero"`
+	Status       string                     `json:"status,omitempty"`
+	OccurredAt   time.Time                  `json:"occurredAt"`
+}
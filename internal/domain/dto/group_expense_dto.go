@@ -17,16 +17,21 @@ type NewGroupExpenseRequest struct {
 
 type GroupExpenseResponse struct {
 	BaseDTO
-	Currency         string          `json:"currency"`
-	TotalAmount      decimal.Decimal `json:"totalAmount"`
-	ItemsTotalAmount decimal.Decimal `json:"itemsTotalAmount"`
-	FeesTotalAmount  decimal.Decimal `json:"feesTotalAmount"`
-	Description      string          `json:"description"`
-	Status           string          `json:"status"`
-	IsPreviewable    bool            `json:"isPreviewable"`
+	Currency         string           `json:"currency"`
+	TotalAmount      decimal.Decimal  `json:"totalAmount"`
+	ItemsTotalAmount decimal.Decimal  `json:"itemsTotalAmount"`
+	FeesTotalAmount  decimal.Decimal  `json:"feesTotalAmount"`
+	Description      string           `json:"description"`
+	Status           string           `json:"status"`
+	Revision         int              `json:"revision"`
+	IsPreviewable    bool             `json:"isPreviewable"`
+	Category         CategoryResponse `json:"category,omitzero"`
+	Tags             []string         `json:"tags"`
+	GroupID          uuid.UUID        `json:"groupId"`
 
 	// Relationships
 	Payer        SimpleProfile                `json:"payer"`
+	Payers       []ExpensePayerResponse       `json:"payers"`
 	Creator      SimpleProfile                `json:"creator"`
 	Items        []ExpenseItemResponse        `json:"items"`
 	OtherFees    []OtherFeeResponse           `json:"otherFees"`
@@ -34,6 +39,9 @@ type GroupExpenseResponse struct {
 	Bill         ExpenseBillResponse          `json:"bill"`
 	BillExists   bool                         `json:"billExists"`
 
+	// Reconciliation is set when the expense came from a bill with a printed total
+	Reconciliation *BillReconciliation `json:"reconciliation,omitempty"`
+
 	ConfirmationPreview ExpenseConfirmationResponse `json:"confirmationPreview"`
 }
 
@@ -44,26 +52,42 @@ type ExpenseParticipantResponse struct {
 	HasProxy           bool            `json:"hasProxy"`
 }
 
+type ExpensePayerResponse struct {
+	Profile    SimpleProfile   `json:"profile"`
+	PaidAmount decimal.Decimal `json:"paidAmount"`
+}
+
 type NewDraftRequest struct {
 	UserProfileID uuid.UUID `json:"-"`
 	Description   string    `json:"description"`
-	Currency      string    `json:"currency" binding:"len=3"`
+	Currency      string    `json:"currency" binding:"omitempty,len=3"`
+	CategoryID    uuid.UUID `json:"categoryId"`
+	Tags          []string  `json:"tags" binding:"max=20,dive,max=50"`
+	GroupID       uuid.UUID `json:"groupId"` // starts the expense with the group's members the user is friends with
 }
 
 type ExpenseParticipantsRequest struct {
 	ParticipantProfileIDs []uuid.UUID             `json:"participantProfileIds" binding:"required,min=1"`
 	ProxyByProfileIDs     map[uuid.UUID]uuid.UUID `json:"proxyByProfileIds"`
-	PayerProfileID        uuid.UUID               `json:"payerProfileId" binding:"required"`
+	PayerProfileID        uuid.UUID               `json:"payerProfileId" binding:"required_without=Payers"`
+	Payers                []ExpensePayerRequest   `json:"payers" binding:"omitempty,dive"`
 	UserProfileID         uuid.UUID               `json:"-"`
 	GroupExpenseID        uuid.UUID               `json:"-"`
 }
 
+// ExpensePayerRequest is one of several payers splitting the bill, with the amount they paid.
+type ExpensePayerRequest struct {
+	ProfileID uuid.UUID       `json:"profileId" binding:"required"`
+	Amount    decimal.Decimal `json:"amount" binding:"required"`
+}
+
 type ExpenseConfirmationResponse struct {
 	ID           uuid.UUID                     `json:"id"`
 	Description  string                        `json:"description"`
 	Currency     string                        `json:"currency"`
 	TotalAmount  decimal.Decimal               `json:"totalAmount"`
 	Payer        SimpleProfile                 `json:"payer"`
+	Payers       []ExpensePayerResponse        `json:"payers"`
 	Participants []ConfirmedExpenseParticipant `json:"participants"`
 }
 
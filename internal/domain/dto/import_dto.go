@@ -0,0 +1,55 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/domain/entity"
+	"github.com/shopspring/decimal"
+)
+
+type NewImportRequest struct {
+	ProfileID uuid.UUID           `json:"-"`
+	Source    entity.ImportSource `json:"source" binding:"required,oneof=CSV SPLITWISE"`
+	SelfName  string              `json:"selfName" binding:"required"` // how the user is named in the file
+	Currency  string              `json:"currency" binding:"omitempty,len=3"`
+	Content   string              `json:"content" binding:"required,max=2097152"`
+	DryRun    bool                `json:"dryRun"`
+}
+
+type ImportResponse struct {
+	BaseDTO
+	Source        entity.ImportSource `json:"source"`
+	Status        entity.ImportStatus `json:"status"`
+	DryRun        bool                `json:"dryRun"`
+	TotalRows     int                 `json:"totalRows"`
+	ProcessedRows int                 `json:"processedRows"`
+	Preview       *ImportPreview      `json:"preview,omitempty"`
+	ErrorMessage  string              `json:"errorMessage,omitempty"`
+	CompletedAt   time.Time           `json:"completedAt,omitzero"`
+}
+
+// ImportPreview is what an import records, or would record on a dry run.
+type ImportPreview struct {
+	Debts         int                  `json:"debts"`
+	GroupExpenses int                  `json:"groupExpenses"`
+	NewFriends    []string             `json:"newFriends"`
+	Entries       []ImportEntryPreview `json:"entries"`
+	Skipped       []ImportSkippedRow   `json:"skipped"`
+}
+
+type ImportEntryPreview struct {
+	Line         int             `json:"line"`
+	Kind         string          `json:"kind"` // "DEBT" or "GROUP_EXPENSE"
+	Date         time.Time       `json:"date"`
+	Description  string          `json:"description"`
+	Currency     string          `json:"currency"`
+	Amount       decimal.Decimal `json:"amount"`
+	Payers       []string        `json:"payers"`
+	Participants []string        `json:"participants"`
+}
+
+type ImportSkippedRow struct {
+	Line   int    `json:"line"`
+	Reason string `json:"reason"`
+}
@@ -0,0 +1,8 @@
+package dto
+
+import "github.com/google/uuid"
+
+type InboundMailResponse struct {
+	GroupExpenseID uuid.UUID `json:"groupExpenseId"`
+	BillID         uuid.UUID `json:"billId"`
+}
@@ -41,6 +41,7 @@ type MidtransNotificationPayload struct {
 
 type UpdatePaymentRequest struct {
 	ID       uuid.UUID       `json:"-"`
+	AdminID  uuid.UUID       `json:"-"`
 	Status   string          `json:"status" binding:"required,oneof=pending processing paid canceled error expired"`
 	Amount   decimal.Decimal `json:"amount" binding:"required"`
 	Currency string          `json:"currency" binding:"required"`
@@ -8,6 +8,7 @@ import (
 )
 
 type NewSubscriptionRequest struct {
+	AdminID       uuid.UUID `json:"-"` // zero when the system attaches a subscription
 	ProfileID     uuid.UUID `json:"profileId" binding:"required"`
 	PlanVersionID uuid.UUID `json:"planVersionId" binding:"required"`
 	EndsAt        time.Time `json:"endsAt"`
@@ -40,6 +41,7 @@ type SubscriptionResponse struct {
 
 type UpdateSubscriptionRequest struct {
 	ID                 uuid.UUID `json:"-"`
+	AdminID            uuid.UUID `json:"-"`
 	ProfileID          uuid.UUID `json:"profileId" binding:"required"`
 	PlanVersionID      uuid.UUID `json:"planVersionId" binding:"required"`
 	EndsAt             time.Time `json:"endsAt"`
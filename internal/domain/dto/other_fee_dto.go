@@ -19,10 +19,12 @@ type FeeCalculationMethodInfo struct {
 
 type OtherFeeResponse struct {
 	BaseDTO
-	Name              string                   `json:"name"`
-	Amount            decimal.Decimal          `json:"amount"`
-	CalculationMethod string                   `json:"calculationMethod"`
-	Participants      []FeeParticipantResponse `json:"participants,omitempty"`
+	Name              string                        `json:"name"`
+	Amount            decimal.Decimal               `json:"amount"`
+	Kind              string                        `json:"kind"`
+	CalculationMethod string                        `json:"calculationMethod"`
+	ParticipantShares map[uuid.UUID]decimal.Decimal `json:"participantShares,omitempty"`
+	Participants      []FeeParticipantResponse      `json:"participants,omitempty"`
 }
 
 type NewOtherFeeRequest struct {
@@ -30,7 +32,9 @@ type NewOtherFeeRequest struct {
 	GroupExpenseID    uuid.UUID                     `json:"-"`
 	Name              string                        `json:"name" binding:"required,min=3"`
 	Amount            decimal.Decimal               `json:"amount" binding:"required"`
+	Kind              expenses.FeeKind              `json:"kind" binding:"omitempty,oneof=TAX SERVICE ROUNDING OTHER"`
 	CalculationMethod expenses.FeeCalculationMethod `json:"calculationMethod" binding:"required"`
+	ParticipantShares map[uuid.UUID]decimal.Decimal `json:"participantShares"`
 }
 
 type UpdateOtherFeeRequest struct {
@@ -39,5 +43,7 @@ type UpdateOtherFeeRequest struct {
 	GroupExpenseID    uuid.UUID                     `json:"-"`
 	Name              string                        `json:"name" binding:"required,min=3"`
 	Amount            decimal.Decimal               `json:"amount" binding:"required"`
+	Kind              expenses.FeeKind              `json:"kind" binding:"omitempty,oneof=TAX SERVICE ROUNDING OTHER"`
 	CalculationMethod expenses.FeeCalculationMethod `json:"calculationMethod" binding:"required"`
+	ParticipantShares map[uuid.UUID]decimal.Decimal `json:"participantShares"`
 }
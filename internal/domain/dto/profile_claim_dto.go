@@ -0,0 +1,37 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ProfileClaimLinkResponse struct {
+	URL       string    `json:"url"`
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// ProfileClaimPreviewResponse is shown to the recipient of a claim link before they sign in.
+type ProfileClaimPreviewResponse struct {
+	OwnerName   string    `json:"ownerName"`
+	OwnerAvatar string    `json:"ownerAvatar"`
+	ProfileName string    `json:"profileName"`
+	ExpiresAt   time.Time `json:"expiresAt"`
+}
+
+type ClaimProfileRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
+type ClaimProfileResponse struct {
+	Owner          ProfileResponse `json:"owner"`
+	ClaimedProfile ProfileResponse `json:"claimedProfile"`
+}
+
+// ProfileClaim is the verified content of a claim link.
+type ProfileClaim struct {
+	OwnerProfileID uuid.UUID
+	AnonProfileID  uuid.UUID
+	ExpiresAt      time.Time
+}
@@ -0,0 +1,55 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/domain/entity"
+	"github.com/itsLeonB/cashback/internal/domain/entity/expenses"
+	"github.com/shopspring/decimal"
+)
+
+type NewRecurringTemplateRequest struct {
+	CreatorProfileID uuid.UUID                    `json:"-"`
+	Kind             entity.RecurringTemplateKind `json:"kind" binding:"oneof=GROUP_EXPENSE DEBT"`
+	Name             string                       `json:"name" binding:"required"`
+	CronSpec         string                       `json:"cronSpec" binding:"required"`
+	AutoConfirm      bool                         `json:"autoConfirm"`
+	GroupExpense     *RecurringGroupExpense       `json:"groupExpense" binding:"required_if=Kind GROUP_EXPENSE,omitempty"`
+	Debt             *NewDebtTransactionRequest   `json:"debt" binding:"required_if=Kind DEBT,omitempty"`
+}
+
+// RecurringGroupExpense is the blueprint of a GroupExpense, stored as the payload of a recurring template.
+type RecurringGroupExpense struct {
+	Description           string                  `json:"description"`
+	Currency              string                  `json:"currency" binding:"omitempty,len=3"`
+	PayerProfileID        uuid.UUID               `json:"payerProfileId" binding:"required_without=Payers"`
+	Payers                []ExpensePayerRequest   `json:"payers" binding:"omitempty,dive"`
+	ParticipantProfileIDs []uuid.UUID             `json:"participantProfileIds" binding:"required,min=1"`
+	ProxyByProfileIDs     map[uuid.UUID]uuid.UUID `json:"proxyByProfileIds"`
+	Items                 []RecurringExpenseItem  `json:"items" binding:"required,min=1,dive"`
+	OtherFees             []NewOtherFeeRequest    `json:"otherFees" binding:"dive"`
+	CategoryID            uuid.UUID               `json:"categoryId"`
+	Tags                  []string                `json:"tags" binding:"max=20,dive,max=50"`
+}
+
+type RecurringExpenseItem struct {
+	Name         string                   `json:"name" binding:"required,min=3"`
+	Amount       decimal.Decimal          `json:"amount" binding:"required"`
+	Quantity     int                      `json:"quantity" binding:"required,min=1"`
+	SplitMode    expenses.SplitMode       `json:"splitMode" binding:"omitempty,oneof=WEIGHT EXACT PERCENTAGE"`
+	Participants []ItemParticipantRequest `json:"participants" binding:"required,min=1,dive"`
+}
+
+type RecurringTemplateResponse struct {
+	BaseDTO
+	Kind         entity.RecurringTemplateKind `json:"kind"`
+	Name         string                       `json:"name"`
+	CronSpec     string                       `json:"cronSpec"`
+	AutoConfirm  bool                         `json:"autoConfirm"`
+	IsActive     bool                         `json:"isActive"`
+	NextRunAt    time.Time                    `json:"nextRunAt"`
+	LastRunAt    time.Time                    `json:"lastRunAt,omitzero"`
+	GroupExpense *RecurringGroupExpense       `json:"groupExpense,omitempty"`
+	Debt         *NewDebtTransactionRequest   `json:"debt,omitempty"`
+}
@@ -0,0 +1,36 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type NewSettlementRequest struct {
+	UserProfileID           uuid.UUID                `json:"-"`
+	FriendProfileID         uuid.UUID                `json:"friendProfileId" binding:"required"`
+	Direction               DebtTransactionDirection `json:"direction" binding:"oneof=INCOMING OUTGOING"`
+	Currency                string                   `json:"currency" binding:"omitempty,len=3"`
+	Amount                  decimal.Decimal          `json:"amount" binding:"required"`
+	ProfileTransferMethodID uuid.UUID                `json:"profileTransferMethodId"`
+	TransferMethodID        uuid.UUID                `json:"transferMethodId"`
+	DebtTransactionIDs      []uuid.UUID              `json:"debtTransactionIds"`
+	GroupExpenseIDs         []uuid.UUID              `json:"groupExpenseIds"`
+	Note                    string                   `json:"note"`
+	ProofFilename           string                   `json:"proofFileName" binding:"omitempty,min=3"`
+	GroupID                 uuid.UUID                `json:"groupId"`
+}
+
+type SettlementResponse struct {
+	BaseDTO
+	Payer                 SimpleProfile   `json:"payer"`
+	Payee                 SimpleProfile   `json:"payee"`
+	Currency              string          `json:"currency"`
+	Amount                decimal.Decimal `json:"amount"`
+	TransferMethod        string          `json:"transferMethod"`
+	AccountName           string          `json:"accountName,omitempty"`
+	AccountNumber         string          `json:"accountNumber,omitempty"`
+	Note                  string          `json:"note"`
+	ProofImageURL         string          `json:"proofImageUrl,omitempty"`
+	ProofUploadURL        string          `json:"proofUploadUrl,omitempty"`
+	SettledTransactionIDs []uuid.UUID     `json:"settledTransactionIds"`
+}
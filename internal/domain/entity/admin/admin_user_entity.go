@@ -3,6 +3,7 @@ package admin
 import (
 	"github.com/google/uuid"
 	"github.com/itsLeonB/go-crud"
+	"gorm.io/datatypes"
 )
 
 type User struct {
@@ -17,7 +18,8 @@ func (User) TableName() string {
 
 type Role struct {
 	crud.BaseEntity
-	Name string
+	Name        string
+	Permissions datatypes.JSONSlice[Permission]
 }
 
 func (Role) TableName() string {
@@ -28,6 +30,7 @@ type UserRole struct {
 	crud.BaseEntity
 	UserID uuid.UUID
 	RoleID uuid.UUID
+	Role   Role `gorm:"foreignKey:RoleID"`
 }
 
 func (UserRole) TableName() string {
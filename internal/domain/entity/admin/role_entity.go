@@ -0,0 +1,86 @@
+package admin
+
+import (
+	"slices"
+	"strings"
+)
+
+type Resource string
+
+const (
+	PlanResource         Resource = "plans"
+	PlanVersionResource  Resource = "plan-versions"
+	SubscriptionResource Resource = "subscriptions"
+	PaymentResource      Resource = "payments"
+	ProfileResource      Resource = "profiles"
+	FxRateResource       Resource = "fx-rates"
+	RoleResource         Resource = "roles"
+	AuditLogResource     Resource = "audit-logs"
+)
+
+var Resources = []Resource{
+	PlanResource,
+	PlanVersionResource,
+	SubscriptionResource,
+	PaymentResource,
+	ProfileResource,
+	FxRateResource,
+	RoleResource,
+	AuditLogResource,
+}
+
+type Action string
+
+const (
+	ReadAction   Action = "read"
+	CreateAction Action = "create"
+	UpdateAction Action = "update"
+	DeleteAction Action = "delete"
+)
+
+var Actions = []Action{ReadAction, CreateAction, UpdateAction, DeleteAction}
+
+// Permission allows one action on one resource, written as "resource:action".
+type Permission string
+
+func NewPermission(resource Resource, action Action) Permission {
+	return Permission(string(resource) + ":" + string(action))
+}
+
+func AllPermissions() []Permission {
+	permissions := make([]Permission, 0, len(Resources)*len(Actions))
+	for _, resource := range Resources {
+		for _, action := range Actions {
+			permissions = append(permissions, NewPermission(resource, action))
+		}
+	}
+	return permissions
+}
+
+func (p Permission) IsValid() bool {
+	resource, action, ok := strings.Cut(string(p), ":")
+	return ok && slices.Contains(Resources, Resource(resource)) && slices.Contains(Actions, Action(action))
+}
+
+// SuperAdminRole is seeded by migration and given to the first admin. It is allowed
+// everything, including resources added after it was granted, and cannot be edited.
+const SuperAdminRole = "superadmin"
+
+func (r Role) IsSuperAdmin() bool {
+	return r.Name == SuperAdminRole
+}
+
+func (r Role) Allows(permission Permission) bool {
+	return r.IsSuperAdmin() || slices.Contains(r.Permissions, permission)
+}
+
+// PermissionsOf merges what the roles allow, in the order of AllPermissions.
+func PermissionsOf(roles []Role) []Permission {
+	permissions := make([]Permission, 0)
+	for _, permission := range AllPermissions() {
+		if slices.ContainsFunc(roles, func(r Role) bool { return r.Allows(permission) }) {
+			permissions = append(permissions, permission)
+		}
+	}
+	return permissions
+}
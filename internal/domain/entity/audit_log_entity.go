@@ -0,0 +1,62 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/itsLeonB/go-crud"
+	"gorm.io/datatypes"
+)
+
+type AuditActorType string
+
+const (
+	AdminActor   AuditActorType = "ADMIN"
+	ProfileActor AuditActorType = "PROFILE"
+	SystemActor  AuditActorType = "SYSTEM" // jobs and internal flows without a user behind them
+)
+
+type AuditAction string
+
+const (
+	CreateAuditAction  AuditAction = "CREATE"
+	UpdateAuditAction  AuditAction = "UPDATE"
+	DeleteAuditAction  AuditAction = "DELETE"
+	ConfirmAuditAction AuditAction = "CONFIRM"
+)
+
+const (
+	PaymentAuditEntity      = "PAYMENT"
+	SubscriptionAuditEntity = "SUBSCRIPTION"
+	DebtAuditEntity         = "DEBT_TRANSACTION"
+	GroupExpenseAuditEntity = "GROUP_EXPENSE"
+	SettlementAuditEntity   = "SETTLEMENT"
+	AdminRoleAuditEntity    = "ADMIN_ROLE"
+	AdminUserAuditEntity    = "ADMIN_USER"
+)
+
+// AuditLog records who changed what. Rows are only ever inserted; the table rejects
+// updates and deletes. Before and After hold only the fields that changed.
+type AuditLog struct {
+	crud.BaseEntity
+	ActorType  AuditActorType
+	ActorID    uuid.NullUUID
+	Action     AuditAction
+	EntityType string
+	EntityID   uuid.UUID
+	Before     datatypes.JSON
+	After      datatypes.JSON
+}
+
+// AuditLogSpecification filters audit logs. Zero fields do not filter.
+type AuditLogSpecification struct {
+	ActorType  AuditActorType
+	ActorID    uuid.UUID
+	Action     AuditAction
+	EntityType string
+	EntityID   uuid.UUID
+	From       time.Time
+	To         time.Time // exclusive
+	Limit      int
+	Offset     int
+}
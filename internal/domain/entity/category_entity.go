@@ -0,0 +1,56 @@
+package entity
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/itsLeonB/go-crud"
+)
+
+// Default categories are seeded by migration and shared by every profile.
+const (
+	FoodAndDrinkCategory  = "Food & Drink"
+	GroceriesCategory     = "Groceries"
+	TransportCategory     = "Transport"
+	TravelCategory        = "Travel"
+	AccommodationCategory = "Accommodation"
+	EntertainmentCategory = "Entertainment"
+	ShoppingCategory      = "Shopping"
+	UtilitiesCategory     = "Utilities"
+	RentCategory          = "Rent"
+	HealthCategory        = "Health"
+	OtherCategory         = "Other"
+)
+
+// Category labels group expenses and debts for spending analytics.
+// ProfileID is null for the defaults, and set for categories a user defined for themselves.
+type Category struct {
+	crud.BaseEntity
+	ProfileID uuid.NullUUID
+	Name      string
+}
+
+func (c Category) IsDefault() bool {
+	return !c.ProfileID.Valid
+}
+
+// IsUsableBy tells whether the profile may label its expenses and debts with the category.
+func (c Category) IsUsableBy(profileID uuid.UUID) bool {
+	return c.IsDefault() || c.ProfileID.UUID == profileID
+}
+
+// NormalizeTags trims tags, drops blank ones and duplicates ignoring case, and keeps the first spelling.
+func NormalizeTags(tags []string) []string {
+	normalized := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		key := strings.ToLower(tag)
+		if tag == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		normalized = append(normalized, tag)
+	}
+	return normalized
+}
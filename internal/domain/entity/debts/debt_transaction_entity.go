@@ -2,12 +2,15 @@ package debts
 
 import (
 	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/domain/entity"
 	"github.com/itsLeonB/go-crud"
 	"github.com/shopspring/decimal"
+	"gorm.io/datatypes"
 )
 
 const (
 	GroupExpenseTransferMethod = "GROUP_EXPENSE"
+	ImportTransferMethod       = "IMPORT"
 )
 
 type DebtTransaction struct {
@@ -19,7 +22,16 @@ type DebtTransaction struct {
 	TransferMethodID  uuid.UUID
 	Description       string
 	GroupExpenseID    uuid.NullUUID
+	CategoryID        uuid.NullUUID
+	Tags              datatypes.JSONSlice[string]
+	GroupID           uuid.NullUUID
+
+	// SettlementID is set on the repayment recorded by a settlement.
+	SettlementID uuid.NullUUID
+	// SettledBySettlementID is set on the debts a settlement has closed out.
+	SettledBySettlementID uuid.NullUUID
 
 	// Relationships
 	TransferMethod TransferMethod
+	Category       entity.Category `gorm:"foreignKey:CategoryID"`
 }
@@ -0,0 +1,27 @@
+package debts
+
+import (
+	"github.com/google/uuid"
+	"github.com/itsLeonB/go-crud"
+	"github.com/shopspring/decimal"
+)
+
+// Settlement is a repayment from the payer to the payee that closes out
+// the debt transactions it covers.
+type Settlement struct {
+	crud.BaseEntity
+	PayerProfileID          uuid.UUID
+	PayeeProfileID          uuid.UUID
+	CreatorProfileID        uuid.UUID
+	Currency                string
+	Amount                  decimal.Decimal
+	TransferMethodID        uuid.UUID
+	ProfileTransferMethodID uuid.NullUUID
+	ProofImageName          string
+	Note                    string
+
+	// Relationships
+	TransferMethod        TransferMethod
+	ProfileTransferMethod ProfileTransferMethod
+	SettledTransactions   []DebtTransaction `gorm:"foreignKey:SettledBySettlementID"`
+}
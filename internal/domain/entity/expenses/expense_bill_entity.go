@@ -2,33 +2,104 @@ package expenses
 
 import (
 	"errors"
+	"slices"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/itsLeonB/go-crud"
+	"gorm.io/datatypes"
 )
 
 type BillStatus string
 
 const (
-	NotUploadedBill   BillStatus = "NOT_UPLOADED"
-	PendingBill       BillStatus = "PENDING"           // Newly uploaded bill, to be extracted by OCR service
-	ExtractedBill     BillStatus = "EXTRACTED"         // Text extracted from image, to be parsed by AI service
-	FailedExtracting  BillStatus = "FAILED_EXTRACTING" // OCR failed to extract from image, retryable
-	ParsedBill        BillStatus = "PARSED"            // Expense data parsed from text, considered finished, cannot retry
-	FailedParsingBill BillStatus = "FAILED_PARSING"    // AI failed to parse from text, retryable
-	NotDetectedBill   BillStatus = "NOT_DETECTED"      // AI cannot detect data from text, can upload another image
+	NotUploadedBill    BillStatus = "NOT_UPLOADED"
+	PendingBill        BillStatus = "PENDING"           // Newly uploaded bill, to be extracted by OCR service
+	ExtractedBill      BillStatus = "EXTRACTED"         // Text extracted from image, to be parsed by AI service
+	FailedExtracting   BillStatus = "FAILED_EXTRACTING" // OCR failed to extract from image, retryable
+	AwaitingReviewBill BillStatus = "AWAITING_REVIEW"   // Expense data parsed from text into a proposal, to be reviewed by the user
+	ParsedBill         BillStatus = "PARSED"            // Reviewed proposal applied to the draft, considered finished, cannot retry
+	FailedParsingBill  BillStatus = "FAILED_PARSING"    // AI failed to parse from text, retryable
+	NotDetectedBill    BillStatus = "NOT_DETECTED"      // AI cannot detect data from text, can upload another image
+)
+
+// BillLineDecision is what the user did with a proposed item or fee during review.
+type BillLineDecision string
+
+const (
+	PendingBillLine  BillLineDecision = "PENDING"
+	AcceptedBillLine BillLineDecision = "ACCEPTED"
+	EditedBillLine   BillLineDecision = "EDITED"
+	RejectedBillLine BillLineDecision = "REJECTED"
 )
 
 var ErrExpenseNotDetected = errors.New("NOT_DETECTED")
 
+// MaxBillPages caps the images of one bill, as each page is read by OCR and counts as an upload.
+const MaxBillPages = 10
+
 type ExpenseBill struct {
 	crud.BaseEntity
 	GroupExpenseID uuid.UUID
-	ImageName      string
 	Status         BillStatus
-	ExtractedText  string
+	ExtractedText  string         // Text of all pages, merged in page order
+	Proposal       datatypes.JSON // dto.BillProposal, set once parsed
+	PromptSource   string         // prompt.Source of the prompt sent to the LLM, empty if it was not asked
+	PromptVersion  int
+	Pages          []ExpenseBillPage `gorm:"foreignKey:BillID"`
 }
 
 func (eb ExpenseBill) TableName() string {
 	return "group_expense_bills"
 }
+
+// SortedPages returns the pages in page order.
+func (eb ExpenseBill) SortedPages() []ExpenseBillPage {
+	return sortPages(eb.Pages)
+}
+
+func sortPages(pages []ExpenseBillPage) []ExpenseBillPage {
+	pages = slices.Clone(pages)
+	slices.SortFunc(pages, func(a, b ExpenseBillPage) int { return a.PageNumber - b.PageNumber })
+	return pages
+}
+
+// MergePageTexts joins the extracted text of the pages in page order, so a long receipt
+// or a receipt with a separate service charge slip is parsed as one bill.
+func MergePageTexts(pages []ExpenseBillPage) string {
+	texts := make([]string, 0, len(pages))
+	for _, page := range sortPages(pages) {
+		if text := strings.TrimSpace(page.ExtractedText); text != "" {
+			texts = append(texts, text)
+		}
+	}
+	return strings.Join(texts, "\n\n")
+}
+
+// ExpenseBillPage is one image of a bill. Pages are read by OCR one by one, so their Status only
+// goes from NotUploadedBill through PendingBill to ExtractedBill or FailedExtracting, while parsing
+// and review happen once for the whole bill.
+type ExpenseBillPage struct {
+	crud.BaseEntity
+	BillID        uuid.UUID
+	PageNumber    int
+	ImageName     string
+	Status        BillStatus
+	ExtractedText string
+}
+
+func (ebp ExpenseBillPage) TableName() string {
+	return "group_expense_bill_pages"
+}
+
+// ExpenseBillUpload records a page upload for the subscription upload limit. Uploads are never deleted,
+// so pages removed by reuploading a bill still count.
+type ExpenseBillUpload struct {
+	crud.BaseEntity
+	ProfileID uuid.UUID
+	BillID    uuid.UUID
+}
+
+func (ebu ExpenseBillUpload) TableName() string {
+	return "group_expense_bill_uploads"
+}
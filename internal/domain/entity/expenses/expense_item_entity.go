@@ -7,12 +7,25 @@ import (
 	"github.com/shopspring/decimal"
 )
 
+// SplitMode decides how an item's amount is allocated among its participants.
+type SplitMode string
+
+const (
+	// WeightSplit allocates proportionally to ItemParticipant.Weight, or equally when no weights are set.
+	WeightSplit SplitMode = "WEIGHT"
+	// ExactSplit takes ItemParticipant.Share as the exact amount, which must add up to the item total.
+	ExactSplit SplitMode = "EXACT"
+	// PercentageSplit takes ItemParticipant.Share as a percentage, which must add up to 100.
+	PercentageSplit SplitMode = "PERCENTAGE"
+)
+
 type ExpenseItem struct {
 	crud.BaseEntity
 	GroupExpenseID uuid.UUID
 	Name           string
 	Amount         decimal.Decimal
 	Quantity       int
+	SplitMode      SplitMode
 	Participants   []ItemParticipant `gorm:"foreignKey:ExpenseItemID"`
 }
 
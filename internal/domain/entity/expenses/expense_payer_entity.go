@@ -0,0 +1,22 @@
+package expenses
+
+import (
+	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/domain/entity/users"
+	"github.com/itsLeonB/go-crud"
+	"github.com/shopspring/decimal"
+)
+
+type ExpensePayer struct {
+	crud.BaseEntity
+	GroupExpenseID uuid.UUID
+	ProfileID      uuid.UUID
+	PaidAmount     decimal.Decimal
+
+	// Relationships
+	Profile users.UserProfile `gorm:"foreignKey:ProfileID"`
+}
+
+func (ep ExpensePayer) TableName() string {
+	return "group_expense_payers"
+}
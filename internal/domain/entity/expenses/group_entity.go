@@ -0,0 +1,57 @@
+package expenses
+
+import (
+	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/domain/entity/users"
+	"github.com/itsLeonB/go-crud"
+)
+
+type GroupActivityType string
+
+const (
+	ExpenseGroupActivity      GroupActivityType = "EXPENSE"
+	DebtGroupActivity         GroupActivityType = "DEBT"
+	MemberJoinedGroupActivity GroupActivityType = "MEMBER_JOINED"
+)
+
+// Group is a lasting set of profiles sharing expenses and debts, like a household or a trip.
+type Group struct {
+	crud.BaseEntity
+	CreatorProfileID uuid.UUID
+	Name             string
+	DefaultCurrency  string
+
+	// Relationships
+	Creator users.UserProfile `gorm:"foreignKey:CreatorProfileID"`
+	Members []GroupMember     `gorm:"foreignKey:GroupID"`
+}
+
+func (g Group) HasMember(profileID uuid.UUID) bool {
+	for _, member := range g.Members {
+		if member.ProfileID == profileID {
+			return true
+		}
+	}
+	return false
+}
+
+// DefaultWeights returns the weight of each member's share, used when an item of the group's
+// expenses is split by weight without explicit weights.
+func (g Group) DefaultWeights() map[uuid.UUID]int {
+	weights := make(map[uuid.UUID]int, len(g.Members))
+	for _, member := range g.Members {
+		weights[member.ProfileID] = member.DefaultWeight
+	}
+	return weights
+}
+
+// GroupMember is a real or an anonymous profile in a group.
+type GroupMember struct {
+	crud.BaseEntity
+	GroupID       uuid.UUID
+	ProfileID     uuid.UUID
+	DefaultWeight int
+
+	// Relationships
+	Profile users.UserProfile `gorm:"foreignKey:ProfileID"`
+}
@@ -2,9 +2,11 @@ package expenses
 
 import (
 	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/domain/entity"
 	"github.com/itsLeonB/cashback/internal/domain/entity/users"
 	"github.com/itsLeonB/go-crud"
 	"github.com/shopspring/decimal"
+	"gorm.io/datatypes"
 )
 
 type ExpenseStatus string
@@ -33,13 +35,19 @@ type GroupExpense struct {
 	Status           ExpenseStatus
 	CreatorProfileID uuid.UUID
 	Processed        bool
+	Revision         int // times the expense was amended after being confirmed
+	CategoryID       uuid.NullUUID
+	Tags             datatypes.JSONSlice[string]
+	GroupID          uuid.NullUUID
 
 	// Relationships
+	Category     entity.Category      `gorm:"foreignKey:CategoryID"`
 	Payer        users.UserProfile    `gorm:"foreignKey:PayerProfileID"`
 	Creator      users.UserProfile    `gorm:"foreignKey:CreatorProfileID"`
 	Items        []ExpenseItem        `gorm:"foreignKey:GroupExpenseID"`
 	OtherFees    []OtherFee           `gorm:"foreignKey:GroupExpenseID"`
 	Participants []ExpenseParticipant `gorm:"foreignKey:GroupExpenseID"`
+	Payers       []ExpensePayer       `gorm:"foreignKey:GroupExpenseID"`
 	Bill         ExpenseBill          `gorm:"foreignKey:GroupExpenseID"`
 }
 
@@ -49,18 +57,38 @@ func (GroupExpense) coreRelations() []string {
 		"OtherFees",
 		"Payer",
 		"Creator",
+		"Category",
 		"Items.Participants",
 		"Items.Participants.Profile",
 		"Participants",
 		"Participants.ParticipantProfile",
 		"Participants.ProxyProfile",
+		"Payers",
+		"Payers.Profile",
 	}
 }
 
+// EffectivePayers returns who paid for the expense and how much. Expenses paid by a single
+// profile may not have explicit payer rows, in which case the payer is deemed to have paid the total.
+func (ge GroupExpense) EffectivePayers() []ExpensePayer {
+	if len(ge.Payers) > 0 {
+		return ge.Payers
+	}
+	if !ge.PayerProfileID.Valid {
+		return nil
+	}
+	return []ExpensePayer{{
+		GroupExpenseID: ge.ID,
+		ProfileID:      ge.PayerProfileID.UUID,
+		PaidAmount:     ge.TotalAmount,
+		Profile:        ge.Payer,
+	}}
+}
+
 func (ge GroupExpense) ForCalculationRelations() []string {
 	return append(ge.coreRelations(), "OtherFees.Participants", "OtherFees.Participants.Profile")
 }
 
 func (ge GroupExpense) ForDisplayRelations() []string {
-	return append(ge.coreRelations(), "Bill")
+	return append(ge.coreRelations(), "Bill", "Bill.Pages")
 }
@@ -12,6 +12,7 @@ type ItemParticipant struct {
 	ExpenseItemID   uuid.UUID
 	ProfileID       uuid.UUID
 	Weight          int
+	Share           decimal.Decimal
 	AllocatedAmount decimal.Decimal
 
 	// Relationships
@@ -5,21 +5,47 @@ import (
 	"github.com/itsLeonB/ezutil/v2"
 	"github.com/itsLeonB/go-crud"
 	"github.com/shopspring/decimal"
+	"gorm.io/datatypes"
 )
 
 type FeeCalculationMethod string
 
 const (
-	EqualSplitFee    FeeCalculationMethod = "EQUAL_SPLIT"
-	ItemizedSplitFee FeeCalculationMethod = "ITEMIZED_SPLIT"
+	EqualSplitFee      FeeCalculationMethod = "EQUAL_SPLIT"
+	ItemizedSplitFee   FeeCalculationMethod = "ITEMIZED_SPLIT"
+	PercentageSplitFee FeeCalculationMethod = "PERCENTAGE_SPLIT"
+	FixedPerPersonFee  FeeCalculationMethod = "FIXED_PER_PERSON"
 )
 
+// FeeKind is what a fee is for, as recognised on the receipt or picked by the user.
+type FeeKind string
+
+const (
+	TaxFeeKind      FeeKind = "TAX"
+	ServiceFeeKind  FeeKind = "SERVICE"
+	RoundingFeeKind FeeKind = "ROUNDING"
+	OtherFeeKind    FeeKind = "OTHER"
+)
+
+// SuggestedCalculationMethod is how a fee of this kind is usually split. Tax and service
+// are charged on what each person ordered, rounding is too small to be worth itemizing.
+func (fk FeeKind) SuggestedCalculationMethod() FeeCalculationMethod {
+	switch fk {
+	case TaxFeeKind, ServiceFeeKind:
+		return ItemizedSplitFee
+	default:
+		return EqualSplitFee
+	}
+}
+
 type OtherFee struct {
 	crud.BaseEntity
 	GroupExpenseID    uuid.UUID
 	Name              string
 	Amount            decimal.Decimal
+	Kind              FeeKind
 	CalculationMethod FeeCalculationMethod
+	ParticipantShares datatypes.JSON
 	Participants      []FeeParticipant `gorm:"foreignKey:OtherFeeID"`
 }
 
@@ -27,6 +53,15 @@ func (of OtherFee) ProfileIDs() []uuid.UUID {
 	return ezutil.MapSlice(of.Participants, func(fp FeeParticipant) uuid.UUID { return fp.ProfileID })
 }
 
+// ShareByProfileID decodes ParticipantShares, the per-profile percentages or amounts
+// used by the PercentageSplitFee and FixedPerPersonFee methods.
+func (of OtherFee) ShareByProfileID() (map[uuid.UUID]decimal.Decimal, error) {
+	if len(of.ParticipantShares) == 0 {
+		return map[uuid.UUID]decimal.Decimal{}, nil
+	}
+	return ezutil.Unmarshal[map[uuid.UUID]decimal.Decimal](of.ParticipantShares)
+}
+
 func (of OtherFee) TableName() string {
 	return "group_expense_other_fees"
 }
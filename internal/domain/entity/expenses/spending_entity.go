@@ -0,0 +1,38 @@
+package expenses
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type SpendingGrouping string
+
+const (
+	SpendingByCategory SpendingGrouping = "CATEGORY"
+	SpendingByMonth    SpendingGrouping = "MONTH"
+	SpendingByFriend   SpendingGrouping = "FRIEND" // who paid the expense, in proportion to what each payer paid
+	SpendingByCurrency SpendingGrouping = "CURRENCY"
+)
+
+// SpendingSpecification selects the shares of confirmed expenses to sum up. Zero fields do not filter.
+type SpendingSpecification struct {
+	ProfileIDs []uuid.UUID
+	GroupBy    SpendingGrouping
+	From       time.Time
+	To         time.Time // exclusive
+	Currency   string
+	CategoryID uuid.NullUUID
+	Tag        string
+}
+
+// SpendingTotal is the sum of shares in one group and currency. Amounts in different
+// currencies are never added up, so a group spent in two currencies has two totals.
+type SpendingTotal struct {
+	Key          string // category ID, month as YYYY-MM, payer profile ID or currency; empty for uncategorized
+	Label        string
+	Currency     string
+	Total        decimal.Decimal
+	ExpenseCount int
+}
@@ -0,0 +1,46 @@
+package entity
+
+import (
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/itsLeonB/go-crud"
+	"gorm.io/datatypes"
+)
+
+type ExportKind string
+
+const (
+	DebtsExport           ExportKind = "DEBTS"
+	FriendStatementExport ExportKind = "FRIEND_STATEMENT"
+	GroupExpenseExport    ExportKind = "GROUP_EXPENSE"
+)
+
+type ExportFormat string
+
+const (
+	CSVFormat  ExportFormat = "CSV"
+	XLSXFormat ExportFormat = "XLSX"
+	PDFFormat  ExportFormat = "PDF"
+)
+
+type ExportStatus string
+
+const (
+	PendingExport   ExportStatus = "PENDING"
+	CompletedExport ExportStatus = "COMPLETED"
+	FailedExport    ExportStatus = "FAILED"
+)
+
+// Export is a statement rendered in the background and stored under ObjectKey once completed.
+type Export struct {
+	crud.BaseEntity
+	ProfileID    uuid.UUID
+	Kind         ExportKind
+	Format       ExportFormat
+	Status       ExportStatus
+	Params       datatypes.JSON
+	ObjectKey    sql.NullString
+	ErrorMessage sql.NullString
+	CompletedAt  sql.NullTime
+}
@@ -0,0 +1,17 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/itsLeonB/go-crud"
+	"github.com/shopspring/decimal"
+)
+
+// FxRate is the amount of QuoteCurrency one unit of BaseCurrency buys, effective from EffectiveDate.
+type FxRate struct {
+	crud.BaseEntity
+	BaseCurrency  string
+	QuoteCurrency string
+	Rate          decimal.Decimal
+	EffectiveDate time.Time
+}
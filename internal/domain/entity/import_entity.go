@@ -0,0 +1,43 @@
+package entity
+
+import (
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/itsLeonB/go-crud"
+	"gorm.io/datatypes"
+)
+
+type ImportSource string
+
+const (
+	CSVImport       ImportSource = "CSV"
+	SplitwiseImport ImportSource = "SPLITWISE"
+)
+
+type ImportStatus string
+
+const (
+	PendingImport    ImportStatus = "PENDING"    // Newly requested or confirmed, to be parsed by the worker
+	PreviewedImport  ImportStatus = "PREVIEWED"  // Dry run parsed, awaiting confirmation to be recorded
+	ProcessingImport ImportStatus = "PROCESSING" // Rows are being recorded, progress in ProcessedRows
+	CompletedImport  ImportStatus = "COMPLETED"  // Every planned row is recorded, considered finished
+	FailedImport     ImportStatus = "FAILED"     // Cannot be parsed or stopped midway, cannot retry
+)
+
+// Import is a file of transactions from another app, recorded row by row in the background.
+type Import struct {
+	crud.BaseEntity
+	ProfileID     uuid.UUID
+	Source        ImportSource
+	Status        ImportStatus
+	DryRun        bool
+	SelfName      string
+	Currency      string
+	Content       string
+	TotalRows     int
+	ProcessedRows int
+	Preview       datatypes.JSON
+	ErrorMessage  sql.NullString
+	CompletedAt   sql.NullTime
+}
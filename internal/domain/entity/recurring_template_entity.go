@@ -0,0 +1,31 @@
+package entity
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/itsLeonB/go-crud"
+	"gorm.io/datatypes"
+)
+
+type RecurringTemplateKind string
+
+const (
+	GroupExpenseTemplate RecurringTemplateKind = "GROUP_EXPENSE"
+	DebtTemplate         RecurringTemplateKind = "DEBT"
+)
+
+// RecurringTemplate stamps out a new GroupExpense or DebtTransaction from its Payload whenever NextRunAt is reached.
+type RecurringTemplate struct {
+	crud.BaseEntity
+	CreatorProfileID uuid.UUID
+	Kind             RecurringTemplateKind
+	Name             string
+	CronSpec         string
+	AutoConfirm      bool
+	IsActive         bool
+	NextRunAt        time.Time
+	LastRunAt        sql.NullTime
+	Payload          datatypes.JSON
+}
@@ -12,5 +12,6 @@ type Session struct {
 	crud.BaseEntity
 	UserID     uuid.UUID
 	DeviceID   sql.NullString
+	UserAgent  sql.NullString
 	LastUsedAt time.Time
 }
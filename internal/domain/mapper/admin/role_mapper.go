@@ -0,0 +1,26 @@
+package admin
+
+import (
+	dto "github.com/itsLeonB/cashback/internal/domain/dto/admin"
+	entity "github.com/itsLeonB/cashback/internal/domain/entity/admin"
+	"github.com/itsLeonB/cashback/internal/domain/mapper"
+	"github.com/itsLeonB/ezutil/v2"
+)
+
+func RoleToResponse(r entity.Role) dto.RoleResponse {
+	permissions := ezutil.MapSlice(entity.PermissionsOf([]entity.Role{r}), func(p entity.Permission) string { return string(p) })
+	return dto.RoleResponse{
+		BaseDTO:      mapper.BaseToDTO(r.BaseEntity),
+		Name:         r.Name,
+		Permissions:  permissions,
+		IsSuperAdmin: r.IsSuperAdmin(),
+	}
+}
+
+func UserToResponse(u entity.User, roles []entity.Role) dto.UserResponse {
+	return dto.UserResponse{
+		BaseDTO: mapper.BaseToDTO(u.BaseEntity),
+		Email:   u.Email,
+		Roles:   ezutil.MapSlice(roles, RoleToResponse),
+	}
+}
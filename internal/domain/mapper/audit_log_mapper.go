@@ -0,0 +1,30 @@
+package mapper
+
+import (
+	"encoding/json"
+
+	"github.com/itsLeonB/cashback/internal/domain/dto"
+	"github.com/itsLeonB/cashback/internal/domain/entity"
+)
+
+func AuditLogToResponse(log entity.AuditLog) dto.AuditLogResponse {
+	return dto.AuditLogResponse{
+		ID:         log.ID,
+		CreatedAt:  log.CreatedAt,
+		ActorType:  string(log.ActorType),
+		ActorID:    log.ActorID.UUID,
+		Action:     string(log.Action),
+		EntityType: log.EntityType,
+		EntityID:   log.EntityID,
+		Before:     rawJSON(log.Before),
+		After:      rawJSON(log.After),
+	}
+}
+
+// rawJSON keeps a missing side as null instead of an empty, invalid message.
+func rawJSON(data []byte) json.RawMessage {
+	if len(data) == 0 {
+		return json.RawMessage("null")
+	}
+	return json.RawMessage(data)
+}
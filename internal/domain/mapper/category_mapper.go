@@ -0,0 +1,47 @@
+package mapper
+
+import (
+	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/domain/dto"
+	"github.com/itsLeonB/cashback/internal/domain/entity"
+	"github.com/itsLeonB/cashback/internal/domain/entity/expenses"
+	"gorm.io/datatypes"
+)
+
+func CategoryToResponse(category entity.Category) dto.CategoryResponse {
+	if category.IsZero() {
+		return dto.CategoryResponse{}
+	}
+
+	return dto.CategoryResponse{
+		ID:        category.ID,
+		Name:      category.Name,
+		IsDefault: category.IsDefault(),
+	}
+}
+
+func CategoryIDToEntity(id uuid.UUID) uuid.NullUUID {
+	return uuid.NullUUID{UUID: id, Valid: id != uuid.Nil}
+}
+
+func TagsToEntity(tags []string) datatypes.JSONSlice[string] {
+	return datatypes.NewJSONSlice(entity.NormalizeTags(tags))
+}
+
+// TagsToResponse never returns nil, so rows saved before tags existed still show an empty list.
+func TagsToResponse(tags datatypes.JSONSlice[string]) []string {
+	if tags == nil {
+		return []string{}
+	}
+	return tags
+}
+
+func SpendingTotalToResponse(total expenses.SpendingTotal) dto.SpendingTotal {
+	return dto.SpendingTotal{
+		Key:          total.Key,
+		Label:        total.Label,
+		Currency:     total.Currency,
+		Total:        total.Total,
+		ExpenseCount: total.ExpenseCount,
+	}
+}
@@ -5,18 +5,14 @@ import (
 	"github.com/itsLeonB/cashback/internal/core/logger"
 	"github.com/itsLeonB/cashback/internal/domain/dto"
 	"github.com/itsLeonB/cashback/internal/domain/entity/debts"
+	"github.com/itsLeonB/cashback/internal/domain/service/debt"
 	"github.com/shopspring/decimal"
 )
 
 func MapToFriendBalanceSummary(transactions []debts.DebtTransaction, userAssociatedIDs []uuid.UUID) dto.FriendBalance {
-	totalLent, totalBorrowed, history := calculateBalances(userAssociatedIDs, transactions)
-
-	return dto.FriendBalance{
-		NetBalance:              totalLent.Sub(totalBorrowed),
-		TotalLentToFriend:       totalLent,
-		TotalBorrowedFromFriend: totalBorrowed,
-		TransactionHistory:      history,
-	}
+	balance := calculateBalances(userAssociatedIDs, transactions)
+	balance.NetBalance = balance.TotalLentToFriend.Sub(balance.TotalBorrowedFromFriend)
+	return balance
 }
 
 func SummarizePerCurrency(transactions []debts.DebtTransaction, userAssociatedIDs []uuid.UUID) map[string]dto.FriendBalance {
@@ -33,9 +29,13 @@ func SummarizePerCurrency(transactions []debts.DebtTransaction, userAssociatedID
 	return balancesPerCurrency
 }
 
-func calculateBalances(userAssociatedIDs []uuid.UUID, transactions []debts.DebtTransaction) (decimal.Decimal, decimal.Decimal, []dto.FriendTransactionItem) {
-	totalLent, totalBorrowed := decimal.Zero, decimal.Zero
-	history := make([]dto.FriendTransactionItem, 0, len(transactions))
+// calculateBalances sums up lent and borrowed amounts from the user's point of view.
+// Settled and outstanding totals only count debts, not the repayments recorded by settlements,
+// and are signed like the net balance, so lending and borrowing the same amount nets to zero.
+func calculateBalances(userAssociatedIDs []uuid.UUID, transactions []debts.DebtTransaction) dto.FriendBalance {
+	balance := dto.FriendBalance{
+		TransactionHistory: make([]dto.FriendTransactionItem, 0, len(transactions)),
+	}
 
 	userIDMap := buildIDSet(userAssociatedIDs)
 
@@ -47,27 +47,43 @@ func calculateBalances(userAssociatedIDs []uuid.UUID, transactions []debts.DebtT
 		}
 
 		var transactionType string
-		var amount decimal.Decimal
+		signedAmount := tx.Amount
 		if dir > 0 {
 			transactionType = "LENT"
-			amount = tx.Amount
-			totalLent = totalLent.Add(tx.Amount)
+			balance.TotalLentToFriend = balance.TotalLentToFriend.Add(tx.Amount)
 		} else {
 			transactionType = "BORROWED"
-			amount = tx.Amount
-			totalBorrowed = totalBorrowed.Add(tx.Amount)
+			balance.TotalBorrowedFromFriend = balance.TotalBorrowedFromFriend.Add(tx.Amount)
+			signedAmount = tx.Amount.Neg()
+		}
+
+		if tx.SettledBySettlementID.Valid {
+			balance.TotalSettled = balance.TotalSettled.Add(signedAmount)
+		} else if !tx.SettlementID.Valid {
+			balance.TotalOutstanding = balance.TotalOutstanding.Add(signedAmount)
 		}
 
-		history = append(history, dto.FriendTransactionItem{
+		balance.TransactionHistory = append(balance.TransactionHistory, dto.FriendTransactionItem{
 			BaseDTO:        BaseToDTO(tx.BaseEntity),
 			Type:           transactionType,
-			Amount:         amount,
+			Amount:         tx.Amount,
 			TransferMethod: tx.TransferMethod.Display,
 			Description:    tx.Description,
+			IsSettlement:   tx.SettlementID.Valid,
+			IsSettled:      tx.SettledBySettlementID.Valid,
+			SettlementID:   settlementIDOf(tx),
 		})
 	}
 
-	return totalLent, totalBorrowed, history
+	return balance
+}
+
+// settlementIDOf returns the settlement that recorded or closed out the transaction, if any.
+func settlementIDOf(tx debts.DebtTransaction) uuid.UUID {
+	if tx.SettlementID.Valid {
+		return tx.SettlementID.UUID
+	}
+	return tx.SettledBySettlementID.UUID
 }
 
 // buildIDSet creates a set for fast lookup.
@@ -120,6 +136,9 @@ func DebtTransactionToResponse(userProfileID uuid.UUID, transaction debts.DebtTr
 		Description:    transaction.Description,
 		GroupExpenseID: transaction.GroupExpenseID.UUID,
 		IsFromExpense:  transaction.GroupExpenseID.Valid,
+		Category:       CategoryToResponse(transaction.Category),
+		Tags:           TagsToResponse(transaction.Tags),
+		GroupID:        transaction.GroupID.UUID,
 	}
 }
 
@@ -159,3 +178,40 @@ func NetBalanceByFriend(transactions []debts.DebtTransaction, userAssociatedIDs
 
 	return result
 }
+
+// NetPositionsByProfile computes every profile's net position per currency.
+// Lenders gain a positive position and borrowers a negative one, so each currency sums to zero.
+// Returns map[profileID]map[currency]netPosition.
+func NetPositionsByProfile(transactions []debts.DebtTransaction) map[uuid.UUID]map[string]decimal.Decimal {
+	result := make(map[uuid.UUID]map[string]decimal.Decimal)
+	for _, tx := range transactions {
+		if result[tx.LenderProfileID] == nil {
+			result[tx.LenderProfileID] = make(map[string]decimal.Decimal)
+		}
+		if result[tx.BorrowerProfileID] == nil {
+			result[tx.BorrowerProfileID] = make(map[string]decimal.Decimal)
+		}
+		result[tx.LenderProfileID][tx.Currency] = result[tx.LenderProfileID][tx.Currency].Add(tx.Amount)
+		result[tx.BorrowerProfileID][tx.Currency] = result[tx.BorrowerProfileID][tx.Currency].Sub(tx.Amount)
+	}
+
+	return result
+}
+
+func SettleUpTransferToResponse(userProfileID uuid.UUID, transfer debt.Transfer, profilesByID map[uuid.UUID]dto.ProfileResponse) dto.SettleUpTransfer {
+	from, to := profilesByID[transfer.FromProfileID], profilesByID[transfer.ToProfileID]
+	from.ID, to.ID = transfer.FromProfileID, transfer.ToProfileID
+
+	return dto.SettleUpTransfer{
+		From:     ToSimpleProfile(from, userProfileID),
+		To:       ToSimpleProfile(to, userProfileID),
+		Currency: transfer.Currency,
+		Amount:   transfer.Amount,
+	}
+}
+
+func SettleUpTransferSimpleMapper(userProfileID uuid.UUID, profilesByID map[uuid.UUID]dto.ProfileResponse) func(debt.Transfer) dto.SettleUpTransfer {
+	return func(transfer debt.Transfer) dto.SettleUpTransfer {
+		return SettleUpTransferToResponse(userProfileID, transfer, profilesByID)
+	}
+}
@@ -0,0 +1,42 @@
+package mapper_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/domain/entity/debts"
+	"github.com/itsLeonB/cashback/internal/domain/mapper"
+	"github.com/itsLeonB/go-crud"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapToFriendBalanceSummary(t *testing.T) {
+	user, friend, settlementID := uuid.New(), uuid.New(), uuid.New()
+	transaction := func(lender, borrower uuid.UUID, amount int64) debts.DebtTransaction {
+		return debts.DebtTransaction{
+			BaseEntity:        crud.BaseEntity{ID: uuid.New()},
+			LenderProfileID:   lender,
+			BorrowerProfileID: borrower,
+			Amount:            decimal.NewFromInt(amount),
+		}
+	}
+
+	settledLent := transaction(user, friend, 40)
+	settledLent.SettledBySettlementID = uuid.NullUUID{UUID: settlementID, Valid: true}
+	repayment := transaction(friend, user, 40)
+	repayment.SettlementID = uuid.NullUUID{UUID: settlementID, Valid: true}
+
+	balance := mapper.MapToFriendBalanceSummary([]debts.DebtTransaction{
+		transaction(user, friend, 100),
+		transaction(friend, user, 100),
+		transaction(friend, user, 30),
+		settledLent,
+		repayment,
+	}, []uuid.UUID{user})
+
+	assert.True(t, decimal.NewFromInt(-30).Equal(balance.NetBalance))
+	assert.True(t, decimal.NewFromInt(-30).Equal(balance.TotalOutstanding), balance.TotalOutstanding.String())
+	assert.True(t, decimal.NewFromInt(40).Equal(balance.TotalSettled), balance.TotalSettled.String())
+	assert.Len(t, balance.TransactionHistory, 5)
+}
@@ -1,17 +1,43 @@
 package mapper
 
 import (
+	"github.com/google/uuid"
 	"github.com/itsLeonB/cashback/internal/domain/dto"
 	"github.com/itsLeonB/cashback/internal/domain/entity/expenses"
+	"github.com/itsLeonB/ezutil/v2"
 )
 
+// ExpenseBillToResponse maps the bill with its pages, urls being the image URL of each page by ID.
 func ExpenseBillToResponse(
 	bill expenses.ExpenseBill,
-	url string,
+	urls map[uuid.UUID]string,
 ) dto.ExpenseBillResponse {
+	var proposal *dto.BillProposal
+	if len(bill.Proposal) > 0 {
+		if parsed, err := ezutil.Unmarshal[dto.BillProposal](bill.Proposal); err == nil {
+			proposal = &parsed
+		}
+	}
+
+	pages := ezutil.MapSlice(bill.SortedPages(), func(page expenses.ExpenseBillPage) dto.ExpenseBillPageResponse {
+		return dto.ExpenseBillPageResponse{
+			BaseDTO:    BaseToDTO(page.BaseEntity),
+			PageNumber: page.PageNumber,
+			ImageURL:   urls[page.ID],
+			Status:     string(page.Status),
+		}
+	})
+
+	var imageURL string
+	if len(pages) > 0 {
+		imageURL = pages[0].ImageURL
+	}
+
 	return dto.ExpenseBillResponse{
 		BaseDTO:  BaseToDTO(bill.BaseEntity),
-		ImageURL: url,
+		ImageURL: imageURL,
 		Status:   string(bill.Status),
+		Pages:    pages,
+		Proposal: proposal,
 	}
 }
@@ -9,9 +9,10 @@ import (
 
 func NewExpenseItemRequestToData(req dto.NewExpenseItemRequest) expenses.ExpenseItem {
 	return expenses.ExpenseItem{
-		Name:     req.Name,
-		Amount:   req.Amount,
-		Quantity: req.Quantity,
+		Name:      req.Name,
+		Amount:    req.Amount,
+		Quantity:  req.Quantity,
+		SplitMode: expenses.WeightSplit,
 	}
 }
 
@@ -21,6 +22,7 @@ func ExpenseItemRequestToEntity(request dto.NewExpenseItemRequest) expenses.Expe
 		Name:           request.Name,
 		Amount:         request.Amount,
 		Quantity:       request.Quantity,
+		SplitMode:      expenses.WeightSplit,
 	}
 }
 
@@ -35,6 +37,7 @@ func ItemParticipantRequestToEntity(itemParticipant dto.ItemParticipantRequest)
 	return expenses.ItemParticipant{
 		ProfileID: itemParticipant.ProfileID,
 		Weight:    itemParticipant.Weight,
+		Share:     itemParticipant.Share,
 	}
 }
 
@@ -51,6 +54,7 @@ func ExpenseItemToResponse(item expenses.ExpenseItem, userProfileID uuid.UUID) d
 		Name:           item.Name,
 		Amount:         item.Amount,
 		Quantity:       item.Quantity,
+		SplitMode:      item.SplitMode,
 		Participants:   ezutil.MapSlice(item.Participants, getItemParticipantSimpleMapper(userProfileID)),
 	}
 }
@@ -65,6 +69,7 @@ func itemParticipantToResponse(itemParticipant expenses.ItemParticipant, userPro
 	return dto.ItemParticipantResponse{
 		Profile:         ProfileToSimple(itemParticipant.Profile, userProfileID),
 		Weight:          itemParticipant.Weight,
+		Share:           itemParticipant.Share,
 		AllocatedAmount: itemParticipant.AllocatedAmount,
 	}
 }
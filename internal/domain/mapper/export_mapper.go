@@ -0,0 +1,18 @@
+package mapper
+
+import (
+	"github.com/itsLeonB/cashback/internal/domain/dto"
+	"github.com/itsLeonB/cashback/internal/domain/entity"
+)
+
+func ExportToResponse(export entity.Export, url string) dto.ExportResponse {
+	return dto.ExportResponse{
+		BaseDTO:      BaseToDTO(export.BaseEntity),
+		Kind:         export.Kind,
+		Format:       export.Format,
+		Status:       export.Status,
+		URL:          url,
+		ErrorMessage: export.ErrorMessage.String,
+		CompletedAt:  export.CompletedAt.Time,
+	}
+}
@@ -0,0 +1,26 @@
+package mapper
+
+import (
+	"github.com/itsLeonB/cashback/internal/domain/dto"
+	"github.com/itsLeonB/cashback/internal/domain/entity"
+	"github.com/itsLeonB/cashback/internal/domain/service/fx"
+)
+
+func FxRateToResponse(rate entity.FxRate) dto.FxRateResponse {
+	return dto.FxRateResponse{
+		BaseDTO:       BaseToDTO(rate.BaseEntity),
+		BaseCurrency:  rate.BaseCurrency,
+		QuoteCurrency: rate.QuoteCurrency,
+		Rate:          rate.Rate,
+		EffectiveDate: rate.EffectiveDate.Format(fx.DateLayout),
+	}
+}
+
+func FxRateToRate(rate entity.FxRate) fx.Rate {
+	return fx.Rate{
+		BaseCurrency:  rate.BaseCurrency,
+		QuoteCurrency: rate.QuoteCurrency,
+		Rate:          rate.Rate,
+		Date:          rate.EffectiveDate,
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/itsLeonB/cashback/internal/domain/dto"
 	"github.com/itsLeonB/cashback/internal/domain/entity/debts"
 	"github.com/itsLeonB/cashback/internal/domain/entity/expenses"
+	"github.com/itsLeonB/cashback/internal/domain/service/expense"
 	"github.com/itsLeonB/ezutil/v2"
 	"github.com/itsLeonB/ungerr"
 	"github.com/shopspring/decimal"
@@ -36,7 +37,7 @@ func GroupExpenseRequestToEntity(request dto.NewGroupExpenseRequest) expenses.Gr
 func GroupExpenseToResponse(
 	groupExpense expenses.GroupExpense,
 	userProfileID uuid.UUID,
-	billURL string,
+	billURLs map[uuid.UUID]string,
 	constructPreview bool,
 ) dto.GroupExpenseResponse {
 	expense := dto.GroupExpenseResponse{
@@ -47,12 +48,17 @@ func GroupExpenseToResponse(
 		FeesTotalAmount:  groupExpense.FeesTotal,
 		Description:      groupExpense.Description,
 		Status:           string(groupExpense.Status),
+		Revision:         groupExpense.Revision,
+		Category:         CategoryToResponse(groupExpense.Category),
+		Tags:             TagsToResponse(groupExpense.Tags),
+		GroupID:          groupExpense.GroupID.UUID,
 		Payer:            ProfileToSimple(groupExpense.Payer, userProfileID),
+		Payers:           ezutil.MapSlice(groupExpense.Payers, getExpensePayerSimpleMapper(userProfileID)),
 		Creator:          ProfileToSimple(groupExpense.Creator, userProfileID),
 		Items:            ezutil.MapSlice(groupExpense.Items, getExpenseItemSimpleMapper(userProfileID)),
 		OtherFees:        ezutil.MapSlice(groupExpense.OtherFees, getOtherFeeSimpleMapper(userProfileID)),
 		Participants:     ezutil.MapSlice(groupExpense.Participants, getExpenseParticipantSimpleMapper(userProfileID)),
-		Bill:             ExpenseBillToResponse(groupExpense.Bill, billURL),
+		Bill:             ExpenseBillToResponse(groupExpense.Bill, billURLs),
 		BillExists:       groupExpense.Bill.ID != uuid.Nil,
 	}
 
@@ -60,6 +66,18 @@ func GroupExpenseToResponse(
 		expense.Description = "Untitled Expense at " + time.Now().Format(time.DateOnly)
 	}
 
+	// Once a bill is applied, edits to the draft are compared with the receipt too
+	if proposal := expense.Bill.Proposal; proposal != nil && groupExpense.Bill.Status == expenses.ParsedBill && !proposal.Reconciliation.PrintedTotal.IsZero() {
+		difference := proposal.Reconciliation.PrintedTotal.Sub(groupExpense.ItemsTotal).Sub(groupExpense.FeesTotal)
+		expense.Reconciliation = &dto.BillReconciliation{
+			ItemsTotal:   groupExpense.ItemsTotal,
+			FeesTotal:    groupExpense.FeesTotal,
+			PrintedTotal: proposal.Reconciliation.PrintedTotal,
+			Difference:   difference,
+			Balanced:     difference.IsZero(),
+		}
+	}
+
 	if constructPreview {
 		expense.IsPreviewable = true
 		expense.ConfirmationPreview = ToConfirmationResponse(groupExpense, userProfileID)
@@ -68,9 +86,9 @@ func GroupExpenseToResponse(
 	return expense
 }
 
-func GroupExpenseSimpleMapper(userProfileID uuid.UUID, billURL string, constructPreview bool) func(expenses.GroupExpense) dto.GroupExpenseResponse {
+func GroupExpenseSimpleMapper(userProfileID uuid.UUID, billURLs map[uuid.UUID]string, constructPreview bool) func(expenses.GroupExpense) dto.GroupExpenseResponse {
 	return func(groupExpense expenses.GroupExpense) dto.GroupExpenseResponse {
-		return GroupExpenseToResponse(groupExpense, userProfileID, billURL, constructPreview)
+		return GroupExpenseToResponse(groupExpense, userProfileID, billURLs, constructPreview)
 	}
 }
 
@@ -89,6 +107,22 @@ func getExpenseParticipantSimpleMapper(userProfileID uuid.UUID) func(expenses.Ex
 	}
 }
 
+func getExpensePayerSimpleMapper(userProfileID uuid.UUID) func(expenses.ExpensePayer) dto.ExpensePayerResponse {
+	return func(payer expenses.ExpensePayer) dto.ExpensePayerResponse {
+		return dto.ExpensePayerResponse{
+			Profile:    ProfileToSimple(payer.Profile, userProfileID),
+			PaidAmount: payer.PaidAmount,
+		}
+	}
+}
+
+func ExpensePayerRequestToEntity(req dto.ExpensePayerRequest) expenses.ExpensePayer {
+	return expenses.ExpensePayer{
+		ProfileID:  req.ProfileID,
+		PaidAmount: req.Amount,
+	}
+}
+
 func ExpenseParticipantToData(participant expenses.ExpenseParticipant) (expenses.ExpenseParticipant, error) {
 	if participant.ShareAmount.LessThanOrEqual(decimal.Zero) {
 		return expenses.ExpenseParticipant{}, ungerr.UnprocessableEntityError(fmt.Sprintf(
@@ -103,47 +137,96 @@ func ExpenseParticipantToData(participant expenses.ExpenseParticipant) (expenses
 	}, nil
 }
 
-func GroupExpenseToDebtTransactions(groupExpense expenses.GroupExpense, transferMethodID uuid.UUID) []debts.DebtTransaction {
+// GroupExpenseToDebtTransactions records what every participant owes the payers. A participant's share is split
+// across payers proportionally to what each of them paid, and debts between two payers are netted into one transaction.
+// Proxied shares are owed by the proxy, who in turn lends the share to the participant.
+func GroupExpenseToDebtTransactions(groupExpense expenses.GroupExpense, transferMethodID uuid.UUID) ([]debts.DebtTransaction, error) {
 	groupExpenseID := uuid.NullUUID{UUID: groupExpense.ID, Valid: true}
+	payers := groupExpense.EffectivePayers()
+	if len(payers) == 0 {
+		return nil, ungerr.UnprocessableEntityError("no payer is selected")
+	}
+
+	payerShares := ezutil.MapSlice(payers, func(payer expenses.ExpensePayer) expense.Share {
+		return expense.Share{ProfileID: payer.ProfileID, Value: payer.PaidAmount}
+	})
+	isPayer := make(map[uuid.UUID]bool, len(payers))
+	paidTotal := decimal.Zero
+	for _, payer := range payers {
+		isPayer[payer.ProfileID] = true
+		paidTotal = paidTotal.Add(payer.PaidAmount)
+	}
+
+	newTransaction := func(lenderID, borrowerID uuid.UUID, amount decimal.Decimal, description string) debts.DebtTransaction {
+		return debts.DebtTransaction{
+			LenderProfileID:   lenderID,
+			BorrowerProfileID: borrowerID,
+			Currency:          groupExpense.Currency,
+			Amount:            amount,
+			TransferMethodID:  transferMethodID,
+			GroupExpenseID:    groupExpenseID,
+			Description:       description,
+		}
+	}
+
 	debtTransactions := make([]debts.DebtTransaction, 0, 2*len(groupExpense.Participants))
+	// netOwed[lender][borrower] accumulates debts between payers before netting
+	netOwed := make(map[uuid.UUID]map[uuid.UUID]decimal.Decimal, len(payers))
 
 	for _, participant := range groupExpense.Participants {
-		if groupExpense.PayerProfileID.UUID == participant.ParticipantProfileID || participant.ShareAmount.IsZero() {
+		if participant.ShareAmount.IsZero() {
 			continue
 		}
+
+		borrowerID := participant.ParticipantProfileID
+		description := fmt.Sprintf("Share for group expense: %s", groupExpense.Description)
 		if participant.ProxyProfileID.Valid {
-			debtTransactions = append(debtTransactions, debts.DebtTransaction{
-				LenderProfileID:   participant.ProxyProfileID.UUID,
-				BorrowerProfileID: participant.ParticipantProfileID,
-				Currency:          groupExpense.Currency,
-				Amount:            participant.ShareAmount,
-				TransferMethodID:  transferMethodID,
-				GroupExpenseID:    groupExpenseID,
-				Description:       fmt.Sprintf("Covered share for group expense: %s", groupExpense.Description),
-			})
-			debtTransactions = append(debtTransactions, debts.DebtTransaction{
-				LenderProfileID:   groupExpense.PayerProfileID.UUID,
-				BorrowerProfileID: participant.ProxyProfileID.UUID,
-				Currency:          groupExpense.Currency,
-				Amount:            participant.ShareAmount,
-				TransferMethodID:  transferMethodID,
-				GroupExpenseID:    groupExpenseID,
-				Description:       fmt.Sprintf("Covered %s's share for group expense: %s", participant.ParticipantProfile.Name, groupExpense.Description),
-			})
-		} else {
-			debtTransactions = append(debtTransactions, debts.DebtTransaction{
-				LenderProfileID:   groupExpense.PayerProfileID.UUID,
-				BorrowerProfileID: participant.ParticipantProfileID,
-				Currency:          groupExpense.Currency,
-				Amount:            participant.ShareAmount,
-				TransferMethodID:  transferMethodID,
-				GroupExpenseID:    groupExpenseID,
-				Description:       fmt.Sprintf("Share for group expense: %s", groupExpense.Description),
-			})
+			borrowerID = participant.ProxyProfileID.UUID
+			description = fmt.Sprintf("Covered %s's share for group expense: %s", participant.ParticipantProfile.Name, groupExpense.Description)
+			debtTransactions = append(debtTransactions, newTransaction(
+				borrowerID,
+				participant.ParticipantProfileID,
+				participant.ShareAmount,
+				fmt.Sprintf("Covered share for group expense: %s", groupExpense.Description),
+			))
+		}
+
+		amounts, err := expense.SplitByShares(participant.ShareAmount, payerShares)
+		if err != nil {
+			return nil, err
+		}
+
+		for i, payer := range payers {
+			if payer.ProfileID == borrowerID || amounts[i].IsZero() {
+				continue
+			}
+			if isPayer[borrowerID] {
+				// Unrounded, so rounding only happens once on the netted amount
+				if netOwed[payer.ProfileID] == nil {
+					netOwed[payer.ProfileID] = make(map[uuid.UUID]decimal.Decimal, len(payers))
+				}
+				owed := participant.ShareAmount.Mul(payer.PaidAmount).Div(paidTotal)
+				netOwed[payer.ProfileID][borrowerID] = netOwed[payer.ProfileID][borrowerID].Add(owed)
+				continue
+			}
+			debtTransactions = append(debtTransactions, newTransaction(payer.ProfileID, borrowerID, amounts[i], description))
+		}
+	}
+
+	for i, lender := range payers {
+		for _, borrower := range payers[i+1:] {
+			net := netOwed[lender.ProfileID][borrower.ProfileID].Sub(netOwed[borrower.ProfileID][lender.ProfileID]).Round(2)
+			description := fmt.Sprintf("Net share for group expense: %s", groupExpense.Description)
+			switch {
+			case net.IsPositive():
+				debtTransactions = append(debtTransactions, newTransaction(lender.ProfileID, borrower.ProfileID, net, description))
+			case net.IsNegative():
+				debtTransactions = append(debtTransactions, newTransaction(borrower.ProfileID, lender.ProfileID, net.Neg(), description))
+			}
 		}
 	}
 
-	return debtTransactions
+	return debtTransactions, nil
 }
 
 func ToConfirmationResponse(expense expenses.GroupExpense, userProfileID uuid.UUID) dto.ExpenseConfirmationResponse {
@@ -246,6 +329,7 @@ func ToConfirmationResponse(expense expenses.GroupExpense, userProfileID uuid.UU
 		Currency:     expense.Currency,
 		TotalAmount:  expense.TotalAmount,
 		Payer:        ProfileToSimple(expense.Payer, userProfileID),
+		Payers:       ezutil.MapSlice(expense.Payers, getExpensePayerSimpleMapper(userProfileID)),
 		Participants: participants,
 	}
 }
@@ -0,0 +1,117 @@
+package mapper_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/domain/entity/debts"
+	"github.com/itsLeonB/cashback/internal/domain/entity/expenses"
+	"github.com/itsLeonB/cashback/internal/domain/entity/users"
+	"github.com/itsLeonB/cashback/internal/domain/mapper"
+	"github.com/itsLeonB/go-crud"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupExpenseToDebtTransactions(t *testing.T) {
+	alice, bob, carol := uuid.New(), uuid.New(), uuid.New()
+	transferMethodID := uuid.New()
+
+	participant := func(id uuid.UUID, share int64) expenses.ExpenseParticipant {
+		return expenses.ExpenseParticipant{ParticipantProfileID: id, ShareAmount: decimal.NewFromInt(share)}
+	}
+
+	// owed sums up what borrower owes lender across the transactions
+	owed := func(transactions []debts.DebtTransaction, lender, borrower uuid.UUID) decimal.Decimal {
+		total := decimal.Zero
+		for _, tx := range transactions {
+			switch {
+			case tx.LenderProfileID == lender && tx.BorrowerProfileID == borrower:
+				total = total.Add(tx.Amount)
+			case tx.LenderProfileID == borrower && tx.BorrowerProfileID == lender:
+				total = total.Sub(tx.Amount)
+			}
+		}
+		return total
+	}
+
+	t.Run("single payer lends every other participant their share", func(t *testing.T) {
+		transactions, err := mapper.GroupExpenseToDebtTransactions(expenses.GroupExpense{
+			BaseEntity:     crud.BaseEntity{ID: uuid.New()},
+			PayerProfileID: uuid.NullUUID{UUID: alice, Valid: true},
+			TotalAmount:    decimal.NewFromInt(300),
+			Currency:       "IDR",
+			Participants:   []expenses.ExpenseParticipant{participant(alice, 100), participant(bob, 150), participant(carol, 50)},
+		}, transferMethodID)
+
+		assert.NoError(t, err)
+		assert.Len(t, transactions, 2)
+		assert.True(t, owed(transactions, alice, bob).Equal(decimal.NewFromInt(150)))
+		assert.True(t, owed(transactions, alice, carol).Equal(decimal.NewFromInt(50)))
+		assert.Equal(t, "IDR", transactions[0].Currency)
+		assert.Equal(t, transferMethodID, transactions[0].TransferMethodID)
+	})
+
+	t.Run("multiple payers net their debts to each other", func(t *testing.T) {
+		transactions, err := mapper.GroupExpenseToDebtTransactions(expenses.GroupExpense{
+			PayerProfileID: uuid.NullUUID{UUID: alice, Valid: true},
+			TotalAmount:    decimal.NewFromInt(300),
+			Payers: []expenses.ExpensePayer{
+				{ProfileID: alice, PaidAmount: decimal.NewFromInt(200)},
+				{ProfileID: bob, PaidAmount: decimal.NewFromInt(100)},
+			},
+			Participants: []expenses.ExpenseParticipant{participant(alice, 100), participant(bob, 100), participant(carol, 100)},
+		}, transferMethodID)
+
+		assert.NoError(t, err)
+		assert.Len(t, transactions, 3)
+		assert.True(t, owed(transactions, alice, carol).Equal(decimal.RequireFromString("66.67")))
+		assert.True(t, owed(transactions, bob, carol).Equal(decimal.RequireFromString("33.33")))
+		assert.True(t, owed(transactions, alice, bob).Equal(decimal.RequireFromString("33.33")))
+
+		// Everyone ends up even: alice paid 200 for her 100 share, bob paid 100 for his 100 share
+		assert.True(t, owed(transactions, alice, bob).Add(owed(transactions, alice, carol)).Equal(decimal.NewFromInt(100)))
+		assert.True(t, owed(transactions, bob, carol).Sub(owed(transactions, alice, bob)).IsZero())
+	})
+
+	t.Run("proxy owes the payers and lends the covered participant", func(t *testing.T) {
+		dave := uuid.New()
+		transactions, err := mapper.GroupExpenseToDebtTransactions(expenses.GroupExpense{
+			PayerProfileID: uuid.NullUUID{UUID: alice, Valid: true},
+			TotalAmount:    decimal.NewFromInt(200),
+			Payers: []expenses.ExpensePayer{
+				{ProfileID: alice, PaidAmount: decimal.NewFromInt(100)},
+				{ProfileID: bob, PaidAmount: decimal.NewFromInt(100)},
+			},
+			Participants: []expenses.ExpenseParticipant{
+				participant(alice, 50),
+				participant(bob, 50),
+				participant(dave, 20),
+				{
+					ParticipantProfileID: carol,
+					ShareAmount:          decimal.NewFromInt(80),
+					ProxyProfileID:       uuid.NullUUID{UUID: dave, Valid: true},
+					ParticipantProfile:   users.UserProfile{Name: "Carol"},
+				},
+			},
+		}, transferMethodID)
+
+		assert.NoError(t, err)
+		assert.True(t, owed(transactions, dave, carol).Equal(decimal.NewFromInt(80)))
+		assert.True(t, owed(transactions, alice, dave).Equal(decimal.NewFromInt(50)))
+		assert.True(t, owed(transactions, bob, dave).Equal(decimal.NewFromInt(50)))
+		assert.True(t, owed(transactions, alice, bob).IsZero())
+		assert.True(t, owed(transactions, alice, carol).IsZero())
+		for _, tx := range transactions {
+			assert.NotEqual(t, tx.LenderProfileID, tx.BorrowerProfileID)
+		}
+	})
+
+	t.Run("rejects an expense without payers", func(t *testing.T) {
+		_, err := mapper.GroupExpenseToDebtTransactions(expenses.GroupExpense{
+			Participants: []expenses.ExpenseParticipant{participant(alice, 100)},
+		}, transferMethodID)
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,140 @@
+package mapper
+
+import (
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/domain/dto"
+	"github.com/itsLeonB/cashback/internal/domain/entity/debts"
+	"github.com/itsLeonB/cashback/internal/domain/entity/expenses"
+	"github.com/itsLeonB/ezutil/v2"
+	"github.com/shopspring/decimal"
+)
+
+func GroupToResponse(group expenses.Group, userProfileID uuid.UUID) dto.GroupResponse {
+	return dto.GroupResponse{
+		BaseDTO:         BaseToDTO(group.BaseEntity),
+		Name:            group.Name,
+		DefaultCurrency: group.DefaultCurrency,
+		Creator:         ProfileToSimple(group.Creator, userProfileID),
+		Members:         ezutil.MapSlice(group.Members, groupMemberSimpleMapper(userProfileID)),
+	}
+}
+
+func GroupSimpleMapper(userProfileID uuid.UUID) func(expenses.Group) dto.GroupResponse {
+	return func(group expenses.Group) dto.GroupResponse {
+		return GroupToResponse(group, userProfileID)
+	}
+}
+
+func groupMemberSimpleMapper(userProfileID uuid.UUID) func(expenses.GroupMember) dto.GroupMemberResponse {
+	return func(member expenses.GroupMember) dto.GroupMemberResponse {
+		return dto.GroupMemberResponse{
+			Profile:       ProfileToSimple(member.Profile, userProfileID),
+			IsAnonymous:   !member.Profile.IsReal(),
+			DefaultWeight: member.DefaultWeight,
+			JoinedAt:      member.CreatedAt,
+		}
+	}
+}
+
+func GroupMemberRequestToEntity(req dto.GroupMemberRequest) expenses.GroupMember {
+	weight := req.DefaultWeight
+	if weight < 1 {
+		weight = 1
+	}
+	return expenses.GroupMember{
+		ProfileID:     req.ProfileID,
+		DefaultWeight: weight,
+	}
+}
+
+// GroupBalancesToResponse lists every member's net position in the group, and what they owe the user
+// or are owed by them, per currency. Only the group's debts are counted.
+func GroupBalancesToResponse(group expenses.Group, transactions []debts.DebtTransaction, userAssociatedIDs []uuid.UUID, userProfileID uuid.UUID) []dto.GroupBalanceResponse {
+	positions := NetPositionsByProfile(transactions)
+	withUser := NetBalanceByFriend(transactions, userAssociatedIDs)
+
+	balances := make([]dto.GroupBalanceResponse, 0, len(group.Members))
+	for _, member := range group.Members {
+		balances = append(balances, dto.GroupBalanceResponse{
+			Profile:      ProfileToSimple(member.Profile, userProfileID),
+			NetPositions: nonZeroBalances(positions[member.ProfileID]),
+			WithUser:     nonZeroBalances(withUser[member.ProfileID]),
+		})
+	}
+
+	return balances
+}
+
+func nonZeroBalances(balances map[string]decimal.Decimal) map[string]decimal.Decimal {
+	result := make(map[string]decimal.Decimal, len(balances))
+	for currency, amount := range balances {
+		if !amount.IsZero() {
+			result[currency] = amount
+		}
+	}
+	return result
+}
+
+// GroupActivitiesToResponse merges the group's expenses, its debts not generated by those expenses,
+// and its members joining into one feed, newest first, of at most limit entries.
+func GroupActivitiesToResponse(group expenses.Group, groupExpenses []expenses.GroupExpense, transactions []debts.DebtTransaction, userProfileID uuid.UUID, limit int) []dto.GroupActivityResponse {
+	membersByID := make(map[uuid.UUID]dto.SimpleProfile, len(group.Members))
+	for _, member := range group.Members {
+		membersByID[member.ProfileID] = ProfileToSimple(member.Profile, userProfileID)
+	}
+	// former members are only known by their ID
+	memberProfile := func(id uuid.UUID) dto.SimpleProfile {
+		if profile, ok := membersByID[id]; ok {
+			return profile
+		}
+		return dto.SimpleProfile{ID: id, IsUser: id == userProfileID}
+	}
+
+	activities := make([]dto.GroupActivityResponse, 0, len(groupExpenses)+len(transactions)+len(group.Members))
+	for _, expense := range groupExpenses {
+		activities = append(activities, dto.GroupActivityResponse{
+			Type:        expenses.ExpenseGroupActivity,
+			ID:          expense.ID,
+			Profile:     ProfileToSimple(expense.Creator, userProfileID),
+			Description: expense.Description,
+			Currency:    expense.Currency,
+			Amount:      expense.TotalAmount,
+			Status:      string(expense.Status),
+			OccurredAt:  expense.CreatedAt,
+		})
+	}
+	for _, tx := range transactions {
+		if tx.GroupExpenseID.Valid {
+			continue
+		}
+		activities = append(activities, dto.GroupActivityResponse{
+			Type:         expenses.DebtGroupActivity,
+			ID:           tx.ID,
+			Profile:      memberProfile(tx.LenderProfileID),
+			Counterparty: memberProfile(tx.BorrowerProfileID),
+			Description:  tx.Description,
+			Currency:     tx.Currency,
+			Amount:       tx.Amount,
+			OccurredAt:   tx.CreatedAt,
+		})
+	}
+	for _, member := range group.Members {
+		activities = append(activities, dto.GroupActivityResponse{
+			Type:       expenses.MemberJoinedGroupActivity,
+			ID:         member.ID,
+			Profile:    membersByID[member.ProfileID],
+			OccurredAt: member.CreatedAt,
+		})
+	}
+
+	slices.SortStableFunc(activities, func(a, b dto.GroupActivityResponse) int {
+		return b.OccurredAt.Compare(a.OccurredAt)
+	})
+	if limit > 0 && len(activities) > limit {
+		activities = activities[:limit]
+	}
+
+	return activities
+}
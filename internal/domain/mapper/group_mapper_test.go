@@ -0,0 +1,88 @@
+package mapper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/domain/entity/debts"
+	"github.com/itsLeonB/cashback/internal/domain/entity/expenses"
+	"github.com/itsLeonB/cashback/internal/domain/entity/users"
+	"github.com/itsLeonB/cashback/internal/domain/mapper"
+	"github.com/itsLeonB/go-crud"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupBalancesToResponse(t *testing.T) {
+	alice, bob, carol := uuid.New(), uuid.New(), uuid.New()
+	member := func(id uuid.UUID, name string) expenses.GroupMember {
+		return expenses.GroupMember{ProfileID: id, DefaultWeight: 1, Profile: users.UserProfile{BaseEntity: crud.BaseEntity{ID: id}, Name: name}}
+	}
+	group := expenses.Group{Members: []expenses.GroupMember{member(alice, "Alice"), member(bob, "Bob"), member(carol, "Carol")}}
+
+	debt := func(lender, borrower uuid.UUID, currency string, amount int64) debts.DebtTransaction {
+		return debts.DebtTransaction{LenderProfileID: lender, BorrowerProfileID: borrower, Currency: currency, Amount: decimal.NewFromInt(amount)}
+	}
+	transactions := []debts.DebtTransaction{
+		debt(alice, bob, "IDR", 100),
+		debt(alice, carol, "IDR", 50),
+		debt(carol, alice, "IDR", 50),
+		debt(bob, carol, "USD", 10),
+	}
+
+	balances := mapper.GroupBalancesToResponse(group, transactions, []uuid.UUID{alice}, alice)
+	require.Len(t, balances, 3)
+
+	assert.True(t, balances[0].Profile.IsUser)
+	assert.True(t, balances[0].NetPositions["IDR"].Equal(decimal.NewFromInt(100)))
+	assert.Empty(t, balances[0].WithUser)
+
+	assert.Equal(t, "Bob", balances[1].Profile.Name)
+	assert.True(t, balances[1].NetPositions["IDR"].Equal(decimal.NewFromInt(-100)))
+	assert.True(t, balances[1].NetPositions["USD"].Equal(decimal.NewFromInt(10)))
+	assert.True(t, balances[1].WithUser["IDR"].Equal(decimal.NewFromInt(100)))
+
+	// Carol's debt to Alice was repaid, so nothing is left between them
+	assert.NotContains(t, balances[2].NetPositions, "IDR")
+	assert.True(t, balances[2].NetPositions["USD"].Equal(decimal.NewFromInt(-10)))
+	assert.Empty(t, balances[2].WithUser)
+}
+
+func TestGroupActivitiesToResponse(t *testing.T) {
+	alice, bob := uuid.New(), uuid.New()
+	start := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	at := func(days int) time.Time { return start.AddDate(0, 0, days) }
+
+	group := expenses.Group{Members: []expenses.GroupMember{
+		{BaseEntity: crud.BaseEntity{ID: uuid.New(), CreatedAt: at(0)}, ProfileID: alice, Profile: users.UserProfile{BaseEntity: crud.BaseEntity{ID: alice}, Name: "Alice"}},
+		{BaseEntity: crud.BaseEntity{ID: uuid.New(), CreatedAt: at(1)}, ProfileID: bob, Profile: users.UserProfile{BaseEntity: crud.BaseEntity{ID: bob}, Name: "Bob"}},
+	}}
+	expense := expenses.GroupExpense{
+		BaseEntity:  crud.BaseEntity{ID: uuid.New(), CreatedAt: at(2)},
+		Description: "Groceries",
+		Currency:    "IDR",
+		TotalAmount: decimal.NewFromInt(200),
+		Status:      expenses.ConfirmedExpense,
+		Creator:     users.UserProfile{BaseEntity: crud.BaseEntity{ID: alice}, Name: "Alice"},
+	}
+	transactions := []debts.DebtTransaction{
+		{BaseEntity: crud.BaseEntity{ID: uuid.New(), CreatedAt: at(3)}, LenderProfileID: bob, BorrowerProfileID: alice, Currency: "IDR", Amount: decimal.NewFromInt(30)},
+		{BaseEntity: crud.BaseEntity{ID: uuid.New(), CreatedAt: at(2)}, LenderProfileID: alice, BorrowerProfileID: bob, Amount: decimal.NewFromInt(100), GroupExpenseID: uuid.NullUUID{UUID: expense.ID, Valid: true}},
+	}
+
+	activities := mapper.GroupActivitiesToResponse(group, []expenses.GroupExpense{expense}, transactions, alice, 0)
+	require.Len(t, activities, 4, "debts of expenses are part of the expense")
+
+	assert.Equal(t, expenses.DebtGroupActivity, activities[0].Type)
+	assert.Equal(t, "Bob", activities[0].Profile.Name)
+	assert.True(t, activities[0].Counterparty.IsUser)
+	assert.Equal(t, expenses.ExpenseGroupActivity, activities[1].Type)
+	assert.Equal(t, string(expenses.ConfirmedExpense), activities[1].Status)
+	assert.Equal(t, expenses.MemberJoinedGroupActivity, activities[2].Type)
+	assert.Equal(t, "Bob", activities[2].Profile.Name)
+	assert.Equal(t, expenses.MemberJoinedGroupActivity, activities[3].Type)
+
+	assert.Len(t, mapper.GroupActivitiesToResponse(group, []expenses.GroupExpense{expense}, transactions, alice, 2), 2)
+}
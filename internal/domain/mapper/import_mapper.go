@@ -0,0 +1,69 @@
+package mapper
+
+import (
+	"github.com/itsLeonB/cashback/internal/domain/dto"
+	"github.com/itsLeonB/cashback/internal/domain/entity"
+	"github.com/itsLeonB/cashback/internal/domain/service/importer"
+	"github.com/itsLeonB/ezutil/v2"
+)
+
+func ImportToResponse(imp entity.Import, preview *dto.ImportPreview) dto.ImportResponse {
+	return dto.ImportResponse{
+		BaseDTO:       BaseToDTO(imp.BaseEntity),
+		Source:        imp.Source,
+		Status:        imp.Status,
+		DryRun:        imp.DryRun,
+		TotalRows:     imp.TotalRows,
+		ProcessedRows: imp.ProcessedRows,
+		Preview:       preview,
+		ErrorMessage:  imp.ErrorMessage.String,
+		CompletedAt:   imp.CompletedAt.Time,
+	}
+}
+
+func ImportPlanToPreview(plan importer.Plan, newFriends []string) dto.ImportPreview {
+	preview := dto.ImportPreview{
+		NewFriends: newFriends,
+		Entries:    ezutil.MapSlice(plan.Operations, importOperationToPreview),
+		Skipped: ezutil.MapSlice(plan.Skipped, func(rowError importer.RowError) dto.ImportSkippedRow {
+			return dto.ImportSkippedRow{Line: rowError.Line, Reason: rowError.Reason}
+		}),
+	}
+
+	for _, operation := range plan.Operations {
+		switch operation.Kind {
+		case importer.DebtOperation:
+			preview.Debts++
+		case importer.GroupExpenseOperation:
+			preview.GroupExpenses++
+		}
+	}
+
+	return preview
+}
+
+func importOperationToPreview(operation importer.Operation) dto.ImportEntryPreview {
+	entry := operation.Entry
+	participantName := func(participant importer.Participant) string {
+		return participant.Name
+	}
+
+	preview := dto.ImportEntryPreview{
+		Line:         entry.Line,
+		Kind:         string(operation.Kind),
+		Date:         entry.Date,
+		Description:  entry.Description,
+		Currency:     entry.Currency,
+		Amount:       entry.Total,
+		Payers:       ezutil.MapSlice(entry.Payers, participantName),
+		Participants: ezutil.MapSlice(entry.Owers, participantName),
+	}
+
+	if operation.Kind == importer.DebtOperation {
+		preview.Amount = operation.Amount
+		preview.Payers = []string{operation.Lender}
+		preview.Participants = []string{operation.Borrower}
+	}
+
+	return preview
+}
@@ -20,9 +20,14 @@ func (expenseConfirmedResolver) ResolveTitle(n entity.Notification) (string, err
 		return "", err
 	}
 
+	action := "confirmed"
+	if metadata.Revision > 0 {
+		action = "amended"
+	}
+
 	if metadata.CreatorName == "" {
-		return "Your friend confirmed an expense with you", nil
+		return fmt.Sprintf("Your friend %s an expense with you", action), nil
 	}
 
-	return fmt.Sprintf("%s confirmed an expense with you", metadata.CreatorName), nil
+	return fmt.Sprintf("%s %s an expense with you", metadata.CreatorName, action), nil
 }
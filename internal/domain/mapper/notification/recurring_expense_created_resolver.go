@@ -0,0 +1,28 @@
+package notification
+
+import (
+	"fmt"
+
+	"github.com/itsLeonB/cashback/internal/domain/entity"
+	"github.com/itsLeonB/cashback/internal/domain/message"
+	"github.com/itsLeonB/ezutil/v2"
+)
+
+type recurringExpenseCreatedResolver struct{}
+
+func (recurringExpenseCreatedResolver) Type() string {
+	return message.RecurringExpenseCreated{}.Type()
+}
+
+func (recurringExpenseCreatedResolver) ResolveTitle(n entity.Notification) (string, error) {
+	metadata, err := ezutil.Unmarshal[message.RecurringExpenseCreatedMetadata](n.Metadata)
+	if err != nil {
+		return "", err
+	}
+
+	if metadata.TemplateName == "" {
+		return "A recurring expense is ready for your review", nil
+	}
+
+	return fmt.Sprintf("%s is ready for your review", metadata.TemplateName), nil
+}
@@ -0,0 +1,28 @@
+package notification
+
+import (
+	"fmt"
+
+	"github.com/itsLeonB/cashback/internal/domain/entity"
+	"github.com/itsLeonB/cashback/internal/domain/message"
+	"github.com/itsLeonB/ezutil/v2"
+)
+
+type sessionsRevokedResolver struct{}
+
+func (sessionsRevokedResolver) Type() string {
+	return message.SessionsRevoked{}.Type()
+}
+
+func (sessionsRevokedResolver) ResolveTitle(n entity.Notification) (string, error) {
+	metadata, err := ezutil.Unmarshal[message.SessionsRevokedMetadata](n.Metadata)
+	if err != nil {
+		return "", err
+	}
+
+	if metadata.Count > 1 {
+		return fmt.Sprintf("You were signed out of %d other sessions", metadata.Count), nil
+	}
+
+	return "You were signed out of another session", nil
+}
@@ -40,6 +40,8 @@ func constructResolverMap() map[string]TitleResolver {
 		expenseConfirmedResolver{},
 		friendRequestReceivedResolver{},
 		friendshipCreatedResolver{},
+		recurringExpenseCreatedResolver{},
+		sessionsRevokedResolver{},
 	}
 
 	resolverMap := make(map[string]TitleResolver, len(resolvers))
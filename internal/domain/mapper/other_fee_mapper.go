@@ -1,10 +1,15 @@
 package mapper
 
 import (
+	"encoding/json"
+
 	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/core/logger"
 	"github.com/itsLeonB/cashback/internal/domain/dto"
 	"github.com/itsLeonB/cashback/internal/domain/entity/expenses"
 	"github.com/itsLeonB/ezutil/v2"
+	"github.com/shopspring/decimal"
+	"gorm.io/datatypes"
 )
 
 func OtherFeeRequestToEntity(request dto.NewOtherFeeRequest) expenses.OtherFee {
@@ -12,14 +17,20 @@ func OtherFeeRequestToEntity(request dto.NewOtherFeeRequest) expenses.OtherFee {
 		GroupExpenseID:    request.GroupExpenseID,
 		Name:              request.Name,
 		Amount:            request.Amount,
+		Kind:              feeKindOrDefault(request.Kind),
 		CalculationMethod: request.CalculationMethod,
+		ParticipantShares: participantSharesToJSON(request.ParticipantShares),
 	}
 }
 
 func PatchOtherFeeWithRequest(otherFee expenses.OtherFee, request dto.UpdateOtherFeeRequest) expenses.OtherFee {
 	otherFee.Name = request.Name
 	otherFee.Amount = request.Amount
+	if request.Kind != "" {
+		otherFee.Kind = request.Kind
+	}
 	otherFee.CalculationMethod = request.CalculationMethod
+	otherFee.ParticipantShares = participantSharesToJSON(request.ParticipantShares)
 	return otherFee
 }
 
@@ -30,11 +41,18 @@ func getOtherFeeSimpleMapper(userProfileID uuid.UUID) func(expenses.OtherFee) dt
 }
 
 func OtherFeeToResponse(fee expenses.OtherFee, userProfileID uuid.UUID) dto.OtherFeeResponse {
+	shares, err := fee.ShareByProfileID()
+	if err != nil {
+		logger.Errorf("error decoding participant shares of fee %s: %v", fee.ID, err)
+	}
+
 	return dto.OtherFeeResponse{
 		BaseDTO:           BaseToDTO(fee.BaseEntity),
 		Name:              fee.Name,
 		Amount:            fee.Amount,
+		Kind:              string(feeKindOrDefault(fee.Kind)),
 		CalculationMethod: string(fee.CalculationMethod),
+		ParticipantShares: shares,
 		Participants:      ezutil.MapSlice(fee.Participants, getFeeParticipantSimpleMapper(userProfileID)),
 	}
 }
@@ -56,6 +74,29 @@ func otherFeeRequestToData(req dto.NewOtherFeeRequest) expenses.OtherFee {
 	return expenses.OtherFee{
 		Name:              req.Name,
 		Amount:            req.Amount,
+		Kind:              feeKindOrDefault(req.Kind),
 		CalculationMethod: req.CalculationMethod,
+		ParticipantShares: participantSharesToJSON(req.ParticipantShares),
+	}
+}
+
+func feeKindOrDefault(kind expenses.FeeKind) expenses.FeeKind {
+	if kind == "" {
+		return expenses.OtherFeeKind
 	}
+	return kind
+}
+
+func participantSharesToJSON(shares map[uuid.UUID]decimal.Decimal) datatypes.JSON {
+	if len(shares) == 0 {
+		return datatypes.JSON("{}")
+	}
+
+	data, err := json.Marshal(shares)
+	if err != nil {
+		logger.Errorf("error marshaling fee participant shares: %v", err)
+		return datatypes.JSON("{}")
+	}
+
+	return datatypes.JSON(data)
 }
@@ -0,0 +1,71 @@
+package mapper
+
+import (
+	"github.com/itsLeonB/cashback/internal/core/logger"
+	"github.com/itsLeonB/cashback/internal/domain/dto"
+	"github.com/itsLeonB/cashback/internal/domain/entity"
+	"github.com/itsLeonB/cashback/internal/domain/entity/expenses"
+	"github.com/itsLeonB/ezutil/v2"
+)
+
+func RecurringTemplateToResponse(template entity.RecurringTemplate) dto.RecurringTemplateResponse {
+	resp := dto.RecurringTemplateResponse{
+		BaseDTO:     BaseToDTO(template.BaseEntity),
+		Kind:        template.Kind,
+		Name:        template.Name,
+		CronSpec:    template.CronSpec,
+		AutoConfirm: template.AutoConfirm,
+		IsActive:    template.IsActive,
+		NextRunAt:   template.NextRunAt,
+	}
+
+	if template.LastRunAt.Valid {
+		resp.LastRunAt = template.LastRunAt.Time
+	}
+
+	var err error
+	switch template.Kind {
+	case entity.GroupExpenseTemplate:
+		var payload dto.RecurringGroupExpense
+		payload, err = ezutil.Unmarshal[dto.RecurringGroupExpense](template.Payload)
+		resp.GroupExpense = &payload
+	case entity.DebtTemplate:
+		var payload dto.NewDebtTransactionRequest
+		payload, err = ezutil.Unmarshal[dto.NewDebtTransactionRequest](template.Payload)
+		resp.Debt = &payload
+	}
+	if err != nil {
+		logger.Errorf("error unmarshaling payload of recurring template %s: %v", template.ID, err)
+	}
+
+	return resp
+}
+
+// RecurringGroupExpenseToEntity maps the template's items and fees; participants and the payer
+// are validated and attached by the GroupExpenseService.
+func RecurringGroupExpenseToEntity(req dto.RecurringGroupExpense) expenses.GroupExpense {
+	return expenses.GroupExpense{
+		Description: req.Description,
+		Currency:    req.Currency,
+		Items:       ezutil.MapSlice(req.Items, recurringExpenseItemToEntity),
+		Payers:      ezutil.MapSlice(req.Payers, ExpensePayerRequestToEntity),
+		OtherFees:   ezutil.MapSlice(req.OtherFees, otherFeeRequestToData),
+		CategoryID:  CategoryIDToEntity(req.CategoryID),
+		Tags:        TagsToEntity(req.Tags),
+	}
+}
+
+func recurringExpenseItemToEntity(item dto.RecurringExpenseItem) expenses.ExpenseItem {
+	splitMode := item.SplitMode
+	if splitMode == "" {
+		splitMode = expenses.WeightSplit
+	}
+
+	return expenses.ExpenseItem{
+		Name:         item.Name,
+		Amount:       item.Amount,
+		Quantity:     item.Quantity,
+		SplitMode:    splitMode,
+		Participants: ezutil.MapSlice(item.Participants, ItemParticipantRequestToEntity),
+	}
+}
@@ -0,0 +1,28 @@
+package mapper
+
+import (
+	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/domain/dto"
+	"github.com/itsLeonB/cashback/internal/domain/entity/debts"
+	"github.com/itsLeonB/ezutil/v2"
+)
+
+func SettlementToResponse(userProfileID uuid.UUID, settlement debts.Settlement, profilesByID map[uuid.UUID]dto.ProfileResponse) dto.SettlementResponse {
+	payer, payee := profilesByID[settlement.PayerProfileID], profilesByID[settlement.PayeeProfileID]
+	payer.ID, payee.ID = settlement.PayerProfileID, settlement.PayeeProfileID
+
+	return dto.SettlementResponse{
+		BaseDTO:        BaseToDTO(settlement.BaseEntity),
+		Payer:          ToSimpleProfile(payer, userProfileID),
+		Payee:          ToSimpleProfile(payee, userProfileID),
+		Currency:       settlement.Currency,
+		Amount:         settlement.Amount,
+		TransferMethod: settlement.TransferMethod.Display,
+		AccountName:    settlement.ProfileTransferMethod.AccountName,
+		AccountNumber:  settlement.ProfileTransferMethod.AccountNumber,
+		Note:           settlement.Note,
+		SettledTransactionIDs: ezutil.MapSlice(settlement.SettledTransactions, func(tx debts.DebtTransaction) uuid.UUID {
+			return tx.ID
+		}),
+	}
+}
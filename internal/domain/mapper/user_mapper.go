@@ -16,6 +16,17 @@ func SessionToAuthData(session auth.Session, fgpHash string) map[string]any {
 	}
 }
 
+func SessionToResponse(session auth.Session, currentSessionID string) dto.SessionResponse {
+	return dto.SessionResponse{
+		ID:         session.ID,
+		DeviceID:   session.DeviceID,
+		UserAgent:  session.UserAgent,
+		CreatedAt:  session.CreatedAt,
+		LastUsedAt: session.LastUsedAt,
+		IsCurrent:  session.ID == currentSessionID,
+	}
+}
+
 func UserToResponse(user users.User) dto.UserResponse {
 	return dto.UserResponse{
 		BaseDTO: BaseToDTO(user.BaseEntity),
@@ -12,4 +12,5 @@ func (ExpenseConfirmed) Type() string {
 
 type ExpenseConfirmedMetadata struct {
 	CreatorName string `json:"creatorName"`
+	Revision    int    `json:"revision,omitempty"`
 }
@@ -0,0 +1,11 @@
+package message
+
+import "github.com/google/uuid"
+
+type ExportRequested struct {
+	ID uuid.UUID `json:"id"`
+}
+
+func (ExportRequested) Type() string {
+	return "export-requested"
+}
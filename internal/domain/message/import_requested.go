@@ -0,0 +1,11 @@
+package message
+
+import "github.com/google/uuid"
+
+type ImportRequested struct {
+	ID uuid.UUID `json:"id"`
+}
+
+func (ImportRequested) Type() string {
+	return "import-requested"
+}
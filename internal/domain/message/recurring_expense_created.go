@@ -0,0 +1,16 @@
+package message
+
+import "github.com/google/uuid"
+
+type RecurringExpenseCreated struct {
+	TemplateID     uuid.UUID `json:"templateId"`
+	GroupExpenseID uuid.UUID `json:"groupExpenseId"`
+}
+
+func (RecurringExpenseCreated) Type() string {
+	return "recurring-expense-created"
+}
+
+type RecurringExpenseCreatedMetadata struct {
+	TemplateName string `json:"templateName"`
+}
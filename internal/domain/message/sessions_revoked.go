@@ -0,0 +1,19 @@
+package message
+
+import "github.com/google/uuid"
+
+type SessionsRevoked struct {
+	ProfileID uuid.UUID `json:"profileId"`
+	SessionID uuid.UUID `json:"sessionId"` // one of the revoked sessions
+	UserAgent string    `json:"userAgent"` // set when a single session was revoked
+	Count     int       `json:"count"`
+}
+
+func (SessionsRevoked) Type() string {
+	return "sessions-revoked"
+}
+
+type SessionsRevokedMetadata struct {
+	UserAgent string `json:"userAgent,omitempty"`
+	Count     int    `json:"count"`
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/itsLeonB/cashback/internal/domain/entity"
+	"github.com/itsLeonB/go-crud"
+)
+
+type AuditLogRepository interface {
+	crud.Repository[entity.AuditLog]
+	// FindAllBySpec returns the page of matching logs, newest first, and how many match in total.
+	FindAllBySpec(ctx context.Context, spec entity.AuditLogSpecification) ([]entity.AuditLog, int64, error)
+}
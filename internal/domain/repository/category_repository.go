@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/domain/entity"
+	"github.com/itsLeonB/go-crud"
+)
+
+type CategoryRepository interface {
+	crud.Repository[entity.Category]
+	// FindAllUsable returns the default categories along with the ones the profile defined.
+	FindAllUsable(ctx context.Context, profileID uuid.UUID) ([]entity.Category, error)
+	FindDefaultByName(ctx context.Context, name string) (entity.Category, error)
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/itsLeonB/cashback/internal/domain/entity"
+	"github.com/itsLeonB/go-crud"
+)
+
+type FxRateRepository interface {
+	crud.Repository[entity.FxRate]
+	FindLatest(ctx context.Context, baseCurrency, quoteCurrency string, date time.Time) (entity.FxRate, error)
+	Upsert(ctx context.Context, rates []entity.FxRate) ([]entity.FxRate, error)
+	FindPage(ctx context.Context, limit, offset int) ([]entity.FxRate, int64, error)
+}
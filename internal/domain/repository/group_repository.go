@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/domain/entity/expenses"
+	"github.com/itsLeonB/go-crud"
+)
+
+type GroupRepository interface {
+	crud.Repository[expenses.Group]
+	FindAllByProfileID(ctx context.Context, profileID uuid.UUID) ([]expenses.Group, error)
+	SyncMembers(ctx context.Context, groupID uuid.UUID, members []expenses.GroupMember) error
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/itsLeonB/cashback/internal/domain/entity"
+	"github.com/itsLeonB/go-crud"
+)
+
+type RecurringTemplateRepository interface {
+	crud.Repository[entity.RecurringTemplate]
+	FindAllDue(ctx context.Context, now time.Time) ([]entity.RecurringTemplate, error)
+}
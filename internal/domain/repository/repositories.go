@@ -14,14 +14,22 @@ type DebtTransactionRepository interface {
 	crud.Repository[debts.DebtTransaction]
 	FindAllByMultipleProfileIDs(ctx context.Context, userProfileIDs, friendProfileIDs []uuid.UUID) ([]debts.DebtTransaction, error)
 	FindAllByProfileIDs(ctx context.Context, profileIDs []uuid.UUID, limit int, debtsOnly bool) ([]debts.DebtTransaction, error)
+	FindAllByGroupExpenseIDs(ctx context.Context, groupExpenseIDs []uuid.UUID) ([]debts.DebtTransaction, error)
+	FindAllByIDs(ctx context.Context, ids []uuid.UUID, forUpdate bool) ([]debts.DebtTransaction, error)
+	FindAllByGroupID(ctx context.Context, groupID uuid.UUID, limit int) ([]debts.DebtTransaction, error)
+	MarkSettled(ctx context.Context, ids []uuid.UUID, settlementID uuid.UUID) error
 }
 
 type GroupExpenseRepository interface {
 	crud.Repository[expenses.GroupExpense]
 	SyncParticipants(ctx context.Context, groupExpenseID uuid.UUID, participants []expenses.ExpenseParticipant) error
+	SyncPayers(ctx context.Context, groupExpenseID uuid.UUID, payers []expenses.ExpensePayer) error
 	DeleteItemParticipants(ctx context.Context, expenseID uuid.UUID, newParticipantProfileIDs []uuid.UUID) error
 	FindAllByOwnership(ctx context.Context, profileID uuid.UUID, ownership expenses.ExpenseOwnership, status expenses.ExpenseStatus, limit int) ([]expenses.GroupExpense, error)
 	FindRecentByProfileID(ctx context.Context, profileID uuid.UUID, limit int) ([]expenses.GroupExpense, error)
+	SumShares(ctx context.Context, spec expenses.SpendingSpecification) ([]expenses.SpendingTotal, error)
+	FindAllByGroupID(ctx context.Context, groupID uuid.UUID, limit int) ([]expenses.GroupExpense, error)
+	FindAllByIDs(ctx context.Context, ids []uuid.UUID) ([]expenses.GroupExpense, error)
 }
 
 type ExpenseItemRepository interface {
@@ -2,6 +2,7 @@ package admin
 
 import (
 	"context"
+	"slices"
 
 	"github.com/google/uuid"
 	"github.com/itsLeonB/cashback/internal/appconstant"
@@ -23,20 +24,29 @@ type AuthService interface {
 }
 
 type authService struct {
-	userRepo    crud.Repository[admin.User]
-	hashService sekure.HashService
-	jwtService  sekure.JWTService
+	userRepo     crud.Repository[admin.User]
+	hashService  sekure.HashService
+	jwtService   sekure.JWTService
+	transactor   crud.Transactor
+	roleRepo     crud.Repository[admin.Role]
+	userRoleRepo crud.Repository[admin.UserRole]
 }
 
 func NewAuthService(
 	userRepo crud.Repository[admin.User],
 	hashService sekure.HashService,
 	jwtService sekure.JWTService,
+	transactor crud.Transactor,
+	roleRepo crud.Repository[admin.Role],
+	userRoleRepo crud.Repository[admin.UserRole],
 ) *authService {
 	return &authService{
 		userRepo,
 		hashService,
 		jwtService,
+		transactor,
+		roleRepo,
+		userRoleRepo,
 	}
 }
 
@@ -44,26 +54,39 @@ func (as *authService) Register(ctx context.Context, req dto.RegisterRequest) er
 	ctx, span := otel.Tracer.Start(ctx, "authService.Register")
 	defer span.End()
 
-	users, err := as.userRepo.FindAll(ctx, crud.Specification[admin.User]{})
-	if err != nil {
+	return as.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		users, err := as.userRepo.FindAll(ctx, crud.Specification[admin.User]{})
+		if err != nil {
+			return err
+		}
+		if len(users) > 0 {
+			return ungerr.ForbiddenError("cannot register as there exists admin users")
+		}
+
+		hash, err := as.hashService.Hash(req.Password)
+		if err != nil {
+			return err
+		}
+
+		newUser := admin.User{
+			Email:    req.Email,
+			Password: hash,
+		}
+
+		insertedUser, err := as.userRepo.Insert(ctx, newUser)
+		if err != nil {
+			return err
+		}
+
+		// The first admin has to be able to grant roles to the ones after it.
+		superAdmin, err := as.getOrCreateSuperAdminRole(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = as.userRoleRepo.Insert(ctx, admin.UserRole{UserID: insertedUser.ID, RoleID: superAdmin.ID})
 		return err
-	}
-	if len(users) > 0 {
-		return ungerr.ForbiddenError("cannot register as there exists admin users")
-	}
-
-	hash, err := as.hashService.Hash(req.Password)
-	if err != nil {
-		return err
-	}
-
-	newUser := admin.User{
-		Email:    req.Email,
-		Password: hash,
-	}
-
-	_, err = as.userRepo.Insert(ctx, newUser)
-	return err
+	})
 }
 
 func (as *authService) Login(ctx context.Context, req dto.InternalLoginRequest) (dto.TokenResponse, error) {
@@ -88,8 +111,14 @@ func (as *authService) Login(ctx context.Context, req dto.InternalLoginRequest)
 		return dto.TokenResponse{}, ungerr.NotFoundError(appconstant.ErrAuthUnknownCredentials)
 	}
 
+	userRoles, err := findUserRoles(ctx, as.userRoleRepo, user.ID)
+	if err != nil {
+		return dto.TokenResponse{}, err
+	}
+
 	token, err := as.jwtService.CreateToken(map[string]any{
-		appconstant.ContextUserID.String(): user.ID,
+		appconstant.ContextUserID.String():     user.ID,
+		appconstant.ContextAdminRoles.String(): ezutil.MapSlice(userRoles, func(ur admin.UserRole) string { return ur.Role.Name }),
 	})
 	if err != nil {
 		return dto.TokenResponse{}, err
@@ -125,8 +154,19 @@ func (as *authService) VerifyToken(ctx context.Context, token string) (bool, map
 		return false, nil, err
 	}
 
+	tokenRoles, _ := claims.Data[appconstant.ContextAdminRoles.String()].([]any)
+	roles, err := as.getRoles(ctx, user.ID)
+	if err != nil {
+		return false, nil, err
+	}
+
+	// Roles granted after login apply from the next login, revoked ones stop applying right away.
+	roles = slices.DeleteFunc(roles, func(r admin.Role) bool { return !slices.Contains(tokenRoles, any(r.Name)) })
+
 	return true, map[string]any{
-		appconstant.ContextUserID.String(): user.ID,
+		appconstant.ContextUserID.String():           user.ID,
+		appconstant.ContextAdminRoles.String():       ezutil.MapSlice(roles, func(r admin.Role) string { return r.Name }),
+		appconstant.ContextAdminPermissions.String(): admin.PermissionsOf(roles),
 	}, nil
 }
 
@@ -139,12 +179,42 @@ func (as *authService) Me(ctx context.Context, id uuid.UUID) (dto.AdminMe, error
 		return dto.AdminMe{}, err
 	}
 
+	roles, err := as.getRoles(ctx, user.ID)
+	if err != nil {
+		return dto.AdminMe{}, err
+	}
+
 	return dto.AdminMe{
-		ID:       user.ID,
-		FullName: util.GetNameFromEmail(user.Email),
+		ID:          user.ID,
+		FullName:    util.GetNameFromEmail(user.Email),
+		Roles:       ezutil.MapSlice(roles, func(r admin.Role) string { return r.Name }),
+		Permissions: ezutil.MapSlice(admin.PermissionsOf(roles), func(p admin.Permission) string { return string(p) }),
 	}, nil
 }
 
+func (as *authService) getRoles(ctx context.Context, userID uuid.UUID) ([]admin.Role, error) {
+	userRoles, err := findUserRoles(ctx, as.userRoleRepo, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return ezutil.MapSlice(userRoles, func(ur admin.UserRole) admin.Role { return ur.Role }), nil
+}
+
+func (as *authService) getOrCreateSuperAdminRole(ctx context.Context) (admin.Role, error) {
+	spec := crud.Specification[admin.Role]{}
+	spec.Model.Name = admin.SuperAdminRole
+	role, err := as.roleRepo.FindFirst(ctx, spec)
+	if err != nil {
+		return admin.Role{}, err
+	}
+	if !role.IsZero() {
+		return role, nil
+	}
+
+	return as.roleRepo.Insert(ctx, admin.Role{Name: admin.SuperAdminRole})
+}
+
 func (as *authService) getUser(ctx context.Context, id uuid.UUID) (admin.User, error) {
 	ctx, span := otel.Tracer.Start(ctx, "authService.getUser")
 	defer span.End()
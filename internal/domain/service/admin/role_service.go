@@ -0,0 +1,428 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/core/otel"
+	dto "github.com/itsLeonB/cashback/internal/domain/dto/admin"
+	auditEntity "github.com/itsLeonB/cashback/internal/domain/entity"
+	"github.com/itsLeonB/cashback/internal/domain/entity/admin"
+	mapper "github.com/itsLeonB/cashback/internal/domain/mapper/admin"
+	"github.com/itsLeonB/cashback/internal/domain/service/audit"
+	"github.com/itsLeonB/ezutil/v2"
+	"github.com/itsLeonB/go-crud"
+	"github.com/itsLeonB/ungerr"
+)
+
+type RoleService interface {
+	GetPermissions() []string
+	GetList(ctx context.Context) ([]dto.RoleResponse, error)
+	Create(ctx context.Context, req dto.NewRoleRequest) (dto.RoleResponse, error)
+	Update(ctx context.Context, req dto.UpdateRoleRequest) (dto.RoleResponse, error)
+	Delete(ctx context.Context, adminID, id uuid.UUID) error
+	GetUsers(ctx context.Context) ([]dto.UserResponse, error)
+	SetUserRoles(ctx context.Context, req dto.UserRolesRequest) (dto.UserResponse, error)
+}
+
+type roleService struct {
+	transactor   crud.Transactor
+	roleRepo     crud.Repository[admin.Role]
+	userRoleRepo crud.Repository[admin.UserRole]
+	userRepo     crud.Repository[admin.User]
+	auditSvc     audit.Service
+}
+
+func NewRoleService(
+	transactor crud.Transactor,
+	roleRepo crud.Repository[admin.Role],
+	userRoleRepo crud.Repository[admin.UserRole],
+	userRepo crud.Repository[admin.User],
+	auditSvc audit.Service,
+) *roleService {
+	return &roleService{
+		transactor,
+		roleRepo,
+		userRoleRepo,
+		userRepo,
+		auditSvc,
+	}
+}
+
+func (rs *roleService) GetPermissions() []string {
+	return ezutil.MapSlice(admin.AllPermissions(), func(p admin.Permission) string { return string(p) })
+}
+
+func (rs *roleService) GetList(ctx context.Context) ([]dto.RoleResponse, error) {
+	ctx, span := otel.Tracer.Start(ctx, "RoleService.GetList")
+	defer span.End()
+
+	roles, err := rs.roleRepo.FindAll(ctx, crud.Specification[admin.Role]{})
+	if err != nil {
+		return nil, err
+	}
+
+	return ezutil.MapSlice(roles, mapper.RoleToResponse), nil
+}
+
+func (rs *roleService) Create(ctx context.Context, req dto.NewRoleRequest) (dto.RoleResponse, error) {
+	ctx, span := otel.Tracer.Start(ctx, "RoleService.Create")
+	defer span.End()
+
+	var response dto.RoleResponse
+	err := rs.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		name := strings.TrimSpace(req.Name)
+		if err := rs.validateName(ctx, uuid.Nil, name); err != nil {
+			return err
+		}
+
+		permissions, err := parsePermissions(req.Permissions)
+		if err != nil {
+			return err
+		}
+		if err = rs.checkGrantable(ctx, req.AdminID, permissions); err != nil {
+			return err
+		}
+
+		role, err := rs.roleRepo.Insert(ctx, admin.Role{
+			Name:        name,
+			Permissions: permissions,
+		})
+		if err != nil {
+			return err
+		}
+
+		response = mapper.RoleToResponse(role)
+		return rs.auditSvc.Record(ctx, audit.Entry{
+			Actor:      audit.Admin(req.AdminID),
+			Action:     auditEntity.CreateAuditAction,
+			EntityType: auditEntity.AdminRoleAuditEntity,
+			EntityID:   role.ID,
+			After:      response,
+		})
+	})
+	return response, err
+}
+
+func (rs *roleService) Update(ctx context.Context, req dto.UpdateRoleRequest) (dto.RoleResponse, error) {
+	ctx, span := otel.Tracer.Start(ctx, "RoleService.Update")
+	defer span.End()
+
+	var response dto.RoleResponse
+	err := rs.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		role, err := rs.getEditableByID(ctx, req.ID)
+		if err != nil {
+			return err
+		}
+
+		name := strings.TrimSpace(req.Name)
+		if err = rs.validateName(ctx, role.ID, name); err != nil {
+			return err
+		}
+
+		permissions, err := parsePermissions(req.Permissions)
+		if err != nil {
+			return err
+		}
+		if err = rs.checkGrantable(ctx, req.AdminID, permissions); err != nil {
+			return err
+		}
+
+		before := mapper.RoleToResponse(role)
+		role.Name = name
+		role.Permissions = permissions
+		updatedRole, err := rs.roleRepo.Update(ctx, role)
+		if err != nil {
+			return err
+		}
+
+		response = mapper.RoleToResponse(updatedRole)
+		return rs.auditSvc.Record(ctx, audit.Entry{
+			Actor:      audit.Admin(req.AdminID),
+			Action:     auditEntity.UpdateAuditAction,
+			EntityType: auditEntity.AdminRoleAuditEntity,
+			EntityID:   role.ID,
+			Before:     before,
+			After:      response,
+		})
+	})
+	return response, err
+}
+
+func (rs *roleService) Delete(ctx context.Context, adminID, id uuid.UUID) error {
+	ctx, span := otel.Tracer.Start(ctx, "RoleService.Delete")
+	defer span.End()
+
+	return rs.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		role, err := rs.getEditableByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if err = rs.roleRepo.Delete(ctx, role); err != nil {
+			return err
+		}
+
+		return rs.auditSvc.Record(ctx, audit.Entry{
+			Actor:      audit.Admin(adminID),
+			Action:     auditEntity.DeleteAuditAction,
+			EntityType: auditEntity.AdminRoleAuditEntity,
+			EntityID:   role.ID,
+			Before:     mapper.RoleToResponse(role),
+		})
+	})
+}
+
+func (rs *roleService) GetUsers(ctx context.Context) ([]dto.UserResponse, error) {
+	ctx, span := otel.Tracer.Start(ctx, "RoleService.GetUsers")
+	defer span.End()
+
+	users, err := rs.userRepo.FindAll(ctx, crud.Specification[admin.User]{})
+	if err != nil {
+		return nil, err
+	}
+
+	spec := crud.Specification[admin.UserRole]{}
+	spec.PreloadRelations = []string{"Role"}
+	userRoles, err := rs.userRoleRepo.FindAll(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	rolesByUser := make(map[uuid.UUID][]admin.Role, len(users))
+	for _, userRole := range userRoles {
+		rolesByUser[userRole.UserID] = append(rolesByUser[userRole.UserID], userRole.Role)
+	}
+
+	return ezutil.MapSlice(users, func(u admin.User) dto.UserResponse {
+		return mapper.UserToResponse(u, rolesByUser[u.ID])
+	}), nil
+}
+
+func (rs *roleService) SetUserRoles(ctx context.Context, req dto.UserRolesRequest) (dto.UserResponse, error) {
+	ctx, span := otel.Tracer.Start(ctx, "RoleService.SetUserRoles")
+	defer span.End()
+
+	var response dto.UserResponse
+	err := rs.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		userSpec := crud.Specification[admin.User]{}
+		userSpec.Model.ID = req.UserID
+		user, err := rs.userRepo.FindFirst(ctx, userSpec)
+		if err != nil {
+			return err
+		}
+		if user.IsZero() {
+			return ungerr.NotFoundError(fmt.Sprintf("admin user with ID %s is not found", req.UserID))
+		}
+
+		roles, err := rs.roleRepo.FindAll(ctx, crud.Specification[admin.Role]{})
+		if err != nil {
+			return err
+		}
+		rolesByID := make(map[uuid.UUID]admin.Role, len(roles))
+		for _, role := range roles {
+			rolesByID[role.ID] = role
+		}
+
+		newRoles := make([]admin.Role, 0, len(req.RoleIDs))
+		for _, roleID := range req.RoleIDs {
+			role, ok := rolesByID[roleID]
+			if !ok {
+				return ungerr.NotFoundError(fmt.Sprintf("role with ID %s is not found", roleID))
+			}
+			if !slices.ContainsFunc(newRoles, func(r admin.Role) bool { return r.ID == roleID }) {
+				newRoles = append(newRoles, role)
+			}
+		}
+
+		currentUserRoles, err := findUserRoles(ctx, rs.userRoleRepo, user.ID)
+		if err != nil {
+			return err
+		}
+
+		toDelete := make([]admin.UserRole, 0, len(currentUserRoles))
+		for _, userRole := range currentUserRoles {
+			if !slices.ContainsFunc(newRoles, func(r admin.Role) bool { return r.ID == userRole.RoleID }) {
+				toDelete = append(toDelete, userRole)
+			}
+		}
+		toInsert := make([]admin.UserRole, 0, len(newRoles))
+		for _, role := range newRoles {
+			if !slices.ContainsFunc(currentUserRoles, func(ur admin.UserRole) bool { return ur.RoleID == role.ID }) {
+				toInsert = append(toInsert, admin.UserRole{UserID: user.ID, RoleID: role.ID})
+			}
+		}
+
+		if err = rs.checkSuperAdminChangeAllowed(ctx, req.AdminID, toInsert, toDelete, rolesByID); err != nil {
+			return err
+		}
+		for _, userRole := range toInsert {
+			if err = rs.checkGrantable(ctx, req.AdminID, rolesByID[userRole.RoleID].Permissions); err != nil {
+				return err
+			}
+		}
+		if err = rs.checkSuperAdminKept(ctx, toDelete); err != nil {
+			return err
+		}
+
+		if len(toDelete) > 0 {
+			if err = rs.userRoleRepo.DeleteMany(ctx, toDelete); err != nil {
+				return err
+			}
+		}
+		if len(toInsert) > 0 {
+			if _, err = rs.userRoleRepo.InsertMany(ctx, toInsert); err != nil {
+				return err
+			}
+		}
+
+		response = mapper.UserToResponse(user, newRoles)
+		if len(toDelete) == 0 && len(toInsert) == 0 {
+			return nil
+		}
+
+		currentRoles := ezutil.MapSlice(currentUserRoles, func(ur admin.UserRole) admin.Role { return ur.Role })
+		return rs.auditSvc.Record(ctx, audit.Entry{
+			Actor:      audit.Admin(req.AdminID),
+			Action:     auditEntity.UpdateAuditAction,
+			EntityType: auditEntity.AdminUserAuditEntity,
+			EntityID:   user.ID,
+			Before:     mapper.UserToResponse(user, currentRoles),
+			After:      response,
+		})
+	})
+	return response, err
+}
+
+// checkGrantable refuses to hand out permissions the acting admin does not hold, so managing
+// roles cannot be used to build and assign oneself a role with more access.
+func (rs *roleService) checkGrantable(ctx context.Context, adminID uuid.UUID, permissions []admin.Permission) error {
+	if len(permissions) == 0 {
+		return nil
+	}
+	if adminID == uuid.Nil {
+		return ungerr.ForbiddenError("cannot grant permissions without an acting admin")
+	}
+
+	actorRoles, err := findUserRoles(ctx, rs.userRoleRepo, adminID)
+	if err != nil {
+		return err
+	}
+	held := admin.PermissionsOf(ezutil.MapSlice(actorRoles, func(ur admin.UserRole) admin.Role { return ur.Role }))
+	for _, permission := range permissions {
+		if !slices.Contains(held, permission) {
+			return ungerr.ForbiddenError(fmt.Sprintf("cannot grant permission %s that you do not hold", permission))
+		}
+	}
+
+	return nil
+}
+
+// checkSuperAdminChangeAllowed only lets a superadmin grant or revoke the superadmin role,
+// so holding roles:update is not enough to promote oneself to full access.
+func (rs *roleService) checkSuperAdminChangeAllowed(
+	ctx context.Context,
+	adminID uuid.UUID,
+	added, removed []admin.UserRole,
+	rolesByID map[uuid.UUID]admin.Role,
+) error {
+	touchesSuperAdmin := slices.ContainsFunc(added, func(ur admin.UserRole) bool { return rolesByID[ur.RoleID].IsSuperAdmin() }) ||
+		slices.ContainsFunc(removed, func(ur admin.UserRole) bool { return ur.Role.IsSuperAdmin() })
+	if !touchesSuperAdmin {
+		return nil
+	}
+	if adminID == uuid.Nil {
+		return ungerr.ForbiddenError("only a superadmin can grant or revoke the superadmin role")
+	}
+
+	actorRoles, err := findUserRoles(ctx, rs.userRoleRepo, adminID)
+	if err != nil {
+		return err
+	}
+	if !slices.ContainsFunc(actorRoles, func(ur admin.UserRole) bool { return ur.Role.IsSuperAdmin() }) {
+		return ungerr.ForbiddenError("only a superadmin can grant or revoke the superadmin role")
+	}
+
+	return nil
+}
+
+// checkSuperAdminKept refuses to take the superadmin role away from its last holder,
+// as nobody would be left to manage roles.
+func (rs *roleService) checkSuperAdminKept(ctx context.Context, removed []admin.UserRole) error {
+	idx := slices.IndexFunc(removed, func(ur admin.UserRole) bool { return ur.Role.IsSuperAdmin() })
+	if idx < 0 {
+		return nil
+	}
+
+	spec := crud.Specification[admin.UserRole]{}
+	spec.Model.RoleID = removed[idx].RoleID
+	holders, err := rs.userRoleRepo.FindAll(ctx, spec)
+	if err != nil {
+		return err
+	}
+	if len(holders) <= 1 {
+		return ungerr.UnprocessableEntityError("cannot remove the last superadmin")
+	}
+
+	return nil
+}
+
+func (rs *roleService) validateName(ctx context.Context, id uuid.UUID, name string) error {
+	if name == admin.SuperAdminRole {
+		return ungerr.ConflictError(fmt.Sprintf("role name %s is reserved", name))
+	}
+
+	spec := crud.Specification[admin.Role]{}
+	spec.Model.Name = name
+	existing, err := rs.roleRepo.FindFirst(ctx, spec)
+	if err != nil {
+		return err
+	}
+	if !existing.IsZero() && existing.ID != id {
+		return ungerr.ConflictError(fmt.Sprintf("role %s already exists", name))
+	}
+
+	return nil
+}
+
+func (rs *roleService) getEditableByID(ctx context.Context, id uuid.UUID) (admin.Role, error) {
+	spec := crud.Specification[admin.Role]{}
+	spec.Model.ID = id
+	spec.ForUpdate = true
+	role, err := rs.roleRepo.FindFirst(ctx, spec)
+	if err != nil {
+		return admin.Role{}, err
+	}
+	if role.IsZero() {
+		return admin.Role{}, ungerr.NotFoundError(fmt.Sprintf("role with ID %s is not found", id))
+	}
+	if role.IsSuperAdmin() {
+		return admin.Role{}, ungerr.ForbiddenError("superadmin role cannot be changed")
+	}
+
+	return role, nil
+}
+
+func parsePermissions(values []string) ([]admin.Permission, error) {
+	permissions := make([]admin.Permission, 0, len(values))
+	for _, value := range values {
+		permission := admin.Permission(value)
+		if !permission.IsValid() {
+			return nil, ungerr.ValidationError(fmt.Sprintf("unknown permission %s", value))
+		}
+		if !slices.Contains(permissions, permission) {
+			permissions = append(permissions, permission)
+		}
+	}
+	return permissions, nil
+}
+
+func findUserRoles(ctx context.Context, userRoleRepo crud.Repository[admin.UserRole], userID uuid.UUID) ([]admin.UserRole, error) {
+	spec := crud.Specification[admin.UserRole]{}
+	spec.Model.UserID = userID
+	spec.PreloadRelations = []string{"Role"}
+	return userRoleRepo.FindAll(ctx, spec)
+}
@@ -0,0 +1,123 @@
+package admin_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	dto "github.com/itsLeonB/cashback/internal/domain/dto/admin"
+	"github.com/itsLeonB/cashback/internal/domain/entity/admin"
+	service "github.com/itsLeonB/cashback/internal/domain/service/admin"
+	"github.com/itsLeonB/cashback/internal/mocks"
+	"github.com/itsLeonB/go-crud"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type roleServiceMocks struct {
+	roleRepo     *mocks.MockRepository[admin.Role]
+	userRoleRepo *mocks.MockRepository[admin.UserRole]
+	userRepo     *mocks.MockRepository[admin.User]
+}
+
+func newTestRoleService(t *testing.T) (service.RoleService, roleServiceMocks) {
+	transactor := mocks.NewMockTransactor(t)
+	transactor.EXPECT().
+		WithinTransaction(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error { return fn(ctx) })
+
+	m := roleServiceMocks{
+		roleRepo:     mocks.NewMockRepository[admin.Role](t),
+		userRoleRepo: mocks.NewMockRepository[admin.UserRole](t),
+		userRepo:     mocks.NewMockRepository[admin.User](t),
+	}
+
+	// No audit service: every case here is refused before anything is recorded
+	return service.NewRoleService(transactor, m.roleRepo, m.userRoleRepo, m.userRepo, nil), m
+}
+
+func userRolesOf(userID uuid.UUID) any {
+	return mock.MatchedBy(func(spec crud.Specification[admin.UserRole]) bool { return spec.Model.UserID == userID })
+}
+
+func newRoleManager() (admin.User, admin.Role) {
+	roleManager := admin.Role{
+		Name: "role manager",
+		Permissions: []admin.Permission{
+			admin.NewPermission(admin.RoleResource, admin.CreateAction),
+			admin.NewPermission(admin.RoleResource, admin.UpdateAction),
+		},
+	}
+	roleManager.ID = uuid.New()
+
+	actor := admin.User{Email: "manager@example.com"}
+	actor.ID = uuid.New()
+
+	return actor, roleManager
+}
+
+func TestSetUserRoles_RoleManagerCannotGrantSuperAdmin(t *testing.T) {
+	svc, m := newTestRoleService(t)
+	actor, roleManager := newRoleManager()
+	superAdmin := admin.Role{Name: admin.SuperAdminRole}
+	superAdmin.ID = uuid.New()
+
+	m.userRepo.On("FindFirst", mock.Anything, mock.Anything).Return(actor, nil)
+	m.roleRepo.On("FindAll", mock.Anything, mock.Anything).Return([]admin.Role{superAdmin, roleManager}, nil)
+	m.userRoleRepo.On("FindAll", mock.Anything, userRolesOf(actor.ID)).
+		Return([]admin.UserRole{{UserID: actor.ID, RoleID: roleManager.ID, Role: roleManager}}, nil)
+
+	// No InsertMany expectation: granting the role must not reach the repository
+	_, err := svc.SetUserRoles(context.Background(), dto.UserRolesRequest{
+		AdminID: actor.ID,
+		UserID:  actor.ID,
+		RoleIDs: []uuid.UUID{roleManager.ID, superAdmin.ID},
+	})
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "only a superadmin")
+}
+
+func TestCreate_RoleManagerCannotCreateRoleWithPermissionsTheyLack(t *testing.T) {
+	svc, m := newTestRoleService(t)
+	actor, roleManager := newRoleManager()
+
+	m.roleRepo.On("FindFirst", mock.Anything, mock.Anything).Return(admin.Role{}, nil)
+	m.userRoleRepo.On("FindAll", mock.Anything, userRolesOf(actor.ID)).
+		Return([]admin.UserRole{{UserID: actor.ID, RoleID: roleManager.ID, Role: roleManager}}, nil)
+
+	// No Insert expectation: the role must not be created
+	_, err := svc.Create(context.Background(), dto.NewRoleRequest{
+		AdminID: actor.ID,
+		Name:    "everything",
+		Permissions: []string{
+			string(admin.NewPermission(admin.RoleResource, admin.UpdateAction)),
+			string(admin.NewPermission(admin.AuditLogResource, admin.ReadAction)),
+		},
+	})
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "do not hold")
+}
+
+func TestSetUserRoles_RoleManagerCannotSelfAssignBroaderRole(t *testing.T) {
+	svc, m := newTestRoleService(t)
+	actor, roleManager := newRoleManager()
+	everything := admin.Role{Name: "everything", Permissions: admin.AllPermissions()}
+	everything.ID = uuid.New()
+
+	m.userRepo.On("FindFirst", mock.Anything, mock.Anything).Return(actor, nil)
+	m.roleRepo.On("FindAll", mock.Anything, mock.Anything).Return([]admin.Role{roleManager, everything}, nil)
+	m.userRoleRepo.On("FindAll", mock.Anything, userRolesOf(actor.ID)).
+		Return([]admin.UserRole{{UserID: actor.ID, RoleID: roleManager.ID, Role: roleManager}}, nil)
+
+	// No InsertMany expectation: the role must not be assigned
+	_, err := svc.SetUserRoles(context.Background(), dto.UserRolesRequest{
+		AdminID: actor.ID,
+		UserID:  actor.ID,
+		RoleIDs: []uuid.UUID{roleManager.ID, everything.ID},
+	})
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "do not hold")
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+
+	"github.com/itsLeonB/cashback/internal/core/otel"
+	"github.com/itsLeonB/cashback/internal/domain/dto"
+	"github.com/itsLeonB/cashback/internal/domain/entity/expenses"
+	"github.com/itsLeonB/cashback/internal/domain/mapper"
+	"github.com/itsLeonB/cashback/internal/domain/repository"
+	"github.com/itsLeonB/ezutil/v2"
+	"github.com/itsLeonB/ungerr"
+)
+
+type analyticsServiceImpl struct {
+	expenseRepo repository.GroupExpenseRepository
+	profileSvc  ProfileService
+}
+
+func NewAnalyticsService(expenseRepo repository.GroupExpenseRepository, profileSvc ProfileService) AnalyticsService {
+	return &analyticsServiceImpl{expenseRepo, profileSvc}
+}
+
+// GetSpending sums up the user's shares of confirmed group expenses, counting the shares of
+// the anonymous profiles associated with the user as theirs too.
+func (as *analyticsServiceImpl) GetSpending(ctx context.Context, req dto.SpendingRequest) (dto.SpendingResponse, error) {
+	ctx, span := otel.Tracer.Start(ctx, "AnalyticsService.GetSpending")
+	defer span.End()
+
+	if !req.From.IsZero() && !req.To.IsZero() && req.To.Before(req.From) {
+		return dto.SpendingResponse{}, ungerr.ValidationError("to must not be before from")
+	}
+
+	profileIDs, err := as.profileSvc.GetAssociatedIDs(ctx, req.ProfileID)
+	if err != nil {
+		return dto.SpendingResponse{}, err
+	}
+
+	spec := expenses.SpendingSpecification{
+		ProfileIDs: profileIDs,
+		GroupBy:    req.GroupBy,
+		From:       req.From,
+		Currency:   req.Currency,
+		CategoryID: mapper.CategoryIDToEntity(req.CategoryID),
+		Tag:        req.Tag,
+	}
+	if !req.To.IsZero() {
+		// to is inclusive for the user, the specification's is exclusive
+		spec.To = req.To.AddDate(0, 0, 1)
+	}
+
+	totals, err := as.expenseRepo.SumShares(ctx, spec)
+	if err != nil {
+		return dto.SpendingResponse{}, err
+	}
+
+	return dto.SpendingResponse{
+		GroupBy: req.GroupBy,
+		Totals:  ezutil.MapSlice(totals, mapper.SpendingTotalToResponse),
+	}, nil
+}
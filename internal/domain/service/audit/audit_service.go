@@ -0,0 +1,186 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+
+	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/core/otel"
+	"github.com/itsLeonB/cashback/internal/domain/dto"
+	"github.com/itsLeonB/cashback/internal/domain/entity"
+	"github.com/itsLeonB/cashback/internal/domain/mapper"
+	"github.com/itsLeonB/cashback/internal/domain/repository"
+	"github.com/itsLeonB/ezutil/v2"
+	"github.com/itsLeonB/ungerr"
+	"gorm.io/datatypes"
+)
+
+const (
+	defaultLimit = 100
+
+	// changes to these fields alone are bookkeeping, not something anyone did
+	updatedAtField = "updatedAt"
+)
+
+type Actor struct {
+	Type entity.AuditActorType
+	ID   uuid.UUID
+}
+
+// Admin is the admin user behind a change, or the system when there is none.
+func Admin(id uuid.UUID) Actor {
+	if id == uuid.Nil {
+		return System()
+	}
+	return Actor{entity.AdminActor, id}
+}
+
+func Profile(id uuid.UUID) Actor {
+	return Actor{entity.ProfileActor, id}
+}
+
+func System() Actor {
+	return Actor{Type: entity.SystemActor}
+}
+
+// Entry describes one change. Before is nil for creations and After is nil for deletions;
+// both are snapshots that get marshaled to JSON, usually the response DTO of the entity.
+type Entry struct {
+	Actor      Actor
+	Action     entity.AuditAction
+	EntityType string
+	EntityID   uuid.UUID
+	Before     any
+	After      any
+}
+
+type Service interface {
+	// Record appends the entry. Call it inside the transaction of the change so both commit together.
+	Record(ctx context.Context, entry Entry) error
+	GetList(ctx context.Context, query dto.AuditLogQuery) ([]dto.AuditLogResponse, int64, error)
+}
+
+type service struct {
+	auditLogRepo repository.AuditLogRepository
+}
+
+func NewService(auditLogRepo repository.AuditLogRepository) *service {
+	return &service{auditLogRepo}
+}
+
+func (s *service) Record(ctx context.Context, entry Entry) error {
+	ctx, span := otel.Tracer.Start(ctx, "AuditService.Record")
+	defer span.End()
+
+	before, after, err := diff(entry.Before, entry.After)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.auditLogRepo.Insert(ctx, entity.AuditLog{
+		ActorType:  entry.Actor.Type,
+		ActorID:    uuid.NullUUID{UUID: entry.Actor.ID, Valid: entry.Actor.ID != uuid.Nil},
+		Action:     entry.Action,
+		EntityType: entry.EntityType,
+		EntityID:   entry.EntityID,
+		Before:     before,
+		After:      after,
+	})
+	return err
+}
+
+func (s *service) GetList(ctx context.Context, query dto.AuditLogQuery) ([]dto.AuditLogResponse, int64, error) {
+	ctx, span := otel.Tracer.Start(ctx, "AuditService.GetList")
+	defer span.End()
+
+	spec := entity.AuditLogSpecification{
+		ActorType:  entity.AuditActorType(query.ActorType),
+		ActorID:    query.ActorID,
+		Action:     entity.AuditAction(query.Action),
+		EntityType: query.EntityType,
+		EntityID:   query.EntityID,
+		From:       query.From,
+		Limit:      query.Limit,
+		Offset:     query.Offset,
+	}
+	if !query.To.IsZero() {
+		spec.To = query.To.AddDate(0, 0, 1)
+	}
+	if spec.Limit == 0 {
+		spec.Limit = defaultLimit
+	}
+
+	logs, total, err := s.auditLogRepo.FindAllBySpec(ctx, spec)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return ezutil.MapSlice(logs, mapper.AuditLogToResponse), total, nil
+}
+
+// diff marshals both snapshots and, when there are two, keeps only the top-level fields
+// that differ, so an entry shows what changed rather than two near-identical copies.
+func diff(before, after any) (datatypes.JSON, datatypes.JSON, error) {
+	beforeFields, err := toFields(before)
+	if err != nil {
+		return nil, nil, err
+	}
+	afterFields, err := toFields(after)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if beforeFields != nil && afterFields != nil {
+		delete(beforeFields, updatedAtField)
+		delete(afterFields, updatedAtField)
+		for key, value := range beforeFields {
+			if otherValue, ok := afterFields[key]; ok && reflect.DeepEqual(value, otherValue) {
+				delete(beforeFields, key)
+				delete(afterFields, key)
+			}
+		}
+	}
+
+	beforeJSON, err := toJSON(beforeFields)
+	if err != nil {
+		return nil, nil, err
+	}
+	afterJSON, err := toJSON(afterFields)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return beforeJSON, afterJSON, nil
+}
+
+func toFields(snapshot any) (map[string]any, error) {
+	if snapshot == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, ungerr.Wrap(err, "error marshaling audit snapshot")
+	}
+
+	var fields map[string]any
+	if err = json.Unmarshal(data, &fields); err != nil {
+		return nil, ungerr.Wrap(err, "audit snapshot is not a JSON object")
+	}
+
+	return fields, nil
+}
+
+func toJSON(fields map[string]any) (datatypes.JSON, error) {
+	if fields == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return nil, ungerr.Wrap(err, "error marshaling audit fields")
+	}
+
+	return datatypes.JSON(data), nil
+}
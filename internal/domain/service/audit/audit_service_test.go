@@ -0,0 +1,77 @@
+package audit_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/domain/entity"
+	"github.com/itsLeonB/cashback/internal/domain/repository"
+	"github.com/itsLeonB/cashback/internal/domain/service/audit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeAuditLogRepository struct {
+	repository.AuditLogRepository
+	inserted []entity.AuditLog
+}
+
+func (r *fakeAuditLogRepository) Insert(_ context.Context, log entity.AuditLog) (entity.AuditLog, error) {
+	r.inserted = append(r.inserted, log)
+	return log, nil
+}
+
+type snapshot struct {
+	Status    string `json:"status"`
+	Amount    string `json:"amount"`
+	UpdatedAt string `json:"updatedAt"`
+}
+
+func TestRecord_UpdateKeepsOnlyChangedFields(t *testing.T) {
+	repo := &fakeAuditLogRepository{}
+	adminID, paymentID := uuid.New(), uuid.New()
+
+	err := audit.NewService(repo).Record(context.Background(), audit.Entry{
+		Actor:      audit.Admin(adminID),
+		Action:     entity.UpdateAuditAction,
+		EntityType: entity.PaymentAuditEntity,
+		EntityID:   paymentID,
+		Before:     snapshot{Status: "pending", Amount: "100", UpdatedAt: "2026-01-01"},
+		After:      snapshot{Status: "paid", Amount: "100", UpdatedAt: "2026-01-02"},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, repo.inserted, 1)
+	log := repo.inserted[0]
+	assert.Equal(t, entity.AdminActor, log.ActorType)
+	assert.Equal(t, uuid.NullUUID{UUID: adminID, Valid: true}, log.ActorID)
+	assert.Equal(t, paymentID, log.EntityID)
+	assert.JSONEq(t, `{"status":"pending"}`, string(log.Before))
+	assert.JSONEq(t, `{"status":"paid"}`, string(log.After))
+}
+
+func TestRecord_CreateHasNoBefore(t *testing.T) {
+	repo := &fakeAuditLogRepository{}
+
+	err := audit.NewService(repo).Record(context.Background(), audit.Entry{
+		Actor:      audit.Profile(uuid.New()),
+		Action:     entity.CreateAuditAction,
+		EntityType: entity.DebtAuditEntity,
+		EntityID:   uuid.New(),
+		After:      snapshot{Status: "new", Amount: "5", UpdatedAt: "2026-01-01"},
+	})
+
+	require.NoError(t, err)
+	log := repo.inserted[0]
+	assert.Equal(t, entity.ProfileActor, log.ActorType)
+	assert.Nil(t, log.Before)
+	assert.JSONEq(t, `{"status":"new","amount":"5","updatedAt":"2026-01-01"}`, string(log.After))
+}
+
+func TestAdmin_WithoutIDIsSystem(t *testing.T) {
+	actor := audit.Admin(uuid.Nil)
+
+	assert.Equal(t, entity.SystemActor, actor.Type)
+	assert.Equal(t, uuid.Nil, actor.ID)
+}
@@ -19,8 +19,9 @@ type UserStore interface {
 
 // SessionStore handles session persistence.
 type SessionStore interface {
-	Create(ctx context.Context, userID string) (Session, error)
+	Create(ctx context.Context, userID string, device Device) (Session, error)
 	GetByID(ctx context.Context, id string) (Session, error)
+	FindActiveByUser(ctx context.Context, userID string) ([]Session, error)
 	Delete(ctx context.Context, id string) error
 	Touch(ctx context.Context, id string) error
 }
@@ -18,8 +18,19 @@ func (u User) IsZero() bool {
 
 // Session represents an active auth session.
 type Session struct {
-	ID     string
-	UserID string
+	ID         string
+	UserID     string
+	DeviceID   string
+	UserAgent  string
+	CreatedAt  time.Time
+	LastUsedAt time.Time
+}
+
+// Device describes the client a session is opened from. Both fields are
+// optional and only used to help users recognize their sessions.
+type Device struct {
+	ID        string
+	UserAgent string
 }
 
 // IsZero reports whether s is the zero value.
@@ -2,6 +2,8 @@ package service
 
 import (
 	"context"
+
+	"github.com/itsLeonB/cashback/internal/domain/service/auth"
 )
 
 // AuthHooks holds optional callbacks that allow application-specific business
@@ -10,9 +12,10 @@ import (
 // fields are treated as no-ops.
 
 type AuthHooks struct {
-	// BeforeLogout runs before the session is revoked during logout. It is
-	// called after the request is validated but before session deletion. Errors
-	// returned by this hook are non-blocking and do not abort the logout flow.
+	// BeforeLogout runs before a session is revoked, either by logging out or
+	// from another of the user's sessions. It is called after the request is
+	// validated but before session deletion. Errors returned by this hook are
+	// non-blocking and do not abort the logout flow.
 	//
 	// Use cases: unsubscribe push notifications, emit audit events.
 	BeforeLogout func(ctx context.Context, sessionID string) error
@@ -28,11 +31,20 @@ type AuthHooks struct {
 
 	// AfterOAuthLogin runs after a successful OAuth authentication, regardless
 	// of whether the user is new or returning. The isNewUser flag distinguishes
-	// between first-time registration and existing account login. Errors
-	// returned by this hook are non-blocking.
+	// between first-time registration and existing account login. The claims
+	// hold application-specific data passed along when the login was started,
+	// such as a profile claim token. Errors returned by this hook are
+	// non-blocking.
 	//
 	// Use cases: record referral for new OAuth users, sync profile data.
-	AfterOAuthLogin func(ctx context.Context, userID string, provider string, isNewUser bool) error
+	AfterOAuthLogin func(ctx context.Context, userID string, provider string, isNewUser bool, claims map[string]any) error
+
+	// AfterSessionsRevoked runs after a user signs out some of their sessions
+	// from another one, once the sessions are deleted. It is not called for a
+	// plain logout. Errors returned by this hook are non-blocking.
+	//
+	// Use cases: tell the user where they were signed out, emit audit events.
+	AfterSessionsRevoked func(ctx context.Context, userID string, sessions []auth.Session) error
 
 	// ClaimsBuilder is called whenever a JWT access token is being issued
 	// (during both login and token refresh). It receives the base claims
@@ -42,8 +54,7 @@ type AuthHooks struct {
 	//
 	// This hook lives on AuthHooks for discoverability, but it is wired into
 	// SessionService (not AuthService) because claims are built during token
-	// creation. Extract ClaimsBuilder from the hooks value and pass it to
-	// NewSessionService.
+	// creation. Pass the hooks value to NewSessionService as well.
 	//
 	// Use cases: embed slug, tier, or other app-specific fields into the JWT.
 	ClaimsBuilder func(ctx context.Context, userID string, baseClaims map[string]any) (map[string]any, error)
@@ -68,9 +79,17 @@ func (h AuthHooks) CallAfterEmailVerified(ctx context.Context, userID, profileID
 }
 
 // CallAfterOAuthLogin invokes AfterOAuthLogin if it is non-nil.
-func (h AuthHooks) CallAfterOAuthLogin(ctx context.Context, userID string, provider string, isNewUser bool) error {
+func (h AuthHooks) CallAfterOAuthLogin(ctx context.Context, userID string, provider string, isNewUser bool, claims map[string]any) error {
 	if h.AfterOAuthLogin == nil {
 		return nil
 	}
-	return h.AfterOAuthLogin(ctx, userID, provider, isNewUser)
+	return h.AfterOAuthLogin(ctx, userID, provider, isNewUser, claims)
+}
+
+// CallAfterSessionsRevoked invokes AfterSessionsRevoked if it is non-nil.
+func (h AuthHooks) CallAfterSessionsRevoked(ctx context.Context, userID string, sessions []auth.Session) error {
+	if h.AfterSessionsRevoked == nil {
+		return nil
+	}
+	return h.AfterSessionsRevoked(ctx, userID, sessions)
 }
@@ -107,12 +107,12 @@ func (as *authServiceImpl) executeRegistration(ctx context.Context, request dto.
 			return err
 		}
 
-		return as.sendVerificationMail(ctx, newUser, as.verificationURL, request.Slug)
+		return as.sendVerificationMail(ctx, newUser, as.verificationURL, request.Slug, request.ClaimToken)
 	})
 	return isVerified, err
 }
 
-func (as *authServiceImpl) sendVerificationMail(ctx context.Context, user auth.User, verificationURL string, slug string) error {
+func (as *authServiceImpl) sendVerificationMail(ctx context.Context, user auth.User, verificationURL, slug, claimToken string) error {
 	claims := map[string]any{
 		"id":    user.ID,
 		"email": user.Email,
@@ -121,6 +121,9 @@ func (as *authServiceImpl) sendVerificationMail(ctx context.Context, user auth.U
 	if slug != "" {
 		claims["slug"] = slug
 	}
+	if claimToken != "" {
+		claims["claimToken"] = claimToken
+	}
 
 	token, err := as.jwtService.CreateToken(claims)
 	if err != nil {
@@ -157,7 +160,7 @@ func (as *authServiceImpl) InternalLogin(ctx context.Context, req dto.InternalLo
 		return dto.TokenResponse{}, ungerr.NotFoundError(appconstant.ErrAuthUnknownCredentials)
 	}
 
-	return as.sessionSvc.CreateTokenAndSession(ctx, user)
+	return as.sessionSvc.CreateTokenAndSession(ctx, user, req.Device)
 }
 
 func (as *authServiceImpl) VerifyToken(ctx context.Context, token string, fingerprint string) (bool, map[string]any, error) {
@@ -236,7 +239,7 @@ func (as *authServiceImpl) VerifyToken(ctx context.Context, token string, finger
 	return true, result, nil
 }
 
-func (as *authServiceImpl) VerifyRegistration(ctx context.Context, token string) (dto.TokenResponse, error) {
+func (as *authServiceImpl) VerifyRegistration(ctx context.Context, token string, device auth.Device) (dto.TokenResponse, error) {
 	ctx, span := otel.Tracer.Start(ctx, "AuthService.VerifyRegistration")
 	defer span.End()
 
@@ -272,7 +275,7 @@ func (as *authServiceImpl) VerifyRegistration(ctx context.Context, token string)
 			return err
 		}
 
-		response, err = as.sessionSvc.CreateTokenAndSession(ctx, user)
+		response, err = as.sessionSvc.CreateTokenAndSession(ctx, user, device)
 		return err
 	})
 	return response, err
@@ -333,7 +336,7 @@ func (as *authServiceImpl) sendResetPasswordMail(ctx context.Context, user auth.
 	return as.mailSvc.SendPasswordReset(ctx, user.Email, name, url)
 }
 
-func (as *authServiceImpl) ResetPassword(ctx context.Context, token, newPassword string) (dto.TokenResponse, error) {
+func (as *authServiceImpl) ResetPassword(ctx context.Context, token, newPassword string, device auth.Device) (dto.TokenResponse, error) {
 	ctx, span := otel.Tracer.Start(ctx, "AuthService.ResetPassword")
 	defer span.End()
 
@@ -396,7 +399,7 @@ func (as *authServiceImpl) ResetPassword(ctx context.Context, token, newPassword
 			ID:    id,
 			Email: email,
 		}
-		response, err = as.sessionSvc.CreateTokenAndSession(ctx, user)
+		response, err = as.sessionSvc.CreateTokenAndSession(ctx, user, device)
 		return err
 	})
 	return response, err
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/core/otel"
+	"github.com/itsLeonB/cashback/internal/domain/dto"
+	"github.com/itsLeonB/cashback/internal/domain/entity"
+	"github.com/itsLeonB/cashback/internal/domain/mapper"
+	"github.com/itsLeonB/cashback/internal/domain/repository"
+	"github.com/itsLeonB/ezutil/v2"
+	"github.com/itsLeonB/go-crud"
+	"github.com/itsLeonB/ungerr"
+)
+
+type categoryServiceImpl struct {
+	transactor   crud.Transactor
+	categoryRepo repository.CategoryRepository
+}
+
+func NewCategoryService(transactor crud.Transactor, categoryRepo repository.CategoryRepository) CategoryService {
+	return &categoryServiceImpl{transactor, categoryRepo}
+}
+
+func (cs *categoryServiceImpl) Create(ctx context.Context, req dto.NewCategoryRequest) (dto.CategoryResponse, error) {
+	ctx, span := otel.Tracer.Start(ctx, "CategoryService.Create")
+	defer span.End()
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return dto.CategoryResponse{}, ungerr.ValidationError("category name is required")
+	}
+
+	var resp dto.CategoryResponse
+	err := cs.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		categories, err := cs.categoryRepo.FindAllUsable(ctx, req.ProfileID)
+		if err != nil {
+			return err
+		}
+		for _, category := range categories {
+			if strings.EqualFold(category.Name, name) {
+				return ungerr.ConflictError(fmt.Sprintf("category %s already exists", category.Name))
+			}
+		}
+
+		insertedCategory, err := cs.categoryRepo.Insert(ctx, entity.Category{
+			ProfileID: uuid.NullUUID{UUID: req.ProfileID, Valid: true},
+			Name:      name,
+		})
+		if err != nil {
+			return err
+		}
+
+		resp = mapper.CategoryToResponse(insertedCategory)
+		return nil
+	})
+	return resp, err
+}
+
+func (cs *categoryServiceImpl) GetAll(ctx context.Context, profileID uuid.UUID) ([]dto.CategoryResponse, error) {
+	ctx, span := otel.Tracer.Start(ctx, "CategoryService.GetAll")
+	defer span.End()
+
+	categories, err := cs.categoryRepo.FindAllUsable(ctx, profileID)
+	if err != nil {
+		return nil, err
+	}
+
+	return ezutil.MapSlice(categories, mapper.CategoryToResponse), nil
+}
+
+// Delete removes a category the profile defined. Expenses and debts labeled with it become uncategorized.
+func (cs *categoryServiceImpl) Delete(ctx context.Context, profileID, id uuid.UUID) error {
+	ctx, span := otel.Tracer.Start(ctx, "CategoryService.Delete")
+	defer span.End()
+
+	return cs.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		category, err := cs.GetUsable(ctx, profileID, id)
+		if err != nil {
+			return err
+		}
+		if category.IsDefault() {
+			return ungerr.UnprocessableEntityError("default categories cannot be deleted")
+		}
+		return cs.categoryRepo.Delete(ctx, category)
+	})
+}
+
+func (cs *categoryServiceImpl) GetUsable(ctx context.Context, profileID, id uuid.UUID) (entity.Category, error) {
+	ctx, span := otel.Tracer.Start(ctx, "CategoryService.GetUsable")
+	defer span.End()
+
+	spec := crud.Specification[entity.Category]{}
+	spec.Model.ID = id
+	category, err := cs.categoryRepo.FindFirst(ctx, spec)
+	if err != nil {
+		return entity.Category{}, err
+	}
+	if category.IsZero() || !category.IsUsableBy(profileID) {
+		return entity.Category{}, ungerr.NotFoundError(fmt.Sprintf("category with ID %s is not found", id))
+	}
+
+	return category, nil
+}
+
+func (cs *categoryServiceImpl) GetDefaultByName(ctx context.Context, name string) (entity.Category, error) {
+	ctx, span := otel.Tracer.Start(ctx, "CategoryService.GetDefaultByName")
+	defer span.End()
+
+	return cs.categoryRepo.FindDefaultByName(ctx, name)
+}
+
+// usableCategory checks that the profile may label with the category, where uuid.Nil means no category.
+func usableCategory(ctx context.Context, categorySvc CategoryService, profileID, id uuid.UUID) (entity.Category, error) {
+	if id == uuid.Nil {
+		return entity.Category{}, nil
+	}
+	return categorySvc.GetUsable(ctx, profileID, id)
+}
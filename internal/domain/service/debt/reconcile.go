@@ -0,0 +1,56 @@
+package debt
+
+import (
+	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/domain/entity/debts"
+	"github.com/itsLeonB/ezutil/v2"
+	"github.com/shopspring/decimal"
+)
+
+type pairKey struct {
+	currency string
+	first    uuid.UUID
+	second   uuid.UUID
+}
+
+// Reconcile returns the debts that bring the ones already recorded up to date with the expected ones,
+// so history is compensated instead of rewritten. Both sides are netted per pair of profiles and currency,
+// and pairs that already match produce nothing. Only lender, borrower, currency and amount are set.
+func Reconcile(recorded, expected []debts.DebtTransaction) []debts.DebtTransaction {
+	net := make(map[pairKey]decimal.Decimal)
+	order := make([]pairKey, 0, len(expected))
+
+	add := func(tx debts.DebtTransaction, sign int64) {
+		// The amount is owed by second to first
+		key := pairKey{tx.Currency, tx.LenderProfileID, tx.BorrowerProfileID}
+		amount := tx.Amount.Mul(decimal.NewFromInt(sign))
+		if ezutil.CompareUUID(key.first, key.second) > 0 {
+			key.first, key.second = key.second, key.first
+			amount = amount.Neg()
+		}
+		if _, ok := net[key]; !ok {
+			order = append(order, key)
+		}
+		net[key] = net[key].Add(amount)
+	}
+
+	for _, tx := range expected {
+		add(tx, 1)
+	}
+	for _, tx := range recorded {
+		add(tx, -1)
+	}
+
+	deltas := make([]debts.DebtTransaction, 0, len(order))
+	for _, key := range order {
+		amount := net[key]
+		switch {
+		case amount.IsPositive():
+			deltas = append(deltas, debts.DebtTransaction{LenderProfileID: key.first, BorrowerProfileID: key.second, Currency: key.currency, Amount: amount})
+		case amount.IsNegative():
+			deltas = append(deltas, debts.DebtTransaction{LenderProfileID: key.second, BorrowerProfileID: key.first, Currency: key.currency, Amount: amount.Neg()})
+		}
+	}
+
+	return deltas
+}
@@ -0,0 +1,83 @@
+package debt_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/domain/entity/debts"
+	"github.com/itsLeonB/cashback/internal/domain/service/debt"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReconcile(t *testing.T) {
+	alice, bob, carol := uuid.New(), uuid.New(), uuid.New()
+
+	tx := func(lender, borrower uuid.UUID, currency string, amount int64) debts.DebtTransaction {
+		return debts.DebtTransaction{
+			LenderProfileID:   lender,
+			BorrowerProfileID: borrower,
+			Currency:          currency,
+			Amount:            decimal.NewFromInt(amount),
+		}
+	}
+
+	t.Run("nothing to compensate when debts are unchanged", func(t *testing.T) {
+		recorded := []debts.DebtTransaction{tx(alice, bob, "IDR", 100), tx(alice, carol, "IDR", 50)}
+
+		assert.Empty(t, debt.Reconcile(recorded, recorded))
+	})
+
+	t.Run("records only the difference of a changed share", func(t *testing.T) {
+		deltas := debt.Reconcile(
+			[]debts.DebtTransaction{tx(alice, bob, "IDR", 100)},
+			[]debts.DebtTransaction{tx(alice, bob, "IDR", 120)},
+		)
+
+		assert.Len(t, deltas, 1)
+		assert.Equal(t, alice, deltas[0].LenderProfileID)
+		assert.Equal(t, bob, deltas[0].BorrowerProfileID)
+		assert.True(t, deltas[0].Amount.Equal(decimal.NewFromInt(20)))
+	})
+
+	t.Run("reverses the debt of a removed participant and of a lowered share", func(t *testing.T) {
+		deltas := debt.Reconcile(
+			[]debts.DebtTransaction{tx(alice, bob, "IDR", 100), tx(alice, carol, "IDR", 50)},
+			[]debts.DebtTransaction{tx(alice, bob, "IDR", 70)},
+		)
+
+		assert.Len(t, deltas, 2)
+		assert.Equal(t, bob, deltas[0].LenderProfileID)
+		assert.Equal(t, alice, deltas[0].BorrowerProfileID)
+		assert.True(t, deltas[0].Amount.Equal(decimal.NewFromInt(30)))
+		assert.Equal(t, carol, deltas[1].LenderProfileID)
+		assert.Equal(t, alice, deltas[1].BorrowerProfileID)
+		assert.True(t, deltas[1].Amount.Equal(decimal.NewFromInt(50)))
+	})
+
+	t.Run("a changed payer flips the debt", func(t *testing.T) {
+		deltas := debt.Reconcile(
+			[]debts.DebtTransaction{tx(alice, bob, "IDR", 100)},
+			[]debts.DebtTransaction{tx(bob, alice, "IDR", 100)},
+		)
+
+		assert.Len(t, deltas, 1)
+		assert.Equal(t, bob, deltas[0].LenderProfileID)
+		assert.Equal(t, alice, deltas[0].BorrowerProfileID)
+		assert.True(t, deltas[0].Amount.Equal(decimal.NewFromInt(200)))
+	})
+
+	t.Run("a changed currency reverses the old currency", func(t *testing.T) {
+		deltas := debt.Reconcile(
+			[]debts.DebtTransaction{tx(alice, bob, "IDR", 100)},
+			[]debts.DebtTransaction{tx(alice, bob, "USD", 10)},
+		)
+
+		assert.Len(t, deltas, 2)
+		assert.Equal(t, "USD", deltas[0].Currency)
+		assert.Equal(t, alice, deltas[0].LenderProfileID)
+		assert.Equal(t, "IDR", deltas[1].Currency)
+		assert.Equal(t, bob, deltas[1].LenderProfileID)
+		assert.True(t, deltas[1].Amount.Equal(decimal.NewFromInt(100)))
+	})
+}
@@ -0,0 +1,108 @@
+package debt
+
+import (
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/itsLeonB/ezutil/v2"
+	"github.com/shopspring/decimal"
+)
+
+// Transfer is a single suggested payment from a debtor to a creditor.
+type Transfer struct {
+	FromProfileID uuid.UUID
+	ToProfileID   uuid.UUID
+	Currency      string
+	Amount        decimal.Decimal
+}
+
+type SettleUpPlanner interface {
+	// Plan takes the net position of every profile per currency
+	// (positive: is owed money, negative: owes money) and returns
+	// the suggested transfers grouped by currency.
+	Plan(positions map[uuid.UUID]map[string]decimal.Decimal) map[string][]Transfer
+}
+
+type settleUpPlannerImpl struct{}
+
+func NewSettleUpPlanner() SettleUpPlanner {
+	return &settleUpPlannerImpl{}
+}
+
+type position struct {
+	profileID uuid.UUID
+	amount    decimal.Decimal
+}
+
+func (s *settleUpPlannerImpl) Plan(positions map[uuid.UUID]map[string]decimal.Decimal) map[string][]Transfer {
+	positionsByCurrency := make(map[string][]position)
+	for profileID, currencies := range positions {
+		for currency, amount := range currencies {
+			if amount.IsZero() {
+				continue
+			}
+			positionsByCurrency[currency] = append(positionsByCurrency[currency], position{profileID, amount})
+		}
+	}
+
+	plan := make(map[string][]Transfer, len(positionsByCurrency))
+	for currency, currencyPositions := range positionsByCurrency {
+		if transfers := planCurrency(currency, currencyPositions); len(transfers) > 0 {
+			plan[currency] = transfers
+		}
+	}
+
+	return plan
+}
+
+// planCurrency greedily matches the largest creditor with the largest debtor.
+// Every step fully settles at least one side, so a group of n profiles
+// needs at most n-1 transfers.
+func planCurrency(currency string, positions []position) []Transfer {
+	creditors := make([]position, 0, len(positions))
+	debtors := make([]position, 0, len(positions))
+	for _, p := range positions {
+		if p.amount.IsPositive() {
+			creditors = append(creditors, p)
+		} else {
+			debtors = append(debtors, position{p.profileID, p.amount.Neg()})
+		}
+	}
+
+	transfers := make([]Transfer, 0, max(len(creditors), len(debtors)))
+	for len(creditors) > 0 && len(debtors) > 0 {
+		sortPositions(creditors)
+		sortPositions(debtors)
+
+		amount := decimal.Min(creditors[0].amount, debtors[0].amount)
+		transfers = append(transfers, Transfer{
+			FromProfileID: debtors[0].profileID,
+			ToProfileID:   creditors[0].profileID,
+			Currency:      currency,
+			Amount:        amount,
+		})
+
+		creditors[0].amount = creditors[0].amount.Sub(amount)
+		debtors[0].amount = debtors[0].amount.Sub(amount)
+
+		if creditors[0].amount.IsZero() {
+			creditors = creditors[1:]
+		}
+		if debtors[0].amount.IsZero() {
+			debtors = debtors[1:]
+		}
+	}
+
+	return transfers
+}
+
+// sortPositions orders by amount descending, using profile ID as tiebreaker
+// so that the same balances always produce the same plan.
+func sortPositions(positions []position) {
+	slices.SortFunc(positions, func(a, b position) int {
+		if c := b.amount.Cmp(a.amount); c != 0 {
+			return c
+		}
+		return ezutil.CompareUUID(a.profileID, b.profileID)
+	})
+}
@@ -0,0 +1,109 @@
+package debt_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/domain/service/debt"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSettleUpPlanner_ChainCollapses(t *testing.T) {
+	planner := debt.NewSettleUpPlanner()
+	alice, bob, carol := uuid.New(), uuid.New(), uuid.New()
+
+	// Bob owes Alice 50, Carol owes Bob 50 -> Carol pays Alice directly
+	positions := map[uuid.UUID]map[string]decimal.Decimal{
+		alice: {"IDR": decimal.NewFromInt(50)},
+		bob:   {"IDR": decimal.Zero},
+		carol: {"IDR": decimal.NewFromInt(-50)},
+	}
+
+	plan := planner.Plan(positions)
+
+	assert.Len(t, plan["IDR"], 1)
+	assert.Equal(t, carol, plan["IDR"][0].FromProfileID)
+	assert.Equal(t, alice, plan["IDR"][0].ToProfileID)
+	assert.True(t, plan["IDR"][0].Amount.Equal(decimal.NewFromInt(50)))
+}
+
+func TestSettleUpPlanner_FlatmatesNeedAtMostNMinusOneTransfers(t *testing.T) {
+	planner := debt.NewSettleUpPlanner()
+	ids := []uuid.UUID{uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()}
+
+	positions := map[uuid.UUID]map[string]decimal.Decimal{
+		ids[0]: {"IDR": decimal.NewFromInt(120)},
+		ids[1]: {"IDR": decimal.NewFromInt(30)},
+		ids[2]: {"IDR": decimal.NewFromInt(-40)},
+		ids[3]: {"IDR": decimal.NewFromInt(-60)},
+		ids[4]: {"IDR": decimal.NewFromInt(-50)},
+	}
+
+	plan := planner.Plan(positions)
+
+	assert.LessOrEqual(t, len(plan["IDR"]), len(ids)-1)
+	assertSettles(t, positions, plan, "IDR")
+}
+
+func TestSettleUpPlanner_CurrenciesAreKeptSeparate(t *testing.T) {
+	planner := debt.NewSettleUpPlanner()
+	alice, bob := uuid.New(), uuid.New()
+
+	positions := map[uuid.UUID]map[string]decimal.Decimal{
+		alice: {"IDR": decimal.NewFromInt(100), "SGD": decimal.NewFromFloat(-12.5)},
+		bob:   {"IDR": decimal.NewFromInt(-100), "SGD": decimal.NewFromFloat(12.5)},
+	}
+
+	plan := planner.Plan(positions)
+
+	assert.Len(t, plan, 2)
+	assert.Equal(t, bob, plan["IDR"][0].FromProfileID)
+	assert.Equal(t, alice, plan["SGD"][0].FromProfileID)
+	assert.True(t, plan["SGD"][0].Amount.Equal(decimal.NewFromFloat(12.5)))
+}
+
+func TestSettleUpPlanner_SettledBalancesProduceNoTransfers(t *testing.T) {
+	planner := debt.NewSettleUpPlanner()
+
+	positions := map[uuid.UUID]map[string]decimal.Decimal{
+		uuid.New(): {"IDR": decimal.Zero},
+		uuid.New(): {"IDR": decimal.Zero},
+	}
+
+	assert.Empty(t, planner.Plan(positions))
+}
+
+func TestSettleUpPlanner_IsDeterministic(t *testing.T) {
+	planner := debt.NewSettleUpPlanner()
+	ids := []uuid.UUID{uuid.New(), uuid.New(), uuid.New(), uuid.New()}
+
+	positions := map[uuid.UUID]map[string]decimal.Decimal{
+		ids[0]: {"IDR": decimal.NewFromInt(10)},
+		ids[1]: {"IDR": decimal.NewFromInt(10)},
+		ids[2]: {"IDR": decimal.NewFromInt(-10)},
+		ids[3]: {"IDR": decimal.NewFromInt(-10)},
+	}
+
+	first := planner.Plan(positions)
+	for range 10 {
+		assert.Equal(t, first, planner.Plan(positions))
+	}
+}
+
+func assertSettles(t *testing.T, positions map[uuid.UUID]map[string]decimal.Decimal, plan map[string][]debt.Transfer, currency string) {
+	t.Helper()
+
+	remaining := make(map[uuid.UUID]decimal.Decimal, len(positions))
+	for id, currencies := range positions {
+		remaining[id] = currencies[currency]
+	}
+	for _, transfer := range plan[currency] {
+		assert.True(t, transfer.Amount.IsPositive())
+		remaining[transfer.FromProfileID] = remaining[transfer.FromProfileID].Add(transfer.Amount)
+		remaining[transfer.ToProfileID] = remaining[transfer.ToProfileID].Sub(transfer.Amount)
+	}
+	for id, amount := range remaining {
+		assert.True(t, amount.IsZero(), "profile %s left with %s", id, amount)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 
 	mapset "github.com/deckarep/golang-set/v2"
 	"github.com/google/uuid"
@@ -16,6 +17,8 @@ import (
 	"github.com/itsLeonB/cashback/internal/domain/mapper"
 	"github.com/itsLeonB/cashback/internal/domain/message"
 	"github.com/itsLeonB/cashback/internal/domain/repository"
+	"github.com/itsLeonB/cashback/internal/domain/service/audit"
+	"github.com/itsLeonB/cashback/internal/domain/service/debt"
 	"github.com/itsLeonB/ezutil/v2"
 	"github.com/itsLeonB/go-crud"
 	"github.com/itsLeonB/ungerr"
@@ -30,6 +33,12 @@ type debtServiceImpl struct {
 	profileService            ProfileService
 	expenseService            GroupExpenseService
 	taskQueue                 queue.TaskQueue
+	fxRateService             FxRateService
+	settleUpPlanner           debt.SettleUpPlanner
+	categoryService           CategoryService
+	groupService              GroupService
+	transactor                crud.Transactor
+	auditService              audit.Service
 }
 
 func NewDebtService(
@@ -39,6 +48,11 @@ func NewDebtService(
 	profileService ProfileService,
 	expenseService GroupExpenseService,
 	taskQueue queue.TaskQueue,
+	fxRateService FxRateService,
+	categoryService CategoryService,
+	groupService GroupService,
+	transactor crud.Transactor,
+	auditService audit.Service,
 ) DebtService {
 	return &debtServiceImpl{
 		debtTransactionRepository,
@@ -47,6 +61,12 @@ func NewDebtService(
 		profileService,
 		expenseService,
 		taskQueue,
+		fxRateService,
+		debt.NewSettleUpPlanner(),
+		categoryService,
+		groupService,
+		transactor,
+		auditService,
 	}
 }
 
@@ -74,12 +94,25 @@ func (ds *debtServiceImpl) RecordNewTransaction(ctx context.Context, req dto.New
 		return dto.DebtTransactionResponse{}, err
 	}
 
+	category, err := usableCategory(ctx, ds.categoryService, req.UserProfileID, req.CategoryID)
+	if err != nil {
+		return dto.DebtTransactionResponse{}, err
+	}
+
 	lenderID, borrowerID := req.UserProfileID, req.FriendProfileID
 	if req.Direction == dto.IncomingDebt {
 		lenderID, borrowerID = req.FriendProfileID, req.UserProfileID
 	}
 
+	group, err := groupOfBoth(ctx, ds.groupService, req.GroupID, req.UserProfileID, req.FriendProfileID)
+	if err != nil {
+		return dto.DebtTransactionResponse{}, err
+	}
+
 	currency := req.Currency
+	if currency == "" {
+		currency = group.DefaultCurrency
+	}
 	if currency == "" {
 		userProfile, err := ds.profileService.GetEntityByID(ctx, req.UserProfileID)
 		if err != nil {
@@ -88,25 +121,45 @@ func (ds *debtServiceImpl) RecordNewTransaction(ctx context.Context, req dto.New
 		currency = userProfile.HomeCurrency
 	}
 
-	insertedDebt, err := ds.debtTransactionRepository.Insert(ctx, debts.DebtTransaction{
-		LenderProfileID:   lenderID,
-		BorrowerProfileID: borrowerID,
-		Amount:            req.Amount,
-		TransferMethodID:  req.TransferMethodID,
-		Description:       req.Description,
-		Currency:          currency,
+	var response dto.DebtTransactionResponse
+	err = ds.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		insertedDebt, err := ds.debtTransactionRepository.Insert(ctx, debts.DebtTransaction{
+			LenderProfileID:   lenderID,
+			BorrowerProfileID: borrowerID,
+			Amount:            req.Amount,
+			TransferMethodID:  req.TransferMethodID,
+			Description:       req.Description,
+			Currency:          currency,
+			CategoryID:        mapper.CategoryIDToEntity(category.ID),
+			Tags:              mapper.TagsToEntity(req.Tags),
+			GroupID:           uuid.NullUUID{UUID: group.ID, Valid: !group.IsZero()},
+		})
+		if err != nil {
+			return err
+		}
+
+		insertedDebt.TransferMethod = transferMethod
+		insertedDebt.Category = category
+		response = mapper.DebtTransactionToResponse(req.UserProfileID, insertedDebt, make(map[uuid.UUID]dto.ProfileResponse))
+
+		return ds.auditService.Record(ctx, audit.Entry{
+			Actor:      audit.Profile(req.UserProfileID),
+			Action:     entity.CreateAuditAction,
+			EntityType: entity.DebtAuditEntity,
+			EntityID:   insertedDebt.ID,
+			After:      response,
+		})
 	})
 	if err != nil {
 		return dto.DebtTransactionResponse{}, err
 	}
 
 	go ds.taskQueue.AsyncEnqueue(ctx, message.DebtCreated{
-		ID:               insertedDebt.ID,
+		ID:               response.ID,
 		CreatorProfileID: req.UserProfileID,
 	})
 
-	insertedDebt.TransferMethod = transferMethod
-	return mapper.DebtTransactionToResponse(req.UserProfileID, insertedDebt, make(map[uuid.UUID]dto.ProfileResponse)), nil
+	return response, nil
 }
 
 func (ds *debtServiceImpl) GetTransactions(ctx context.Context, profileID uuid.UUID) ([]dto.DebtTransactionResponse, error) {
@@ -137,7 +190,7 @@ func (ds *debtServiceImpl) GetTransactions(ctx context.Context, profileID uuid.U
 	return ezutil.MapSlice(transactions, mapper.DebtTransactionSimpleMapper(profileID, profilesByID)), nil
 }
 
-func (ds *debtServiceImpl) GetTransactionSummary(ctx context.Context, profileID uuid.UUID) (map[string]dto.FriendBalance, error) {
+func (ds *debtServiceImpl) GetTransactionSummary(ctx context.Context, profileID uuid.UUID, inHomeCurrency bool) (map[string]dto.FriendBalance, error) {
 	ctx, span := otel.Tracer.Start(ctx, "DebtService.GetTransactionSummary")
 	defer span.End()
 
@@ -151,6 +204,16 @@ func (ds *debtServiceImpl) GetTransactionSummary(ctx context.Context, profileID
 		return nil, err
 	}
 
+	if inHomeCurrency {
+		userProfile, err := ds.profileService.GetEntityByID(ctx, profileID)
+		if err != nil {
+			return nil, err
+		}
+		if transactions, err = ds.fxRateService.ConvertTransactions(ctx, transactions, userProfile.HomeCurrency); err != nil {
+			return nil, err
+		}
+	}
+
 	return mapper.SummarizePerCurrency(transactions, profileIDs), nil
 }
 
@@ -163,10 +226,43 @@ func (ds *debtServiceImpl) ProcessConfirmedGroupExpense(ctx context.Context, gro
 		return err
 	}
 
-	debtTransactions := mapper.GroupExpenseToDebtTransactions(groupExpense, transferMethod.ID)
+	debtTransactions, err := mapper.GroupExpenseToDebtTransactions(groupExpense, transferMethod.ID)
+	if err != nil {
+		return err
+	}
+
+	if groupExpense.Revision > 0 {
+		// An amended expense already has debts recorded, so only the differences are added on top
+		recordedTransactions, err := ds.debtTransactionRepository.FindAllByGroupExpenseIDs(ctx, []uuid.UUID{groupExpense.ID})
+		if err != nil {
+			return err
+		}
+
+		debtTransactions = debt.Reconcile(recordedTransactions, debtTransactions)
+		if len(debtTransactions) == 0 {
+			return nil
+		}
+
+		for i := range debtTransactions {
+			debtTransactions[i].TransferMethodID = transferMethod.ID
+			debtTransactions[i].GroupExpenseID = uuid.NullUUID{UUID: groupExpense.ID, Valid: true}
+			debtTransactions[i].Description = fmt.Sprintf("Amended share (revision %d) for group expense: %s", groupExpense.Revision, groupExpense.Description)
+		}
+	}
+
+	// Debts of an expense are labeled like it, so they show up under the same category and group
+	for i := range debtTransactions {
+		debtTransactions[i].CategoryID = groupExpense.CategoryID
+		debtTransactions[i].Tags = groupExpense.Tags
+		debtTransactions[i].GroupID = groupExpense.GroupID
+	}
+
+	insertedTransactions, err := ds.debtTransactionRepository.InsertMany(ctx, debtTransactions)
+	if err != nil {
+		return err
+	}
 
-	_, err = ds.debtTransactionRepository.InsertMany(ctx, debtTransactions)
-	return err
+	return recordDebtsCreated(ctx, ds.auditService, groupExpense.CreatorProfileID, insertedTransactions)
 }
 
 func (ds *debtServiceImpl) GetAllByProfileIDs(ctx context.Context, userProfileID, friendProfileID uuid.UUID) ([]debts.DebtTransaction, []uuid.UUID, error) {
@@ -178,8 +274,8 @@ func (ds *debtServiceImpl) GetAllByProfileIDs(ctx context.Context, userProfileID
 		return nil, nil, err
 	}
 
-	userIDs := ds.getAssociatedIDs(profiles[userProfileID])
-	friendIDs := ds.getAssociatedIDs(profiles[friendProfileID])
+	userIDs := associatedProfileIDs(profiles[userProfileID])
+	friendIDs := associatedProfileIDs(profiles[friendProfileID])
 
 	transactions, err := ds.debtTransactionRepository.FindAllByMultipleProfileIDs(ctx, userIDs, friendIDs)
 	return transactions, userIDs, err
@@ -199,26 +295,136 @@ func (ds *debtServiceImpl) GetNetBalancesByFriend(ctx context.Context, profileID
 		return nil, err
 	}
 
-	raw := mapper.NetBalanceByFriend(transactions, profileIDs)
+	return ds.consolidateToRealProfiles(ctx, mapper.NetBalanceByFriend(transactions, profileIDs))
+}
+
+func (ds *debtServiceImpl) GetSettleUpPlan(ctx context.Context, req dto.SettleUpRequest) (map[string][]dto.SettleUpTransfer, error) {
+	ctx, span := otel.Tracer.Start(ctx, "DebtService.GetSettleUpPlan")
+	defer span.End()
+
+	var positions map[uuid.UUID]map[string]decimal.Decimal
+	var err error
+	if len(req.GroupExpenseIDs) > 0 {
+		positions, err = ds.getGroupExpensePositions(ctx, req.UserProfileID, req.GroupExpenseIDs)
+	} else {
+		positions, err = ds.getFriendPositions(ctx, req.UserProfileID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	plan := ds.settleUpPlanner.Plan(positions)
+
+	planProfileIDs := mapset.NewSet[uuid.UUID]()
+	for _, transfers := range plan {
+		for _, transfer := range transfers {
+			planProfileIDs.Add(transfer.FromProfileID)
+			planProfileIDs.Add(transfer.ToProfileID)
+		}
+	}
+	if planProfileIDs.Cardinality() == 0 {
+		return map[string][]dto.SettleUpTransfer{}, nil
+	}
+
+	profilesByID, err := ds.profileService.GetByIDs(ctx, planProfileIDs.ToSlice())
+	if err != nil {
+		return nil, err
+	}
+
+	response := make(map[string][]dto.SettleUpTransfer, len(plan))
+	for currency, transfers := range plan {
+		response[currency] = ezutil.MapSlice(transfers, mapper.SettleUpTransferSimpleMapper(req.UserProfileID, profilesByID))
+	}
+
+	return response, nil
+}
+
+// getFriendPositions turns the user's pairwise balances into net positions,
+// so that the planner can route a friend's debt straight to another friend the user owes.
+func (ds *debtServiceImpl) getFriendPositions(ctx context.Context, profileID uuid.UUID) (map[uuid.UUID]map[string]decimal.Decimal, error) {
+	balances, err := ds.GetNetBalancesByFriend(ctx, profileID)
+	if err != nil {
+		return nil, err
+	}
+
+	positions := make(map[uuid.UUID]map[string]decimal.Decimal, len(balances)+1)
+	positions[profileID] = make(map[string]decimal.Decimal)
+	for friendProfileID, currencies := range balances {
+		positions[friendProfileID] = make(map[string]decimal.Decimal, len(currencies))
+		for currency, amount := range currencies {
+			positions[friendProfileID][currency] = amount.Neg()
+			positions[profileID][currency] = positions[profileID][currency].Add(amount)
+		}
+	}
 
-	// Consolidate anon profile IDs to their real profiles
-	counterpartyIDs := make([]uuid.UUID, 0, len(raw))
+	return positions, nil
+}
+
+// getGroupExpensePositions computes the net positions of everyone involved in the given
+// confirmed group expenses. The user, or one of their associated profiles, must be the creator
+// or a participant of each of them.
+func (ds *debtServiceImpl) getGroupExpensePositions(ctx context.Context, profileID uuid.UUID, groupExpenseIDs []uuid.UUID) (map[uuid.UUID]map[string]decimal.Decimal, error) {
+	profileIDs, err := ds.profileService.GetAssociatedIDs(ctx, profileID)
+	if err != nil {
+		return nil, err
+	}
+
+	groupExpenses, err := ds.expenseService.GetByIDs(ctx, groupExpenseIDs)
+	if err != nil {
+		return nil, err
+	}
+	groupExpensesByID := make(map[uuid.UUID]expenses.GroupExpense, len(groupExpenses))
+	for _, groupExpense := range groupExpenses {
+		groupExpensesByID[groupExpense.ID] = groupExpense
+	}
+
+	for _, id := range groupExpenseIDs {
+		groupExpense, ok := groupExpensesByID[id]
+		if !ok || !isInvolvedInExpense(groupExpense, profileIDs) {
+			return nil, ungerr.NotFoundError(fmt.Sprintf("group expense with ID %s is not found", id))
+		}
+		if groupExpense.Status != expenses.ConfirmedExpense {
+			return nil, ungerr.UnprocessableEntityError(fmt.Sprintf("group expense %s is not confirmed", id))
+		}
+	}
+
+	transactions, err := ds.debtTransactionRepository.FindAllByGroupExpenseIDs(ctx, groupExpenseIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return ds.consolidateToRealProfiles(ctx, mapper.NetPositionsByProfile(transactions))
+}
+
+func isInvolvedInExpense(groupExpense expenses.GroupExpense, profileIDs []uuid.UUID) bool {
+	if slices.Contains(profileIDs, groupExpense.CreatorProfileID) {
+		return true
+	}
+	return slices.ContainsFunc(groupExpense.Participants, func(p expenses.ExpenseParticipant) bool {
+		return slices.Contains(profileIDs, p.ParticipantProfileID)
+	})
+}
+
+// consolidateToRealProfiles merges the amounts of anon profiles into their associated real profiles
+// and drops zero balances.
+func (ds *debtServiceImpl) consolidateToRealProfiles(ctx context.Context, raw map[uuid.UUID]map[string]decimal.Decimal) (map[uuid.UUID]map[string]decimal.Decimal, error) {
+	profileIDs := make([]uuid.UUID, 0, len(raw))
 	for id := range raw {
-		counterpartyIDs = append(counterpartyIDs, id)
+		profileIDs = append(profileIDs, id)
 	}
-	if len(counterpartyIDs) == 0 {
+	if len(profileIDs) == 0 {
 		return raw, nil
 	}
 
-	profiles, err := ds.profileService.GetByIDs(ctx, counterpartyIDs)
+	profiles, err := ds.profileService.GetByIDs(ctx, profileIDs)
 	if err != nil {
 		return nil, err
 	}
 
 	consolidated := make(map[uuid.UUID]map[string]decimal.Decimal, len(raw))
-	for cpID, currencies := range raw {
-		canonicalID := cpID
-		if p, ok := profiles[cpID]; ok && p.RealProfileID != uuid.Nil {
+	for id, currencies := range raw {
+		canonicalID := id
+		if p, ok := profiles[id]; ok && p.RealProfileID != uuid.Nil {
 			canonicalID = p.RealProfileID
 		}
 		if consolidated[canonicalID] == nil {
@@ -272,6 +478,62 @@ func (ds *debtServiceImpl) GetRecent(ctx context.Context, profileID uuid.UUID) (
 	return ezutil.MapSlice(transactions, mapper.DebtTransactionSimpleMapper(profileID, profilesByID)), nil
 }
 
+// UpdateLabels sets the category and tags of a debt the user lent or borrowed.
+func (ds *debtServiceImpl) UpdateLabels(ctx context.Context, req dto.LabelsRequest) (dto.DebtTransactionResponse, error) {
+	ctx, span := otel.Tracer.Start(ctx, "DebtService.UpdateLabels")
+	defer span.End()
+
+	profileIDs, err := ds.profileService.GetAssociatedIDs(ctx, req.ProfileID)
+	if err != nil {
+		return dto.DebtTransactionResponse{}, err
+	}
+
+	transactions, err := ds.debtTransactionRepository.FindAllByIDs(ctx, []uuid.UUID{req.ID}, false)
+	if err != nil {
+		return dto.DebtTransactionResponse{}, err
+	}
+	if len(transactions) == 0 {
+		return dto.DebtTransactionResponse{}, ungerr.NotFoundError(fmt.Sprintf("debt transaction with ID %s is not found", req.ID))
+	}
+	transaction := transactions[0]
+	userIDs := mapset.NewSet(profileIDs...)
+	if !userIDs.Contains(transaction.LenderProfileID) && !userIDs.Contains(transaction.BorrowerProfileID) {
+		return dto.DebtTransactionResponse{}, ungerr.NotFoundError(fmt.Sprintf("debt transaction with ID %s is not found", req.ID))
+	}
+
+	category, err := usableCategory(ctx, ds.categoryService, req.ProfileID, req.CategoryID)
+	if err != nil {
+		return dto.DebtTransactionResponse{}, err
+	}
+
+	transaction.CategoryID = mapper.CategoryIDToEntity(category.ID)
+	transaction.Tags = mapper.TagsToEntity(req.Tags)
+	updatedTransaction, err := ds.debtTransactionRepository.Update(ctx, transaction)
+	if err != nil {
+		return dto.DebtTransactionResponse{}, err
+	}
+
+	transferMethod, err := ds.transferMethodService.GetByID(ctx, updatedTransaction.TransferMethodID)
+	if err != nil {
+		return dto.DebtTransactionResponse{}, err
+	}
+	updatedTransaction.TransferMethod = transferMethod
+	updatedTransaction.Category = category
+
+	profilesByID, err := ds.profileService.GetByIDs(ctx, []uuid.UUID{transaction.LenderProfileID, transaction.BorrowerProfileID})
+	if err != nil {
+		return dto.DebtTransactionResponse{}, err
+	}
+
+	// The user may be on either side through an associated profile
+	userProfileID := transaction.LenderProfileID
+	if userIDs.Contains(transaction.BorrowerProfileID) {
+		userProfileID = transaction.BorrowerProfileID
+	}
+
+	return mapper.DebtTransactionToResponse(userProfileID, updatedTransaction, profilesByID), nil
+}
+
 func (ds *debtServiceImpl) ConstructNotification(ctx context.Context, msg message.DebtCreated) (entity.Notification, error) {
 	ctx, span := otel.Tracer.Start(ctx, "DebtService.ConstructNotification")
 	defer span.End()
@@ -320,7 +582,8 @@ func (ds *debtServiceImpl) ConstructNotification(ctx context.Context, msg messag
 	}, nil
 }
 
-func (ds *debtServiceImpl) getAssociatedIDs(profile dto.ProfileResponse) []uuid.UUID {
+// associatedProfileIDs returns the profile's own ID together with its linked real or anon profile IDs.
+func associatedProfileIDs(profile dto.ProfileResponse) []uuid.UUID {
 	ids := []uuid.UUID{profile.ID}
 	if profile.IsAnonymous {
 		if profile.RealProfileID != uuid.Nil {
@@ -331,3 +594,19 @@ func (ds *debtServiceImpl) getAssociatedIDs(profile dto.ProfileResponse) []uuid.
 	}
 	return ids
 }
+
+// recordDebtsCreated audits debts the profile created along with something else, such as an expense or a settlement.
+func recordDebtsCreated(ctx context.Context, auditSvc audit.Service, profileID uuid.UUID, transactions []debts.DebtTransaction) error {
+	for _, transaction := range transactions {
+		if err := auditSvc.Record(ctx, audit.Entry{
+			Actor:      audit.Profile(profileID),
+			Action:     entity.CreateAuditAction,
+			EntityType: entity.DebtAuditEntity,
+			EntityID:   transaction.ID,
+			After:      mapper.DebtTransactionToResponse(profileID, transaction, make(map[uuid.UUID]dto.ProfileResponse)),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
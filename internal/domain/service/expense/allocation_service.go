@@ -1,14 +1,16 @@
 package expense
 
 import (
+	"fmt"
+
 	"github.com/itsLeonB/cashback/internal/domain/entity/expenses"
-	"github.com/itsLeonB/ezutil/v2"
 	"github.com/itsLeonB/ungerr"
 	"github.com/shopspring/decimal"
 )
 
 type AllocationService interface {
 	AllocateAmounts(totalAmount decimal.Decimal, participants []expenses.ItemParticipant) ([]expenses.ItemParticipant, error)
+	AllocateBySplitMode(mode expenses.SplitMode, totalAmount decimal.Decimal, participants []expenses.ItemParticipant) ([]expenses.ItemParticipant, error)
 }
 
 type allocationServiceImpl struct{}
@@ -57,6 +59,79 @@ func (a *allocationServiceImpl) AllocateAmounts(totalAmount decimal.Decimal, par
 	return result, nil
 }
 
+func (a *allocationServiceImpl) AllocateBySplitMode(mode expenses.SplitMode, totalAmount decimal.Decimal, participants []expenses.ItemParticipant) ([]expenses.ItemParticipant, error) {
+	switch mode {
+	case expenses.WeightSplit, "":
+		return a.AllocateAmounts(totalAmount, participants)
+	case expenses.ExactSplit:
+		return allocateExact(totalAmount, participants)
+	case expenses.PercentageSplit:
+		return allocateByPercentage(totalAmount, participants)
+	default:
+		return nil, ungerr.UnprocessableEntityError(fmt.Sprintf("unsupported split mode: %s", mode))
+	}
+}
+
+// allocateExact uses each participant's Share as its allocated amount, as long as they add up to the total.
+func allocateExact(totalAmount decimal.Decimal, participants []expenses.ItemParticipant) ([]expenses.ItemParticipant, error) {
+	if len(participants) == 0 {
+		return nil, ungerr.UnprocessableEntityError("no participants provided")
+	}
+
+	result := make([]expenses.ItemParticipant, len(participants))
+	copy(result, participants)
+
+	for i := range result {
+		if result[i].Share.IsNegative() {
+			return nil, ungerr.UnprocessableEntityError("exact amount cannot be negative")
+		}
+		if !result[i].Share.Equal(result[i].Share.Round(2)) {
+			return nil, ungerr.UnprocessableEntityError("exact amount cannot have more than 2 decimal places")
+		}
+		result[i].AllocatedAmount = result[i].Share
+	}
+
+	if err := validateFinalSum(result, totalAmount); err != nil {
+		return nil, ungerr.UnprocessableEntityError(fmt.Sprintf("exact amounts must add up to %s", totalAmount.String()))
+	}
+
+	return result, nil
+}
+
+// allocateByPercentage splits the total by each participant's Share as a percentage, which must add up to 100.
+func allocateByPercentage(totalAmount decimal.Decimal, participants []expenses.ItemParticipant) ([]expenses.ItemParticipant, error) {
+	if len(participants) == 0 {
+		return nil, ungerr.UnprocessableEntityError("no participants provided")
+	}
+
+	percentageSum := decimal.Zero
+	shares := make([]Share, len(participants))
+	for i, p := range participants {
+		percentageSum = percentageSum.Add(p.Share)
+		shares[i] = Share{ProfileID: p.ProfileID, Value: p.Share}
+	}
+	if !percentageSum.Equal(decimal.NewFromInt(100)) {
+		return nil, ungerr.UnprocessableEntityError("percentages must add up to 100")
+	}
+
+	amounts, err := SplitByShares(totalAmount, shares)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]expenses.ItemParticipant, len(participants))
+	copy(result, participants)
+	for i := range result {
+		result[i].AllocatedAmount = amounts[i]
+	}
+
+	if err := validateFinalSum(result, totalAmount); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
 // Helper function to determine which weights to use
 func determineWeights(participants []expenses.ItemParticipant, weightSum int) []int {
 	weights := make([]int, len(participants))
@@ -108,23 +183,12 @@ func applyRemainder(participants []expenses.ItemParticipant, weights []int, rema
 		return
 	}
 
-	// Find index with highest weight (using ProfileID as tiebreaker)
-	maxIdx := 0
-	maxWeight := weights[0]
-	minProfileID := participants[0].ProfileID
-
-	for i := 1; i < len(weights); i++ {
-		currentWeight := weights[i]
-		currentProfileID := participants[i].ProfileID
-
-		if currentWeight > maxWeight ||
-			(currentWeight == maxWeight && ezutil.CompareUUID(currentProfileID, minProfileID) < 0) {
-			maxIdx = i
-			maxWeight = currentWeight
-			minProfileID = currentProfileID
-		}
+	shares := make([]Share, len(participants))
+	for i := range participants {
+		shares[i] = Share{ProfileID: participants[i].ProfileID, Value: decimal.NewFromInt(int64(weights[i]))}
 	}
 
+	maxIdx := largestShareIndex(shares)
 	participants[maxIdx].AllocatedAmount = participants[maxIdx].AllocatedAmount.Add(remainder)
 }
 
@@ -125,3 +125,99 @@ func TestAllocationService_RoundingRemainder(t *testing.T) {
 	}
 	assert.True(t, totalAllocated.Equal(totalAmount))
 }
+
+func TestAllocationService_ExactSplit(t *testing.T) {
+	service := expense.NewAllocationService()
+	totalAmount := decimal.NewFromInt(100000)
+
+	participants := []expenses.ItemParticipant{
+		{ProfileID: uuid.New(), Share: decimal.NewFromInt(40000)},
+		{ProfileID: uuid.New(), Share: decimal.NewFromInt(60000)},
+	}
+
+	result, err := service.AllocateBySplitMode(expenses.ExactSplit, totalAmount, participants)
+
+	assert.NoError(t, err)
+	assert.True(t, result[0].AllocatedAmount.Equal(decimal.NewFromInt(40000)))
+	assert.True(t, result[1].AllocatedAmount.Equal(decimal.NewFromInt(60000)))
+}
+
+func TestAllocationService_ExactSplitMismatch(t *testing.T) {
+	service := expense.NewAllocationService()
+
+	participants := []expenses.ItemParticipant{
+		{ProfileID: uuid.New(), Share: decimal.NewFromInt(40000)},
+		{ProfileID: uuid.New(), Share: decimal.NewFromInt(50000)},
+	}
+
+	_, err := service.AllocateBySplitMode(expenses.ExactSplit, decimal.NewFromInt(100000), participants)
+
+	assert.Error(t, err)
+}
+
+func TestAllocationService_PercentageSplit(t *testing.T) {
+	service := expense.NewAllocationService()
+	totalAmount := decimal.NewFromInt(100)
+
+	participants := []expenses.ItemParticipant{
+		{ProfileID: uuid.New(), Share: decimal.RequireFromString("33.33")},
+		{ProfileID: uuid.New(), Share: decimal.RequireFromString("33.33")},
+		{ProfileID: uuid.New(), Share: decimal.RequireFromString("33.34")},
+	}
+
+	result, err := service.AllocateBySplitMode(expenses.PercentageSplit, totalAmount, participants)
+
+	assert.NoError(t, err)
+	assert.True(t, result[0].AllocatedAmount.Equal(decimal.RequireFromString("33.33")))
+	assert.True(t, result[1].AllocatedAmount.Equal(decimal.RequireFromString("33.33")))
+	assert.True(t, result[2].AllocatedAmount.Equal(decimal.RequireFromString("33.34")))
+}
+
+func TestAllocationService_PercentageSplitRemainder(t *testing.T) {
+	service := expense.NewAllocationService()
+	totalAmount := decimal.NewFromInt(10)
+
+	participants := []expenses.ItemParticipant{
+		{ProfileID: uuid.New(), Share: decimal.NewFromInt(60)},
+		{ProfileID: uuid.New(), Share: decimal.RequireFromString("20.5")},
+		{ProfileID: uuid.New(), Share: decimal.RequireFromString("19.5")},
+	}
+
+	result, err := service.AllocateBySplitMode(expenses.PercentageSplit, totalAmount, participants)
+
+	assert.NoError(t, err)
+	totalAllocated := decimal.Zero
+	for _, p := range result {
+		totalAllocated = totalAllocated.Add(p.AllocatedAmount)
+	}
+	assert.True(t, totalAllocated.Equal(totalAmount))
+	assert.True(t, result[0].AllocatedAmount.Equal(decimal.NewFromInt(6)))
+}
+
+func TestAllocationService_PercentageSplitNotHundred(t *testing.T) {
+	service := expense.NewAllocationService()
+
+	participants := []expenses.ItemParticipant{
+		{ProfileID: uuid.New(), Share: decimal.NewFromInt(60)},
+		{ProfileID: uuid.New(), Share: decimal.NewFromInt(30)},
+	}
+
+	_, err := service.AllocateBySplitMode(expenses.PercentageSplit, decimal.NewFromInt(100), participants)
+
+	assert.Error(t, err)
+}
+
+func TestSplitByShares_RemainderGoesToLargestShare(t *testing.T) {
+	shares := []expense.Share{
+		{ProfileID: uuid.New(), Value: decimal.NewFromInt(1)},
+		{ProfileID: uuid.New(), Value: decimal.NewFromInt(1)},
+		{ProfileID: uuid.New(), Value: decimal.NewFromInt(2)},
+	}
+
+	amounts, err := expense.SplitByShares(decimal.RequireFromString("0.10"), shares)
+
+	assert.NoError(t, err)
+	assert.True(t, amounts[0].Equal(decimal.RequireFromString("0.03")))
+	assert.True(t, amounts[1].Equal(decimal.RequireFromString("0.03")))
+	assert.True(t, amounts[2].Equal(decimal.RequireFromString("0.04")))
+}
@@ -0,0 +1,57 @@
+// Package billeval scores bill parsing against a golden corpus of receipts, so prompt and
+// normaliser changes are measured before they ship.
+package billeval
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/itsLeonB/cashback/internal/domain/dto"
+	"github.com/itsLeonB/ungerr"
+)
+
+// Case is one receipt of the corpus: the OCR text in <name>.txt and what should be read off it in <name>.json.
+type Case struct {
+	Name     string
+	Text     string
+	Expected dto.NewGroupExpenseRequest
+}
+
+// LoadCorpus reads every <name>.txt in dir along with its <name>.json, sorted by name.
+func LoadCorpus(dir string) ([]Case, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.txt"))
+	if err != nil {
+		return nil, ungerr.Wrap(err, "error listing bill corpus")
+	}
+	sort.Strings(paths)
+
+	cases := make([]Case, 0, len(paths))
+	for _, path := range paths {
+		text, err := os.ReadFile(path)
+		if err != nil {
+			return nil, ungerr.Wrap(err, "error reading bill corpus text")
+		}
+
+		name := strings.TrimSuffix(filepath.Base(path), ".txt")
+		rawExpected, err := os.ReadFile(filepath.Join(dir, name+".json"))
+		if err != nil {
+			return nil, ungerr.Wrap(err, "error reading expected result of "+name)
+		}
+
+		var expected dto.NewGroupExpenseRequest
+		if err := json.Unmarshal(rawExpected, &expected); err != nil {
+			return nil, ungerr.Wrap(err, "error decoding expected result of "+name)
+		}
+
+		cases = append(cases, Case{name, string(text), expected})
+	}
+
+	if len(cases) == 0 {
+		return nil, ungerr.Unknownf("no receipts in bill corpus %s", dir)
+	}
+
+	return cases, nil
+}
@@ -0,0 +1,167 @@
+package billeval
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/itsLeonB/cashback/internal/core/service/prompt"
+	"github.com/itsLeonB/cashback/internal/domain/service/expense/billparse"
+	"github.com/itsLeonB/ungerr"
+)
+
+// CaseResult is how one receipt of the corpus was read.
+type CaseResult struct {
+	Name   string
+	Parser billparse.ParserKind
+	Err    error
+	Scores Scores
+}
+
+// Report is a run of the corpus through one prompt version.
+type Report struct {
+	// Prompt is the prompt name and version, e.g. "parse-bill v1", which baselines are kept per.
+	Prompt       string
+	PromptSource prompt.Source
+	Cases        []CaseResult
+	Overall      Scores
+}
+
+// Run parses every receipt of the corpus with parser, which reads them with p.
+func Run(ctx context.Context, p billparse.BillParsePrompt, parser *billparse.Parser, cases []Case) Report {
+	report := Report{Prompt: PromptLabel(p)}
+
+	all := make([]Scores, 0, len(cases))
+	for _, c := range cases {
+		result, err := parser.Parse(ctx, c.Text)
+		if result.PromptSource != "" {
+			report.PromptSource = result.PromptSource
+		}
+
+		caseResult := CaseResult{Name: c.Name, Parser: result.Parser, Err: err}
+		if err == nil {
+			caseResult.Scores = Score(c.Expected, result.Request)
+		}
+
+		report.Cases = append(report.Cases, caseResult)
+		all = append(all, caseResult.Scores)
+	}
+
+	report.Overall = Mean(all)
+	return report
+}
+
+func PromptLabel(p billparse.BillParsePrompt) string {
+	return fmt.Sprintf("%s v%d", p.PromptName, p.Version)
+}
+
+// Baseline keeps the last accepted scores of each prompt version, overall and per receipt.
+type Baseline map[string]BaselineEntry
+
+type BaselineEntry struct {
+	Overall Scores            `json:"overall"`
+	Cases   map[string]Scores `json:"cases"`
+}
+
+// LoadBaseline reads the baseline file, and is empty when there is none yet.
+func LoadBaseline(path string) (Baseline, error) {
+	raw, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return Baseline{}, nil
+	}
+	if err != nil {
+		return nil, ungerr.Wrap(err, "error reading bill eval baseline")
+	}
+
+	var baseline Baseline
+	if err := json.Unmarshal(raw, &baseline); err != nil {
+		return nil, ungerr.Wrap(err, "error decoding bill eval baseline")
+	}
+	return baseline, nil
+}
+
+// Accept records the report as the baseline of its prompt version.
+func (b Baseline) Accept(report Report) {
+	entry := BaselineEntry{Overall: report.Overall, Cases: make(map[string]Scores, len(report.Cases))}
+	for _, c := range report.Cases {
+		entry.Cases[c.Name] = c.Scores
+	}
+	b[report.Prompt] = entry
+}
+
+func (b Baseline) Save(path string) error {
+	raw, err := json.MarshalIndent(b, "", "  ")
+	if err != nil {
+		return ungerr.Wrap(err, "error encoding bill eval baseline")
+	}
+	if err := os.WriteFile(path, append(raw, '\n'), 0o644); err != nil {
+		return ungerr.Wrap(err, "error writing bill eval baseline")
+	}
+	return nil
+}
+
+// Regression is a score that dropped below the baseline of the same prompt version.
+type Regression struct {
+	Prompt   string
+	Case     string // empty for the overall score
+	Metric   string
+	Baseline float64
+	Got      float64
+}
+
+func (r Regression) String() string {
+	scope := "overall"
+	if r.Case != "" {
+		scope = r.Case
+	}
+	return fmt.Sprintf("%s: %s %s dropped from %.2f to %.2f", r.Prompt, scope, r.Metric, r.Baseline, r.Got)
+}
+
+// Regressions compares the report with the baseline of its prompt version. Receipts new to the
+// corpus have nothing to regress from, and neither does a prompt version without a baseline.
+func (b Baseline) Regressions(report Report, tolerance float64) []Regression {
+	entry, ok := b[report.Prompt]
+	if !ok {
+		return nil
+	}
+
+	var regressions []Regression
+	compare := func(caseName string, baseline, got Scores) {
+		for _, metric := range Metrics {
+			if got.Get(metric) < baseline.Get(metric)-tolerance {
+				regressions = append(regressions, Regression{report.Prompt, caseName, metric, baseline.Get(metric), got.Get(metric)})
+			}
+		}
+	}
+
+	compare("", entry.Overall, report.Overall)
+	for _, c := range report.Cases {
+		if baseline, ok := entry.Cases[c.Name]; ok {
+			compare(c.Name, baseline, c.Scores)
+		}
+	}
+
+	return regressions
+}
+
+// Print writes the report as a table, one row per receipt.
+func (r Report) Print(w io.Writer) error {
+	fmt.Fprintf(w, "prompt %s (%s)\n\n", r.Prompt, r.PromptSource)
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "receipt\tparser\titems\tquantities\tprices\ttotals\terror")
+	for _, c := range r.Cases {
+		errMsg := ""
+		if c.Err != nil {
+			errMsg = c.Err.Error()
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%.2f\t%.2f\t%.2f\t%.2f\t%s\n", c.Name, c.Parser, c.Scores.Items, c.Scores.Quantities, c.Scores.Prices, c.Scores.Totals, errMsg)
+	}
+	fmt.Fprintf(tw, "overall\t\t%.2f\t%.2f\t%.2f\t%.2f\t\n", r.Overall.Items, r.Overall.Quantities, r.Overall.Prices, r.Overall.Totals)
+
+	return tw.Flush()
+}
@@ -0,0 +1,123 @@
+package billeval_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/itsLeonB/cashback/internal/core/config"
+	"github.com/itsLeonB/cashback/internal/core/logger"
+	"github.com/itsLeonB/cashback/internal/core/service/langfuse"
+	"github.com/itsLeonB/cashback/internal/core/service/llm"
+	"github.com/itsLeonB/cashback/internal/core/service/prompt"
+	"github.com/itsLeonB/cashback/internal/domain/dto"
+	"github.com/itsLeonB/cashback/internal/domain/service/expense/billeval"
+	"github.com/itsLeonB/cashback/internal/domain/service/expense/billparse"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testdataDir = "../../../../../testdata/bills"
+
+func TestMain(m *testing.M) {
+	logger.Init("test")
+	os.Exit(m.Run())
+}
+
+func item(name string, amount int64, quantity int) dto.NewExpenseItemRequest {
+	return dto.NewExpenseItemRequest{Name: name, Amount: decimal.NewFromInt(amount), Quantity: quantity}
+}
+
+func TestScore(t *testing.T) {
+	expected := dto.NewGroupExpenseRequest{
+		TotalAmount: decimal.NewFromInt(61000),
+		Items: []dto.NewExpenseItemRequest{
+			item("Nasi Goreng", 25000, 2),
+			item("Es Teh Manis", 8000, 1),
+			item("Kerupuk", 3000, 1),
+		},
+	}
+
+	t.Run("exact", func(t *testing.T) {
+		assert.Equal(t, billeval.Scores{Items: 1, Quantities: 1, Prices: 1, Totals: 1}, billeval.Score(expected, expected))
+	})
+
+	t.Run("names paired loosely", func(t *testing.T) {
+		got := dto.NewGroupExpenseRequest{
+			TotalAmount: decimal.NewFromInt(61000),
+			Items: []dto.NewExpenseItemRequest{
+				item("NASI GORENG SPESIAL", 25000, 2),
+				item("es teh manis.", 8000, 1),
+				item("Kerupuk", 3000, 1),
+			},
+		}
+		assert.Equal(t, billeval.Scores{Items: 1, Quantities: 1, Prices: 1, Totals: 1}, billeval.Score(expected, got))
+	})
+
+	t.Run("misread and extra lines", func(t *testing.T) {
+		got := dto.NewGroupExpenseRequest{
+			TotalAmount: decimal.NewFromInt(64000),
+			Items: []dto.NewExpenseItemRequest{
+				item("Nasi Goreng", 50000, 1),
+				item("Es Teh Manis", 8000, 1),
+				item("Kerupuk", 3000, 1),
+				item("Tunai", 3000, 1),
+			},
+		}
+		scores := billeval.Score(expected, got)
+		assert.InDelta(t, 0.75, scores.Items, 1e-9)
+		assert.InDelta(t, 2.0/3, scores.Quantities, 1e-9)
+		assert.InDelta(t, 2.0/3, scores.Prices, 1e-9)
+		assert.Zero(t, scores.Totals)
+	})
+}
+
+func TestBaselineRegressions(t *testing.T) {
+	report := billeval.Report{
+		Prompt:  "parse-bill v1",
+		Cases:   []billeval.CaseResult{{Name: "warung", Scores: billeval.Scores{Items: 1, Quantities: 1, Prices: 1, Totals: 1}}},
+		Overall: billeval.Scores{Items: 1, Quantities: 1, Prices: 1, Totals: 1},
+	}
+
+	baseline := billeval.Baseline{}
+	baseline.Accept(report)
+	assert.Empty(t, baseline.Regressions(report, 0))
+
+	report.Cases[0].Scores.Prices = 0.5
+	report.Overall.Prices = 0.5
+	regressions := baseline.Regressions(report, 0)
+	require.Len(t, regressions, 2)
+	assert.Equal(t, "parse-bill v1: overall prices dropped from 1.00 to 0.50", regressions[0].String())
+	assert.Equal(t, "parse-bill v1: warung prices dropped from 1.00 to 0.50", regressions[1].String())
+
+	assert.Empty(t, baseline.Regressions(report, 0.5))
+
+	report.Prompt = "parse-bill v2"
+	assert.Empty(t, baseline.Regressions(report, 0))
+}
+
+// TestCorpus runs the golden corpus against the recorded LLM replies of the active prompt version.
+// After changing the prompt or the parser, rerun the corpus with `make eval-bills` and accept
+// improvements with `make eval-bills ARGS=-update`.
+func TestCorpus(t *testing.T) {
+	cases, err := billeval.LoadCorpus(testdataDir + "/corpus")
+	require.NoError(t, err)
+
+	llmService, err := llm.NewLLMService(config.LLM{Provider: "fake", FixturesDir: testdataDir + "/llm", MaxRetries: 2})
+	require.NoError(t, err)
+
+	registry := prompt.NewRegistry(langfuse.NewClient(config.Langfuse{}))
+	parser := billparse.NewParser(billparse.ActiveBillParsePrompt, registry, llmService)
+
+	report := billeval.Run(context.Background(), billparse.ActiveBillParsePrompt, parser, cases)
+	for _, c := range report.Cases {
+		assert.NoError(t, c.Err, c.Name)
+		assert.Equal(t, billparse.LLMParser, c.Parser, "%s was not read by the LLM, is its reply recorded?", c.Name)
+	}
+
+	baseline, err := billeval.LoadBaseline(testdataDir + "/baseline.json")
+	require.NoError(t, err)
+	require.Contains(t, baseline, report.Prompt)
+	assert.Empty(t, baseline.Regressions(report, 0))
+}
@@ -0,0 +1,121 @@
+package billeval
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/itsLeonB/cashback/internal/domain/dto"
+)
+
+// Scores are accuracies from 0 to 1.
+type Scores struct {
+	// Items is the share of expected items found by name, lowered by extra items that should not be there.
+	Items float64 `json:"items"`
+	// Quantities and Prices are the shares of expected items found with the right quantity and unit price.
+	Quantities float64 `json:"quantities"`
+	Prices     float64 `json:"prices"`
+	// Totals is 1 when the total amount is right.
+	Totals float64 `json:"totals"`
+}
+
+// Metrics names the scores in report order.
+var Metrics = []string{"items", "quantities", "prices", "totals"}
+
+func (s Scores) Get(metric string) float64 {
+	switch metric {
+	case "items":
+		return s.Items
+	case "quantities":
+		return s.Quantities
+	case "prices":
+		return s.Prices
+	default:
+		return s.Totals
+	}
+}
+
+// Score compares a parsed bill with the expected one. Items are paired by name: the same
+// name once case and punctuation are ignored, or else one name containing the other.
+func Score(expected, got dto.NewGroupExpenseRequest) Scores {
+	var scores Scores
+	if expected.TotalAmount.Equal(got.TotalAmount) {
+		scores.Totals = 1
+	}
+
+	if len(expected.Items) == 0 && len(got.Items) == 0 {
+		scores.Items, scores.Quantities, scores.Prices = 1, 1, 1
+		return scores
+	}
+
+	pairs := pairItems(expected.Items, got.Items)
+
+	var quantities, prices int
+	for e, g := range pairs {
+		if expected.Items[e].Quantity == got.Items[g].Quantity {
+			quantities++
+		}
+		if expected.Items[e].Amount.Equal(got.Items[g].Amount) {
+			prices++
+		}
+	}
+
+	scores.Items = float64(len(pairs)) / float64(max(len(expected.Items), len(got.Items)))
+	if len(expected.Items) > 0 {
+		scores.Quantities = float64(quantities) / float64(len(expected.Items))
+		scores.Prices = float64(prices) / float64(len(expected.Items))
+	}
+
+	return scores
+}
+
+// pairItems maps the index of each expected item to the parsed item it was found as.
+func pairItems(expected, got []dto.NewExpenseItemRequest) map[int]int {
+	pairs := make(map[int]int)
+	used := make(map[int]bool)
+
+	match := func(same func(e, g string) bool) {
+		for e, expectedItem := range expected {
+			if _, ok := pairs[e]; ok {
+				continue
+			}
+			for g, gotItem := range got {
+				if !used[g] && same(normalizeName(expectedItem.Name), normalizeName(gotItem.Name)) {
+					pairs[e] = g
+					used[g] = true
+					break
+				}
+			}
+		}
+	}
+
+	match(func(e, g string) bool { return e == g })
+	match(func(e, g string) bool {
+		return e != "" && g != "" && (strings.Contains(e, g) || strings.Contains(g, e))
+	})
+
+	return pairs
+}
+
+func normalizeName(name string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}), " ")
+}
+
+// Mean averages the scores of several receipts.
+func Mean(all []Scores) Scores {
+	var mean Scores
+	if len(all) == 0 {
+		return mean
+	}
+
+	for _, s := range all {
+		mean.Items += s.Items
+		mean.Quantities += s.Quantities
+		mean.Prices += s.Prices
+		mean.Totals += s.Totals
+	}
+
+	n := float64(len(all))
+	return Scores{mean.Items / n, mean.Quantities / n, mean.Prices / n, mean.Totals / n}
+}
@@ -0,0 +1,40 @@
+package billparse
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/itsLeonB/cashback/internal/domain/entity"
+)
+
+// categoryRules suggest a default category from words on the receipt. They are checked in order,
+// so the more specific ones come first: a hotel receipt may well list its restaurant too.
+var categoryRules = []struct {
+	Category string
+	Pattern  *regexp.Regexp
+}{
+	{entity.GroceriesCategory, regexp.MustCompile(`(?i)\b(supermarket|minimarket|hypermart|superindo|transmart|lotte\s*mart|grocer(y|ies)?|sayur)\b`)},
+	{entity.AccommodationCategory, regexp.MustCompile(`(?i)\b(hotel|hostel|resort|villa|guest\s*house|airbnb|room\s*charge|check[\s-]*out)\b`)},
+	{entity.TravelCategory, regexp.MustCompile(`(?i)\b(airlines?|airways|boarding\s*pass|flight|garuda|citilink|airasia|lion\s*air)\b`)},
+	{entity.TransportCategory, regexp.MustCompile(`(?i)\b(grab|gojek|taxi|taksi|bluebird|spbu|pertamina|bensin|pertalite|pertamax|parkir|parking|tol|toll|krl|mrt)\b`)},
+	{entity.HealthCategory, regexp.MustCompile(`(?i)\b(apotek|apotik|pharmacy|farma|klinik|clinic|hospital|rumah\s*sakit|dokter|doctor)\b`)},
+	{entity.EntertainmentCategory, regexp.MustCompile(`(?i)\b(cinema|bioskop|xxi|cgv|karaoke|concert|konser)\b`)},
+	{entity.UtilitiesCategory, regexp.MustCompile(`(?i)\b(pln|listrik|pdam|indihome|internet|pulsa)\b`)},
+	{entity.FoodAndDrinkCategory, regexp.MustCompile(`(?i)\b(resto|restaurant|restoran|cafe|kafe|coffee|kopi|warung|rumah\s*makan|bakery|nasi|mie|ayam|sate|bakso|pizza|burger|pb1|dine\s*in|take\s*away|meja|table)\b`)},
+}
+
+// SuggestCategory guesses which default category the receipt belongs to, from the chain it was
+// recognised as or else its words. It is empty when nothing gives the receipt away.
+func SuggestCategory(text string) string {
+	if template := detectTemplate(strings.Split(text, "\n")); template.Category != "" {
+		return template.Category
+	}
+
+	for _, rule := range categoryRules {
+		if rule.Pattern.MatchString(text) {
+			return rule.Category
+		}
+	}
+
+	return ""
+}
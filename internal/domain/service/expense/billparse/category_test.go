@@ -0,0 +1,30 @@
+package billparse
+
+import (
+	"testing"
+
+	"github.com/itsLeonB/cashback/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSuggestCategory(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{"merchant template", "INDOMARET\nJL. KEMANG RAYA 5\nNASI BOX  1  15000  15000\nTOTAL  15000", entity.GroceriesCategory},
+		{"restaurant", "RM PADANG SEDERHANA\nMeja 4\n3 Nasi Putih  15.000\nTOTAL  15.000", entity.FoodAndDrinkCategory},
+		{"hotel before its restaurant", "GRAND HOTEL\nRoom Charge  850.000\nRestaurant  120.000\nTOTAL  970.000", entity.AccommodationCategory},
+		{"fuel", "SPBU 34.123\nPertalite  10 L  100.000\nTOTAL  100.000", entity.TransportCategory},
+		{"pharmacy", "APOTEK K-24\nParacetamol  12.000\nTOTAL  12.000", entity.HealthCategory},
+		{"whole words only", "TOLERANCE SHOP\nWidget  10.000\nTOTAL  10.000", ""},
+		{"nothing to go by", "TOKO ABC\nBarang  10.000\nTOTAL  10.000", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, SuggestCategory(tt.text))
+		})
+	}
+}
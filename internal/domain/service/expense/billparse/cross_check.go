@@ -0,0 +1,70 @@
+package billparse
+
+import (
+	"fmt"
+
+	"github.com/itsLeonB/cashback/internal/core/service/prompt"
+	"github.com/itsLeonB/cashback/internal/domain/dto"
+	"github.com/shopspring/decimal"
+)
+
+type ParserKind string
+
+const (
+	LLMParser   ParserKind = "LLM"
+	RulesParser ParserKind = "RULES"
+)
+
+// ParseResult is a parsed bill along with which parser read it and anything the reviewer should double check.
+type ParseResult struct {
+	Request        dto.NewGroupExpenseRequest
+	Parser         ParserKind
+	Warnings       []string
+	Reconciliation dto.BillReconciliation
+	// SuggestedCategory is the name of a default category, or empty when none could be told
+	SuggestedCategory string
+	// PromptSource and PromptVersion are set whenever the LLM was asked, even if its answer was not used
+	PromptSource  prompt.Source
+	PromptVersion int
+}
+
+// CrossCheck compares the LLM result with the rule-based one, and with its own sums.
+// Each disagreement becomes a warning on the proposal; the LLM result is kept either way.
+func CrossCheck(llmResult, rulesResult dto.NewGroupExpenseRequest) []string {
+	var warnings []string
+
+	if !llmResult.TotalAmount.Equal(rulesResult.TotalAmount) {
+		warnings = append(warnings, fmt.Sprintf("total was read as %s, but the rule-based parser read %s", llmResult.TotalAmount, rulesResult.TotalAmount))
+	}
+	if !llmResult.Subtotal.Equal(rulesResult.Subtotal) {
+		warnings = append(warnings, fmt.Sprintf("subtotal was read as %s, but the rule-based parser read %s", llmResult.Subtotal, rulesResult.Subtotal))
+	}
+
+	return append(warnings, CheckSums(llmResult)...)
+}
+
+// CheckSums flags a result whose items and fees do not add up to its own subtotal and total.
+func CheckSums(request dto.NewGroupExpenseRequest) []string {
+	itemsTotal, feesTotal := sumLines(request)
+
+	var warnings []string
+	if !itemsTotal.Equal(request.Subtotal) {
+		warnings = append(warnings, fmt.Sprintf("items add up to %s, not the subtotal of %s", itemsTotal, request.Subtotal))
+	}
+	if !itemsTotal.Add(feesTotal).Equal(request.TotalAmount) {
+		warnings = append(warnings, fmt.Sprintf("items and fees add up to %s, not the total of %s", itemsTotal.Add(feesTotal), request.TotalAmount))
+	}
+	return warnings
+}
+
+func sumLines(request dto.NewGroupExpenseRequest) (decimal.Decimal, decimal.Decimal) {
+	itemsTotal := decimal.Zero
+	for _, item := range request.Items {
+		itemsTotal = itemsTotal.Add(item.Amount.Mul(decimal.NewFromInt(int64(item.Quantity))))
+	}
+	feesTotal := decimal.Zero
+	for _, fee := range request.OtherFees {
+		feesTotal = feesTotal.Add(fee.Amount)
+	}
+	return itemsTotal, feesTotal
+}
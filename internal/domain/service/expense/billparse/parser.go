@@ -0,0 +1,92 @@
+package billparse
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/itsLeonB/cashback/internal/core/logger"
+	"github.com/itsLeonB/cashback/internal/core/service/llm"
+	"github.com/itsLeonB/cashback/internal/core/service/prompt"
+	"github.com/itsLeonB/cashback/internal/domain/dto"
+	"github.com/itsLeonB/cashback/internal/domain/entity/expenses"
+)
+
+// Parser reads bill text the way bill processing does, so the evaluation harness
+// scores exactly what users get.
+type Parser struct {
+	prompt     BillParsePrompt
+	registry   prompt.Registry
+	llmService llm.LLMService
+}
+
+func NewParser(p BillParsePrompt, registry prompt.Registry, llmService llm.LLMService) *Parser {
+	return &Parser{p, registry, llmService}
+}
+
+// Parse asks the LLM first and cross-checks its answer against the rule-based parser,
+// which takes over when the LLM fails or is not configured. Either result is reconciled with
+// the receipt's fee lines and printed total before it is checked, and a category is suggested from its words.
+func (bp *Parser) Parse(ctx context.Context, text string) (ParseResult, error) {
+	rulesResult, rulesErr := ParseWithRules(text)
+
+	llmResult, resolved, err := bp.parseWithLLM(ctx, text)
+	result := ParseResult{PromptSource: resolved.Source, PromptVersion: resolved.Version, SuggestedCategory: SuggestCategory(text)}
+	switch {
+	case err == nil:
+		request, reconciliation := Reconcile(llmResult, text)
+		warnings := CheckSums(request)
+		if rulesErr == nil {
+			warnings = CrossCheck(request, rulesResult)
+		}
+		result.Request, result.Parser, result.Warnings, result.Reconciliation = request, LLMParser, warnings, reconciliation
+		return result, nil
+	case errors.Is(err, expenses.ErrExpenseNotDetected):
+		return result, err
+	case rulesErr == nil:
+		if !errors.Is(err, llm.ErrNotConfigured) {
+			logger.Warnf("LLM bill parsing failed, falling back to rules: %v", err)
+		}
+		request, reconciliation := Reconcile(rulesResult, text)
+		result.Request, result.Parser, result.Warnings, result.Reconciliation = request, RulesParser, CheckSums(request), reconciliation
+		return result, nil
+	case errors.Is(err, llm.ErrNotConfigured):
+		return result, rulesErr
+	default:
+		return result, err
+	}
+}
+
+func (bp *Parser) parseWithLLM(ctx context.Context, text string) (dto.NewGroupExpenseRequest, prompt.Resolved, error) {
+	// 1. Pre-process and normalize numeric strings
+	normalizedText := Normalize(text)
+
+	resolved, err := bp.prompt.Get(ctx, bp.registry)
+	if err != nil {
+		return dto.NewGroupExpenseRequest{}, prompt.Resolved{}, err
+	}
+
+	msgs, err := resolved.Prompt.Compile(bp.prompt.CompileVars(string(expenses.NotDetectedBill), normalizedText))
+	if err != nil {
+		return dto.NewGroupExpenseRequest{}, resolved, err
+	}
+
+	llmMsgs := make([]llm.ChatMessage, 0, len(msgs))
+	for _, m := range msgs {
+		llmMsgs = append(llmMsgs, llm.ChatMessage{Role: m.Role, Content: m.Content})
+	}
+
+	raw, err := bp.llmService.ChatJSON(ctx, llmMsgs, bp.prompt.ResponseFormat())
+	if err != nil {
+		return dto.NewGroupExpenseRequest{}, resolved, err
+	}
+	logger.Debugf("prompt response: %s", raw)
+
+	if strings.TrimSpace(raw) == string(expenses.NotDetectedBill) {
+		logger.Info("group expense not detected")
+		return dto.NewGroupExpenseRequest{}, resolved, expenses.ErrExpenseNotDetected
+	}
+
+	request, err := bp.prompt.ParseResponse(raw)
+	return request, resolved, err
+}
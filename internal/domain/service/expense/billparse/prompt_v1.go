@@ -1,27 +1,33 @@
 package billparse
 
 import (
-	"github.com/itsLeonB/cashback/internal/core/service/langfuse"
+	"context"
+
+	"github.com/itsLeonB/cashback/internal/core/service/llm"
+	"github.com/itsLeonB/cashback/internal/core/service/prompt"
 	"github.com/itsLeonB/cashback/internal/domain/dto"
+	"github.com/itsLeonB/cashback/internal/domain/entity/expenses"
 	"github.com/itsLeonB/ezutil/v2"
 )
 
 // BillParsePrompt is the single source of truth for everything that touches
 // the "parse-bill" LLM prompt. The prompt version, variable contract, and
-// response parser are all co-located here so they can only change together.
+// response schema and parser are all co-located here so they can only change together.
 //
 // To ship a new prompt version:
 //  1. Create the new prompt version in Langfuse.
-//  2. Add a new BillParsePromptV2 here with the updated version + parser.
-//  3. Swap the reference in groupExpenseServiceImpl.
-//  4. Delete the old one once fully rolled over.
+//  2. Export it to internal/core/service/prompt/templates/parse-bill/v2.json,
+//     used when Langfuse is down or not configured.
+//  3. Add a new BillParsePromptV2 here with the updated version + parser.
+//  4. Swap the reference in groupExpenseServiceImpl.
+//  5. Delete the old one once fully rolled over.
 type BillParsePrompt struct {
-	// PromptName is the Langfuse prompt identifier.
+	// PromptName is the Langfuse prompt identifier, and the embedded template directory.
 	PromptName string
 	// Version is pinned — never fetched as "latest".
 	Version int
 	// Variables documents the expected template variables.
-	// This is both documentation and a guard (see Compile below).
+	// This is both documentation and a guard, checked against the embedded template by Validate at startup.
 	Variables []string
 }
 
@@ -31,12 +37,17 @@ var ActiveBillParsePrompt = BillParsePrompt{
 	Variables:  []string{"not_detected_bill_string", "text_to_parse"},
 }
 
-// NOTE: When updating the Langfuse prompt "parse-bill", ensure to include the following instruction:
+// NOTE: When updating the Langfuse prompt "parse-bill", and its embedded copy, ensure to include the following instruction:
 // "All numeric values in the provided text have been pre-processed into canonical form
 // (thousands separators removed, decimal separator is '.'). Do not reformat or re-interpret these numbers."
 
-func (p BillParsePrompt) GetOptions() langfuse.GetPromptOptions {
-	return langfuse.GetPromptOptions{Version: &p.Version}
+func (p BillParsePrompt) Get(ctx context.Context, registry prompt.Registry) (prompt.Resolved, error) {
+	return registry.GetPrompt(ctx, p.PromptName, p.Version)
+}
+
+// Validate fails when the embedded template is missing or its variables drift from Variables.
+func (p BillParsePrompt) Validate(registry prompt.Registry) error {
+	return registry.Validate(p.PromptName, p.Version, p.Variables)
 }
 
 func (p BillParsePrompt) CompileVars(notDetectedStr, textToParse string) map[string]any {
@@ -46,6 +57,24 @@ func (p BillParsePrompt) CompileVars(notDetectedStr, textToParse string) map[str
 	}
 }
 
+// billResponseSchema leaves out what the model cannot know. Payers and participant shares are
+// picked by the user, and fee calculation methods are filled in by Reconcile.
+var billResponseSchema = llm.SchemaFor[dto.NewGroupExpenseRequest]().Omit(
+	"payerProfileId",
+	"otherFees.calculationMethod",
+	"otherFees.participantShares",
+)
+
+// ResponseFormat is sent with the prompt so the reply is a NewGroupExpenseRequest, or the
+// not-detected marker for text that is not a bill.
+func (p BillParsePrompt) ResponseFormat() llm.ResponseFormat {
+	return llm.ResponseFormat{
+		Name:   "group_expense",
+		Schema: billResponseSchema,
+		Accept: []string{string(expenses.NotDetectedBill)},
+	}
+}
+
 func (p BillParsePrompt) ParseResponse(raw string) (dto.NewGroupExpenseRequest, error) {
 	return ezutil.Unmarshal[dto.NewGroupExpenseRequest]([]byte(raw))
 }
@@ -0,0 +1,251 @@
+package billparse
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+
+	"github.com/itsLeonB/cashback/internal/domain/dto"
+	"github.com/itsLeonB/cashback/internal/domain/entity/expenses"
+	"github.com/itsLeonB/ungerr"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	amountRegex = regexp.MustCompile(`\d+(?:\.\d+)?`)
+	wordRegex   = regexp.MustCompile(`[\p{L}]{2,}`)
+)
+
+// How much the name and the amount count towards a line's confidence.
+const (
+	nameWeight   = 0.6
+	amountWeight = 0.4
+)
+
+// NewProposal turns the parse result into a proposal for review. Each item and fee is traced back
+// to the line of text it most likely came from, and its confidence is how well that line backs it up:
+// the share of the name's words found on the line, plus whether the amount appears there too.
+func NewProposal(result ParseResult, text string) dto.BillProposal {
+	lines := strings.Split(text, "\n")
+	used := make(map[int]bool)
+	request := result.Request
+
+	proposal := dto.BillProposal{
+		Parser:      string(result.Parser),
+		Warnings:    result.Warnings,
+		Description: request.Description,
+		TotalAmount: request.TotalAmount,
+		Subtotal:    request.Subtotal,
+		Items:       make([]dto.ProposedBillLine, 0, len(request.Items)),
+		OtherFees:   make([]dto.ProposedBillLine, 0, len(request.OtherFees)),
+
+		Reconciliation:    result.Reconciliation,
+		SuggestedCategory: result.SuggestedCategory,
+	}
+
+	for i, item := range request.Items {
+		line := dto.ProposedBillLine{
+			Index:    i,
+			Name:     item.Name,
+			Amount:   item.Amount,
+			Quantity: item.Quantity,
+			Decision: expenses.PendingBillLine,
+		}
+		amounts := []decimal.Decimal{item.Amount, item.Amount.Mul(decimal.NewFromInt(int64(item.Quantity)))}
+		line.SourceLineNumber, line.SourceLine, line.Confidence = matchSourceLine(lines, item.Name, amounts, used)
+		proposal.Items = append(proposal.Items, line)
+	}
+
+	for i, fee := range request.OtherFees {
+		line := dto.ProposedBillLine{
+			Index:             i,
+			Name:              fee.Name,
+			Amount:            fee.Amount,
+			Kind:              fee.Kind,
+			CalculationMethod: fee.CalculationMethod,
+			Decision:          expenses.PendingBillLine,
+		}
+		line.SourceLineNumber, line.SourceLine, line.Confidence = matchSourceLine(lines, fee.Name, []decimal.Decimal{fee.Amount}, used)
+		proposal.OtherFees = append(proposal.OtherFees, line)
+	}
+
+	return proposal
+}
+
+// matchSourceLine finds the best scoring line not yet claimed by another item or fee.
+func matchSourceLine(lines []string, name string, amounts []decimal.Decimal, used map[int]bool) (int, string, float64) {
+	words := wordRegex.FindAllString(strings.ToLower(name), -1)
+
+	bestIndex, bestScore := -1, 0.0
+	for i, line := range lines {
+		if used[i] {
+			continue
+		}
+		if score := scoreLine(line, words, amounts); score > bestScore {
+			bestIndex, bestScore = i, score
+		}
+	}
+
+	if bestIndex < 0 {
+		return 0, "", 0
+	}
+
+	used[bestIndex] = true
+	return bestIndex + 1, strings.TrimSpace(lines[bestIndex]), math.Round(bestScore*100) / 100
+}
+
+func scoreLine(line string, words []string, amounts []decimal.Decimal) float64 {
+	lowered := strings.ToLower(line)
+
+	score := 0.0
+	if len(words) > 0 {
+		found := 0
+		for _, word := range words {
+			if strings.Contains(lowered, word) {
+				found++
+			}
+		}
+		score += nameWeight * float64(found) / float64(len(words))
+	}
+
+	for _, token := range amountRegex.FindAllString(Normalize(line), -1) {
+		value, err := decimal.NewFromString(token)
+		if err != nil {
+			continue
+		}
+		for _, amount := range amounts {
+			if value.Equal(amount) {
+				return score + amountWeight
+			}
+		}
+	}
+
+	return score
+}
+
+// ApplyReview records the user's decisions on the proposal and builds the draft update from
+// the lines that were accepted or edited. Totals are recalculated from those lines, as the
+// proposed total no longer adds up once anything is edited or rejected.
+func ApplyReview(proposal dto.BillProposal, review dto.BillReviewRequest) (dto.BillProposal, dto.NewGroupExpenseRequest, error) {
+	items, err := applyLineReviews(proposal.Items, review.Items, "item")
+	if err != nil {
+		return dto.BillProposal{}, dto.NewGroupExpenseRequest{}, err
+	}
+
+	fees, err := applyLineReviews(proposal.OtherFees, review.OtherFees, "fee")
+	if err != nil {
+		return dto.BillProposal{}, dto.NewGroupExpenseRequest{}, err
+	}
+
+	proposal.Items = items
+	proposal.OtherFees = fees
+	if review.Description != "" {
+		proposal.Description = review.Description
+	}
+
+	request := dto.NewGroupExpenseRequest{Description: proposal.Description}
+	for _, item := range items {
+		if item.Decision == expenses.RejectedBillLine {
+			continue
+		}
+		request.Items = append(request.Items, dto.NewExpenseItemRequest{
+			Name:     item.Name,
+			Amount:   item.Amount,
+			Quantity: item.Quantity,
+		})
+		request.Subtotal = request.Subtotal.Add(item.Amount.Mul(decimal.NewFromInt(int64(item.Quantity))))
+	}
+	if len(request.Items) == 0 {
+		return dto.BillProposal{}, dto.NewGroupExpenseRequest{}, ungerr.UnprocessableEntityError("at least one item must be accepted")
+	}
+
+	request.TotalAmount = request.Subtotal
+	for _, fee := range fees {
+		if fee.Decision == expenses.RejectedBillLine {
+			continue
+		}
+		request.OtherFees = append(request.OtherFees, dto.NewOtherFeeRequest{
+			Name:              fee.Name,
+			Amount:            fee.Amount,
+			Kind:              fee.Kind,
+			CalculationMethod: fee.CalculationMethod,
+		})
+		request.TotalAmount = request.TotalAmount.Add(fee.Amount)
+	}
+
+	proposal.Reconciliation = reconcile(request.Subtotal, request.TotalAmount.Sub(request.Subtotal), proposal.Reconciliation.PrintedTotal)
+
+	return proposal, request, nil
+}
+
+func applyLineReviews(lines []dto.ProposedBillLine, reviews []dto.BillLineReview, kind string) ([]dto.ProposedBillLine, error) {
+	reviewByIndex := make(map[int]dto.BillLineReview, len(reviews))
+	for _, review := range reviews {
+		if review.Index < 0 || review.Index >= len(lines) {
+			return nil, ungerr.ValidationError(fmt.Sprintf("%s index %d is out of range", kind, review.Index))
+		}
+		if _, exists := reviewByIndex[review.Index]; exists {
+			return nil, ungerr.ValidationError(fmt.Sprintf("%s index %d is reviewed more than once", kind, review.Index))
+		}
+		reviewByIndex[review.Index] = review
+	}
+
+	reviewed := make([]dto.ProposedBillLine, len(lines))
+	for i, line := range lines {
+		line.Decision = expenses.AcceptedBillLine
+		if review, ok := reviewByIndex[i]; ok {
+			line.Decision = review.Decision
+			if review.Decision == expenses.EditedBillLine {
+				line = editLine(line, review, kind)
+			}
+		}
+
+		// Lines left as proposed are checked too, so a misread line has to be edited or rejected
+		if line.Decision != expenses.RejectedBillLine {
+			if err := validateLine(line, kind); err != nil {
+				return nil, err
+			}
+		}
+		reviewed[i] = line
+	}
+
+	return reviewed, nil
+}
+
+func editLine(line dto.ProposedBillLine, review dto.BillLineReview, kind string) dto.ProposedBillLine {
+	if review.Name != "" {
+		line.Name = review.Name
+	}
+	if !review.Amount.IsZero() {
+		line.Amount = review.Amount
+	}
+	if review.Quantity > 0 && kind == "item" {
+		line.Quantity = review.Quantity
+	}
+	if review.CalculationMethod != "" && kind == "fee" {
+		line.CalculationMethod = review.CalculationMethod
+	}
+	if review.Kind != "" && kind == "fee" {
+		line.Kind = review.Kind
+	}
+	return line
+}
+
+func validateLine(line dto.ProposedBillLine, kind string) error {
+	if len(strings.TrimSpace(line.Name)) < 3 {
+		return ungerr.ValidationError(fmt.Sprintf("%s %d: name must be at least 3 characters", kind, line.Index))
+	}
+	// Rounding can go either way, e.g. "Pembulatan -55"
+	if line.Kind == expenses.RoundingFeeKind {
+		if line.Amount.IsZero() {
+			return ungerr.ValidationError(fmt.Sprintf("%s %d: amount must not be zero", kind, line.Index))
+		}
+	} else if !line.Amount.IsPositive() {
+		return ungerr.ValidationError(fmt.Sprintf("%s %d: amount must be positive", kind, line.Index))
+	}
+	if kind == "item" && line.Quantity < 1 {
+		return ungerr.ValidationError(fmt.Sprintf("%s %d: quantity must be at least 1", kind, line.Index))
+	}
+	return nil
+}
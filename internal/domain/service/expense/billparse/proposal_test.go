@@ -0,0 +1,124 @@
+package billparse
+
+import (
+	"testing"
+
+	"github.com/itsLeonB/cashback/internal/domain/dto"
+	"github.com/itsLeonB/cashback/internal/domain/entity/expenses"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+const receiptText = `WARUNG MAKAN SEDERHANA
+Nasi Goreng Spesial  2 x 25.000  50.000
+Es Teh Manis         1 x 8.000    8.000
+Subtotal                         58.000
+Service Charge 5%                 2.900
+TOTAL                            60.900`
+
+func parsedReceipt() dto.NewGroupExpenseRequest {
+	return dto.NewGroupExpenseRequest{
+		Description: "Warung Makan",
+		TotalAmount: decimal.NewFromInt(64900),
+		Subtotal:    decimal.NewFromInt(62000),
+		Items: []dto.NewExpenseItemRequest{
+			{Name: "Nasi Goreng Spesial", Amount: decimal.NewFromInt(25000), Quantity: 2},
+			{Name: "Es Teh Manis", Amount: decimal.NewFromInt(12000), Quantity: 1}, // misread price
+		},
+		OtherFees: []dto.NewOtherFeeRequest{
+			{Name: "Service Charge", Amount: decimal.NewFromInt(2900), CalculationMethod: expenses.ItemizedSplitFee},
+		},
+	}
+}
+
+func TestNewProposal(t *testing.T) {
+	proposal := NewProposal(ParseResult{Request: parsedReceipt(), Parser: LLMParser}, receiptText)
+
+	assert.Equal(t, "LLM", proposal.Parser)
+
+	nasi := proposal.Items[0]
+	assert.Equal(t, 2, nasi.SourceLineNumber)
+	assert.Equal(t, "Nasi Goreng Spesial  2 x 25.000  50.000", nasi.SourceLine)
+	assert.Equal(t, 1.0, nasi.Confidence)
+	assert.Equal(t, expenses.PendingBillLine, nasi.Decision)
+
+	esTeh := proposal.Items[1]
+	assert.Equal(t, 3, esTeh.SourceLineNumber)
+	assert.Equal(t, 0.6, esTeh.Confidence)
+
+	service := proposal.OtherFees[0]
+	assert.Equal(t, 5, service.SourceLineNumber)
+	assert.Equal(t, 1.0, service.Confidence)
+
+	unknown := NewProposal(ParseResult{Request: dto.NewGroupExpenseRequest{
+		Items: []dto.NewExpenseItemRequest{{Name: "Tiramisu", Amount: decimal.NewFromInt(99999), Quantity: 1}},
+	}}, receiptText)
+	assert.Equal(t, 0, unknown.Items[0].SourceLineNumber)
+	assert.Equal(t, 0.0, unknown.Items[0].Confidence)
+}
+
+func TestApplyReview(t *testing.T) {
+	proposal := NewProposal(ParseResult{Request: parsedReceipt(), Parser: LLMParser}, receiptText)
+
+	reviewed, request, err := ApplyReview(proposal, dto.BillReviewRequest{
+		Items: []dto.BillLineReview{
+			{Index: 1, Decision: expenses.EditedBillLine, Amount: decimal.NewFromInt(8000)},
+		},
+		OtherFees: []dto.BillLineReview{
+			{Index: 0, Decision: expenses.RejectedBillLine},
+		},
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, expenses.AcceptedBillLine, reviewed.Items[0].Decision)
+	assert.Equal(t, expenses.EditedBillLine, reviewed.Items[1].Decision)
+	assert.Equal(t, "Es Teh Manis", reviewed.Items[1].Name)
+	assert.Equal(t, expenses.RejectedBillLine, reviewed.OtherFees[0].Decision)
+
+	assert.Equal(t, "Warung Makan", request.Description)
+	assert.Len(t, request.Items, 2)
+	assert.Empty(t, request.OtherFees)
+	assert.True(t, request.Subtotal.Equal(decimal.NewFromInt(58000)))
+	assert.True(t, request.TotalAmount.Equal(decimal.NewFromInt(58000)))
+}
+
+func TestApplyReviewErrors(t *testing.T) {
+	proposal := NewProposal(ParseResult{Request: parsedReceipt(), Parser: LLMParser}, receiptText)
+
+	tests := []struct {
+		name  string
+		items []dto.BillLineReview
+	}{
+		{"index out of range", []dto.BillLineReview{{Index: 2, Decision: expenses.AcceptedBillLine}}},
+		{"reviewed twice", []dto.BillLineReview{{Index: 0, Decision: expenses.AcceptedBillLine}, {Index: 0, Decision: expenses.RejectedBillLine}}},
+		{"name too short", []dto.BillLineReview{{Index: 0, Decision: expenses.EditedBillLine, Name: "Es"}}},
+		{"negative amount", []dto.BillLineReview{{Index: 0, Decision: expenses.EditedBillLine, Amount: decimal.NewFromInt(-1)}}},
+		{"everything rejected", []dto.BillLineReview{{Index: 0, Decision: expenses.RejectedBillLine}, {Index: 1, Decision: expenses.RejectedBillLine}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := ApplyReview(proposal, dto.BillReviewRequest{Items: tt.items})
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestApplyReviewRounding(t *testing.T) {
+	request := parsedReceiptConsistent()
+	request.OtherFees = append(request.OtherFees, dto.NewOtherFeeRequest{
+		Name: "Pembulatan", Amount: decimal.NewFromInt(-900), Kind: expenses.RoundingFeeKind, CalculationMethod: expenses.EqualSplitFee,
+	})
+	proposal := NewProposal(ParseResult{
+		Request:        request,
+		Parser:         LLMParser,
+		Reconciliation: dto.BillReconciliation{PrintedTotal: decimal.NewFromInt(60000)},
+	}, receiptText)
+
+	reviewed, applied, err := ApplyReview(proposal, dto.BillReviewRequest{})
+	assert.NoError(t, err)
+
+	assert.Equal(t, expenses.RoundingFeeKind, applied.OtherFees[1].Kind)
+	assert.True(t, applied.TotalAmount.Equal(decimal.NewFromInt(60000)))
+	assert.True(t, reviewed.Reconciliation.Balanced)
+}
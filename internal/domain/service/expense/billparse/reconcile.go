@@ -0,0 +1,130 @@
+package billparse
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/itsLeonB/cashback/internal/domain/dto"
+	"github.com/itsLeonB/cashback/internal/domain/entity/expenses"
+	"github.com/shopspring/decimal"
+)
+
+// ClassifyFee recognises what a fee is for from its name, e.g. "PB1 10%" is a tax
+// and "Pembulatan" is rounding.
+func ClassifyFee(name string) expenses.FeeKind {
+	switch {
+	case roundingLabel.MatchString(name):
+		return expenses.RoundingFeeKind
+	case serviceLabel.MatchString(name):
+		return expenses.ServiceFeeKind
+	case taxLabel.MatchString(name):
+		return expenses.TaxFeeKind
+	default:
+		return expenses.OtherFeeKind
+	}
+}
+
+func newFee(name string, amount decimal.Decimal) dto.NewOtherFeeRequest {
+	kind := ClassifyFee(name)
+	return dto.NewOtherFeeRequest{
+		Name:              name,
+		Amount:            amount,
+		Kind:              kind,
+		CalculationMethod: kind.SuggestedCalculationMethod(),
+	}
+}
+
+// Reconcile runs after either parser. It types the parsed fees, adds the tax, service and
+// rounding lines of the receipt that the parser left out, and compares the items and fees
+// with the total printed on the receipt. Falls back to the parsed total when none is found.
+func Reconcile(request dto.NewGroupExpenseRequest, text string) (dto.NewGroupExpenseRequest, dto.BillReconciliation) {
+	lines := strings.Split(text, "\n")
+	template := detectTemplate(lines)
+
+	request.OtherFees = slices.Clone(request.OtherFees)
+	for i, fee := range request.OtherFees {
+		if fee.Kind == "" {
+			request.OtherFees[i].Kind = ClassifyFee(fee.Name)
+		}
+		if fee.CalculationMethod == "" {
+			request.OtherFees[i].CalculationMethod = request.OtherFees[i].Kind.SuggestedCalculationMethod()
+		}
+	}
+
+	claimed := make(map[int]bool)
+	printedTotal := decimal.Zero
+	for _, raw := range lines {
+		// Payment and change lines end the bill
+		if paymentLines.MatchString(raw) {
+			break
+		}
+
+		line := tokenize(raw)
+		amount := line.last()
+		if len(line.label) == 0 || amount.IsZero() || matchesAny(raw, slices.Concat(template.Ignore, []*regexp.Regexp{discountLines})) {
+			continue
+		}
+		label := strings.Join(line.label, " ")
+
+		switch {
+		case subtotalLabel.MatchString(label):
+			continue
+		case totalLabel.MatchString(label) && !totalNotMoney.MatchString(label):
+			// The last total is the one paid, e.g. the grand total below a rounding line
+			printedTotal = amount
+			continue
+		}
+
+		kind := ClassifyFee(label)
+		if kind == expenses.OtherFeeKind || (kind == expenses.TaxFeeKind && template.TaxIncluded) {
+			continue
+		}
+		if !claimFee(request.OtherFees, claimed, kind, amount) {
+			request.OtherFees = append(request.OtherFees, newFee(label, amount))
+			claimed[len(request.OtherFees)-1] = true
+		}
+	}
+
+	if printedTotal.IsZero() {
+		printedTotal = request.TotalAmount
+	}
+	itemsTotal, feesTotal := sumLines(request)
+
+	return request, reconcile(itemsTotal, feesTotal, printedTotal)
+}
+
+// claimFee matches a fee line of the receipt to a parsed fee of the same kind, preferring one
+// with the same amount. A parsed fee with a misread amount is still claimed, so it is not added twice.
+func claimFee(fees []dto.NewOtherFeeRequest, claimed map[int]bool, kind expenses.FeeKind, amount decimal.Decimal) bool {
+	match := -1
+	for i, fee := range fees {
+		if claimed[i] || fee.Kind != kind {
+			continue
+		}
+		if fee.Amount.Equal(amount) {
+			match = i
+			break
+		}
+		if match < 0 {
+			match = i
+		}
+	}
+
+	if match < 0 {
+		return false
+	}
+	claimed[match] = true
+	return true
+}
+
+func reconcile(itemsTotal, feesTotal, printedTotal decimal.Decimal) dto.BillReconciliation {
+	difference := printedTotal.Sub(itemsTotal).Sub(feesTotal)
+	return dto.BillReconciliation{
+		ItemsTotal:   itemsTotal,
+		FeesTotal:    feesTotal,
+		PrintedTotal: printedTotal,
+		Difference:   difference,
+		Balanced:     difference.IsZero(),
+	}
+}
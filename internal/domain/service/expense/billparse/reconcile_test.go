@@ -0,0 +1,92 @@
+package billparse
+
+import (
+	"testing"
+
+	"github.com/itsLeonB/cashback/internal/domain/dto"
+	"github.com/itsLeonB/cashback/internal/domain/entity/expenses"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassifyFee(t *testing.T) {
+	assert.Equal(t, expenses.TaxFeeKind, ClassifyFee("PB1 10%"))
+	assert.Equal(t, expenses.TaxFeeKind, ClassifyFee("PPN 11%"))
+	assert.Equal(t, expenses.ServiceFeeKind, ClassifyFee("Service 5%"))
+	assert.Equal(t, expenses.RoundingFeeKind, ClassifyFee("Pembulatan"))
+	assert.Equal(t, expenses.OtherFeeKind, ClassifyFee("Delivery"))
+}
+
+func TestReconcile(t *testing.T) {
+	text := `RM PADANG SALERO
+Rendang        2  30.000  60.000
+Es Jeruk       2   9.000  18.000
+Subtotal               78.000
+Service 5%              3.900
+PB1 10%                 8.190
+TOTAL                  90.090
+Pembulatan                -90
+GRAND TOTAL            90.000
+Tunai                 100.000`
+
+	parsed := dto.NewGroupExpenseRequest{
+		TotalAmount: decimal.NewFromInt(90090),
+		Subtotal:    decimal.NewFromInt(78000),
+		Items: []dto.NewExpenseItemRequest{
+			{Name: "Rendang", Amount: decimal.NewFromInt(30000), Quantity: 2},
+			{Name: "Es Jeruk", Amount: decimal.NewFromInt(9000), Quantity: 2},
+		},
+		OtherFees: []dto.NewOtherFeeRequest{
+			{Name: "Service", Amount: decimal.NewFromInt(3900)},
+		},
+	}
+
+	request, reconciliation := Reconcile(parsed, text)
+
+	assert.Len(t, request.OtherFees, 3)
+	assert.Equal(t, expenses.ServiceFeeKind, request.OtherFees[0].Kind)
+	assert.Equal(t, expenses.ItemizedSplitFee, request.OtherFees[0].CalculationMethod)
+
+	tax := request.OtherFees[1]
+	assert.Equal(t, "PB1 10%", tax.Name)
+	assert.Equal(t, expenses.TaxFeeKind, tax.Kind)
+	assert.Equal(t, expenses.ItemizedSplitFee, tax.CalculationMethod)
+	assert.True(t, tax.Amount.Equal(decimal.NewFromInt(8190)))
+
+	rounding := request.OtherFees[2]
+	assert.Equal(t, expenses.RoundingFeeKind, rounding.Kind)
+	assert.Equal(t, expenses.EqualSplitFee, rounding.CalculationMethod)
+	assert.True(t, rounding.Amount.Equal(decimal.NewFromInt(-90)))
+
+	assert.True(t, reconciliation.PrintedTotal.Equal(decimal.NewFromInt(90000)))
+	assert.True(t, reconciliation.FeesTotal.Equal(decimal.NewFromInt(12000)))
+	assert.True(t, reconciliation.Difference.IsZero())
+	assert.True(t, reconciliation.Balanced)
+
+	// The parsed input is left as it was
+	assert.Len(t, parsed.OtherFees, 1)
+}
+
+func TestReconcileDiscrepancy(t *testing.T) {
+	request, reconciliation := Reconcile(parsedReceipt(), receiptText)
+
+	assert.Len(t, request.OtherFees, 1)
+	assert.True(t, reconciliation.PrintedTotal.Equal(decimal.NewFromInt(60900)))
+	assert.True(t, reconciliation.Difference.Equal(decimal.NewFromInt(-4000)))
+	assert.False(t, reconciliation.Balanced)
+}
+
+func TestReconcileTaxIncluded(t *testing.T) {
+	text := `INDOMARET
+INDOMIE GRG  2  3100  6200
+PPN          614
+TOTAL        6200`
+
+	request, reconciliation := Reconcile(dto.NewGroupExpenseRequest{
+		TotalAmount: decimal.NewFromInt(6200),
+		Items:       []dto.NewExpenseItemRequest{{Name: "INDOMIE GRG", Amount: decimal.NewFromInt(3100), Quantity: 2}},
+	}, text)
+
+	assert.Empty(t, request.OtherFees)
+	assert.True(t, reconciliation.Balanced)
+}
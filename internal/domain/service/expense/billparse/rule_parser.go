@@ -0,0 +1,324 @@
+package billparse
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/itsLeonB/cashback/internal/domain/dto"
+	"github.com/itsLeonB/cashback/internal/domain/entity"
+	"github.com/itsLeonB/cashback/internal/domain/entity/expenses"
+	"github.com/shopspring/decimal"
+)
+
+// MerchantTemplate adjusts the rule-based parser to a chain whose receipts share a layout.
+type MerchantTemplate struct {
+	Name string
+	// Detect is matched against the header, the first lines of the receipt.
+	Detect *regexp.Regexp
+	// Ignore lists lines that look like items but are not, e.g. loyalty points.
+	Ignore []*regexp.Regexp
+	// TaxIncluded means tax lines only show the tax already in the prices, so they are not fees.
+	TaxIncluded bool
+	// Category is the default category its receipts are suggested.
+	Category string
+}
+
+const headerLines = 5
+
+var merchantTemplates = []MerchantTemplate{
+	{
+		Name:   "Indomaret",
+		Detect: regexp.MustCompile(`(?i)indomaret`),
+		Ignore: []*regexp.Regexp{
+			regexp.MustCompile(`(?i)\b(hemat|dpp|harga jual|poin|stamp)\b`),
+		},
+		TaxIncluded: true,
+		Category:    entity.GroceriesCategory,
+	},
+	{
+		Name:   "Alfamart",
+		Detect: regexp.MustCompile(`(?i)alfa\s*(mart|midi)`),
+		Ignore: []*regexp.Regexp{
+			regexp.MustCompile(`(?i)\b(hemat|dpp|total item|poin|star)\b`),
+		},
+		TaxIncluded: true,
+		Category:    entity.GroceriesCategory,
+	},
+}
+
+var (
+	subtotalLabel = regexp.MustCompile(`(?i)^sub\s*-?\s*(total|ttl)\b`)
+	totalLabel    = regexp.MustCompile(`(?i)^(grand\s*total|total|tagihan|amount due|jumlah)\b`)
+	totalNotMoney = regexp.MustCompile(`(?i)\b(item|items|qty|quantity)\b`)
+	taxLabel      = regexp.MustCompile(`(?i)\b(tax|pajak|ppn|pb1|vat|gst)\b`)
+	serviceLabel  = regexp.MustCompile(`(?i)\b(service|servis|svc)\b`)
+
+	roundingLabel = regexp.MustCompile(`(?i)\b(rounding|pembulatan)\b`)
+	// Discounts are not read yet, the cross-check surfaces the difference
+	discountLines = regexp.MustCompile(`(?i)\b(disc|discount|diskon|potongan|voucher|promo)\b`)
+	// Payment and change, which come after the total
+	paymentLines = regexp.MustCompile(`(?i)\b(cash|tunai|kembali|kembalian|change|card|debit|kredit|credit|qris|ovo|gopay|dana|shopeepay|edc|bayar)\b`)
+
+	nonItemLines = []*regexp.Regexp{
+		paymentLines,
+		// Receipt metadata and address
+		regexp.MustCompile(`(?i)\b(kasir|cashier|table|meja|order|npwp|telp?|phone|invoice|struk|receipt|guest|pax|jl|jalan|no|kec|kota)\b`),
+		regexp.MustCompile(`\d{1,2}[/.:-]\d{1,2}[/.:-]\d{2,4}|\d{1,2}:\d{2}`),
+	}
+
+	currencyToken = regexp.MustCompile(`(?i)^(rp\.?|idr|\$)$`)
+	numberToken   = regexp.MustCompile(`^\d+(\.\d+)?$`)
+	quantityToken = regexp.MustCompile(`(?i)^(\d{1,2})x$|^x(\d{1,2})$`)
+	lettersRegex  = regexp.MustCompile(`\p{L}{2,}`)
+)
+
+// maxQuantity keeps numbers that are part of a name, like "Aqua 600", from being read as quantities.
+const maxQuantity = 99
+
+// ParseWithRules reads a receipt without an LLM, using layout rules and the merchant templates.
+// It takes the OCR text as extracted and normalizes each token itself, as normalizing the whole
+// text can join numbers from neighbouring columns. Returns expenses.ErrExpenseNotDetected when
+// no item line is found.
+func ParseWithRules(text string) (dto.NewGroupExpenseRequest, error) {
+	lines := strings.Split(text, "\n")
+	template := detectTemplate(lines)
+
+	request := dto.NewGroupExpenseRequest{Description: describe(lines, template)}
+	var subtotal, total decimal.Decimal
+	pendingName := ""
+
+	for _, raw := range lines {
+		line := tokenize(raw)
+		if len(line.label) == 0 && len(line.numbers) == 0 {
+			continue
+		}
+		label := strings.Join(line.label, " ")
+
+		// Rounding is added by Reconcile
+		if matchesAny(raw, slices.Concat(template.Ignore, []*regexp.Regexp{discountLines, roundingLabel})) {
+			continue
+		}
+
+		switch {
+		case subtotalLabel.MatchString(label):
+			subtotal = line.last()
+			continue
+		case totalLabel.MatchString(label) && !totalNotMoney.MatchString(label):
+			if total.IsZero() {
+				total = line.last()
+			}
+			continue
+		case taxLabel.MatchString(label), serviceLabel.MatchString(label):
+			if !(template.TaxIncluded && taxLabel.MatchString(label)) && line.last().IsPositive() {
+				request.OtherFees = append(request.OtherFees, newFee(label, line.last()))
+			}
+			continue
+		}
+
+		// Nothing below the total is an item
+		if !total.IsZero() {
+			continue
+		}
+		if matchesAny(raw, nonItemLines) {
+			pendingName = ""
+			continue
+		}
+
+		// A name on its own line, with its quantity and price on the next one
+		if len(line.numbers) == 0 {
+			pendingName = label
+			continue
+		}
+		if len(line.label) == 0 {
+			if pendingName == "" {
+				continue
+			}
+			line.label = []string{pendingName}
+		}
+		pendingName = ""
+
+		if item, ok := line.toItem(); ok {
+			request.Items = append(request.Items, item)
+		}
+	}
+
+	if len(request.Items) == 0 {
+		return dto.NewGroupExpenseRequest{}, expenses.ErrExpenseNotDetected
+	}
+
+	itemsTotal, feesTotal := sumLines(request)
+
+	request.Subtotal = subtotal
+	if request.Subtotal.IsZero() {
+		request.Subtotal = itemsTotal
+	}
+	request.TotalAmount = total
+	if request.TotalAmount.IsZero() {
+		request.TotalAmount = request.Subtotal.Add(feesTotal)
+	}
+
+	return request, nil
+}
+
+func detectTemplate(lines []string) MerchantTemplate {
+	header := strings.Join(lines[:min(headerLines, len(lines))], "\n")
+	for _, template := range merchantTemplates {
+		if template.Detect.MatchString(header) {
+			return template
+		}
+	}
+	return MerchantTemplate{}
+}
+
+func describe(lines []string, template MerchantTemplate) string {
+	if template.Name != "" {
+		return template.Name
+	}
+	for _, line := range lines[:min(headerLines, len(lines))] {
+		if lettersRegex.MatchString(line) {
+			return strings.TrimSpace(line)
+		}
+	}
+	return ""
+}
+
+func matchesAny(line string, patterns []*regexp.Regexp) bool {
+	for _, pattern := range patterns {
+		if pattern.MatchString(line) {
+			return true
+		}
+	}
+	return false
+}
+
+// receiptLine is a line split into its leading quantity, its label and its trailing numbers.
+type receiptLine struct {
+	quantity int
+	label    []string
+	numbers  []decimal.Decimal
+	// multiplied is set when the numbers are written as "2 x 25000"
+	multiplied bool
+}
+
+func tokenize(raw string) receiptLine {
+	var line receiptLine
+	var tokens []string
+	for _, token := range strings.Fields(raw) {
+		if !currencyToken.MatchString(token) {
+			tokens = append(tokens, token)
+		}
+	}
+
+	// Leading quantity, as in "2x Nasi Goreng" or "2 Nasi Goreng"
+	if len(tokens) > 1 {
+		if qty, ok := parseQuantity(tokens[0]); ok && lettersRegex.MatchString(tokens[1]) {
+			line.quantity = qty
+			tokens = tokens[1:]
+		}
+	}
+
+	// Trailing numbers, read right to left until the first word
+	end := len(tokens)
+	for end > 0 {
+		token := tokens[end-1]
+		if token == "x" || token == "X" || token == "@" {
+			line.multiplied = true
+			end--
+			continue
+		}
+		if qty, ok := parseQuantity(token); ok && strings.ContainsAny(token, "xX") {
+			line.numbers = append([]decimal.Decimal{decimal.NewFromInt(int64(qty))}, line.numbers...)
+			line.multiplied = true
+			end--
+			continue
+		}
+		// Rounding and discounts are printed as "-55" or "(55)"
+		unsigned, negative := strings.CutPrefix(token, "-")
+		if strings.HasPrefix(unsigned, "(") && strings.HasSuffix(unsigned, ")") {
+			unsigned, negative = strings.Trim(unsigned, "()"), true
+		}
+		normalized := Normalize(unsigned)
+		if !numberToken.MatchString(normalized) {
+			break
+		}
+		number, _ := decimal.NewFromString(normalized)
+		if negative {
+			number = number.Neg()
+		}
+		line.numbers = append([]decimal.Decimal{number}, line.numbers...)
+		end--
+	}
+	line.label = tokens[:end]
+
+	return line
+}
+
+func parseQuantity(token string) (int, bool) {
+	if matches := quantityToken.FindStringSubmatch(token); matches != nil {
+		token = matches[1] + matches[2]
+	}
+	qty, err := decimal.NewFromString(token)
+	if err != nil || !qty.IsInteger() || qty.IntPart() < 1 || qty.IntPart() > maxQuantity {
+		return 0, false
+	}
+	return int(qty.IntPart()), true
+}
+
+func (rl receiptLine) last() decimal.Decimal {
+	if len(rl.numbers) == 0 {
+		return decimal.Zero
+	}
+	return rl.numbers[len(rl.numbers)-1]
+}
+
+// toItem reads the trailing numbers as "qty price total", "qty x price", "qty total" or just
+// "total". Numbers that fit none of these are put back into the name.
+func (rl receiptLine) toItem() (dto.NewExpenseItemRequest, bool) {
+	numbers := rl.numbers
+	name := rl.label
+	qty := rl.quantity
+	var price decimal.Decimal
+
+	isQuantity := func(d decimal.Decimal) bool {
+		return d.IsInteger() && d.IsPositive() && d.IntPart() <= maxQuantity
+	}
+
+	switch {
+	case len(numbers) >= 3 && isQuantity(numbers[len(numbers)-3]) &&
+		numbers[len(numbers)-3].Mul(numbers[len(numbers)-2]).Equal(numbers[len(numbers)-1]):
+		qty = int(numbers[len(numbers)-3].IntPart())
+		price = numbers[len(numbers)-2]
+		numbers = numbers[:len(numbers)-3]
+	case len(numbers) >= 2 && rl.multiplied && isQuantity(numbers[len(numbers)-2]):
+		qty = int(numbers[len(numbers)-2].IntPart())
+		price = numbers[len(numbers)-1]
+		numbers = numbers[:len(numbers)-2]
+	case len(numbers) >= 2 && qty == 0 && isQuantity(numbers[len(numbers)-2]) &&
+		numbers[len(numbers)-1].Mod(numbers[len(numbers)-2]).IsZero():
+		qty = int(numbers[len(numbers)-2].IntPart())
+		price = numbers[len(numbers)-1].Div(numbers[len(numbers)-2]).Round(2)
+		numbers = numbers[:len(numbers)-2]
+	default:
+		if qty == 0 {
+			qty = 1
+		}
+		price = numbers[len(numbers)-1].Div(decimal.NewFromInt(int64(qty))).Round(2)
+		numbers = numbers[:len(numbers)-1]
+	}
+
+	for _, number := range numbers {
+		name = append(name, number.String())
+	}
+
+	item := dto.NewExpenseItemRequest{
+		Name:     strings.Join(name, " "),
+		Amount:   price,
+		Quantity: qty,
+	}
+	if !lettersRegex.MatchString(item.Name) || !item.Amount.IsPositive() {
+		return dto.NewExpenseItemRequest{}, false
+	}
+
+	return item, true
+}
@@ -0,0 +1,103 @@
+package billparse
+
+import (
+	"testing"
+
+	"github.com/itsLeonB/cashback/internal/domain/dto"
+	"github.com/itsLeonB/cashback/internal/domain/entity/expenses"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseWithRules(t *testing.T) {
+	text := `KOPI KENANGAN
+Jl. Sudirman No. 12
+Kasir: Budi   12/01/2024 19:30
+2x Kopi Susu        Rp 36.000
+Croissant
+1 x 22.000  22.000
+Aqua 600            8.000
+Nasi Goreng  3  25.000  75.000
+Subtotal           141.000
+Diskon             -10.000
+Service Charge 5%    7.050
+PB1 10%             14.805
+TOTAL              162.855
+Tunai              200.000
+Kembali             37.145`
+
+	request, err := ParseWithRules(text)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "KOPI KENANGAN", request.Description)
+	assert.Equal(t, []dto.NewExpenseItemRequest{
+		{Name: "Kopi Susu", Amount: decimal.NewFromInt(18000), Quantity: 2},
+		{Name: "Croissant", Amount: decimal.NewFromInt(22000), Quantity: 1},
+		{Name: "Aqua 600", Amount: decimal.NewFromInt(8000), Quantity: 1},
+		{Name: "Nasi Goreng", Amount: decimal.NewFromInt(25000), Quantity: 3},
+	}, roundItems(request.Items))
+
+	assert.Len(t, request.OtherFees, 2)
+	assert.Equal(t, "Service Charge 5%", request.OtherFees[0].Name)
+	assert.True(t, request.OtherFees[0].Amount.Equal(decimal.NewFromInt(7050)))
+	assert.Equal(t, expenses.ItemizedSplitFee, request.OtherFees[0].CalculationMethod)
+	assert.Equal(t, "PB1 10%", request.OtherFees[1].Name)
+
+	assert.True(t, request.Subtotal.Equal(decimal.NewFromInt(141000)))
+	assert.True(t, request.TotalAmount.Equal(decimal.NewFromInt(162855)))
+}
+
+func TestParseWithRulesMerchantTemplate(t *testing.T) {
+	text := `INDOMARET
+PT INDOMARCO PRISMATAMA
+INDOMIE GRG  2  3100  6200
+TOTAL HEMAT  500
+HARGA JUAL :  6200
+PPN          614
+TOTAL        6200`
+
+	request, err := ParseWithRules(text)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "Indomaret", request.Description)
+	assert.Len(t, request.Items, 1)
+	assert.Equal(t, "INDOMIE GRG", request.Items[0].Name)
+	assert.Equal(t, 2, request.Items[0].Quantity)
+	assert.Empty(t, request.OtherFees)
+	assert.True(t, request.Subtotal.Equal(decimal.NewFromInt(6200)))
+	assert.True(t, request.TotalAmount.Equal(decimal.NewFromInt(6200)))
+}
+
+func TestParseWithRulesNotDetected(t *testing.T) {
+	_, err := ParseWithRules("Thank you for shopping\nSee you again")
+	assert.ErrorIs(t, err, expenses.ErrExpenseNotDetected)
+}
+
+func TestCrossCheck(t *testing.T) {
+	rules := dto.NewGroupExpenseRequest{TotalAmount: decimal.NewFromInt(60900), Subtotal: decimal.NewFromInt(58000)}
+
+	assert.Empty(t, CrossCheck(parsedReceiptConsistent(), rules))
+
+	misread := parsedReceipt()
+	warnings := CrossCheck(misread, rules)
+	assert.Len(t, warnings, 2)
+	assert.Contains(t, warnings[0], "total was read as 64900")
+
+	misread.TotalAmount = decimal.NewFromInt(60900)
+	assert.Equal(t, []string{"items and fees add up to 64900, not the total of 60900"}, CheckSums(misread))
+}
+
+func parsedReceiptConsistent() dto.NewGroupExpenseRequest {
+	request := parsedReceipt()
+	request.Items[1].Amount = decimal.NewFromInt(8000)
+	request.Subtotal = decimal.NewFromInt(58000)
+	request.TotalAmount = decimal.NewFromInt(60900)
+	return request
+}
+
+func roundItems(items []dto.NewExpenseItemRequest) []dto.NewExpenseItemRequest {
+	for i := range items {
+		items[i].Amount = decimal.RequireFromString(items[i].Amount.StringFixed(0))
+	}
+	return items
+}
@@ -0,0 +1,67 @@
+package expense
+
+import (
+	"github.com/google/uuid"
+	"github.com/itsLeonB/ezutil/v2"
+	"github.com/itsLeonB/ungerr"
+	"github.com/shopspring/decimal"
+)
+
+// Share is one profile's claim on an amount, relative to the other shares of the same split.
+type Share struct {
+	ProfileID uuid.UUID
+	Value     decimal.Decimal
+}
+
+// SplitByShares divides totalAmount proportionally to the share values, rounded to 2 decimals.
+// The rounding remainder goes to the largest share, like AllocateAmounts, and the result always adds up to totalAmount.
+func SplitByShares(totalAmount decimal.Decimal, shares []Share) ([]decimal.Decimal, error) {
+	if len(shares) == 0 {
+		return nil, ungerr.UnprocessableEntityError("no participants provided")
+	}
+
+	shareTotal := decimal.Zero
+	for _, share := range shares {
+		if share.Value.IsNegative() {
+			return nil, ungerr.UnprocessableEntityError("share cannot be negative")
+		}
+		shareTotal = shareTotal.Add(share.Value)
+	}
+	if !shareTotal.IsPositive() {
+		return nil, ungerr.UnprocessableEntityError("shares must add up to more than 0")
+	}
+
+	amounts := make([]decimal.Decimal, len(shares))
+	allocatedSum := decimal.Zero
+	for i, share := range shares {
+		amounts[i] = totalAmount.Mul(share.Value).Div(shareTotal).Round(2)
+		allocatedSum = allocatedSum.Add(amounts[i])
+	}
+
+	if remainder := totalAmount.Sub(allocatedSum); !remainder.IsZero() {
+		idx := largestShareIndex(shares)
+		amounts[idx] = amounts[idx].Add(remainder)
+	}
+
+	finalSum := decimal.Zero
+	for _, amount := range amounts {
+		finalSum = finalSum.Add(amount)
+	}
+	if !finalSum.Equal(totalAmount) {
+		return nil, ungerr.Unknownf("fail to split amounts, calculated finalSum: %s, totalAmount: %s", finalSum.String(), totalAmount.String())
+	}
+
+	return amounts, nil
+}
+
+// largestShareIndex picks who absorbs the rounding remainder: the highest share, using ProfileID as tiebreaker.
+func largestShareIndex(shares []Share) int {
+	maxIdx := 0
+	for i := 1; i < len(shares); i++ {
+		cmp := shares[i].Value.Cmp(shares[maxIdx].Value)
+		if cmp > 0 || (cmp == 0 && ezutil.CompareUUID(shares[i].ProfileID, shares[maxIdx].ProfileID) < 0) {
+			maxIdx = i
+		}
+	}
+	return maxIdx
+}
@@ -0,0 +1,53 @@
+package expense
+
+import (
+	"fmt"
+
+	mapset "github.com/deckarep/golang-set/v2"
+	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/domain/entity/expenses"
+	"github.com/itsLeonB/ezutil/v2"
+	"github.com/itsLeonB/ungerr"
+	"github.com/shopspring/decimal"
+)
+
+// BuildFromTemplate allocates every item's amount among its participants and recalculates the totals,
+// so an expense stamped out from a recurring template lands in the same state as one built by hand.
+// The expense's Participants must already be set.
+func BuildFromTemplate(groupExpense expenses.GroupExpense) (expenses.GroupExpense, error) {
+	if len(groupExpense.Items) == 0 {
+		return expenses.GroupExpense{}, ungerr.UnprocessableEntityError("template does not have items")
+	}
+
+	participantIDs := mapset.NewSet(ezutil.MapSlice(groupExpense.Participants, func(p expenses.ExpenseParticipant) uuid.UUID {
+		return p.ParticipantProfileID
+	})...)
+
+	allocationSvc := NewAllocationService()
+	items := make([]expenses.ExpenseItem, 0, len(groupExpense.Items))
+	for _, item := range groupExpense.Items {
+		for _, participant := range item.Participants {
+			if !participantIDs.Contains(participant.ProfileID) {
+				return expenses.GroupExpense{}, ungerr.UnprocessableEntityError(fmt.Sprintf("participant of item %s is not an expense participant", item.Name))
+			}
+		}
+
+		allocated, err := allocationSvc.AllocateBySplitMode(item.SplitMode, item.TotalAmount(), item.Participants)
+		if err != nil {
+			return expenses.GroupExpense{}, err
+		}
+
+		item.Participants = allocated
+		items = append(items, item)
+	}
+	groupExpense.Items = items
+
+	feesTotal := decimal.Zero
+	for _, fee := range groupExpense.OtherFees {
+		feesTotal = feesTotal.Add(fee.Amount)
+	}
+	groupExpense.FeesTotal = feesTotal
+
+	recalculated, _, err := NewCalculationService().RecalculateExpense(groupExpense, true)
+	return recalculated, err
+}
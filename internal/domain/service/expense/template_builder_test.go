@@ -0,0 +1,70 @@
+package expense_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/domain/entity/expenses"
+	"github.com/itsLeonB/cashback/internal/domain/service/expense"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildFromTemplate(t *testing.T) {
+	alice, bob := uuid.New(), uuid.New()
+	participants := []expenses.ExpenseParticipant{
+		{ParticipantProfileID: alice},
+		{ParticipantProfileID: bob},
+	}
+
+	t.Run("allocates items and totals the expense", func(t *testing.T) {
+		built, err := expense.BuildFromTemplate(expenses.GroupExpense{
+			Status:       expenses.DraftExpense,
+			Participants: participants,
+			Items: []expenses.ExpenseItem{
+				{
+					Name:     "Rent",
+					Amount:   decimal.NewFromInt(300),
+					Quantity: 1,
+					Participants: []expenses.ItemParticipant{
+						{ProfileID: alice, Weight: 2},
+						{ProfileID: bob, Weight: 1},
+					},
+				},
+			},
+			OtherFees: []expenses.OtherFee{
+				{Name: "Service", Amount: decimal.NewFromInt(30), CalculationMethod: expenses.EqualSplitFee},
+			},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, expenses.ReadyExpense, built.Status)
+		assert.True(t, built.ItemsTotal.Equal(decimal.NewFromInt(300)))
+		assert.True(t, built.FeesTotal.Equal(decimal.NewFromInt(30)))
+		assert.True(t, built.TotalAmount.Equal(decimal.NewFromInt(330)))
+		assert.True(t, built.Items[0].Participants[0].AllocatedAmount.Equal(decimal.NewFromInt(200)))
+		assert.True(t, built.Items[0].Participants[1].AllocatedAmount.Equal(decimal.NewFromInt(100)))
+	})
+
+	t.Run("rejects item participants outside the expense", func(t *testing.T) {
+		_, err := expense.BuildFromTemplate(expenses.GroupExpense{
+			Participants: participants,
+			Items: []expenses.ExpenseItem{
+				{
+					Name:         "Rent",
+					Amount:       decimal.NewFromInt(300),
+					Quantity:     1,
+					Participants: []expenses.ItemParticipant{{ProfileID: uuid.New()}},
+				},
+			},
+		})
+
+		assert.Error(t, err)
+	})
+
+	t.Run("rejects templates without items", func(t *testing.T) {
+		_, err := expense.BuildFromTemplate(expenses.GroupExpense{Participants: participants})
+
+		assert.Error(t, err)
+	})
+}
@@ -2,8 +2,11 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"path"
+	"slices"
 	"strings"
 
 	"github.com/google/uuid"
@@ -16,40 +19,55 @@ import (
 	"github.com/itsLeonB/cashback/internal/core/util"
 	"github.com/itsLeonB/cashback/internal/domain/dto"
 	"github.com/itsLeonB/cashback/internal/domain/entity/expenses"
+	"github.com/itsLeonB/cashback/internal/domain/mapper"
 	"github.com/itsLeonB/cashback/internal/domain/message"
 	"github.com/itsLeonB/cashback/internal/domain/repository"
+	"github.com/itsLeonB/cashback/internal/domain/service/expense/billparse"
 	"github.com/itsLeonB/ezutil/v2"
 	"github.com/itsLeonB/go-crud"
 	"github.com/itsLeonB/ungerr"
+	"gorm.io/datatypes"
 )
 
+// billFileExtensions are the files users can upload as a bill page: photos, and PDFs whose text is read directly.
+var billFileExtensions = []string{".jpg", ".jpeg", ".png", ".webp", ".pdf"}
+
 type expenseBillServiceImpl struct {
 	taskQueue            queue.TaskQueue
 	billRepo             repository.ExpenseBillRepository
+	pageRepo             crud.Repository[expenses.ExpenseBillPage]
+	uploadRepo           crud.Repository[expenses.ExpenseBillUpload]
 	transactor           crud.Transactor
 	imageSvc             storage.ImageService
 	ocrSvc               ocr.OCRService
 	expenseSvc           GroupExpenseService
 	subscriptionLimitSvc SubscriptionLimitService
+	categorySvc          CategoryService
 }
 
 func NewExpenseBillService(
 	taskQueue queue.TaskQueue,
 	billRepo repository.ExpenseBillRepository,
+	pageRepo crud.Repository[expenses.ExpenseBillPage],
+	uploadRepo crud.Repository[expenses.ExpenseBillUpload],
 	transactor crud.Transactor,
 	imageSvc storage.ImageService,
 	ocrSvc ocr.OCRService,
 	expenseSvc GroupExpenseService,
 	subscriptionLimitSvc SubscriptionLimitService,
+	categorySvc CategoryService,
 ) ExpenseBillService {
 	return &expenseBillServiceImpl{
 		taskQueue,
 		billRepo,
+		pageRepo,
+		uploadRepo,
 		transactor,
 		imageSvc,
 		ocrSvc,
 		expenseSvc,
 		subscriptionLimitSvc,
+		categorySvc,
 	}
 }
 
@@ -57,6 +75,10 @@ func (ebs *expenseBillServiceImpl) SavePresigned(ctx context.Context, req dto.Pr
 	ctx, span := otel.Tracer.Start(ctx, "ExpenseBillService.SavePresigned")
 	defer span.End()
 
+	if !slices.Contains(billFileExtensions, strings.ToLower(path.Ext(req.Filename))) {
+		return dto.PresignedExpenseBillResponse{}, ungerr.ValidationError("bill must be a JPEG, PNG or WEBP image or a PDF")
+	}
+
 	var resp dto.PresignedExpenseBillResponse
 	err := ebs.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
 		bill, err := ebs.getBillForUpload(ctx, req.ProfileID, req.GroupExpenseID)
@@ -64,11 +86,13 @@ func (ebs *expenseBillServiceImpl) SavePresigned(ctx context.Context, req dto.Pr
 			return err
 		}
 
-		if bill.ID == uuid.Nil {
-			bill.ImageName = ObjectKeyToFileID(util.GenerateObjectKey(req.Filename)).ObjectKey
+		page, err := ebs.getPageForUpload(ctx, bill, req.Filename, req.AddPage)
+		if err != nil {
+			return err
 		}
 
 		bill.Status = expenses.NotUploadedBill
+		bill.Pages = nil
 		var savedBill expenses.ExpenseBill
 		if bill.ID == uuid.Nil {
 			savedBill, err = ebs.billRepo.Insert(ctx, bill)
@@ -79,13 +103,23 @@ func (ebs *expenseBillServiceImpl) SavePresigned(ctx context.Context, req dto.Pr
 			return err
 		}
 
-		fileID := ObjectKeyToFileID(savedBill.ImageName)
+		page.BillID = savedBill.ID
+		page.Status = expenses.NotUploadedBill
+		page.ExtractedText = ""
+		savedPage, err := ebs.savePage(ctx, req.ProfileID, page)
+		if err != nil {
+			return err
+		}
+
+		fileID := ObjectKeyToFileID(savedPage.ImageName)
 		uploadURL, err := ebs.imageSvc.GetUploadURL(fileID)
 		if err != nil {
 			return err
 		}
 
 		resp.BillID = savedBill.ID
+		resp.PageID = savedPage.ID
+		resp.PageNumber = savedPage.PageNumber
 		resp.UploadURL = uploadURL
 
 		return nil
@@ -93,6 +127,58 @@ func (ebs *expenseBillServiceImpl) SavePresigned(ctx context.Context, req dto.Pr
 	return resp, err
 }
 
+// Upload stores a bill received by the server itself, such as a forwarded e-receipt, as the first
+// page of the expense's bill and queues it for extraction.
+func (ebs *expenseBillServiceImpl) Upload(ctx context.Context, req dto.NewExpenseBillRequest) (uuid.UUID, error) {
+	ctx, span := otel.Tracer.Start(ctx, "ExpenseBillService.Upload")
+	defer span.End()
+
+	var billID uuid.UUID
+	err := ebs.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		bill, err := ebs.getBillForUpload(ctx, req.ProfileID, req.GroupExpenseID)
+		if err != nil {
+			return err
+		}
+
+		page, err := ebs.getPageForUpload(ctx, bill, req.Filename, false)
+		if err != nil {
+			return err
+		}
+
+		if _, err = ebs.imageSvc.Upload(ctx, &storage.ImageUploadRequest{
+			ImageData:      req.ImageData,
+			ContentType:    req.ContentType,
+			FileSize:       req.FileSize,
+			FileIdentifier: ObjectKeyToFileID(page.ImageName),
+		}); err != nil {
+			return err
+		}
+
+		bill.Status = expenses.PendingBill
+		bill.Pages = nil
+		var savedBill expenses.ExpenseBill
+		if bill.ID == uuid.Nil {
+			savedBill, err = ebs.billRepo.Insert(ctx, bill)
+		} else {
+			savedBill, err = ebs.billRepo.Update(ctx, bill)
+		}
+		if err != nil {
+			return err
+		}
+
+		page.BillID = savedBill.ID
+		page.Status = expenses.PendingBill
+		page.ExtractedText = ""
+		if _, err = ebs.savePage(ctx, req.ProfileID, page); err != nil {
+			return err
+		}
+
+		billID = savedBill.ID
+		return ebs.taskQueue.Enqueue(ctx, message.ExpenseBillUploaded{ID: savedBill.ID})
+	})
+	return billID, err
+}
+
 func (ebs *expenseBillServiceImpl) getBillForUpload(ctx context.Context, profileID, expenseID uuid.UUID) (expenses.ExpenseBill, error) {
 	if err := ebs.subscriptionLimitSvc.CheckUploadLimit(ctx, profileID); err != nil {
 		return expenses.ExpenseBill{}, err
@@ -109,7 +195,7 @@ func (ebs *expenseBillServiceImpl) getBillForUpload(ctx context.Context, profile
 	}
 
 	switch expense.Bill.Status {
-	case expenses.NotUploadedBill, expenses.FailedExtracting, expenses.FailedParsingBill, expenses.NotDetectedBill:
+	case expenses.NotUploadedBill, expenses.FailedExtracting, expenses.FailedParsingBill, expenses.NotDetectedBill, expenses.AwaitingReviewBill:
 		return expense.Bill, nil
 	case expenses.PendingBill, expenses.ExtractedBill, expenses.ParsedBill:
 		return expenses.ExpenseBill{}, ungerr.BadRequestError("Not allowed to reupload")
@@ -118,14 +204,72 @@ func (ebs *expenseBillServiceImpl) getBillForUpload(ctx context.Context, profile
 	}
 }
 
+// getPageForUpload returns the next page when adding one. Otherwise the bill is replaced: its first
+// page is kept to upload over, so a reupload is not counted against the upload limit again. The other
+// pages are deleted, but their uploads stay logged and still count.
+func (ebs *expenseBillServiceImpl) getPageForUpload(ctx context.Context, bill expenses.ExpenseBill, filename string, addPage bool) (expenses.ExpenseBillPage, error) {
+	pages := bill.SortedPages()
+	newPage := expenses.ExpenseBillPage{
+		PageNumber: 1,
+		ImageName:  ObjectKeyToFileID(util.GenerateObjectKey(filename)).ObjectKey,
+	}
+
+	if len(pages) == 0 {
+		return newPage, nil
+	}
+
+	if addPage {
+		if len(pages) >= expenses.MaxBillPages {
+			return expenses.ExpenseBillPage{}, ungerr.UnprocessableEntityError(fmt.Sprintf("a bill can have at most %d pages", expenses.MaxBillPages))
+		}
+		newPage.PageNumber = pages[len(pages)-1].PageNumber + 1
+		return newPage, nil
+	}
+
+	if len(pages) > 1 {
+		if err := ebs.pageRepo.DeleteMany(ctx, pages[1:]); err != nil {
+			return expenses.ExpenseBillPage{}, err
+		}
+	}
+	// The new file may have another extension, which tells OCR how to read it
+	pages[0].ImageName = newPage.ImageName
+	return pages[0], nil
+}
+
+// savePage inserts a new page and logs it as an upload, or updates a page being uploaded over.
+func (ebs *expenseBillServiceImpl) savePage(ctx context.Context, profileID uuid.UUID, page expenses.ExpenseBillPage) (expenses.ExpenseBillPage, error) {
+	if page.ID != uuid.Nil {
+		return ebs.pageRepo.Update(ctx, page)
+	}
+
+	savedPage, err := ebs.pageRepo.Insert(ctx, page)
+	if err != nil {
+		return expenses.ExpenseBillPage{}, err
+	}
+
+	if _, err = ebs.uploadRepo.Insert(ctx, expenses.ExpenseBillUpload{
+		ProfileID: profileID,
+		BillID:    page.BillID,
+	}); err != nil {
+		return expenses.ExpenseBillPage{}, err
+	}
+
+	return savedPage, nil
+}
+
+// ExtractBillText reads every page of the bill not read yet, one OCR call per page. Once all pages
+// are read their texts are merged for parsing; if any page fails, the bill can be retried and only
+// the failed pages are read again.
 func (ebs *expenseBillServiceImpl) ExtractBillText(ctx context.Context, msg message.ExpenseBillUploaded) error {
 	ctx, span := otel.Tracer.Start(ctx, "ExpenseBillService.ExtractBillText")
 	defer span.End()
 
+	extracted := false
 	err := ebs.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
 		spec := crud.Specification[expenses.ExpenseBill]{}
 		spec.Model.ID = msg.ID
 		spec.ForUpdate = true
+		spec.PreloadRelations = []string{"Pages"}
 		bill, err := ebs.billRepo.FindFirst(ctx, spec)
 		if err != nil {
 			return err
@@ -135,25 +279,49 @@ func (ebs *expenseBillServiceImpl) ExtractBillText(ctx context.Context, msg mess
 			return nil
 		}
 
-		uri := ebs.imageSvc.GetURI(ObjectKeyToFileID(bill.ImageName))
-		text, err := ebs.ocrSvc.ExtractFromURI(ctx, uri)
-		if err != nil {
+		pages := bill.SortedPages()
+		var extractErrs []error
+		for i, page := range pages {
+			if page.Status == expenses.ExtractedBill {
+				continue
+			}
+
+			uri := ebs.imageSvc.GetURI(ObjectKeyToFileID(page.ImageName))
+			text, err := ebs.ocrSvc.ExtractFromURI(ctx, uri)
+			if err != nil {
+				page.Status = expenses.FailedExtracting
+				extractErrs = append(extractErrs, fmt.Errorf("page %d: %w", page.PageNumber, err))
+			} else {
+				page.ExtractedText = text
+				page.Status = expenses.ExtractedBill
+			}
+
+			if pages[i], err = ebs.pageRepo.Update(ctx, page); err != nil {
+				return err
+			}
+		}
+
+		bill.Pages = nil
+		if len(extractErrs) > 0 {
 			bill.Status = expenses.FailedExtracting
 			_, statusErr := ebs.billRepo.Update(ctx, bill)
 			if statusErr != nil {
-				return errors.Join(err, statusErr)
+				return errors.Join(append(extractErrs, statusErr)...)
 			}
 			// Don't return error here, just log the error
-			logger.Errorf("failed to extract bill text: %v", err)
+			logger.Errorf("failed to extract bill text: %v", errors.Join(extractErrs...))
 			return nil
 		}
 
-		bill.ExtractedText = text
+		bill.ExtractedText = expenses.MergePageTexts(pages)
 		bill.Status = expenses.ExtractedBill
-		_, err = ebs.billRepo.Update(ctx, bill)
-		return err
+		if _, err = ebs.billRepo.Update(ctx, bill); err != nil {
+			return err
+		}
+		extracted = true
+		return nil
 	})
-	if err != nil {
+	if err != nil || !extracted {
 		return err
 	}
 
@@ -169,6 +337,7 @@ func (ebs *expenseBillServiceImpl) TriggerParsing(ctx context.Context, expenseID
 		spec.Model.ID = billID
 		spec.Model.GroupExpenseID = expenseID
 		spec.ForUpdate = true
+		spec.PreloadRelations = []string{"Pages"}
 		bill, err := ebs.billRepo.FindFirst(ctx, spec)
 		if err != nil {
 			return err
@@ -178,7 +347,21 @@ func (ebs *expenseBillServiceImpl) TriggerParsing(ctx context.Context, expenseID
 		}
 
 		if bill.Status == expenses.FailedExtracting || bill.Status == expenses.NotUploadedBill {
+			pages := bill.Pages
+			if len(pages) == 0 {
+				return ungerr.UnprocessableEntityError(fmt.Sprintf("bill %s has no uploaded pages", billID))
+			}
+			for i := range pages {
+				if pages[i].Status != expenses.ExtractedBill {
+					pages[i].Status = expenses.PendingBill
+				}
+			}
+			if _, err := ebs.pageRepo.SaveMany(ctx, pages); err != nil {
+				return err
+			}
+
 			bill.Status = expenses.PendingBill
+			bill.Pages = nil
 			if _, err := ebs.billRepo.Update(ctx, bill); err != nil {
 				return err
 			}
@@ -197,6 +380,7 @@ func (ebs *expenseBillServiceImpl) TriggerParsing(ctx context.Context, expenseID
 		}
 
 		bill.Status = expenses.ExtractedBill
+		bill.Pages = nil
 		if _, err := ebs.billRepo.Update(ctx, bill); err != nil {
 			return err
 		}
@@ -205,22 +389,113 @@ func (ebs *expenseBillServiceImpl) TriggerParsing(ctx context.Context, expenseID
 	})
 }
 
+func (ebs *expenseBillServiceImpl) Review(ctx context.Context, req dto.BillReviewRequest) (dto.ExpenseBillResponse, error) {
+	ctx, span := otel.Tracer.Start(ctx, "ExpenseBillService.Review")
+	defer span.End()
+
+	var resp dto.ExpenseBillResponse
+	err := ebs.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		expense, err := ebs.expenseSvc.GetUnconfirmedForUpdate(ctx, req.ProfileID, req.GroupExpenseID)
+		if err != nil {
+			return err
+		}
+
+		spec := crud.Specification[expenses.ExpenseBill]{}
+		spec.Model.ID = req.BillID
+		spec.Model.GroupExpenseID = req.GroupExpenseID
+		spec.ForUpdate = true
+		spec.PreloadRelations = []string{"Pages"}
+		bill, err := ebs.billRepo.FindFirst(ctx, spec)
+		if err != nil {
+			return err
+		}
+		if bill.IsZero() {
+			return ungerr.NotFoundError(fmt.Sprintf("expense bill with ID %s is not found", req.BillID))
+		}
+		if bill.Status != expenses.AwaitingReviewBill {
+			return ungerr.UnprocessableEntityError(fmt.Sprintf("bill %s is not awaiting review", req.BillID))
+		}
+
+		proposal, err := ezutil.Unmarshal[dto.BillProposal](bill.Proposal)
+		if err != nil {
+			return ungerr.Wrap(err, "error unmarshaling bill proposal")
+		}
+
+		reviewed, request, err := billparse.ApplyReview(proposal, req)
+		if err != nil {
+			return err
+		}
+
+		if expense.CategoryID, err = ebs.reviewedCategoryID(ctx, expense, req, proposal.SuggestedCategory); err != nil {
+			return err
+		}
+
+		if err = ebs.expenseSvc.UpdateDraft(ctx, expense, request); err != nil {
+			return err
+		}
+
+		reviewedJSON, err := json.Marshal(reviewed)
+		if err != nil {
+			return ungerr.Wrap(err, "error marshaling bill proposal")
+		}
+		pages := bill.Pages
+		bill.Proposal = datatypes.JSON(reviewedJSON)
+		bill.Status = expenses.ParsedBill
+		bill.Pages = nil
+		updatedBill, err := ebs.billRepo.Update(ctx, bill)
+		if err != nil {
+			return err
+		}
+		updatedBill.Pages = pages
+
+		urls, err := billPageURLs(ebs.imageSvc, updatedBill)
+		if err != nil {
+			return err
+		}
+
+		resp = mapper.ExpenseBillToResponse(updatedBill, urls)
+		return nil
+	})
+	return resp, err
+}
+
+// reviewedCategoryID is the category the user picked while reviewing, or else the suggested one
+// unless the draft was already categorized.
+func (ebs *expenseBillServiceImpl) reviewedCategoryID(ctx context.Context, expense expenses.GroupExpense, req dto.BillReviewRequest, suggested string) (uuid.NullUUID, error) {
+	if req.CategoryID != uuid.Nil {
+		category, err := ebs.categorySvc.GetUsable(ctx, req.ProfileID, req.CategoryID)
+		if err != nil {
+			return uuid.NullUUID{}, err
+		}
+		return mapper.CategoryIDToEntity(category.ID), nil
+	}
+	if expense.CategoryID.Valid || suggested == "" {
+		return expense.CategoryID, nil
+	}
+
+	category, err := ebs.categorySvc.GetDefaultByName(ctx, suggested)
+	if err != nil {
+		return uuid.NullUUID{}, err
+	}
+	return mapper.CategoryIDToEntity(category.ID), nil
+}
+
 func (ebs *expenseBillServiceImpl) Cleanup(ctx context.Context) error {
 	ctx, span := otel.Tracer.Start(ctx, "ExpenseBillService.Cleanup")
 	defer span.End()
 
-	spec := crud.Specification[expenses.ExpenseBill]{}
-	bills, err := ebs.billRepo.FindAll(ctx, spec)
+	spec := crud.Specification[expenses.ExpenseBillPage]{}
+	pages, err := ebs.pageRepo.FindAll(ctx, spec)
 	if err != nil {
 		return err
 	}
 
-	if len(bills) < 1 {
+	if len(pages) < 1 {
 		logger.Info("no bills available")
 		return nil
 	}
 
-	validObjectKeys := ezutil.MapSlice(bills, func(eb expenses.ExpenseBill) string { return eb.ImageName })
+	validObjectKeys := ezutil.MapSlice(pages, func(page expenses.ExpenseBillPage) string { return page.ImageName })
 
 	logger.Infof("obtained object keys from DB:\n%s", strings.Join(validObjectKeys, "\n"))
 
@@ -233,3 +508,16 @@ func ObjectKeyToFileID(objectKey string) storage.FileIdentifier {
 		ObjectKey:  objectKey,
 	}
 }
+
+// billPageURLs gets the image URL of each page of the bill, by page ID.
+func billPageURLs(imageSvc storage.ImageService, bill expenses.ExpenseBill) (map[uuid.UUID]string, error) {
+	urls := make(map[uuid.UUID]string, len(bill.Pages))
+	for _, page := range bill.Pages {
+		url, err := imageSvc.GetURL(ObjectKeyToFileID(page.ImageName))
+		if err != nil {
+			return urls, err
+		}
+		urls[page.ID] = url
+	}
+	return urls, nil
+}
@@ -0,0 +1,135 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/core/config"
+	"github.com/itsLeonB/cashback/internal/core/service/storage"
+	"github.com/itsLeonB/cashback/internal/domain/dto"
+	"github.com/itsLeonB/cashback/internal/domain/entity/expenses"
+	"github.com/itsLeonB/cashback/internal/domain/service"
+	"github.com/itsLeonB/cashback/internal/mocks"
+	"github.com/itsLeonB/go-crud"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// billStore keeps one bill with its pages and the upload log in memory.
+type billStore struct {
+	bill    expenses.ExpenseBill
+	pages   map[uuid.UUID]expenses.ExpenseBillPage
+	uploads []expenses.ExpenseBillUpload
+}
+
+func TestSavePresigned_ReuploadKeepsUploadsOfDeletedPages(t *testing.T) {
+	prevConfig := config.Global
+	config.Global = &config.Config{App: config.App{BucketNameExpenseBill: "expense-bills"}}
+	t.Cleanup(func() { config.Global = prevConfig })
+
+	profileID := uuid.New()
+	expenseID := uuid.New()
+	store := &billStore{
+		bill:  expenses.ExpenseBill{BaseEntity: crud.BaseEntity{ID: uuid.New()}, GroupExpenseID: expenseID, Status: expenses.AwaitingReviewBill},
+		pages: map[uuid.UUID]expenses.ExpenseBillPage{},
+	}
+
+	transactor := mocks.NewMockTransactor(t)
+	transactor.EXPECT().
+		WithinTransaction(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error { return fn(ctx) })
+
+	subLimitSvc := mocks.NewMockSubscriptionLimitService(t)
+	subLimitSvc.EXPECT().CheckUploadLimit(mock.Anything, profileID).Return(nil)
+
+	expenseSvc := mocks.NewMockGroupExpenseService(t)
+	expenseSvc.EXPECT().
+		GetUnconfirmedForUpdate(mock.Anything, profileID, expenseID).
+		RunAndReturn(func(_ context.Context, _, id uuid.UUID) (expenses.GroupExpense, error) {
+			bill := store.bill
+			bill.Pages = nil
+			for _, page := range store.pages {
+				bill.Pages = append(bill.Pages, page)
+			}
+			return expenses.GroupExpense{BaseEntity: crud.BaseEntity{ID: id}, Bill: bill}, nil
+		})
+
+	billRepo := mocks.NewMockExpenseBillRepository(t)
+	billRepo.EXPECT().
+		Update(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, bill expenses.ExpenseBill) (expenses.ExpenseBill, error) {
+			store.bill = bill
+			return bill, nil
+		})
+
+	pageRepo := mocks.NewMockRepository[expenses.ExpenseBillPage](t)
+	pageRepo.On("Insert", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			page := args.Get(1).(expenses.ExpenseBillPage)
+			page.ID = uuid.New()
+			store.pages[page.ID] = page
+		}).
+		Return(expenses.ExpenseBillPage{}, nil)
+	pageRepo.On("Update", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			page := args.Get(1).(expenses.ExpenseBillPage)
+			store.pages[page.ID] = page
+		}).
+		Return(expenses.ExpenseBillPage{}, nil)
+	pageRepo.On("DeleteMany", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			for _, page := range args.Get(1).([]expenses.ExpenseBillPage) {
+				delete(store.pages, page.ID)
+			}
+		}).
+		Return(nil)
+
+	uploadRepo := mocks.NewMockRepository[expenses.ExpenseBillUpload](t)
+	uploadRepo.On("Insert", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			store.uploads = append(store.uploads, args.Get(1).(expenses.ExpenseBillUpload))
+		}).
+		Return(expenses.ExpenseBillUpload{}, nil)
+
+	imageSvc := mocks.NewMockImageService(t)
+	imageSvc.EXPECT().
+		GetUploadURL(mock.Anything).
+		RunAndReturn(func(fileID storage.FileIdentifier) (string, error) {
+			return "https://storage.example.com/" + fileID.ObjectKey, nil
+		})
+
+	svc := service.NewExpenseBillService(nil, billRepo, pageRepo, uploadRepo, transactor, imageSvc, nil, expenseSvc, subLimitSvc, nil)
+
+	upload := func(addPage bool) {
+		_, err := svc.SavePresigned(context.Background(), dto.PresignedExpenseBillRequest{
+			ProfileID:      profileID,
+			GroupExpenseID: expenseID,
+			Filename:       "receipt.jpg",
+			AddPage:        addPage,
+		})
+		require.NoError(t, err)
+	}
+
+	upload(false)
+	upload(true)
+	upload(true)
+	assert.Len(t, store.pages, 3)
+	assert.Len(t, store.uploads, 3)
+
+	// Reuploading keeps the first page to upload over and deletes the others, but not their uploads
+	upload(false)
+	assert.Len(t, store.pages, 1)
+	assert.Len(t, store.uploads, 3)
+
+	// Adding the pages again counts them again
+	upload(true)
+	upload(true)
+	assert.Len(t, store.pages, 3)
+	assert.Len(t, store.uploads, 5)
+	for _, u := range store.uploads {
+		assert.Equal(t, profileID, u.ProfileID)
+		assert.Equal(t, store.bill.ID, u.BillID)
+	}
+}
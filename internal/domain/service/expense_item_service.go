@@ -22,18 +22,21 @@ type expenseItemServiceImpl struct {
 	expenseItemRepository repository.ExpenseItemRepository
 	groupExpenseSvc       GroupExpenseService
 	allocationSvc         expense.AllocationService
+	groupSvc              GroupService
 }
 
 func NewExpenseItemService(
 	transactor crud.Transactor,
 	expenseItemRepository repository.ExpenseItemRepository,
 	groupExpenseSvc GroupExpenseService,
+	groupSvc GroupService,
 ) ExpenseItemService {
 	return &expenseItemServiceImpl{
 		transactor,
 		expenseItemRepository,
 		groupExpenseSvc,
 		expense.NewAllocationService(),
+		groupSvc,
 	}
 }
 
@@ -119,7 +122,23 @@ func (ges *expenseItemServiceImpl) SyncParticipants(ctx context.Context, req dto
 			return err
 		}
 
+		splitMode := req.SplitMode
+		if splitMode == "" {
+			splitMode = expenses.WeightSplit
+		}
+		if expenseItem.SplitMode != splitMode {
+			expenseItem.SplitMode = splitMode
+			if expenseItem, err = ges.expenseItemRepository.Update(ctx, expenseItem); err != nil {
+				return err
+			}
+		}
+
 		expenseItem.Participants = ezutil.MapSlice(req.Participants, mapper.ItemParticipantRequestToEntity)
+		if splitMode == expenses.WeightSplit {
+			if err = ges.applyGroupWeights(ctx, req.GroupExpenseID, expenseItem.Participants); err != nil {
+				return err
+			}
+		}
 		expenseItem, err = ges.allocateAndSyncParticipants(ctx, expenseItem)
 		if err != nil {
 			return err
@@ -129,12 +148,45 @@ func (ges *expenseItemServiceImpl) SyncParticipants(ctx context.Context, req dto
 	})
 }
 
+// applyGroupWeights fills in the default weights of the group's members when an item of a group's
+// expense is split by weight without explicit weights. Former members weigh 1.
+func (ges *expenseItemServiceImpl) applyGroupWeights(ctx context.Context, groupExpenseID uuid.UUID, participants []expenses.ItemParticipant) error {
+	for _, participant := range participants {
+		if participant.Weight != 0 {
+			return nil
+		}
+	}
+	if len(participants) == 0 {
+		return nil
+	}
+
+	groupExpense, err := ges.groupExpenseSvc.GetByID(ctx, groupExpenseID, false)
+	if err != nil {
+		return err
+	}
+	if !groupExpense.GroupID.Valid {
+		return nil
+	}
+
+	group, err := ges.groupSvc.GetByID(ctx, groupExpense.GroupID.UUID)
+	if err != nil {
+		return err
+	}
+
+	weights := group.DefaultWeights()
+	for i, participant := range participants {
+		participants[i].Weight = max(weights[participant.ProfileID], 1)
+	}
+
+	return nil
+}
+
 func (ges *expenseItemServiceImpl) allocateAndSyncParticipants(ctx context.Context, expenseItem expenses.ExpenseItem) (expenses.ExpenseItem, error) {
 	var err error
 	allocatedParticipants := []expenses.ItemParticipant{}
 
 	if len(expenseItem.Participants) > 0 {
-		allocatedParticipants, err = ges.allocationSvc.AllocateAmounts(expenseItem.TotalAmount(), expenseItem.Participants)
+		allocatedParticipants, err = ges.allocationSvc.AllocateBySplitMode(expenseItem.SplitMode, expenseItem.TotalAmount(), expenseItem.Participants)
 		if err != nil {
 			return expenses.ExpenseItem{}, err
 		}
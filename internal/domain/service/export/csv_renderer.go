@@ -0,0 +1,75 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strings"
+
+	"github.com/itsLeonB/cashback/internal/domain/entity"
+	"github.com/itsLeonB/ungerr"
+)
+
+// csvFormulaPrefixes start a formula when a cell is opened in a spreadsheet.
+const csvFormulaPrefixes = "=+-@\t\r"
+
+type csvRenderer struct{}
+
+func newCSVRenderer() Renderer {
+	return &csvRenderer{}
+}
+
+func (cr *csvRenderer) GetFormat() entity.ExportFormat {
+	return entity.CSVFormat
+}
+
+func (cr *csvRenderer) ContentType() string {
+	return "text/csv"
+}
+
+func (cr *csvRenderer) Extension() string {
+	return "csv"
+}
+
+// Render writes the tables one after another, each preceded by its title and separated by an empty record.
+func (cr *csvRenderer) Render(doc Document) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+
+	records := [][]string{{csvCell(doc.Title)}}
+	if doc.Subtitle != "" {
+		records = append(records, []string{csvCell(doc.Subtitle)})
+	}
+
+	for _, table := range doc.Tables {
+		records = append(records, []string{}, []string{csvCell(table.Title)}, csvRecord(table.Header))
+		for _, row := range table.Rows {
+			records = append(records, csvRecord(row))
+		}
+		if len(table.Footer) > 0 {
+			records = append(records, csvRecord(table.Footer))
+		}
+	}
+
+	if err := writer.WriteAll(records); err != nil {
+		return nil, ungerr.Wrap(err, "error writing csv")
+	}
+
+	return buf.Bytes(), nil
+}
+
+func csvRecord(cells []string) []string {
+	record := make([]string, len(cells))
+	for i, cell := range cells {
+		record[i] = csvCell(cell)
+	}
+	return record
+}
+
+// csvCell quotes a cell that a spreadsheet would run as a formula, such as a description
+// starting with "=HYPERLINK(", so it is shown as text. Amounts stay numbers, as in XLSX.
+func csvCell(value string) string {
+	if value == "" || !strings.ContainsRune(csvFormulaPrefixes, rune(value[0])) || xlsxNumberPattern.MatchString(value) {
+		return value
+	}
+	return "'" + value
+}
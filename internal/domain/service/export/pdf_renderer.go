@@ -0,0 +1,213 @@
+package export
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/itsLeonB/cashback/internal/domain/entity"
+	"github.com/itsLeonB/ungerr"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+)
+
+// A4 portrait in points, laid out in a monospaced font so table columns line up without measuring glyphs.
+const (
+	pdfPageWidth    = 595
+	pdfPageHeight   = 842
+	pdfMargin       = 40
+	pdfFontSize     = 8
+	pdfLineHeight   = 11
+	pdfMaxColumnLen = 40
+	pdfColumnGap    = 2
+
+	// Courier glyphs are 0.6em wide
+	pdfCharsPerLine = (pdfPageWidth - 2*pdfMargin) * 10 / (pdfFontSize * 6)
+	pdfLinesPerPage = (pdfPageHeight - 2*pdfMargin) / pdfLineHeight
+)
+
+type pdfLine struct {
+	text string
+	bold bool
+}
+
+type pdfRenderer struct{}
+
+func newPDFRenderer() Renderer {
+	return &pdfRenderer{}
+}
+
+func (pr *pdfRenderer) GetFormat() entity.ExportFormat {
+	return entity.PDFFormat
+}
+
+func (pr *pdfRenderer) ContentType() string {
+	return "application/pdf"
+}
+
+func (pr *pdfRenderer) Extension() string {
+	return "pdf"
+}
+
+// Render lays the document out as text lines and paginates them into a minimal PDF using the built-in Courier fonts.
+func (pr *pdfRenderer) Render(doc Document) ([]byte, error) {
+	lines := layoutLines(doc)
+	// Encoders keep state, so each render gets its own
+	encoder := encoding.ReplaceUnsupported(charmap.Windows1252.NewEncoder())
+
+	var pages [][]pdfLine
+	for start := 0; start < len(lines); start += pdfLinesPerPage {
+		pages = append(pages, lines[start:min(start+pdfLinesPerPage, len(lines))])
+	}
+
+	// Objects 1-4 are the catalog, page tree and fonts, followed by a page and content stream pair per page.
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Courier /Encoding /WinAnsiEncoding >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Courier-Bold /Encoding /WinAnsiEncoding >>",
+	}
+
+	pageRefs := make([]string, len(pages))
+	for i, page := range pages {
+		pageObj := len(objects) + 1
+		pageRefs[i] = fmt.Sprintf("%d 0 R", pageObj)
+
+		content, err := pageContent(encoder, page)
+		if err != nil {
+			return nil, err
+		}
+
+		objects = append(objects,
+			fmt.Sprintf(
+				"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>",
+				pdfPageWidth, pdfPageHeight, pageObj+1,
+			),
+			fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(content), content),
+		)
+	}
+	objects[1] = fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(pageRefs, " "), len(pages))
+
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n")
+
+	offsets := make([]int, len(objects))
+	for i, object := range objects {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, object)
+	}
+
+	xrefOffset := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xrefOffset)
+
+	return buf.Bytes(), nil
+}
+
+func pageContent(encoder *encoding.Encoder, lines []pdfLine) (string, error) {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "BT\n%d TL\n%d %d Td\n", pdfLineHeight, pdfMargin, pdfPageHeight-pdfMargin)
+
+	bold := false
+	fmt.Fprintf(&sb, "/F1 %d Tf\n", pdfFontSize)
+	for _, line := range lines {
+		if line.bold != bold {
+			bold = line.bold
+			font := "/F1"
+			if bold {
+				font = "/F2"
+			}
+			fmt.Fprintf(&sb, "%s %d Tf\n", font, pdfFontSize)
+		}
+
+		encoded, err := encoder.String(line.text)
+		if err != nil {
+			return "", ungerr.Wrap(err, "error encoding pdf text")
+		}
+		fmt.Fprintf(&sb, "(%s) Tj T*\n", escapePDFString(encoded))
+	}
+
+	sb.WriteString("ET")
+	return sb.String(), nil
+}
+
+// layoutLines renders every table as fixed-width text, truncating cells and lines that do not fit the page.
+func layoutLines(doc Document) []pdfLine {
+	lines := []pdfLine{{doc.Title, true}}
+	if doc.Subtitle != "" {
+		lines = append(lines, pdfLine{doc.Subtitle, false})
+	}
+
+	for _, table := range doc.Tables {
+		widths := columnWidths(table)
+		separator := strings.Repeat("-", min(totalWidth(widths), pdfCharsPerLine))
+
+		lines = append(lines, pdfLine{}, pdfLine{table.Title, true})
+		if len(table.Header) > 0 {
+			lines = append(lines, pdfLine{formatRow(table.Header, widths), true}, pdfLine{separator, false})
+		}
+		for _, row := range table.Rows {
+			lines = append(lines, pdfLine{formatRow(row, widths), false})
+		}
+		if len(table.Footer) > 0 {
+			lines = append(lines, pdfLine{separator, false}, pdfLine{formatRow(table.Footer, widths), true})
+		}
+	}
+
+	for i := range lines {
+		lines[i].text = truncateRunes(lines[i].text, pdfCharsPerLine)
+	}
+
+	return lines
+}
+
+func columnWidths(table Table) []int {
+	var widths []int
+	measure := func(row []string) {
+		for i, cell := range row {
+			if i >= len(widths) {
+				widths = append(widths, 0)
+			}
+			widths[i] = max(widths[i], min(utf8.RuneCountInString(cell), pdfMaxColumnLen))
+		}
+	}
+
+	measure(table.Header)
+	for _, row := range table.Rows {
+		measure(row)
+	}
+	measure(table.Footer)
+
+	return widths
+}
+
+func totalWidth(widths []int) int {
+	total := 0
+	for _, width := range widths {
+		total += width + pdfColumnGap
+	}
+	return max(total-pdfColumnGap, 0)
+}
+
+func formatRow(cells []string, widths []int) string {
+	var sb strings.Builder
+	for i, cell := range cells {
+		cell = truncateRunes(cell, widths[i])
+		if i > 0 {
+			sb.WriteString(strings.Repeat(" ", pdfColumnGap))
+		}
+		sb.WriteString(cell)
+		if i < len(cells)-1 {
+			sb.WriteString(strings.Repeat(" ", widths[i]-utf8.RuneCountInString(cell)))
+		}
+	}
+	return sb.String()
+}
+
+func escapePDFString(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `(`, `\(`, `)`, `\)`, "\r", "", "\n", " ").Replace(value)
+}
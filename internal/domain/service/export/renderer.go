@@ -0,0 +1,60 @@
+package export
+
+import (
+	"log"
+
+	"github.com/itsLeonB/cashback/internal/domain/entity"
+)
+
+var namespace = "[ExportRenderer]"
+
+// Document is a statement made of titled tables, independent of the format it is rendered into.
+type Document struct {
+	Title    string
+	Subtitle string
+	Tables   []Table
+}
+
+type Table struct {
+	Title  string
+	Header []string
+	Rows   [][]string
+	Footer []string
+}
+
+type Renderer interface {
+	GetFormat() entity.ExportFormat
+	ContentType() string
+	Extension() string
+	Render(doc Document) ([]byte, error)
+}
+
+var initFuncs = []func() Renderer{
+	newCSVRenderer,
+	newXLSXRenderer,
+	newPDFRenderer,
+}
+
+func NewRendererRegistry() map[entity.ExportFormat]Renderer {
+	registry := make(map[entity.ExportFormat]Renderer)
+
+	for _, initFunc := range initFuncs {
+		if initFunc == nil {
+			log.Fatalf("%s initFunc is nil", namespace)
+		}
+
+		renderer := initFunc()
+		if renderer == nil {
+			log.Fatalf("%s renderer is nil", namespace)
+		}
+
+		format := renderer.GetFormat()
+		if _, exists := registry[format]; exists {
+			log.Fatalf("%s duplicate renderer for format: %s", namespace, format)
+		}
+
+		registry[format] = renderer
+	}
+
+	return registry
+}
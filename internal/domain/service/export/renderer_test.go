@@ -0,0 +1,170 @@
+package export_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/itsLeonB/cashback/internal/domain/entity"
+	"github.com/itsLeonB/cashback/internal/domain/service/export"
+	"github.com/stretchr/testify/assert"
+)
+
+var sampleDocument = export.Document{
+	Title:    "Debt statement",
+	Subtitle: "October 2026",
+	Tables: []export.Table{
+		{
+			Title:  "Transactions (IDR)",
+			Header: []string{"Date", "Friend", "Amount"},
+			Rows: [][]string{
+				{"2026-10-01", "Budi (Café)", "15000.00"},
+				{"2026-10-02", "Ani & <Co>", "-2500.50"},
+			},
+			Footer: []string{"", "Net", "12499.50"},
+		},
+		{
+			Title:  "Transactions (USD)",
+			Header: []string{"Date", "Friend", "Amount"},
+			Rows:   [][]string{{"2026-10-03", "Carol", "10.00"}},
+		},
+	},
+}
+
+func TestNewRendererRegistry(t *testing.T) {
+	registry := export.NewRendererRegistry()
+
+	for _, format := range []entity.ExportFormat{entity.CSVFormat, entity.XLSXFormat, entity.PDFFormat} {
+		renderer, ok := registry[format]
+		assert.True(t, ok, format)
+		assert.Equal(t, format, renderer.GetFormat())
+		assert.NotEmpty(t, renderer.ContentType())
+		assert.NotEmpty(t, renderer.Extension())
+	}
+}
+
+func TestCSVRenderer(t *testing.T) {
+	content, err := export.NewRendererRegistry()[entity.CSVFormat].Render(sampleDocument)
+	assert.NoError(t, err)
+
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.FieldsPerRecord = -1
+	records, err := reader.ReadAll()
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"Debt statement"}, records[0])
+	assert.Contains(t, records, []string{"Transactions (IDR)"})
+	assert.Contains(t, records, []string{"2026-10-02", "Ani & <Co>", "-2500.50"})
+	assert.Contains(t, records, []string{"", "Net", "12499.50"})
+	assert.Contains(t, records, []string{"2026-10-03", "Carol", "10.00"})
+}
+
+func TestCSVRendererEscapesFormulas(t *testing.T) {
+	doc := export.Document{
+		Title: "Statement",
+		Tables: []export.Table{{
+			Title:  "History",
+			Header: []string{"Description", "Amount"},
+			Rows: [][]string{
+				{`=HYPERLINK("https://evil.example","Click")`, "-2500.50"},
+				{"+1+1", "10.00"},
+				{"-2+3", "-1"},
+				{"@SUM(A1)", "0"},
+				{"\tTab", "1"},
+			},
+		}},
+	}
+
+	content, err := export.NewRendererRegistry()[entity.CSVFormat].Render(doc)
+	assert.NoError(t, err)
+
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.FieldsPerRecord = -1
+	records, err := reader.ReadAll()
+	assert.NoError(t, err)
+
+	assert.Contains(t, records, []string{`'=HYPERLINK("https://evil.example","Click")`, "-2500.50"})
+	assert.Contains(t, records, []string{"'+1+1", "10.00"})
+	assert.Contains(t, records, []string{"'-2+3", "-1"})
+	assert.Contains(t, records, []string{"'@SUM(A1)", "0"})
+	assert.Contains(t, records, []string{"'\tTab", "1"})
+}
+
+func TestXLSXRenderer(t *testing.T) {
+	content, err := export.NewRendererRegistry()[entity.XLSXFormat].Render(sampleDocument)
+	assert.NoError(t, err)
+
+	archive, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	assert.NoError(t, err)
+
+	parts := make(map[string]string)
+	for _, file := range archive.File {
+		rc, err := file.Open()
+		assert.NoError(t, err)
+		data, err := io.ReadAll(rc)
+		assert.NoError(t, err)
+		_ = rc.Close()
+		parts[file.Name] = string(data)
+	}
+
+	assert.Contains(t, parts, "[Content_Types].xml")
+	assert.Contains(t, parts["xl/workbook.xml"], `name="Transactions (IDR)"`)
+	assert.Contains(t, parts["xl/workbook.xml"], `name="Transactions (USD)"`)
+
+	sheet := parts["xl/worksheets/sheet1.xml"]
+	assert.Contains(t, sheet, "Debt statement")
+	assert.Contains(t, sheet, "Ani &amp; &lt;Co&gt;")
+	assert.Contains(t, sheet, "<v>-2500.50</v>")
+	assert.NotContains(t, parts["xl/worksheets/sheet2.xml"], "Debt statement")
+}
+
+func TestPDFRenderer(t *testing.T) {
+	t.Run("renders text with a valid cross-reference table", func(t *testing.T) {
+		content, err := export.NewRendererRegistry()[entity.PDFFormat].Render(sampleDocument)
+		assert.NoError(t, err)
+
+		pdf := string(content)
+		assert.True(t, strings.HasPrefix(pdf, "%PDF-1.4"))
+		assert.True(t, strings.HasSuffix(pdf, "%%EOF\n"))
+		assert.Contains(t, pdf, "(Debt statement) Tj")
+		assert.Contains(t, pdf, "Caf\xe9")
+		assertValidXref(t, pdf)
+	})
+
+	t.Run("paginates long documents", func(t *testing.T) {
+		doc := export.Document{Title: "Long", Tables: []export.Table{{Title: "Rows", Header: []string{"No"}}}}
+		for i := range 200 {
+			doc.Tables[0].Rows = append(doc.Tables[0].Rows, []string{strconv.Itoa(i)})
+		}
+
+		content, err := export.NewRendererRegistry()[entity.PDFFormat].Render(doc)
+		assert.NoError(t, err)
+
+		pdf := string(content)
+		assert.Equal(t, 3, strings.Count(pdf, "/Type /Page /Parent"))
+		assert.Contains(t, pdf, "/Count 3")
+		assertValidXref(t, pdf)
+	})
+}
+
+// assertValidXref checks every object offset in the xref table points at its object.
+func assertValidXref(t *testing.T, pdf string) {
+	t.Helper()
+
+	startxref := regexp.MustCompile(`startxref\n(\d+)\n`).FindStringSubmatch(pdf)
+	assert.Len(t, startxref, 2)
+	xrefOffset, _ := strconv.Atoi(startxref[1])
+	assert.True(t, strings.HasPrefix(pdf[xrefOffset:], "xref\n"))
+
+	entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllStringSubmatch(pdf[xrefOffset:], -1)
+	assert.NotEmpty(t, entries)
+	for i, entry := range entries {
+		offset, _ := strconv.Atoi(entry[1])
+		assert.True(t, strings.HasPrefix(pdf[offset:], strconv.Itoa(i+1)+" 0 obj"), "object %d", i+1)
+	}
+}
@@ -0,0 +1,237 @@
+package export
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/itsLeonB/cashback/internal/domain/dto"
+	"github.com/itsLeonB/ungerr"
+	"github.com/shopspring/decimal"
+)
+
+const monthLayout = "2006-01"
+
+// Period bounds a statement to [From, To). The zero Period covers all time.
+type Period struct {
+	From time.Time
+	To   time.Time
+}
+
+// ParseMonth turns a YYYY-MM month into its period, or all time if month is empty.
+func ParseMonth(month string) (Period, error) {
+	if month == "" {
+		return Period{}, nil
+	}
+	from, err := time.Parse(monthLayout, month)
+	if err != nil {
+		return Period{}, ungerr.ValidationError(fmt.Sprintf("invalid month: %s, expected YYYY-MM", month))
+	}
+	return Period{from, from.AddDate(0, 1, 0)}, nil
+}
+
+func (p Period) Contains(t time.Time) bool {
+	if p.From.IsZero() {
+		return true
+	}
+	return !t.Before(p.From) && t.Before(p.To)
+}
+
+func (p Period) String() string {
+	if p.From.IsZero() {
+		return "All time"
+	}
+	return p.From.Format("January 2006")
+}
+
+// DebtStatement lists the transactions in the period, one table per currency, with the net position as footer.
+func DebtStatement(transactions []dto.DebtTransactionResponse, period Period) Document {
+	byCurrency := make(map[string][]dto.DebtTransactionResponse)
+	for _, transaction := range transactions {
+		if period.Contains(transaction.CreatedAt) {
+			byCurrency[transaction.Currency] = append(byCurrency[transaction.Currency], transaction)
+		}
+	}
+
+	doc := Document{
+		Title:    "Debt statement",
+		Subtitle: period.String(),
+	}
+
+	for _, currency := range sortedKeys(byCurrency) {
+		net := decimal.Zero
+		rows := make([][]string, 0, len(byCurrency[currency]))
+		for _, transaction := range byCurrency[currency] {
+			if transaction.Type == "LENT" {
+				net = net.Add(transaction.Amount)
+			} else {
+				net = net.Sub(transaction.Amount)
+			}
+			rows = append(rows, []string{
+				formatDate(transaction.CreatedAt),
+				transaction.Profile.Name,
+				transaction.Type,
+				transaction.Description,
+				transaction.TransferMethod,
+				formatAmount(transaction.Amount),
+			})
+		}
+
+		doc.Tables = append(doc.Tables, Table{
+			Title:  fmt.Sprintf("Transactions (%s)", currency),
+			Header: []string{"Date", "Friend", "Type", "Description", "Transfer method", "Amount"},
+			Rows:   rows,
+			Footer: []string{"", "", "", "", "Net (owed to you)", formatAmount(net)},
+		})
+	}
+
+	return doc
+}
+
+// FriendStatement summarizes the balances with a friend per currency, followed by the history in the period.
+// The balances are always all-time, as what is owed does not restart each month.
+func FriendStatement(details dto.FriendDetailsResponse, period Period) Document {
+	doc := Document{
+		Title:    fmt.Sprintf("Statement with %s", details.Friend.Name),
+		Subtitle: period.String(),
+	}
+
+	currencies := sortedKeys(details.BalancesPerCurrency)
+	summary := Table{
+		Title:  "Balances (all time)",
+		Header: []string{"Currency", "Net balance", "Lent", "Borrowed", "Settled (net)", "Outstanding (net)"},
+	}
+	for _, currency := range currencies {
+		balance := details.BalancesPerCurrency[currency]
+		summary.Rows = append(summary.Rows, []string{
+			currency,
+			formatAmount(balance.NetBalance),
+			formatAmount(balance.TotalLentToFriend),
+			formatAmount(balance.TotalBorrowedFromFriend),
+			formatAmount(balance.TotalSettled),
+			formatAmount(balance.TotalOutstanding),
+		})
+	}
+	doc.Tables = append(doc.Tables, summary)
+
+	for _, currency := range currencies {
+		table := Table{
+			Title:  fmt.Sprintf("History (%s)", currency),
+			Header: []string{"Date", "Type", "Description", "Transfer method", "Settled", "Amount"},
+		}
+		for _, item := range details.BalancesPerCurrency[currency].TransactionHistory {
+			if !period.Contains(item.CreatedAt) {
+				continue
+			}
+			table.Rows = append(table.Rows, []string{
+				formatDate(item.CreatedAt),
+				item.Type,
+				item.Description,
+				item.TransferMethod,
+				formatBool(item.IsSettled),
+				formatAmount(item.Amount),
+			})
+		}
+		doc.Tables = append(doc.Tables, table)
+	}
+
+	return doc
+}
+
+// GroupExpenseBreakdown lists the items, fees and what each participant owes for a group expense.
+func GroupExpenseBreakdown(expense dto.GroupExpenseResponse) Document {
+	doc := Document{
+		Title:    expense.Description,
+		Subtitle: fmt.Sprintf("%s, %s, total %s %s", formatDate(expense.CreatedAt), expense.Status, expense.Currency, formatAmount(expense.TotalAmount)),
+	}
+
+	items := Table{
+		Title:  "Items",
+		Header: []string{"Item", "Quantity", "Price", "Total", "Split"},
+		Footer: []string{"Subtotal", "", "", formatAmount(expense.ItemsTotalAmount), ""},
+	}
+	for _, item := range expense.Items {
+		items.Rows = append(items.Rows, []string{
+			item.Name,
+			strconv.Itoa(item.Quantity),
+			formatAmount(item.Amount),
+			formatAmount(item.Amount.Mul(decimal.NewFromInt(int64(item.Quantity)))),
+			string(item.SplitMode),
+		})
+	}
+
+	fees := Table{
+		Title:  "Fees",
+		Header: []string{"Fee", "Method", "Amount"},
+		Footer: []string{"Total fees", "", formatAmount(expense.FeesTotalAmount)},
+	}
+	for _, fee := range expense.OtherFees {
+		fees.Rows = append(fees.Rows, []string{fee.Name, fee.CalculationMethod, formatAmount(fee.Amount)})
+	}
+
+	shares := Table{
+		Title:  "Shares",
+		Header: []string{"Participant", "Items", "Fees", "Total", "Covered by"},
+	}
+	if expense.IsPreviewable {
+		for _, participant := range expense.ConfirmationPreview.Participants {
+			shares.Rows = append(shares.Rows, []string{
+				participant.Profile.Name,
+				formatAmount(participant.ItemsTotal),
+				formatAmount(participant.FeesTotal),
+				formatAmount(participant.Total),
+				participant.ProxyProfile.Name,
+			})
+		}
+	} else {
+		for _, participant := range expense.Participants {
+			shares.Rows = append(shares.Rows, []string{
+				participant.ParticipantProfile.Name,
+				"",
+				"",
+				formatAmount(participant.ShareAmount),
+				participant.ProxyProfile.Name,
+			})
+		}
+	}
+
+	doc.Tables = []Table{items, fees, shares}
+
+	if len(expense.Payers) > 0 {
+		payers := Table{
+			Title:  "Payers",
+			Header: []string{"Payer", "Paid"},
+		}
+		for _, payer := range expense.Payers {
+			payers.Rows = append(payers.Rows, []string{payer.Profile.Name, formatAmount(payer.PaidAmount)})
+		}
+		doc.Tables = append(doc.Tables, payers)
+	}
+
+	return doc
+}
+
+func formatAmount(amount decimal.Decimal) string {
+	return amount.StringFixed(2)
+}
+
+func formatDate(t time.Time) string {
+	return t.Format(time.DateOnly)
+}
+
+func formatBool(b bool) string {
+	if b {
+		return "Yes"
+	}
+	return "No"
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,105 @@
+package export_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/itsLeonB/cashback/internal/domain/dto"
+	"github.com/itsLeonB/cashback/internal/domain/service/export"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMonth(t *testing.T) {
+	period, err := export.ParseMonth("2026-10")
+	assert.NoError(t, err)
+	assert.True(t, period.Contains(time.Date(2026, 10, 31, 23, 0, 0, 0, time.UTC)))
+	assert.False(t, period.Contains(time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, "October 2026", period.String())
+
+	allTime, err := export.ParseMonth("")
+	assert.NoError(t, err)
+	assert.True(t, allTime.Contains(time.Date(2001, 1, 1, 0, 0, 0, 0, time.UTC)))
+
+	_, err = export.ParseMonth("10/2026")
+	assert.Error(t, err)
+}
+
+func TestDebtStatement(t *testing.T) {
+	october := time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC)
+	transaction := func(txType, currency string, amount int64, createdAt time.Time) dto.DebtTransactionResponse {
+		return dto.DebtTransactionResponse{
+			BaseDTO:  dto.BaseDTO{CreatedAt: createdAt},
+			Profile:  dto.SimpleProfile{Name: "Budi"},
+			Type:     txType,
+			Currency: currency,
+			Amount:   decimal.NewFromInt(amount),
+		}
+	}
+
+	period, _ := export.ParseMonth("2026-10")
+	doc := export.DebtStatement([]dto.DebtTransactionResponse{
+		transaction("LENT", "USD", 10, october),
+		transaction("LENT", "IDR", 100, october),
+		transaction("BORROWED", "IDR", 30, october),
+		transaction("LENT", "IDR", 999, october.AddDate(0, -1, 0)),
+	}, period)
+
+	assert.Len(t, doc.Tables, 2)
+	assert.Equal(t, "Transactions (IDR)", doc.Tables[0].Title)
+	assert.Len(t, doc.Tables[0].Rows, 2)
+	assert.Equal(t, "70.00", doc.Tables[0].Footer[5])
+	assert.Equal(t, "Transactions (USD)", doc.Tables[1].Title)
+}
+
+func TestFriendStatement(t *testing.T) {
+	october := time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC)
+	period, _ := export.ParseMonth("2026-10")
+	doc := export.FriendStatement(dto.FriendDetailsResponse{
+		Friend: dto.FriendDetails{Name: "Budi"},
+		BalancesPerCurrency: map[string]dto.FriendBalance{
+			"IDR": {
+				NetBalance: decimal.NewFromInt(1099),
+				TransactionHistory: []dto.FriendTransactionItem{
+					{BaseDTO: dto.BaseDTO{CreatedAt: october}, Type: "LENT", Amount: decimal.NewFromInt(100)},
+					{BaseDTO: dto.BaseDTO{CreatedAt: october.AddDate(0, -1, 0)}, Type: "LENT", Amount: decimal.NewFromInt(999)},
+				},
+			},
+		},
+	}, period)
+
+	assert.Equal(t, "October 2026", doc.Subtitle)
+	assert.Len(t, doc.Tables, 2)
+	assert.Equal(t, "Balances (all time)", doc.Tables[0].Title)
+	assert.Equal(t, "1099.00", doc.Tables[0].Rows[0][1])
+	assert.Equal(t, "History (IDR)", doc.Tables[1].Title)
+	assert.Len(t, doc.Tables[1].Rows, 1)
+}
+
+func TestGroupExpenseBreakdown(t *testing.T) {
+	doc := export.GroupExpenseBreakdown(dto.GroupExpenseResponse{
+		Description:      "Dinner",
+		Currency:         "IDR",
+		TotalAmount:      decimal.NewFromInt(330),
+		ItemsTotalAmount: decimal.NewFromInt(300),
+		FeesTotalAmount:  decimal.NewFromInt(30),
+		Items: []dto.ExpenseItemResponse{
+			{Name: "Pizza", Amount: decimal.NewFromInt(150), Quantity: 2},
+		},
+		OtherFees: []dto.OtherFeeResponse{
+			{Name: "Tax", Amount: decimal.NewFromInt(30), CalculationMethod: "EQUAL_SPLIT"},
+		},
+		IsPreviewable: true,
+		ConfirmationPreview: dto.ExpenseConfirmationResponse{
+			Participants: []dto.ConfirmedExpenseParticipant{
+				{Profile: dto.SimpleProfile{Name: "Ani"}, ItemsTotal: decimal.NewFromInt(150), FeesTotal: decimal.NewFromInt(15), Total: decimal.NewFromInt(165)},
+			},
+		},
+	})
+
+	assert.Equal(t, "Dinner", doc.Title)
+	assert.Len(t, doc.Tables, 3)
+	assert.Equal(t, []string{"Pizza", "2", "150.00", "300.00", ""}, doc.Tables[0].Rows[0])
+	assert.Equal(t, []string{"Tax", "EQUAL_SPLIT", "30.00"}, doc.Tables[1].Rows[0])
+	assert.Equal(t, []string{"Ani", "150.00", "15.00", "165.00", ""}, doc.Tables[2].Rows[0])
+}
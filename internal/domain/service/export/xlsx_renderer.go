@@ -0,0 +1,235 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/itsLeonB/cashback/internal/domain/entity"
+	"github.com/itsLeonB/ungerr"
+)
+
+const (
+	xlsxMaxSheetNameLength = 31
+	xlsxBoldStyle          = 1
+)
+
+var (
+	xlsxNumberPattern     = regexp.MustCompile(`^-?\d+(\.\d+)?$`)
+	xlsxSheetNameReplacer = strings.NewReplacer("[", "(", "]", ")", ":", "-", "*", "-", "?", "", "/", "-", "\\", "-")
+)
+
+type xlsxPart struct {
+	name    string
+	content string
+}
+
+const xlsxRootRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+	`</Relationships>`
+
+const xlsxStyles = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+	`<fonts count="2"><font><sz val="11"/><name val="Calibri"/></font><font><b/><sz val="11"/><name val="Calibri"/></font></fonts>` +
+	`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>` +
+	`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+	`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+	`<cellXfs count="2"><xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/><xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1"/></cellXfs>` +
+	`</styleSheet>`
+
+type xlsxRenderer struct{}
+
+func newXLSXRenderer() Renderer {
+	return &xlsxRenderer{}
+}
+
+func (xr *xlsxRenderer) GetFormat() entity.ExportFormat {
+	return entity.XLSXFormat
+}
+
+func (xr *xlsxRenderer) ContentType() string {
+	return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+}
+
+func (xr *xlsxRenderer) Extension() string {
+	return "xlsx"
+}
+
+// Render writes a minimal SpreadsheetML workbook with one sheet per table.
+// Numeric cells are written as numbers so they can be summed in the spreadsheet.
+func (xr *xlsxRenderer) Render(doc Document) ([]byte, error) {
+	tables := doc.Tables
+	if len(tables) == 0 {
+		tables = []Table{{Title: doc.Title}}
+	}
+
+	parts := []xlsxPart{
+		{"[Content_Types].xml", xlsxContentTypes(len(tables))},
+		{"_rels/.rels", xlsxRootRels},
+		{"xl/workbook.xml", xlsxWorkbook(xlsxSheetNames(tables))},
+		{"xl/_rels/workbook.xml.rels", xlsxWorkbookRels(len(tables))},
+		{"xl/styles.xml", xlsxStyles},
+	}
+	for i, table := range tables {
+		parts = append(parts, xlsxPart{fmt.Sprintf("xl/worksheets/sheet%d.xml", i+1), xlsxSheet(doc, table, i == 0)})
+	}
+
+	var buf bytes.Buffer
+	zipWriter := zip.NewWriter(&buf)
+	for _, part := range parts {
+		writer, err := zipWriter.Create(part.name)
+		if err != nil {
+			return nil, ungerr.Wrap(err, "error creating xlsx part")
+		}
+		if _, err = writer.Write([]byte(part.content)); err != nil {
+			return nil, ungerr.Wrap(err, "error writing xlsx part")
+		}
+	}
+	if err := zipWriter.Close(); err != nil {
+		return nil, ungerr.Wrap(err, "error finalizing xlsx")
+	}
+
+	return buf.Bytes(), nil
+}
+
+func xlsxContentTypes(sheetCount int) string {
+	var sb strings.Builder
+	sb.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">`)
+	sb.WriteString(`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>`)
+	sb.WriteString(`<Default Extension="xml" ContentType="application/xml"/>`)
+	sb.WriteString(`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>`)
+	sb.WriteString(`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>`)
+	for i := 1; i <= sheetCount; i++ {
+		fmt.Fprintf(&sb, `<Override PartName="/xl/worksheets/sheet%d.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>`, i)
+	}
+	sb.WriteString(`</Types>`)
+	return sb.String()
+}
+
+func xlsxWorkbook(sheetNames []string) string {
+	var sb strings.Builder
+	sb.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets>`)
+	for i, name := range sheetNames {
+		fmt.Fprintf(&sb, `<sheet name="%s" sheetId="%d" r:id="rId%d"/>`, xlsxEscape(name), i+1, i+1)
+	}
+	sb.WriteString(`</sheets></workbook>`)
+	return sb.String()
+}
+
+func xlsxWorkbookRels(sheetCount int) string {
+	var sb strings.Builder
+	sb.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">`)
+	for i := 1; i <= sheetCount; i++ {
+		fmt.Fprintf(&sb, `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet%d.xml"/>`, i, i)
+	}
+	fmt.Fprintf(&sb, `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>`, sheetCount+1)
+	sb.WriteString(`</Relationships>`)
+	return sb.String()
+}
+
+// xlsxSheet lays the table out from the first row. Only the first sheet carries the document title.
+func xlsxSheet(doc Document, table Table, withTitle bool) string {
+	type row struct {
+		cells []string
+		bold  bool
+	}
+
+	var rows []row
+	if withTitle {
+		rows = append(rows, row{[]string{doc.Title}, true})
+		if doc.Subtitle != "" {
+			rows = append(rows, row{[]string{doc.Subtitle}, false})
+		}
+		rows = append(rows, row{})
+	}
+	if table.Title != "" {
+		rows = append(rows, row{[]string{table.Title}, true})
+	}
+	if len(table.Header) > 0 {
+		rows = append(rows, row{table.Header, true})
+	}
+	for _, cells := range table.Rows {
+		rows = append(rows, row{cells, false})
+	}
+	if len(table.Footer) > 0 {
+		rows = append(rows, row{table.Footer, true})
+	}
+
+	var sb strings.Builder
+	sb.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+	for i, r := range rows {
+		fmt.Fprintf(&sb, `<row r="%d">`, i+1)
+		for j, value := range r.cells {
+			if value == "" {
+				continue
+			}
+			ref := fmt.Sprintf("%s%d", xlsxColumnName(j), i+1)
+			style := ""
+			if r.bold {
+				style = fmt.Sprintf(` s="%d"`, xlsxBoldStyle)
+			}
+			if xlsxNumberPattern.MatchString(value) {
+				fmt.Fprintf(&sb, `<c r="%s"%s><v>%s</v></c>`, ref, style, value)
+			} else {
+				fmt.Fprintf(&sb, `<c r="%s"%s t="inlineStr"><is><t xml:space="preserve">%s</t></is></c>`, ref, style, xlsxEscape(value))
+			}
+		}
+		sb.WriteString(`</row>`)
+	}
+	sb.WriteString(`</sheetData></worksheet>`)
+	return sb.String()
+}
+
+// xlsxColumnName converts a zero-based column index into its spreadsheet letters, e.g. 0 -> A, 27 -> AB.
+func xlsxColumnName(index int) string {
+	name := ""
+	for index >= 0 {
+		name = string(rune('A'+index%26)) + name
+		index = index/26 - 1
+	}
+	return name
+}
+
+// xlsxSheetNames derives valid and unique sheet names from the table titles.
+func xlsxSheetNames(tables []Table) []string {
+	names := make([]string, len(tables))
+	used := make(map[string]bool, len(tables))
+	for i, table := range tables {
+		base := strings.TrimSpace(xlsxSheetNameReplacer.Replace(table.Title))
+		if base == "" {
+			base = fmt.Sprintf("Sheet%d", i+1)
+		}
+		base = truncateRunes(base, xlsxMaxSheetNameLength)
+
+		name := base
+		for n := 2; used[strings.ToLower(name)]; n++ {
+			suffix := fmt.Sprintf(" (%d)", n)
+			name = truncateRunes(base, xlsxMaxSheetNameLength-len(suffix)) + suffix
+		}
+		used[strings.ToLower(name)] = true
+		names[i] = name
+	}
+	return names
+}
+
+func xlsxEscape(value string) string {
+	var buf bytes.Buffer
+	_ = xml.EscapeText(&buf, []byte(value)) // writing to a bytes.Buffer does not fail
+	return buf.String()
+}
+
+func truncateRunes(value string, length int) string {
+	runes := []rune(value)
+	if len(runes) <= length {
+		return value
+	}
+	return string(runes[:length])
+}
@@ -0,0 +1,252 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/core/logger"
+	"github.com/itsLeonB/cashback/internal/core/otel"
+	"github.com/itsLeonB/cashback/internal/core/service/queue"
+	"github.com/itsLeonB/cashback/internal/core/service/storage"
+	"github.com/itsLeonB/cashback/internal/domain/dto"
+	"github.com/itsLeonB/cashback/internal/domain/entity"
+	"github.com/itsLeonB/cashback/internal/domain/mapper"
+	"github.com/itsLeonB/cashback/internal/domain/message"
+	"github.com/itsLeonB/cashback/internal/domain/service/export"
+	"github.com/itsLeonB/go-crud"
+	"github.com/itsLeonB/ungerr"
+	"gorm.io/datatypes"
+)
+
+const exportURLExpiry = 15 * time.Minute
+
+type exportServiceImpl struct {
+	transactor       crud.Transactor
+	exportRepo       crud.Repository[entity.Export]
+	debtSvc          DebtService
+	friendDetailsSvc FriendDetailsService
+	expenseSvc       GroupExpenseService
+	storageRepo      storage.StorageRepository
+	bucketName       string
+	taskQueue        queue.TaskQueue
+	renderers        map[entity.ExportFormat]export.Renderer
+}
+
+func NewExportService(
+	transactor crud.Transactor,
+	exportRepo crud.Repository[entity.Export],
+	debtSvc DebtService,
+	friendDetailsSvc FriendDetailsService,
+	expenseSvc GroupExpenseService,
+	storageRepo storage.StorageRepository,
+	bucketName string,
+	taskQueue queue.TaskQueue,
+) *exportServiceImpl {
+	return &exportServiceImpl{
+		transactor,
+		exportRepo,
+		debtSvc,
+		friendDetailsSvc,
+		expenseSvc,
+		storageRepo,
+		bucketName,
+		taskQueue,
+		export.NewRendererRegistry(),
+	}
+}
+
+func (es *exportServiceImpl) Request(ctx context.Context, req dto.NewExportRequest) (dto.ExportResponse, error) {
+	ctx, span := otel.Tracer.Start(ctx, "ExportService.Request")
+	defer span.End()
+
+	if _, ok := es.renderers[req.Format]; !ok {
+		return dto.ExportResponse{}, ungerr.ValidationError(fmt.Sprintf("unsupported export format: %s", req.Format))
+	}
+	if _, err := export.ParseMonth(req.Month); err != nil {
+		return dto.ExportResponse{}, err
+	}
+
+	params := dto.ExportParams{
+		Month:          req.Month,
+		InHomeCurrency: req.InHomeCurrency,
+	}
+	switch req.Kind {
+	case entity.DebtsExport:
+	case entity.FriendStatementExport:
+		if req.FriendshipID == uuid.Nil {
+			return dto.ExportResponse{}, ungerr.ValidationError("friendshipId is required for friend statements")
+		}
+		params.FriendshipID = req.FriendshipID
+	case entity.GroupExpenseExport:
+		if req.GroupExpenseID == uuid.Nil {
+			return dto.ExportResponse{}, ungerr.ValidationError("groupExpenseId is required for group expense exports")
+		}
+		params.GroupExpenseID = req.GroupExpenseID
+	default:
+		return dto.ExportResponse{}, ungerr.ValidationError(fmt.Sprintf("unsupported export kind: %s", req.Kind))
+	}
+
+	marshaledParams, err := json.Marshal(params)
+	if err != nil {
+		return dto.ExportResponse{}, ungerr.Wrap(err, "error marshaling export params")
+	}
+
+	var resp dto.ExportResponse
+	err = es.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		insertedExport, err := es.exportRepo.Insert(ctx, entity.Export{
+			ProfileID: req.ProfileID,
+			Kind:      req.Kind,
+			Format:    req.Format,
+			Status:    entity.PendingExport,
+			Params:    datatypes.JSON(marshaledParams),
+		})
+		if err != nil {
+			return err
+		}
+
+		if err = es.taskQueue.Enqueue(ctx, message.ExportRequested{ID: insertedExport.ID}); err != nil {
+			return err
+		}
+
+		resp = mapper.ExportToResponse(insertedExport, "")
+		return nil
+	})
+	return resp, err
+}
+
+func (es *exportServiceImpl) GetByID(ctx context.Context, profileID, id uuid.UUID) (dto.ExportResponse, error) {
+	ctx, span := otel.Tracer.Start(ctx, "ExportService.GetByID")
+	defer span.End()
+
+	spec := crud.Specification[entity.Export]{}
+	spec.Model.ID = id
+	spec.Model.ProfileID = profileID
+	exp, err := es.exportRepo.FindFirst(ctx, spec)
+	if err != nil {
+		return dto.ExportResponse{}, err
+	}
+	if exp.IsZero() {
+		return dto.ExportResponse{}, ungerr.NotFoundError(fmt.Sprintf("export with ID %s is not found", id))
+	}
+
+	if exp.Status != entity.CompletedExport {
+		return mapper.ExportToResponse(exp, ""), nil
+	}
+
+	url, err := es.storageRepo.GetSignedURL(es.fileID(exp.ObjectKey.String), exportURLExpiry)
+	if err != nil {
+		return dto.ExportResponse{}, err
+	}
+
+	return mapper.ExportToResponse(exp, url), nil
+}
+
+// Generate renders and uploads a pending export. Failures are recorded on the export instead of
+// being retried, since rerunning the same request would fail the same way.
+func (es *exportServiceImpl) Generate(ctx context.Context, msg message.ExportRequested) error {
+	ctx, span := otel.Tracer.Start(ctx, "ExportService.Generate")
+	defer span.End()
+
+	spec := crud.Specification[entity.Export]{}
+	spec.Model.ID = msg.ID
+	exp, err := es.exportRepo.FindFirst(ctx, spec)
+	if err != nil {
+		return err
+	}
+	if exp.IsZero() {
+		return ungerr.NotFoundError(fmt.Sprintf("export with ID %s is not found", msg.ID))
+	}
+	if exp.Status != entity.PendingExport {
+		logger.Infof("export %s is already %s, skipping", exp.ID, exp.Status)
+		return nil
+	}
+
+	objectKey, err := es.render(ctx, exp)
+	if err != nil {
+		logger.Errorf("error generating export %s: %v", exp.ID, err)
+		exp.Status = entity.FailedExport
+		exp.ErrorMessage = sql.NullString{String: err.Error(), Valid: true}
+	} else {
+		exp.Status = entity.CompletedExport
+		exp.ObjectKey = sql.NullString{String: objectKey, Valid: true}
+	}
+	exp.CompletedAt = sql.NullTime{Time: time.Now(), Valid: true}
+
+	_, err = es.exportRepo.Update(ctx, exp)
+	return err
+}
+
+func (es *exportServiceImpl) render(ctx context.Context, exp entity.Export) (string, error) {
+	renderer, ok := es.renderers[exp.Format]
+	if !ok {
+		return "", ungerr.ValidationError(fmt.Sprintf("unsupported export format: %s", exp.Format))
+	}
+
+	var params dto.ExportParams
+	if err := json.Unmarshal(exp.Params, &params); err != nil {
+		return "", ungerr.Wrap(err, "error unmarshaling export params")
+	}
+
+	doc, err := es.buildDocument(ctx, exp.ProfileID, exp.Kind, params)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := renderer.Render(doc)
+	if err != nil {
+		return "", err
+	}
+
+	objectKey := fmt.Sprintf("%s/%s.%s", exp.ProfileID, exp.ID, renderer.Extension())
+	if err = es.storageRepo.Upload(ctx, &storage.StorageUploadRequest{
+		Data:           data,
+		ContentType:    renderer.ContentType(),
+		CacheControl:   "private, no-store",
+		FileIdentifier: es.fileID(objectKey),
+	}); err != nil {
+		return "", err
+	}
+
+	return objectKey, nil
+}
+
+func (es *exportServiceImpl) buildDocument(ctx context.Context, profileID uuid.UUID, kind entity.ExportKind, params dto.ExportParams) (export.Document, error) {
+	period, err := export.ParseMonth(params.Month)
+	if err != nil {
+		return export.Document{}, err
+	}
+
+	switch kind {
+	case entity.DebtsExport:
+		transactions, err := es.debtSvc.GetTransactions(ctx, profileID)
+		if err != nil {
+			return export.Document{}, err
+		}
+		return export.DebtStatement(transactions, period), nil
+	case entity.FriendStatementExport:
+		details, err := es.friendDetailsSvc.GetDetails(ctx, profileID, params.FriendshipID, params.InHomeCurrency)
+		if err != nil {
+			return export.Document{}, err
+		}
+		return export.FriendStatement(details, period), nil
+	case entity.GroupExpenseExport:
+		expense, err := es.expenseSvc.GetDetails(ctx, params.GroupExpenseID, profileID)
+		if err != nil {
+			return export.Document{}, err
+		}
+		return export.GroupExpenseBreakdown(expense), nil
+	default:
+		return export.Document{}, ungerr.ValidationError(fmt.Sprintf("unsupported export kind: %s", kind))
+	}
+}
+
+func (es *exportServiceImpl) fileID(objectKey string) storage.FileIdentifier {
+	return storage.FileIdentifier{
+		BucketName: es.bucketName,
+		ObjectKey:  objectKey,
+	}
+}
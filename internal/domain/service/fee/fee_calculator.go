@@ -19,6 +19,8 @@ type FeeCalculator interface {
 var initFuncs = []func() FeeCalculator{
 	newEqualSplitFeeCalculator,
 	newItemizedSplitFeeCalculator,
+	newPercentageSplitFeeCalculator,
+	newFixedPerPersonFeeCalculator,
 }
 
 func NewFeeCalculatorRegistry() map[expenses.FeeCalculationMethod]FeeCalculator {
@@ -0,0 +1,106 @@
+package fee_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/domain/entity/expenses"
+	"github.com/itsLeonB/cashback/internal/domain/service/fee"
+	"github.com/itsLeonB/go-crud"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func newFee(t *testing.T, method expenses.FeeCalculationMethod, amount decimal.Decimal, shares map[uuid.UUID]decimal.Decimal) expenses.OtherFee {
+	data, err := json.Marshal(shares)
+	assert.NoError(t, err)
+
+	return expenses.OtherFee{
+		BaseEntity:        crud.BaseEntity{ID: uuid.New()},
+		GroupExpenseID:    uuid.New(),
+		Amount:            amount,
+		CalculationMethod: method,
+		ParticipantShares: data,
+	}
+}
+
+func newGroupExpense(profileIDs ...uuid.UUID) expenses.GroupExpense {
+	participants := make([]expenses.ExpenseParticipant, len(profileIDs))
+	for i, id := range profileIDs {
+		participants[i] = expenses.ExpenseParticipant{ParticipantProfileID: id}
+	}
+	return expenses.GroupExpense{Participants: participants}
+}
+
+func sumShares(participants []expenses.FeeParticipant) decimal.Decimal {
+	total := decimal.Zero
+	for _, p := range participants {
+		total = total.Add(p.ShareAmount)
+	}
+	return total
+}
+
+func TestPercentageSplitFeeCalculator(t *testing.T) {
+	calculator := fee.NewFeeCalculatorRegistry()[expenses.PercentageSplitFee]
+	alice, bob := uuid.New(), uuid.New()
+	groupExpense := newGroupExpense(alice, bob)
+
+	t.Run("splits by percentage and keeps the total", func(t *testing.T) {
+		otherFee := newFee(t, expenses.PercentageSplitFee, decimal.RequireFromString("100.01"), map[uuid.UUID]decimal.Decimal{
+			alice: decimal.NewFromInt(60),
+			bob:   decimal.NewFromInt(40),
+		})
+
+		assert.NoError(t, calculator.Validate(otherFee, groupExpense))
+		participants := calculator.Split(otherFee, groupExpense)
+		assert.True(t, participants[0].ShareAmount.Equal(decimal.RequireFromString("60.01")))
+		assert.True(t, participants[1].ShareAmount.Equal(decimal.NewFromInt(40)))
+		assert.True(t, sumShares(participants).Equal(otherFee.Amount))
+	})
+
+	t.Run("rejects percentages not adding up to 100", func(t *testing.T) {
+		otherFee := newFee(t, expenses.PercentageSplitFee, decimal.NewFromInt(100), map[uuid.UUID]decimal.Decimal{
+			alice: decimal.NewFromInt(60),
+			bob:   decimal.NewFromInt(30),
+		})
+
+		assert.Error(t, calculator.Validate(otherFee, groupExpense))
+	})
+
+	t.Run("rejects shares of non-participants", func(t *testing.T) {
+		otherFee := newFee(t, expenses.PercentageSplitFee, decimal.NewFromInt(100), map[uuid.UUID]decimal.Decimal{
+			alice:      decimal.NewFromInt(60),
+			uuid.New(): decimal.NewFromInt(40),
+		})
+
+		assert.Error(t, calculator.Validate(otherFee, groupExpense))
+	})
+}
+
+func TestFixedPerPersonFeeCalculator(t *testing.T) {
+	calculator := fee.NewFeeCalculatorRegistry()[expenses.FixedPerPersonFee]
+	alice, bob, carol := uuid.New(), uuid.New(), uuid.New()
+	groupExpense := newGroupExpense(alice, bob, carol)
+
+	t.Run("assigns fixed amounts and zero to the rest", func(t *testing.T) {
+		otherFee := newFee(t, expenses.FixedPerPersonFee, decimal.NewFromInt(30000), map[uuid.UUID]decimal.Decimal{
+			alice: decimal.NewFromInt(10000),
+			bob:   decimal.NewFromInt(20000),
+		})
+
+		assert.NoError(t, calculator.Validate(otherFee, groupExpense))
+		participants := calculator.Split(otherFee, groupExpense)
+		assert.Len(t, participants, 3)
+		assert.True(t, participants[2].ShareAmount.IsZero())
+		assert.True(t, sumShares(participants).Equal(otherFee.Amount))
+	})
+
+	t.Run("rejects amounts not adding up to the fee", func(t *testing.T) {
+		otherFee := newFee(t, expenses.FixedPerPersonFee, decimal.NewFromInt(30000), map[uuid.UUID]decimal.Decimal{
+			alice: decimal.NewFromInt(10000),
+		})
+
+		assert.Error(t, calculator.Validate(otherFee, groupExpense))
+	})
+}
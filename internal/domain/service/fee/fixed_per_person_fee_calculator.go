@@ -0,0 +1,85 @@
+package fee
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/domain/dto"
+	"github.com/itsLeonB/cashback/internal/domain/entity/expenses"
+	"github.com/itsLeonB/ungerr"
+	"github.com/shopspring/decimal"
+)
+
+type fixedPerPersonFeeCalculator struct {
+	method expenses.FeeCalculationMethod
+}
+
+func newFixedPerPersonFeeCalculator() FeeCalculator {
+	return &fixedPerPersonFeeCalculator{
+		expenses.FixedPerPersonFee,
+	}
+}
+
+func (fc *fixedPerPersonFeeCalculator) GetMethod() expenses.FeeCalculationMethod {
+	return fc.method
+}
+
+func (fc *fixedPerPersonFeeCalculator) Validate(fee expenses.OtherFee, groupExpense expenses.GroupExpense) error {
+	if fee.IsZero() {
+		return ungerr.Unknown("fee ID cannot be nil")
+	}
+
+	if fee.GroupExpenseID == uuid.Nil {
+		return ungerr.Unknown("group expense ID cannot be nil")
+	}
+
+	if fee.Amount.IsZero() {
+		return ungerr.Unknown("amount cannot be zero")
+	}
+
+	if len(groupExpense.Participants) < 1 {
+		return ungerr.Unknown("must have participants")
+	}
+
+	amounts, err := participantShares(fee, groupExpense)
+	if err != nil {
+		return err
+	}
+
+	amountSum := decimal.Zero
+	for _, share := range amounts {
+		if !share.Value.Equal(share.Value.Round(2)) {
+			return ungerr.UnprocessableEntityError(fmt.Sprintf("amounts of fee %s cannot have more than 2 decimal places", fee.Name))
+		}
+		amountSum = amountSum.Add(share.Value)
+	}
+	if !amountSum.Equal(fee.Amount) {
+		return ungerr.UnprocessableEntityError(fmt.Sprintf("amounts of fee %s must add up to %s", fee.Name, fee.Amount.String()))
+	}
+
+	return nil
+}
+
+func (fc *fixedPerPersonFeeCalculator) Split(fee expenses.OtherFee, groupExpense expenses.GroupExpense) []expenses.FeeParticipant {
+	// Errors are already caught by Validate
+	amounts, _ := participantShares(fee, groupExpense)
+
+	feeParticipants := make([]expenses.FeeParticipant, len(amounts))
+	for i, share := range amounts {
+		feeParticipants[i] = expenses.FeeParticipant{
+			OtherFeeID:  fee.ID,
+			ProfileID:   share.ProfileID,
+			ShareAmount: share.Value,
+		}
+	}
+
+	return feeParticipants
+}
+
+func (fc *fixedPerPersonFeeCalculator) GetInfo() dto.FeeCalculationMethodInfo {
+	return dto.FeeCalculationMethodInfo{
+		Name:        string(fc.method),
+		Display:     "Fixed amount per person",
+		Description: "Each participant pays the fixed amount set for them",
+	}
+}
@@ -0,0 +1,112 @@
+package fee
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/domain/dto"
+	"github.com/itsLeonB/cashback/internal/domain/entity/expenses"
+	"github.com/itsLeonB/cashback/internal/domain/service/expense"
+	"github.com/itsLeonB/ungerr"
+	"github.com/shopspring/decimal"
+)
+
+type percentageSplitFeeCalculator struct {
+	method expenses.FeeCalculationMethod
+}
+
+func newPercentageSplitFeeCalculator() FeeCalculator {
+	return &percentageSplitFeeCalculator{
+		expenses.PercentageSplitFee,
+	}
+}
+
+func (fc *percentageSplitFeeCalculator) GetMethod() expenses.FeeCalculationMethod {
+	return fc.method
+}
+
+func (fc *percentageSplitFeeCalculator) Validate(fee expenses.OtherFee, groupExpense expenses.GroupExpense) error {
+	if fee.IsZero() {
+		return ungerr.Unknown("fee ID cannot be nil")
+	}
+
+	if fee.GroupExpenseID == uuid.Nil {
+		return ungerr.Unknown("group expense ID cannot be nil")
+	}
+
+	if fee.Amount.IsZero() {
+		return ungerr.Unknown("amount cannot be zero")
+	}
+
+	if len(groupExpense.Participants) < 1 {
+		return ungerr.Unknown("must have participants")
+	}
+
+	percentages, err := participantShares(fee, groupExpense)
+	if err != nil {
+		return err
+	}
+
+	percentageSum := decimal.Zero
+	for _, share := range percentages {
+		percentageSum = percentageSum.Add(share.Value)
+	}
+	if !percentageSum.Equal(decimal.NewFromInt(100)) {
+		return ungerr.UnprocessableEntityError(fmt.Sprintf("percentages of fee %s must add up to 100", fee.Name))
+	}
+
+	_, err = expense.SplitByShares(fee.Amount, percentages)
+	return err
+}
+
+func (fc *percentageSplitFeeCalculator) Split(fee expenses.OtherFee, groupExpense expenses.GroupExpense) []expenses.FeeParticipant {
+	// Errors are already caught by Validate
+	percentages, _ := participantShares(fee, groupExpense)
+	amounts, _ := expense.SplitByShares(fee.Amount, percentages)
+
+	feeParticipants := make([]expenses.FeeParticipant, len(percentages))
+	for i, share := range percentages {
+		feeParticipants[i] = expenses.FeeParticipant{
+			OtherFeeID:  fee.ID,
+			ProfileID:   share.ProfileID,
+			ShareAmount: amounts[i],
+		}
+	}
+
+	return feeParticipants
+}
+
+func (fc *percentageSplitFeeCalculator) GetInfo() dto.FeeCalculationMethodInfo {
+	return dto.FeeCalculationMethodInfo{
+		Name:        string(fc.method),
+		Display:     "Percentage split",
+		Description: "Split the fee by the percentage set for each participant",
+	}
+}
+
+// participantShares lines up the fee's per-profile shares with the expense participants,
+// defaulting to zero for participants without a share.
+func participantShares(fee expenses.OtherFee, groupExpense expenses.GroupExpense) ([]expense.Share, error) {
+	shareByProfileID, err := fee.ShareByProfileID()
+	if err != nil {
+		return nil, err
+	}
+
+	shares := make([]expense.Share, len(groupExpense.Participants))
+	for i, participant := range groupExpense.Participants {
+		value, ok := shareByProfileID[participant.ParticipantProfileID]
+		if ok {
+			delete(shareByProfileID, participant.ParticipantProfileID)
+		}
+		if value.IsNegative() {
+			return nil, ungerr.UnprocessableEntityError(fmt.Sprintf("share of fee %s cannot be negative", fee.Name))
+		}
+		shares[i] = expense.Share{ProfileID: participant.ParticipantProfileID, Value: value}
+	}
+
+	if len(shareByProfileID) > 0 {
+		return nil, ungerr.UnprocessableEntityError(fmt.Sprintf("fee %s has shares for profiles outside the expense participants", fee.Name))
+	}
+
+	return shares, nil
+}
@@ -17,21 +17,24 @@ type friendDetailsServiceImpl struct {
 	debtSvc       DebtService
 	profileSvc    ProfileService
 	friendshipSvc FriendshipService
+	fxRateSvc     FxRateService
 }
 
 func NewFriendDetailsService(
 	debtSvc DebtService,
 	profileSvc ProfileService,
 	friendshipSvc FriendshipService,
+	fxRateSvc FxRateService,
 ) FriendDetailsService {
 	return &friendDetailsServiceImpl{
 		debtSvc,
 		profileSvc,
 		friendshipSvc,
+		fxRateSvc,
 	}
 }
 
-func (fds *friendDetailsServiceImpl) GetDetails(ctx context.Context, profileID, friendshipID uuid.UUID) (dto.FriendDetailsResponse, error) {
+func (fds *friendDetailsServiceImpl) GetDetails(ctx context.Context, profileID, friendshipID uuid.UUID, inHomeCurrency bool) (dto.FriendDetailsResponse, error) {
 	ctx, span := otel.Tracer.Start(ctx, "FriendDetailsService.GetDetails")
 	defer span.End()
 
@@ -65,6 +68,16 @@ func (fds *friendDetailsServiceImpl) GetDetails(ctx context.Context, profileID,
 		return dto.FriendDetailsResponse{}, err
 	}
 
+	if inHomeCurrency {
+		userProfile, err := fds.profileSvc.GetEntityByID(ctx, profileID)
+		if err != nil {
+			return dto.FriendDetailsResponse{}, err
+		}
+		if debtTransactions, err = fds.fxRateSvc.ConvertTransactions(ctx, debtTransactions, userProfile.HomeCurrency); err != nil {
+			return dto.FriendDetailsResponse{}, err
+		}
+	}
+
 	return mapper.MapToFriendDetailsResponse(response, debtTransactions, userAssociatedIDs)
 }
 
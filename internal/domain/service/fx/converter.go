@@ -0,0 +1,17 @@
+package fx
+
+import "github.com/shopspring/decimal"
+
+const amountScale = 2
+
+// Convert converts the amount from one currency to the other using the given rate,
+// which may be quoted in either direction of the pair.
+func Convert(amount decimal.Decimal, fromCurrency, toCurrency string, rate Rate) decimal.Decimal {
+	if fromCurrency == toCurrency {
+		return amount
+	}
+	if rate.BaseCurrency == fromCurrency && rate.QuoteCurrency == toCurrency {
+		return amount.Mul(rate.Rate).Round(amountScale)
+	}
+	return amount.DivRound(rate.Rate, amountScale)
+}
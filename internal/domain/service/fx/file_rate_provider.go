@@ -0,0 +1,95 @@
+package fx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/itsLeonB/cashback/internal/core/logger"
+	"github.com/itsLeonB/ungerr"
+	"github.com/shopspring/decimal"
+)
+
+const DateLayout = time.DateOnly
+
+type fileRate struct {
+	Base  string          `json:"base"`
+	Quote string          `json:"quote"`
+	Rate  decimal.Decimal `json:"rate"`
+	Date  string          `json:"date"`
+}
+
+// fileRateProvider serves rates from a local JSON file, meant for development and self-hosting.
+// The file holds an array of {"base", "quote", "rate", "date"} objects with dates in YYYY-MM-DD.
+type fileRateProvider struct {
+	ratesByPair map[string][]Rate
+}
+
+func newFileRateProvider(path string) (*fileRateProvider, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			logger.Warnf("fx rates file %s is not found, no fallback rates are available", path)
+			return &fileRateProvider{map[string][]Rate{}}, nil
+		}
+		return nil, ungerr.Wrap(err, fmt.Sprintf("error reading fx rates file %s", path))
+	}
+
+	return parseFileRates(content)
+}
+
+func parseFileRates(content []byte) (*fileRateProvider, error) {
+	var rows []fileRate
+	if err := json.Unmarshal(content, &rows); err != nil {
+		return nil, ungerr.Wrap(err, "error parsing fx rates file")
+	}
+
+	ratesByPair := make(map[string][]Rate)
+	for _, row := range rows {
+		date, err := time.Parse(DateLayout, row.Date)
+		if err != nil {
+			return nil, ungerr.Wrap(err, fmt.Sprintf("invalid date %q in fx rates file", row.Date))
+		}
+		if !row.Rate.IsPositive() {
+			return nil, ungerr.Unknownf("rate for %s/%s on %s must be positive", row.Base, row.Quote, row.Date)
+		}
+		rate := Rate{
+			BaseCurrency:  strings.ToUpper(row.Base),
+			QuoteCurrency: strings.ToUpper(row.Quote),
+			Rate:          row.Rate,
+			Date:          date,
+		}
+		key := pairKey(rate.BaseCurrency, rate.QuoteCurrency)
+		ratesByPair[key] = append(ratesByPair[key], rate)
+	}
+
+	for _, rates := range ratesByPair {
+		slices.SortFunc(rates, func(a, b Rate) int { return a.Date.Compare(b.Date) })
+	}
+
+	return &fileRateProvider{ratesByPair}, nil
+}
+
+func (frp *fileRateProvider) Provider() string {
+	return "file"
+}
+
+func (frp *fileRateProvider) GetRate(_ context.Context, baseCurrency, quoteCurrency string, date time.Time) (Rate, bool, error) {
+	rates := frp.ratesByPair[pairKey(baseCurrency, quoteCurrency)]
+	for i := len(rates) - 1; i >= 0; i-- {
+		if !rates[i].Date.After(date) {
+			return rates[i], true, nil
+		}
+	}
+	return Rate{}, false, nil
+}
+
+func pairKey(baseCurrency, quoteCurrency string) string {
+	return baseCurrency + "/" + quoteCurrency
+}
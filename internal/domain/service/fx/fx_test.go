@@ -0,0 +1,69 @@
+package fx_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/itsLeonB/cashback/internal/core/config"
+	"github.com/itsLeonB/cashback/internal/core/logger"
+	"github.com/itsLeonB/cashback/internal/domain/service/fx"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	logger.Init("test")
+	os.Exit(m.Run())
+}
+
+func TestConvert_UsesRateInEitherDirection(t *testing.T) {
+	rate := fx.Rate{BaseCurrency: "SGD", QuoteCurrency: "IDR", Rate: decimal.NewFromInt(12000)}
+
+	assert.True(t, fx.Convert(decimal.NewFromFloat(1.5), "SGD", "IDR", rate).Equal(decimal.NewFromInt(18000)))
+	assert.True(t, fx.Convert(decimal.NewFromInt(30000), "IDR", "SGD", rate).Equal(decimal.NewFromFloat(2.5)))
+	assert.True(t, fx.Convert(decimal.NewFromInt(10), "IDR", "IDR", fx.Rate{}).Equal(decimal.NewFromInt(10)))
+}
+
+func TestFileRateProvider_ReturnsLatestRateOnOrBeforeDate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rates.json")
+	content := `[
+		{"base": "SGD", "quote": "IDR", "rate": "12000", "date": "2026-01-01"},
+		{"base": "sgd", "quote": "idr", "rate": "12100", "date": "2026-02-01"}
+	]`
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	provider, err := fx.NewRateProvider(config.FX{RateProvider: "file", RatesFile: path})
+	require.NoError(t, err)
+
+	rate, found, err := provider.GetRate(context.Background(), "SGD", "IDR", time.Date(2026, 1, 20, 10, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.True(t, rate.Rate.Equal(decimal.NewFromInt(12000)))
+
+	rate, found, err = provider.GetRate(context.Background(), "SGD", "IDR", time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.True(t, rate.Rate.Equal(decimal.NewFromInt(12100)))
+
+	_, found, err = provider.GetRate(context.Background(), "SGD", "IDR", time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.False(t, found)
+}
+
+func TestFileRateProvider_MissingFileHasNoRates(t *testing.T) {
+	provider, err := fx.NewRateProvider(config.FX{RateProvider: "file", RatesFile: filepath.Join(t.TempDir(), "missing.json")})
+	require.NoError(t, err)
+
+	_, found, err := provider.GetRate(context.Background(), "SGD", "IDR", time.Now())
+	require.NoError(t, err)
+	assert.False(t, found)
+}
+
+func TestNewRateProvider_RejectsUnknownProvider(t *testing.T) {
+	_, err := fx.NewRateProvider(config.FX{RateProvider: "unknown"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,35 @@
+package fx
+
+import (
+	"context"
+	"time"
+
+	"github.com/itsLeonB/cashback/internal/core/config"
+	"github.com/itsLeonB/ungerr"
+	"github.com/shopspring/decimal"
+)
+
+// Rate is the amount of QuoteCurrency one unit of BaseCurrency buys, effective from Date.
+type Rate struct {
+	BaseCurrency  string
+	QuoteCurrency string
+	Rate          decimal.Decimal
+	Date          time.Time
+}
+
+// RateProvider is the fallback source of rates when none has been uploaded for a currency pair.
+type RateProvider interface {
+	Provider() string
+	// GetRate returns the latest rate effective on or before the given date.
+	// The boolean is false when the provider has no such rate.
+	GetRate(ctx context.Context, baseCurrency, quoteCurrency string, date time.Time) (Rate, bool, error)
+}
+
+func NewRateProvider(cfg config.FX) (RateProvider, error) {
+	switch cfg.RateProvider {
+	case "file":
+		return newFileRateProvider(cfg.RatesFile)
+	default:
+		return nil, ungerr.Unknownf("unsupported fx rate provider: %s", cfg.RateProvider)
+	}
+}
@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/itsLeonB/cashback/internal/core/otel"
+	"github.com/itsLeonB/cashback/internal/domain/dto"
+	"github.com/itsLeonB/cashback/internal/domain/entity"
+	"github.com/itsLeonB/cashback/internal/domain/entity/debts"
+	"github.com/itsLeonB/cashback/internal/domain/mapper"
+	"github.com/itsLeonB/cashback/internal/domain/repository"
+	"github.com/itsLeonB/cashback/internal/domain/service/fx"
+	"github.com/itsLeonB/ezutil/v2"
+	"github.com/itsLeonB/ungerr"
+)
+
+const defaultFxRateLimit = 100
+
+type fxRateServiceImpl struct {
+	fxRateRepo   repository.FxRateRepository
+	rateProvider fx.RateProvider
+}
+
+func NewFxRateService(
+	fxRateRepo repository.FxRateRepository,
+	rateProvider fx.RateProvider,
+) FxRateService {
+	return &fxRateServiceImpl{
+		fxRateRepo,
+		rateProvider,
+	}
+}
+
+func (frs *fxRateServiceImpl) Upload(ctx context.Context, req dto.UploadFxRatesRequest) ([]dto.FxRateResponse, error) {
+	ctx, span := otel.Tracer.Start(ctx, "FxRateService.Upload")
+	defer span.End()
+
+	rates := make([]entity.FxRate, 0, len(req.Rates))
+	for _, rate := range req.Rates {
+		if !rate.Rate.IsPositive() {
+			return nil, ungerr.ValidationError(fmt.Sprintf("rate for %s/%s must be greater than 0", rate.BaseCurrency, rate.QuoteCurrency))
+		}
+		effectiveDate, err := time.Parse(fx.DateLayout, rate.EffectiveDate)
+		if err != nil {
+			return nil, ungerr.ValidationError(fmt.Sprintf("invalid effective date: %s", rate.EffectiveDate))
+		}
+		rates = append(rates, entity.FxRate{
+			BaseCurrency:  strings.ToUpper(rate.BaseCurrency),
+			QuoteCurrency: strings.ToUpper(rate.QuoteCurrency),
+			Rate:          rate.Rate,
+			EffectiveDate: effectiveDate,
+		})
+	}
+
+	savedRates, err := frs.fxRateRepo.Upsert(ctx, rates)
+	if err != nil {
+		return nil, err
+	}
+
+	return ezutil.MapSlice(savedRates, mapper.FxRateToResponse), nil
+}
+
+func (frs *fxRateServiceImpl) GetList(ctx context.Context, query dto.FxRateQuery) ([]dto.FxRateResponse, int64, error) {
+	ctx, span := otel.Tracer.Start(ctx, "FxRateService.GetList")
+	defer span.End()
+
+	limit := query.Limit
+	if limit == 0 {
+		limit = defaultFxRateLimit
+	}
+
+	rates, total, err := frs.fxRateRepo.FindPage(ctx, limit, query.Offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return ezutil.MapSlice(rates, mapper.FxRateToResponse), total, nil
+}
+
+func (frs *fxRateServiceImpl) ConvertTransactions(ctx context.Context, transactions []debts.DebtTransaction, currency string) ([]debts.DebtTransaction, error) {
+	ctx, span := otel.Tracer.Start(ctx, "FxRateService.ConvertTransactions")
+	defer span.End()
+
+	ratesByKey := make(map[string]fx.Rate)
+	converted := make([]debts.DebtTransaction, 0, len(transactions))
+	for _, tx := range transactions {
+		if tx.Currency == currency {
+			converted = append(converted, tx)
+			continue
+		}
+
+		date := tx.CreatedAt.UTC().Truncate(24 * time.Hour)
+		key := tx.Currency + "/" + date.Format(fx.DateLayout)
+		rate, ok := ratesByKey[key]
+		if !ok {
+			var err error
+			if rate, err = frs.getRate(ctx, tx.Currency, currency, date); err != nil {
+				return nil, err
+			}
+			ratesByKey[key] = rate
+		}
+
+		tx.Amount = fx.Convert(tx.Amount, tx.Currency, currency, rate)
+		tx.Currency = currency
+		converted = append(converted, tx)
+	}
+
+	return converted, nil
+}
+
+// getRate looks up the rate effective on the date, preferring uploaded rates over the provider
+// and falling back to the inverse pair when the direct one is missing.
+func (frs *fxRateServiceImpl) getRate(ctx context.Context, fromCurrency, toCurrency string, date time.Time) (fx.Rate, error) {
+	pairs := [][2]string{{fromCurrency, toCurrency}, {toCurrency, fromCurrency}}
+
+	for _, pair := range pairs {
+		rate, err := frs.fxRateRepo.FindLatest(ctx, pair[0], pair[1], date)
+		if err != nil {
+			return fx.Rate{}, err
+		}
+		if !rate.IsZero() {
+			return mapper.FxRateToRate(rate), nil
+		}
+	}
+
+	for _, pair := range pairs {
+		rate, found, err := frs.rateProvider.GetRate(ctx, pair[0], pair[1], date)
+		if err != nil {
+			return fx.Rate{}, err
+		}
+		if found {
+			return rate, nil
+		}
+	}
+
+	return fx.Rate{}, ungerr.UnprocessableEntityError(fmt.Sprintf("no %s/%s exchange rate is available on %s", fromCurrency, toCurrency, date.Format(fx.DateLayout)))
+}
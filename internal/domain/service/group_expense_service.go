@@ -6,15 +6,15 @@ import (
 	"errors"
 	"fmt"
 	"slices"
-	"strings"
+	"time"
 
 	mapset "github.com/deckarep/golang-set/v2"
 	"github.com/google/uuid"
 	"github.com/itsLeonB/cashback/internal/appconstant"
 	"github.com/itsLeonB/cashback/internal/core/logger"
 	"github.com/itsLeonB/cashback/internal/core/otel"
-	"github.com/itsLeonB/cashback/internal/core/service/langfuse"
 	"github.com/itsLeonB/cashback/internal/core/service/llm"
+	"github.com/itsLeonB/cashback/internal/core/service/prompt"
 	"github.com/itsLeonB/cashback/internal/core/service/queue"
 	"github.com/itsLeonB/cashback/internal/core/service/storage"
 	"github.com/itsLeonB/cashback/internal/domain/dto"
@@ -23,6 +23,7 @@ import (
 	"github.com/itsLeonB/cashback/internal/domain/mapper"
 	"github.com/itsLeonB/cashback/internal/domain/message"
 	"github.com/itsLeonB/cashback/internal/domain/repository"
+	"github.com/itsLeonB/cashback/internal/domain/service/audit"
 	"github.com/itsLeonB/cashback/internal/domain/service/expense"
 	"github.com/itsLeonB/cashback/internal/domain/service/expense/billparse"
 	"github.com/itsLeonB/cashback/internal/domain/service/fee"
@@ -30,26 +31,31 @@ import (
 	"github.com/itsLeonB/go-crud"
 	"github.com/itsLeonB/ungerr"
 	"github.com/shopspring/decimal"
+	"gorm.io/datatypes"
 )
 
 type groupExpenseServiceImpl struct {
 	friendshipService     FriendshipService
 	expenseRepo           repository.GroupExpenseRepository
+	debtTransactionRepo   repository.DebtTransactionRepository
 	transactor            crud.Transactor
 	feeCalculatorRegistry map[expenses.FeeCalculationMethod]fee.FeeCalculator
 	otherFeeRepository    repository.OtherFeeRepository
 	billRepo              crud.Repository[expenses.ExpenseBill]
-	llmService            llm.LLMService
+	billParser            *billparse.Parser
 	calculationSvc        expense.CalculationService
 	imageSvc              storage.ImageService
 	taskQueue             queue.TaskQueue
-	langfuseClient        langfuse.Client
 	profileSvc            ProfileService
+	categorySvc           CategoryService
+	groupSvc              GroupService
+	auditSvc              audit.Service
 }
 
 func NewGroupExpenseService(
 	friendshipService FriendshipService,
 	expenseRepo repository.GroupExpenseRepository,
+	debtTransactionRepo repository.DebtTransactionRepository,
 	transactor crud.Transactor,
 	feeCalculatorRegistry map[expenses.FeeCalculationMethod]fee.FeeCalculator,
 	otherFeeRepository repository.OtherFeeRepository,
@@ -57,22 +63,28 @@ func NewGroupExpenseService(
 	llmService llm.LLMService,
 	imageSvc storage.ImageService,
 	taskQueue queue.TaskQueue,
-	langfuseClient langfuse.Client,
+	promptRegistry prompt.Registry,
 	profileSvc ProfileService,
+	categorySvc CategoryService,
+	groupSvc GroupService,
+	auditSvc audit.Service,
 ) GroupExpenseService {
 	return &groupExpenseServiceImpl{
 		friendshipService,
 		expenseRepo,
+		debtTransactionRepo,
 		transactor,
 		feeCalculatorRegistry,
 		otherFeeRepository,
 		billRepo,
-		llmService,
+		billparse.NewParser(billparse.ActiveBillParsePrompt, promptRegistry, llmService),
 		expense.NewCalculationService(),
 		imageSvc,
 		taskQueue,
-		langfuseClient,
 		profileSvc,
+		categorySvc,
+		groupSvc,
+		auditSvc,
 	}
 }
 
@@ -90,19 +102,167 @@ func (ges *groupExpenseServiceImpl) CreateDraft(ctx context.Context, req dto.New
 		currency = profile.HomeCurrency
 	}
 
+	category, err := usableCategory(ctx, ges.categorySvc, req.UserProfileID, req.CategoryID)
+	if err != nil {
+		return dto.GroupExpenseResponse{}, err
+	}
+
 	newDraftExpense := expenses.GroupExpense{
 		CreatorProfileID: req.UserProfileID,
 		Description:      req.Description,
 		Status:           expenses.DraftExpense,
 		Currency:         currency,
+		CategoryID:       mapper.CategoryIDToEntity(category.ID),
+		Tags:             mapper.TagsToEntity(req.Tags),
+	}
+
+	if req.GroupID != uuid.Nil {
+		if err = ges.startInGroup(ctx, &newDraftExpense, req.GroupID, req.Currency == ""); err != nil {
+			return dto.GroupExpenseResponse{}, err
+		}
 	}
 
 	insertedDraftExpense, err := ges.expenseRepo.Insert(ctx, newDraftExpense)
 	if err != nil {
 		return dto.GroupExpenseResponse{}, err
 	}
+	if insertedDraftExpense.GroupID.Valid {
+		// loads the profiles of the participants it started with
+		return ges.GetDetails(ctx, insertedDraftExpense.ID, req.UserProfileID)
+	}
+	insertedDraftExpense.Category = category
+
+	return mapper.GroupExpenseToResponse(insertedDraftExpense, req.UserProfileID, nil, false), nil
+}
+
+// startInGroup files a new draft under the group, paid by its creator and shared with the members
+// the creator is friends with, so that they do not have to be selected again.
+func (ges *groupExpenseServiceImpl) startInGroup(ctx context.Context, draft *expenses.GroupExpense, groupID uuid.UUID, useGroupCurrency bool) error {
+	group, err := ges.groupSvc.GetMembership(ctx, draft.CreatorProfileID, groupID)
+	if err != nil {
+		return err
+	}
 
-	return mapper.GroupExpenseToResponse(insertedDraftExpense, req.UserProfileID, "", false), nil
+	draft.GroupID = uuid.NullUUID{UUID: group.ID, Valid: true}
+	draft.PayerProfileID = uuid.NullUUID{UUID: draft.CreatorProfileID, Valid: true}
+	if useGroupCurrency {
+		draft.Currency = group.DefaultCurrency
+	}
+
+	for _, member := range group.Members {
+		if member.ProfileID != draft.CreatorProfileID {
+			isFriends, _, err := ges.friendshipService.IsFriends(ctx, draft.CreatorProfileID, member.ProfileID)
+			if err != nil {
+				return err
+			}
+			if !isFriends {
+				continue
+			}
+		}
+		draft.Participants = append(draft.Participants, expenses.ExpenseParticipant{ParticipantProfileID: member.ProfileID})
+	}
+
+	return nil
+}
+
+// CreateFromTemplate inserts a new expense stamped out from a recurring template, with its items already allocated.
+func (ges *groupExpenseServiceImpl) CreateFromTemplate(ctx context.Context, creatorProfileID uuid.UUID, template dto.RecurringGroupExpense) (expenses.GroupExpense, error) {
+	ctx, span := otel.Tracer.Start(ctx, "GroupExpenseService.CreateFromTemplate")
+	defer span.End()
+
+	groupExpense, err := ges.buildFromTemplate(ctx, creatorProfileID, template)
+	if err != nil {
+		return expenses.GroupExpense{}, err
+	}
+
+	return ges.expenseRepo.Insert(ctx, groupExpense)
+}
+
+// CreateImported records an expense from another app as already confirmed, dated when it originally happened.
+// Its debts are left to the caller, as they should carry the same date.
+func (ges *groupExpenseServiceImpl) CreateImported(ctx context.Context, creatorProfileID uuid.UUID, template dto.RecurringGroupExpense, occurredAt time.Time) (expenses.GroupExpense, error) {
+	ctx, span := otel.Tracer.Start(ctx, "GroupExpenseService.CreateImported")
+	defer span.End()
+
+	var groupExpense expenses.GroupExpense
+	err := ges.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		builtExpense, err := ges.buildFromTemplate(ctx, creatorProfileID, template)
+		if err != nil {
+			return err
+		}
+		if err = validateForConfirmation(builtExpense); err != nil {
+			return err
+		}
+
+		builtExpense.CreatedAt = occurredAt
+		groupExpense, err = ges.expenseRepo.Insert(ctx, builtExpense)
+		if err != nil {
+			return err
+		}
+
+		updatedParticipants, err := ges.calculateUpdatedExpenseParticipants(ctx, groupExpense)
+		if err != nil {
+			return err
+		}
+
+		groupExpense.Status = expenses.ConfirmedExpense
+		groupExpense.Processed = true
+		groupExpense, err = ges.expenseRepo.Update(ctx, groupExpense)
+		if err != nil {
+			return err
+		}
+
+		if err = ges.expenseRepo.SyncParticipants(ctx, groupExpense.ID, updatedParticipants); err != nil {
+			return err
+		}
+
+		groupExpense.Participants = updatedParticipants
+		return ges.auditSvc.Record(ctx, audit.Entry{
+			Actor:      audit.Profile(creatorProfileID),
+			Action:     entity.CreateAuditAction,
+			EntityType: entity.GroupExpenseAuditEntity,
+			EntityID:   groupExpense.ID,
+			After:      mapper.ToConfirmationResponse(groupExpense, creatorProfileID),
+		})
+	})
+	return groupExpense, err
+}
+
+func (ges *groupExpenseServiceImpl) buildFromTemplate(ctx context.Context, creatorProfileID uuid.UUID, template dto.RecurringGroupExpense) (expenses.GroupExpense, error) {
+	if len(template.Payers) > 0 && template.PayerProfileID == uuid.Nil {
+		template.PayerProfileID = largestPayerID(template.Payers)
+	}
+
+	participants, _, err := ges.validateAndGetParticipants(ctx, dto.ExpenseParticipantsRequest{
+		ParticipantProfileIDs: template.ParticipantProfileIDs,
+		ProxyByProfileIDs:     template.ProxyByProfileIDs,
+		PayerProfileID:        template.PayerProfileID,
+		Payers:                template.Payers,
+		UserProfileID:         creatorProfileID,
+	})
+	if err != nil {
+		return expenses.GroupExpense{}, err
+	}
+
+	if _, err = usableCategory(ctx, ges.categorySvc, creatorProfileID, template.CategoryID); err != nil {
+		return expenses.GroupExpense{}, err
+	}
+
+	groupExpense := mapper.RecurringGroupExpenseToEntity(template)
+	groupExpense.CreatorProfileID = creatorProfileID
+	groupExpense.PayerProfileID = uuid.NullUUID{UUID: template.PayerProfileID, Valid: true}
+	groupExpense.Status = expenses.DraftExpense
+	groupExpense.Participants = participants
+
+	if groupExpense.Currency == "" {
+		profile, err := ges.profileSvc.GetEntityByID(ctx, creatorProfileID)
+		if err != nil {
+			return expenses.GroupExpense{}, err
+		}
+		groupExpense.Currency = profile.HomeCurrency
+	}
+
+	return expense.BuildFromTemplate(groupExpense)
 }
 
 func (ges *groupExpenseServiceImpl) GetAll(ctx context.Context, userProfileID uuid.UUID, ownership expenses.ExpenseOwnership, status expenses.ExpenseStatus) ([]dto.GroupExpenseResponse, error) {
@@ -114,7 +274,7 @@ func (ges *groupExpenseServiceImpl) GetAll(ctx context.Context, userProfileID uu
 		return nil, err
 	}
 
-	return ezutil.MapSlice(groupExpenses, mapper.GroupExpenseSimpleMapper(userProfileID, "", false)), nil
+	return ezutil.MapSlice(groupExpenses, mapper.GroupExpenseSimpleMapper(userProfileID, nil, false)), nil
 }
 
 func (ges *groupExpenseServiceImpl) GetDetails(ctx context.Context, id, userProfileID uuid.UUID) (dto.GroupExpenseResponse, error) {
@@ -144,15 +304,12 @@ func (ges *groupExpenseServiceImpl) GetDetails(ctx context.Context, id, userProf
 		return dto.GroupExpenseResponse{}, ungerr.NotFoundError("expense not found")
 	}
 
-	var billURL string
-	if !groupExpense.Bill.IsZero() {
-		billURL, err = ges.imageSvc.GetURL(ObjectKeyToFileID(groupExpense.Bill.ImageName))
-		if err != nil {
-			logger.Errorf("error retrieving bill image URL: %v", err)
-		}
+	billURLs, err := billPageURLs(ges.imageSvc, groupExpense.Bill)
+	if err != nil {
+		logger.Errorf("error retrieving bill image URL: %v", err)
 	}
 
-	return mapper.GroupExpenseToResponse(groupExpense, userProfileID, billURL, groupExpense.Status == expenses.ConfirmedExpense), nil
+	return mapper.GroupExpenseToResponse(groupExpense, userProfileID, billURLs, groupExpense.Status == expenses.ConfirmedExpense), nil
 }
 
 func (ges *groupExpenseServiceImpl) ConfirmDraft(ctx context.Context, id, profileID uuid.UUID, dryRun bool) (dto.ExpenseConfirmationResponse, error) {
@@ -176,6 +333,8 @@ func (ges *groupExpenseServiceImpl) ConfirmDraft(ctx context.Context, id, profil
 			return err
 		}
 
+		before := mapper.ToConfirmationResponse(groupExpense, profileID)
+
 		updatedParticipants, err := ges.calculateUpdatedExpenseParticipants(ctx, groupExpense)
 		if err != nil {
 			return err
@@ -202,7 +361,18 @@ func (ges *groupExpenseServiceImpl) ConfirmDraft(ctx context.Context, id, profil
 		}
 
 		response = mapper.ToConfirmationResponse(groupExpense, profileID)
-		return nil
+		if dryRun {
+			return nil
+		}
+
+		return ges.auditSvc.Record(ctx, audit.Entry{
+			Actor:      audit.Profile(profileID),
+			Action:     entity.ConfirmAuditAction,
+			EntityType: entity.GroupExpenseAuditEntity,
+			EntityID:   groupExpense.ID,
+			Before:     before,
+			After:      response,
+		})
 	})
 	return response, err
 }
@@ -220,6 +390,19 @@ func validateForConfirmation(groupExpense expenses.GroupExpense) error {
 	if !groupExpense.PayerProfileID.Valid {
 		return ungerr.UnprocessableEntityError("no payer is selected")
 	}
+	if len(groupExpense.Payers) > 0 {
+		paidTotal := decimal.Zero
+		for _, payer := range groupExpense.Payers {
+			paidTotal = paidTotal.Add(payer.PaidAmount)
+		}
+		if !paidTotal.Equal(groupExpense.TotalAmount) {
+			return ungerr.UnprocessableEntityError(fmt.Sprintf(
+				"paid amounts add up to %s, but the expense total is %s",
+				paidTotal.String(),
+				groupExpense.TotalAmount.String(),
+			))
+		}
+	}
 	return nil
 }
 
@@ -313,14 +496,80 @@ func (ges *groupExpenseServiceImpl) Delete(ctx context.Context, userProfileID, i
 		if err != nil {
 			return err
 		}
+		if expense.Revision > 0 {
+			return ungerr.UnprocessableEntityError("amended expense already has debts recorded, confirm it instead")
+		}
 		return ges.expenseRepo.Delete(ctx, expense)
 	})
 }
 
+// Amend reopens a confirmed expense as a new revision, so it can be edited like a draft.
+// Re-confirming it records the differences against the debts of the previous revision.
+func (ges *groupExpenseServiceImpl) Amend(ctx context.Context, id, userProfileID uuid.UUID) (dto.GroupExpenseResponse, error) {
+	ctx, span := otel.Tracer.Start(ctx, "GroupExpenseService.Amend")
+	defer span.End()
+
+	var response dto.GroupExpenseResponse
+	err := ges.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		spec := crud.Specification[expenses.GroupExpense]{}
+		spec.Model.ID = id
+		spec.Model.CreatorProfileID = userProfileID
+		spec.ForUpdate = true
+		spec.PreloadRelations = spec.Model.ForDisplayRelations()
+
+		groupExpense, err := ges.getGroupExpense(ctx, spec)
+		if err != nil {
+			return err
+		}
+		if groupExpense.Status != expenses.ConfirmedExpense {
+			return ungerr.UnprocessableEntityError("only confirmed expenses can be amended")
+		}
+		if !groupExpense.Processed {
+			return ungerr.UnprocessableEntityError("expense is still being processed, try again later")
+		}
+
+		before := mapper.GroupExpenseToResponse(groupExpense, userProfileID, nil, false)
+
+		groupExpense.Status = expenses.ReadyExpense
+		groupExpense.Processed = false
+		groupExpense.Revision++
+		if _, err = ges.expenseRepo.Update(ctx, groupExpense); err != nil {
+			return err
+		}
+
+		response = mapper.GroupExpenseToResponse(groupExpense, userProfileID, nil, false)
+
+		// Only the reopening is in After, so Before keeps the whole expense as confirmed
+		// until the amended one is confirmed again
+		return ges.auditSvc.Record(ctx, audit.Entry{
+			Actor:      audit.Profile(userProfileID),
+			Action:     entity.UpdateAuditAction,
+			EntityType: entity.GroupExpenseAuditEntity,
+			EntityID:   groupExpense.ID,
+			Before:     before,
+			After: amendedExpense{
+				Status:   response.Status,
+				Revision: response.Revision,
+			},
+		})
+	})
+	return response, err
+}
+
+// amendedExpense is what Amend changes on a confirmed expense.
+type amendedExpense struct {
+	Status   string `json:"status"`
+	Revision int    `json:"revision"`
+}
+
 func (ges *groupExpenseServiceImpl) SyncParticipants(ctx context.Context, req dto.ExpenseParticipantsRequest) error {
 	ctx, span := otel.Tracer.Start(ctx, "GroupExpenseService.SyncParticipants")
 	defer span.End()
 
+	if len(req.Payers) > 0 && req.PayerProfileID == uuid.Nil {
+		req.PayerProfileID = largestPayerID(req.Payers)
+	}
+
 	participants, profileIDs, err := ges.validateAndGetParticipants(ctx, req)
 	if err != nil {
 		return err
@@ -332,6 +581,16 @@ func (ges *groupExpenseServiceImpl) SyncParticipants(ctx context.Context, req dt
 			return err
 		}
 
+		if expense.GroupID.Valid {
+			if err = ges.checkGroupMembers(ctx, expense.GroupID.UUID, profileIDs); err != nil {
+				return err
+			}
+		}
+
+		if err = ges.expenseRepo.SyncPayers(ctx, expense.ID, ezutil.MapSlice(req.Payers, mapper.ExpensePayerRequestToEntity)); err != nil {
+			return err
+		}
+
 		expense.PayerProfileID = uuid.NullUUID{
 			UUID:  req.PayerProfileID,
 			Valid: true,
@@ -355,8 +614,9 @@ func (ges *groupExpenseServiceImpl) validateAndGetParticipants(ctx context.Conte
 	if participantSet.Cardinality() != len(req.ParticipantProfileIDs) {
 		return nil, nil, ungerr.UnprocessableEntityError("duplicate participant profile IDs given")
 	}
-	if !participantSet.Contains(req.PayerProfileID) {
-		return nil, nil, ungerr.UnprocessableEntityError("payer profile ID must be one of the participant profile IDs")
+	payerSet, err := validatePayers(participantSet, req)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	if err := ges.checkFriendships(ctx, req.ParticipantProfileIDs, req.UserProfileID); err != nil {
@@ -364,7 +624,7 @@ func (ges *groupExpenseServiceImpl) validateAndGetParticipants(ctx context.Conte
 	}
 
 	if req.ProxyByProfileIDs != nil {
-		if err := ges.validateProxies(participantSet, req.ProxyByProfileIDs, req.PayerProfileID); err != nil {
+		if err := ges.validateProxies(participantSet, req.ProxyByProfileIDs, payerSet); err != nil {
 			return nil, nil, err
 		}
 	}
@@ -389,15 +649,56 @@ func (ges *groupExpenseServiceImpl) validateAndGetParticipants(ctx context.Conte
 	return participants, participantSlice, nil
 }
 
-func (ges *groupExpenseServiceImpl) validateProxies(participantSet mapset.Set[uuid.UUID], proxyByProfileIDs map[uuid.UUID]uuid.UUID, payerProfileID uuid.UUID) error {
+// validatePayers returns the set of profiles paying for the expense. With no explicit payers,
+// the single payer is deemed to have paid the whole expense.
+func validatePayers(participantSet mapset.Set[uuid.UUID], req dto.ExpenseParticipantsRequest) (mapset.Set[uuid.UUID], error) {
+	if !participantSet.Contains(req.PayerProfileID) {
+		return nil, ungerr.UnprocessableEntityError("payer profile ID must be one of the participant profile IDs")
+	}
+
+	if len(req.Payers) == 0 {
+		return mapset.NewSet(req.PayerProfileID), nil
+	}
+
+	payerSet := mapset.NewSetWithSize[uuid.UUID](len(req.Payers))
+	for _, payer := range req.Payers {
+		if !payer.Amount.IsPositive() {
+			return nil, ungerr.UnprocessableEntityError(fmt.Sprintf("payer %s must pay more than 0", payer.ProfileID))
+		}
+		if !participantSet.Contains(payer.ProfileID) {
+			return nil, ungerr.UnprocessableEntityError(fmt.Sprintf("payer %s must be one of the participant profile IDs", payer.ProfileID))
+		}
+		if !payerSet.Add(payer.ProfileID) {
+			return nil, ungerr.UnprocessableEntityError("duplicate payer profile IDs given")
+		}
+	}
+	if !payerSet.Contains(req.PayerProfileID) {
+		return nil, ungerr.UnprocessableEntityError("payer profile ID must be one of the payers")
+	}
+
+	return payerSet, nil
+}
+
+// largestPayerID picks the primary payer of a multi-payer expense, shown where only one payer fits.
+func largestPayerID(payers []dto.ExpensePayerRequest) uuid.UUID {
+	largest := payers[0]
+	for _, payer := range payers[1:] {
+		if payer.Amount.GreaterThan(largest.Amount) {
+			largest = payer
+		}
+	}
+	return largest.ProfileID
+}
+
+func (ges *groupExpenseServiceImpl) validateProxies(participantSet mapset.Set[uuid.UUID], proxyByProfileIDs map[uuid.UUID]uuid.UUID, payerSet mapset.Set[uuid.UUID]) error {
 	for id, proxyID := range proxyByProfileIDs {
 		if proxyID == id {
 			return ungerr.UnprocessableEntityError("proxy cannot be the same as participant")
 		}
-		if proxyID == payerProfileID {
+		if payerSet.Contains(proxyID) {
 			return ungerr.UnprocessableEntityError("proxy cannot be the payer")
 		}
-		if id == payerProfileID {
+		if payerSet.Contains(id) {
 			return ungerr.UnprocessableEntityError("payer cannot have a proxy")
 		}
 		if _, chained := proxyByProfileIDs[proxyID]; chained {
@@ -429,6 +730,20 @@ func (ges *groupExpenseServiceImpl) checkFriendships(ctx context.Context, partic
 	return nil
 }
 
+// checkGroupMembers keeps the participants of a group's expense within the group.
+func (ges *groupExpenseServiceImpl) checkGroupMembers(ctx context.Context, groupID uuid.UUID, participantProfileIDs []uuid.UUID) error {
+	group, err := ges.groupSvc.GetByID(ctx, groupID)
+	if err != nil {
+		return err
+	}
+	for _, pid := range participantProfileIDs {
+		if !group.HasMember(pid) {
+			return ungerr.UnprocessableEntityError(fmt.Sprintf("participant %s is not a member of the group", pid))
+		}
+	}
+	return nil
+}
+
 func (ges *groupExpenseServiceImpl) getGroupExpense(ctx context.Context, spec crud.Specification[expenses.GroupExpense]) (expenses.GroupExpense, error) {
 	groupExpense, err := ges.expenseRepo.FindFirst(ctx, spec)
 	if err != nil {
@@ -450,7 +765,7 @@ func (ges *groupExpenseServiceImpl) GetUnconfirmedForUpdate(ctx context.Context,
 		spec.Model.CreatorProfileID = profileID
 	}
 	spec.ForUpdate = true
-	spec.PreloadRelations = []string{"Items", "Items.Participants", "Bill"}
+	spec.PreloadRelations = []string{"Items", "Items.Participants", "Bill", "Bill.Pages"}
 	groupExpense, err := ges.getGroupExpense(ctx, spec)
 	if err != nil {
 		return expenses.GroupExpense{}, err
@@ -480,7 +795,7 @@ func (ges *groupExpenseServiceImpl) ParseFromBillText(ctx context.Context, msg m
 }
 
 func (ges *groupExpenseServiceImpl) parseFlow(ctx context.Context, expenseBill expenses.ExpenseBill) error {
-	status, err := ges.processAndGetStatus(ctx, expenseBill)
+	status, err := ges.processAndGetStatus(ctx, &expenseBill)
 	expenseBill.Status = status
 	_, statusErr := ges.billRepo.Update(ctx, expenseBill)
 	if statusErr != nil {
@@ -489,8 +804,11 @@ func (ges *groupExpenseServiceImpl) parseFlow(ctx context.Context, expenseBill e
 	return err
 }
 
-func (ges *groupExpenseServiceImpl) processAndGetStatus(ctx context.Context, expenseBill expenses.ExpenseBill) (expenses.BillStatus, error) {
-	request, err := ges.parseExpenseBillTextToExpenseRequest(ctx, expenseBill.ExtractedText)
+// processAndGetStatus stores what was parsed as a proposal on the bill. It only lands in the draft once reviewed.
+func (ges *groupExpenseServiceImpl) processAndGetStatus(ctx context.Context, expenseBill *expenses.ExpenseBill) (expenses.BillStatus, error) {
+	result, err := ges.billParser.Parse(ctx, expenseBill.ExtractedText)
+	expenseBill.PromptSource = string(result.PromptSource)
+	expenseBill.PromptVersion = result.PromptVersion
 	if err != nil {
 		if errors.Is(err, expenses.ErrExpenseNotDetected) {
 			return expenses.NotDetectedBill, nil
@@ -498,19 +816,21 @@ func (ges *groupExpenseServiceImpl) processAndGetStatus(ctx context.Context, exp
 		return expenses.FailedParsingBill, err
 	}
 
+	request := &result.Request
 	request.Items = slices.DeleteFunc(request.Items, func(item dto.NewExpenseItemRequest) bool { return item.Amount.Equal(decimal.Zero) })
 	request.OtherFees = slices.DeleteFunc(request.OtherFees, func(fee dto.NewOtherFeeRequest) bool { return fee.Amount.Equal(decimal.Zero) })
 
-	expense, err := ges.GetUnconfirmedForUpdate(ctx, uuid.Nil, expenseBill.GroupExpenseID)
-	if err != nil {
+	if _, err = ges.GetUnconfirmedForUpdate(ctx, uuid.Nil, expenseBill.GroupExpenseID); err != nil {
 		return expenses.FailedParsingBill, err
 	}
 
-	if err = ges.UpdateDraft(ctx, expense, request); err != nil {
-		return expenses.FailedParsingBill, err
+	proposal, err := json.Marshal(billparse.NewProposal(result, expenseBill.ExtractedText))
+	if err != nil {
+		return expenses.FailedParsingBill, ungerr.Wrap(err, "error marshaling bill proposal")
 	}
+	expenseBill.Proposal = datatypes.JSON(proposal)
 
-	return expenses.ParsedBill, nil
+	return expenses.AwaitingReviewBill, nil
 }
 
 func (ges *groupExpenseServiceImpl) UpdateDraft(ctx context.Context, expense expenses.GroupExpense, request dto.NewGroupExpenseRequest) error {
@@ -567,7 +887,40 @@ func (ges *groupExpenseServiceImpl) GetRecent(ctx context.Context, profileID uui
 		return nil, err
 	}
 
-	return ezutil.MapSlice(expenses, mapper.GroupExpenseSimpleMapper(profileID, "", false)), nil
+	return ezutil.MapSlice(expenses, mapper.GroupExpenseSimpleMapper(profileID, nil, false)), nil
+}
+
+// UpdateLabels sets the category and tags of an expense. Only its creator may label it, but
+// as labels do not change any amounts, confirmed expenses can be labeled too.
+func (ges *groupExpenseServiceImpl) UpdateLabels(ctx context.Context, req dto.LabelsRequest) (dto.GroupExpenseResponse, error) {
+	ctx, span := otel.Tracer.Start(ctx, "GroupExpenseService.UpdateLabels")
+	defer span.End()
+
+	err := ges.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		spec := crud.Specification[expenses.GroupExpense]{}
+		spec.Model.ID = req.ID
+		spec.Model.CreatorProfileID = req.ProfileID
+		spec.ForUpdate = true
+		groupExpense, err := ges.getGroupExpense(ctx, spec)
+		if err != nil {
+			return err
+		}
+
+		category, err := usableCategory(ctx, ges.categorySvc, req.ProfileID, req.CategoryID)
+		if err != nil {
+			return err
+		}
+
+		groupExpense.CategoryID = mapper.CategoryIDToEntity(category.ID)
+		groupExpense.Tags = mapper.TagsToEntity(req.Tags)
+		_, err = ges.expenseRepo.Update(ctx, groupExpense)
+		return err
+	})
+	if err != nil {
+		return dto.GroupExpenseResponse{}, err
+	}
+
+	return ges.GetDetails(ctx, req.ID, req.ProfileID)
 }
 
 func (ges *groupExpenseServiceImpl) validate(request dto.NewGroupExpenseRequest) error {
@@ -593,43 +946,6 @@ func (ges *groupExpenseServiceImpl) validate(request dto.NewGroupExpenseRequest)
 	return nil
 }
 
-func (ges *groupExpenseServiceImpl) parseExpenseBillTextToExpenseRequest(
-	ctx context.Context, text string,
-) (dto.NewGroupExpenseRequest, error) {
-	// 1. Pre-process and normalize numeric strings
-	normalizedText := billparse.Normalize(text)
-
-	p := billparse.ActiveBillParsePrompt
-
-	prompt, err := ges.langfuseClient.GetPrompt(ctx, p.PromptName, p.GetOptions())
-	if err != nil {
-		return dto.NewGroupExpenseRequest{}, err
-	}
-
-	msgs, err := prompt.Compile(p.CompileVars(string(expenses.NotDetectedBill), normalizedText))
-	if err != nil {
-		return dto.NewGroupExpenseRequest{}, err
-	}
-
-	llmMsgs := make([]llm.ChatMessage, 0, len(msgs))
-	for _, m := range msgs {
-		llmMsgs = append(llmMsgs, llm.ChatMessage{Role: m.Role, Content: m.Content})
-	}
-
-	raw, err := ges.llmService.Chat(ctx, llmMsgs)
-	if err != nil {
-		return dto.NewGroupExpenseRequest{}, err
-	}
-	logger.Debugf("prompt response: %s", raw)
-
-	if strings.TrimSpace(raw) == string(expenses.NotDetectedBill) {
-		logger.Info("group expense not detected")
-		return dto.NewGroupExpenseRequest{}, expenses.ErrExpenseNotDetected
-	}
-
-	return p.ParseResponse(raw)
-}
-
 func (ges *groupExpenseServiceImpl) getPendingForProcessingExpenseBill(ctx context.Context, id uuid.UUID) (expenses.ExpenseBill, error) {
 	spec := crud.Specification[expenses.ExpenseBill]{}
 	spec.Model.ID = id
@@ -656,6 +972,14 @@ func (ges *groupExpenseServiceImpl) GetByID(ctx context.Context, id uuid.UUID, f
 	return ges.getGroupExpense(ctx, spec)
 }
 
+// GetByIDs loads the expenses with their participants only, skipping IDs that do not exist.
+func (ges *groupExpenseServiceImpl) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]expenses.GroupExpense, error) {
+	ctx, span := otel.Tracer.Start(ctx, "GroupExpenseService.GetByIDs")
+	defer span.End()
+
+	return ges.expenseRepo.FindAllByIDs(ctx, ids)
+}
+
 func (ges *groupExpenseServiceImpl) ConstructNotifications(ctx context.Context, msg message.ExpenseConfirmed) ([]entity.Notification, error) {
 	ctx, span := otel.Tracer.Start(ctx, "GroupExpenseService.ConstructNotifications")
 	defer span.End()
@@ -665,25 +989,61 @@ func (ges *groupExpenseServiceImpl) ConstructNotifications(ctx context.Context,
 		return nil, err
 	}
 
-	metadata, err := json.Marshal(message.ExpenseConfirmedMetadata{CreatorName: expense.Creator.Name})
+	metadata, err := json.Marshal(message.ExpenseConfirmedMetadata{
+		CreatorName: expense.Creator.Name,
+		Revision:    expense.Revision,
+	})
 	if err != nil {
 		return nil, ungerr.Wrap(err, "error marshaling metadata to json")
 	}
 
-	notifications := make([]entity.Notification, 0, len(expense.Participants))
+	recipientIDs, err := ges.getNotifiedProfileIDs(ctx, expense)
+	if err != nil {
+		return nil, err
+	}
+
+	notifications := make([]entity.Notification, 0, len(recipientIDs))
+	for _, profileID := range recipientIDs {
+		notifications = append(notifications, entity.Notification{
+			ProfileID:  profileID,
+			Type:       msg.Type(),
+			EntityType: "group-expense",
+			EntityID:   msg.ID,
+			Metadata:   metadata,
+		})
+	}
+
+	return notifications, nil
+}
+
+// getNotifiedProfileIDs lists everyone but the creator whose debts the confirmation touches.
+// An amendment can drop a participant and reverse their earlier debts, so the profiles on
+// the debts already recorded for the expense are notified along with the current participants.
+func (ges *groupExpenseServiceImpl) getNotifiedProfileIDs(ctx context.Context, expense expenses.GroupExpense) ([]uuid.UUID, error) {
+	profileIDs := make([]uuid.UUID, 0, len(expense.Participants))
+	seen := mapset.NewSet(expense.CreatorProfileID)
+	add := func(profileID uuid.UUID) {
+		if seen.Add(profileID) {
+			profileIDs = append(profileIDs, profileID)
+		}
+	}
+
 	for _, participant := range expense.Participants {
-		if participant.ParticipantProfileID != expense.CreatorProfileID {
-			notifications = append(notifications, entity.Notification{
-				ProfileID:  participant.ParticipantProfileID,
-				Type:       msg.Type(),
-				EntityType: "group-expense",
-				EntityID:   msg.ID,
-				Metadata:   metadata,
-			})
+		add(participant.ParticipantProfileID)
+	}
+
+	if expense.Revision > 0 {
+		recordedTransactions, err := ges.debtTransactionRepo.FindAllByGroupExpenseIDs(ctx, []uuid.UUID{expense.ID})
+		if err != nil {
+			return nil, err
+		}
+		for _, transaction := range recordedTransactions {
+			add(transaction.LenderProfileID)
+			add(transaction.BorrowerProfileID)
 		}
 	}
 
-	return notifications, nil
+	return profileIDs, nil
 }
 
 func (ges *groupExpenseServiceImpl) ProcessCallback(ctx context.Context, id uuid.UUID, callbackFn func(context.Context, expenses.GroupExpense) error) error {
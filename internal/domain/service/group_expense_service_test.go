@@ -0,0 +1,83 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/domain/entity/debts"
+	"github.com/itsLeonB/cashback/internal/domain/entity/expenses"
+	"github.com/itsLeonB/cashback/internal/domain/message"
+	"github.com/itsLeonB/cashback/internal/domain/service"
+	"github.com/itsLeonB/cashback/internal/domain/service/fee"
+	"github.com/itsLeonB/cashback/internal/mocks"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type groupExpenseServiceMocks struct {
+	expenseRepo         *mocks.MockGroupExpenseRepository
+	debtTransactionRepo *mocks.MockDebtTransactionRepository
+}
+
+func newTestGroupExpenseService(t *testing.T) (service.GroupExpenseService, groupExpenseServiceMocks) {
+	m := groupExpenseServiceMocks{
+		expenseRepo:         mocks.NewMockGroupExpenseRepository(t),
+		debtTransactionRepo: mocks.NewMockDebtTransactionRepository(t),
+	}
+
+	svc := service.NewGroupExpenseService(
+		mocks.NewMockFriendshipService(t),
+		m.expenseRepo,
+		m.debtTransactionRepo,
+		mocks.NewMockTransactor(t),
+		fee.NewFeeCalculatorRegistry(),
+		mocks.NewMockOtherFeeRepository(t),
+		mocks.NewMockExpenseBillRepository(t),
+		mocks.NewMockLLMService(t),
+		mocks.NewMockImageService(t),
+		mocks.NewMockTaskQueue(t),
+		mocks.NewMockRegistry(t),
+		mocks.NewMockProfileService(t),
+		mocks.NewMockCategoryService(t),
+		mocks.NewMockGroupService(t),
+		mocks.NewMockAuditService(t),
+	)
+
+	return svc, m
+}
+
+func TestConstructNotifications_AmendmentNotifiesDroppedParticipant(t *testing.T) {
+	creatorID, keptID, droppedID := uuid.New(), uuid.New(), uuid.New()
+
+	expense := expenses.GroupExpense{
+		CreatorProfileID: creatorID,
+		Revision:         1,
+		Participants: []expenses.ExpenseParticipant{
+			{ParticipantProfileID: creatorID},
+			{ParticipantProfileID: keptID},
+		},
+	}
+	expense.ID = uuid.New()
+
+	// Debts from the first revision, when the dropped participant still had a share
+	recorded := []debts.DebtTransaction{
+		{LenderProfileID: creatorID, BorrowerProfileID: keptID, Currency: "IDR", Amount: decimal.NewFromInt(50000)},
+		{LenderProfileID: creatorID, BorrowerProfileID: droppedID, Currency: "IDR", Amount: decimal.NewFromInt(50000)},
+	}
+
+	svc, m := newTestGroupExpenseService(t)
+	m.expenseRepo.EXPECT().FindFirst(mock.Anything, mock.Anything).Return(expense, nil)
+	m.debtTransactionRepo.EXPECT().FindAllByGroupExpenseIDs(mock.Anything, []uuid.UUID{expense.ID}).Return(recorded, nil)
+
+	notifications, err := svc.ConstructNotifications(context.Background(), message.ExpenseConfirmed{ID: expense.ID})
+	require.NoError(t, err)
+
+	profileIDs := make([]uuid.UUID, 0, len(notifications))
+	for _, notification := range notifications {
+		profileIDs = append(profileIDs, notification.ProfileID)
+	}
+	assert.ElementsMatch(t, []uuid.UUID{keptID, droppedID}, profileIDs)
+}
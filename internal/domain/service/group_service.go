@@ -0,0 +1,378 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	mapset "github.com/deckarep/golang-set/v2"
+	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/appconstant"
+	"github.com/itsLeonB/cashback/internal/core/otel"
+	"github.com/itsLeonB/cashback/internal/domain/dto"
+	"github.com/itsLeonB/cashback/internal/domain/entity/expenses"
+	"github.com/itsLeonB/cashback/internal/domain/mapper"
+	"github.com/itsLeonB/cashback/internal/domain/repository"
+	"github.com/itsLeonB/ezutil/v2"
+	"github.com/itsLeonB/go-crud"
+	"github.com/itsLeonB/ungerr"
+)
+
+const groupActivityLimit = 50
+
+type groupServiceImpl struct {
+	transactor    crud.Transactor
+	groupRepo     repository.GroupRepository
+	expenseRepo   repository.GroupExpenseRepository
+	debtRepo      repository.DebtTransactionRepository
+	friendshipSvc FriendshipService
+	profileSvc    ProfileService
+}
+
+func NewGroupService(
+	transactor crud.Transactor,
+	groupRepo repository.GroupRepository,
+	expenseRepo repository.GroupExpenseRepository,
+	debtRepo repository.DebtTransactionRepository,
+	friendshipSvc FriendshipService,
+	profileSvc ProfileService,
+) GroupService {
+	return &groupServiceImpl{
+		transactor,
+		groupRepo,
+		expenseRepo,
+		debtRepo,
+		friendshipSvc,
+		profileSvc,
+	}
+}
+
+func (gs *groupServiceImpl) Create(ctx context.Context, req dto.NewGroupRequest) (dto.GroupResponse, error) {
+	ctx, span := otel.Tracer.Start(ctx, "GroupService.Create")
+	defer span.End()
+
+	members, err := membersWithCreator(req.ProfileID, req.Members)
+	if err != nil {
+		return dto.GroupResponse{}, err
+	}
+
+	if err = gs.checkFriendships(ctx, req.ProfileID, members, mapset.NewSet[uuid.UUID]()); err != nil {
+		return dto.GroupResponse{}, err
+	}
+
+	currency := req.DefaultCurrency
+	if currency == "" {
+		profile, err := gs.profileSvc.GetEntityByID(ctx, req.ProfileID)
+		if err != nil {
+			return dto.GroupResponse{}, err
+		}
+		currency = profile.HomeCurrency
+	}
+
+	var response dto.GroupResponse
+	err = gs.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		insertedGroup, err := gs.groupRepo.Insert(ctx, expenses.Group{
+			CreatorProfileID: req.ProfileID,
+			Name:             req.Name,
+			DefaultCurrency:  currency,
+			Members:          members,
+		})
+		if err != nil {
+			return err
+		}
+
+		group, err := gs.GetByID(ctx, insertedGroup.ID)
+		if err != nil {
+			return err
+		}
+
+		response = mapper.GroupToResponse(group, req.ProfileID)
+		return nil
+	})
+	return response, err
+}
+
+func (gs *groupServiceImpl) GetAll(ctx context.Context, profileID uuid.UUID) ([]dto.GroupResponse, error) {
+	ctx, span := otel.Tracer.Start(ctx, "GroupService.GetAll")
+	defer span.End()
+
+	groups, err := gs.groupRepo.FindAllByProfileID(ctx, profileID)
+	if err != nil {
+		return nil, err
+	}
+
+	return ezutil.MapSlice(groups, mapper.GroupSimpleMapper(profileID)), nil
+}
+
+func (gs *groupServiceImpl) GetDetails(ctx context.Context, profileID, id uuid.UUID) (dto.GroupResponse, error) {
+	ctx, span := otel.Tracer.Start(ctx, "GroupService.GetDetails")
+	defer span.End()
+
+	group, err := gs.GetMembership(ctx, profileID, id)
+	if err != nil {
+		return dto.GroupResponse{}, err
+	}
+
+	return mapper.GroupToResponse(group, profileID), nil
+}
+
+func (gs *groupServiceImpl) Update(ctx context.Context, req dto.UpdateGroupRequest) (dto.GroupResponse, error) {
+	ctx, span := otel.Tracer.Start(ctx, "GroupService.Update")
+	defer span.End()
+
+	var response dto.GroupResponse
+	err := gs.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if _, err := gs.GetMembership(ctx, req.ProfileID, req.ID); err != nil {
+			return err
+		}
+
+		// members are not preloaded, so that saving the group leaves them alone
+		group, err := gs.getGroup(ctx, req.ID, true, nil)
+		if err != nil {
+			return err
+		}
+
+		group.Name = req.Name
+		group.DefaultCurrency = req.DefaultCurrency
+		if _, err = gs.groupRepo.Update(ctx, group); err != nil {
+			return err
+		}
+
+		if group, err = gs.GetByID(ctx, req.ID); err != nil {
+			return err
+		}
+
+		response = mapper.GroupToResponse(group, req.ProfileID)
+		return nil
+	})
+	return response, err
+}
+
+// SyncMembers replaces the members of the group. Any member can add the profiles they are friends with,
+// but the creator stays, and members with an unsettled balance in the group cannot be removed.
+func (gs *groupServiceImpl) SyncMembers(ctx context.Context, req dto.GroupMembersRequest) (dto.GroupResponse, error) {
+	ctx, span := otel.Tracer.Start(ctx, "GroupService.SyncMembers")
+	defer span.End()
+
+	var response dto.GroupResponse
+	err := gs.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		group, err := gs.getGroup(ctx, req.ID, true, []string{"Members", "Members.Profile"})
+		if err != nil {
+			return err
+		}
+		if !group.HasMember(req.ProfileID) {
+			return ungerr.NotFoundError(fmt.Sprintf("group with ID %s is not found", req.ID))
+		}
+
+		members, err := membersWithCreator(group.CreatorProfileID, req.Members)
+		if err != nil {
+			return err
+		}
+
+		currentIDs := mapset.NewSetWithSize[uuid.UUID](len(group.Members))
+		for _, member := range group.Members {
+			currentIDs.Add(member.ProfileID)
+		}
+		if err = gs.checkFriendships(ctx, req.ProfileID, members, currentIDs); err != nil {
+			return err
+		}
+		if err = gs.checkRemovable(ctx, group, members); err != nil {
+			return err
+		}
+
+		if err = gs.groupRepo.SyncMembers(ctx, group.ID, members); err != nil {
+			return err
+		}
+
+		if group, err = gs.GetByID(ctx, group.ID); err != nil {
+			return err
+		}
+
+		response = mapper.GroupToResponse(group, req.ProfileID)
+		return nil
+	})
+	return response, err
+}
+
+// Delete removes the group. Only its creator can delete it, and its expenses and debts are kept without a group.
+func (gs *groupServiceImpl) Delete(ctx context.Context, profileID, id uuid.UUID) error {
+	ctx, span := otel.Tracer.Start(ctx, "GroupService.Delete")
+	defer span.End()
+
+	return gs.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		group, err := gs.GetMembership(ctx, profileID, id)
+		if err != nil {
+			return err
+		}
+		if group.CreatorProfileID != profileID {
+			return ungerr.ForbiddenError("only the creator can delete the group")
+		}
+
+		return gs.groupRepo.Delete(ctx, group)
+	})
+}
+
+func (gs *groupServiceImpl) GetBalances(ctx context.Context, profileID, id uuid.UUID) ([]dto.GroupBalanceResponse, error) {
+	ctx, span := otel.Tracer.Start(ctx, "GroupService.GetBalances")
+	defer span.End()
+
+	group, err := gs.GetMembership(ctx, profileID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions, err := gs.debtRepo.FindAllByGroupID(ctx, id, -1)
+	if err != nil {
+		return nil, err
+	}
+
+	associatedIDs, err := gs.profileSvc.GetAssociatedIDs(ctx, profileID)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.GroupBalancesToResponse(group, transactions, associatedIDs, profileID), nil
+}
+
+func (gs *groupServiceImpl) GetActivities(ctx context.Context, profileID, id uuid.UUID) ([]dto.GroupActivityResponse, error) {
+	ctx, span := otel.Tracer.Start(ctx, "GroupService.GetActivities")
+	defer span.End()
+
+	group, err := gs.GetMembership(ctx, profileID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	groupExpenses, err := gs.expenseRepo.FindAllByGroupID(ctx, id, groupActivityLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions, err := gs.debtRepo.FindAllByGroupID(ctx, id, groupActivityLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.GroupActivitiesToResponse(group, groupExpenses, transactions, profileID, groupActivityLimit), nil
+}
+
+func (gs *groupServiceImpl) GetByID(ctx context.Context, id uuid.UUID) (expenses.Group, error) {
+	ctx, span := otel.Tracer.Start(ctx, "GroupService.GetByID")
+	defer span.End()
+
+	return gs.getGroup(ctx, id, false, []string{"Creator", "Members", "Members.Profile"})
+}
+
+func (gs *groupServiceImpl) GetMembership(ctx context.Context, profileID, id uuid.UUID) (expenses.Group, error) {
+	ctx, span := otel.Tracer.Start(ctx, "GroupService.GetMembership")
+	defer span.End()
+
+	group, err := gs.GetByID(ctx, id)
+	if err != nil {
+		return expenses.Group{}, err
+	}
+	if !group.HasMember(profileID) {
+		return expenses.Group{}, ungerr.NotFoundError(fmt.Sprintf("group with ID %s is not found", id))
+	}
+
+	return group, nil
+}
+
+func (gs *groupServiceImpl) getGroup(ctx context.Context, id uuid.UUID, forUpdate bool, relations []string) (expenses.Group, error) {
+	spec := crud.Specification[expenses.Group]{}
+	spec.Model.ID = id
+	spec.ForUpdate = forUpdate
+	spec.PreloadRelations = relations
+
+	group, err := gs.groupRepo.FindFirst(ctx, spec)
+	if err != nil {
+		return expenses.Group{}, err
+	}
+	if group.IsZero() {
+		return expenses.Group{}, ungerr.NotFoundError(fmt.Sprintf("group with ID %s is not found", id))
+	}
+
+	return group, nil
+}
+
+// checkFriendships requires the profile to be friends with each member it adds to a group.
+func (gs *groupServiceImpl) checkFriendships(ctx context.Context, profileID uuid.UUID, members []expenses.GroupMember, currentIDs mapset.Set[uuid.UUID]) error {
+	for _, member := range members {
+		if member.ProfileID == profileID || currentIDs.Contains(member.ProfileID) {
+			continue
+		}
+		isFriends, _, err := gs.friendshipSvc.IsFriends(ctx, profileID, member.ProfileID)
+		if err != nil {
+			return err
+		}
+		if !isFriends {
+			return ungerr.UnprocessableEntityError(appconstant.ErrNotFriends)
+		}
+	}
+	return nil
+}
+
+func (gs *groupServiceImpl) checkRemovable(ctx context.Context, group expenses.Group, members []expenses.GroupMember) error {
+	keptIDs := mapset.NewSetWithSize[uuid.UUID](len(members))
+	for _, member := range members {
+		keptIDs.Add(member.ProfileID)
+	}
+
+	var removed []expenses.GroupMember
+	for _, member := range group.Members {
+		if !keptIDs.Contains(member.ProfileID) {
+			removed = append(removed, member)
+		}
+	}
+	if len(removed) == 0 {
+		return nil
+	}
+
+	transactions, err := gs.debtRepo.FindAllByGroupID(ctx, group.ID, -1)
+	if err != nil {
+		return err
+	}
+
+	positions := mapper.NetPositionsByProfile(transactions)
+	for _, member := range removed {
+		for _, amount := range positions[member.ProfileID] {
+			if !amount.IsZero() {
+				return ungerr.UnprocessableEntityError(fmt.Sprintf("%s still has an unsettled balance in the group", member.Profile.Name))
+			}
+		}
+	}
+
+	return nil
+}
+
+// groupOfBoth returns the group when both profiles are its members, where uuid.Nil means no group.
+func groupOfBoth(ctx context.Context, groupSvc GroupService, groupID, userProfileID, friendProfileID uuid.UUID) (expenses.Group, error) {
+	if groupID == uuid.Nil {
+		return expenses.Group{}, nil
+	}
+
+	group, err := groupSvc.GetMembership(ctx, userProfileID, groupID)
+	if err != nil {
+		return expenses.Group{}, err
+	}
+	if !group.HasMember(friendProfileID) {
+		return expenses.Group{}, ungerr.UnprocessableEntityError("friend is not a member of the group")
+	}
+
+	return group, nil
+}
+
+// membersWithCreator maps the requested members, adding the creator if they were left out.
+func membersWithCreator(creatorProfileID uuid.UUID, requests []dto.GroupMemberRequest) ([]expenses.GroupMember, error) {
+	members := make([]expenses.GroupMember, 0, len(requests)+1)
+	seen := mapset.NewSetWithSize[uuid.UUID](len(requests) + 1)
+	for _, req := range requests {
+		if !seen.Add(req.ProfileID) {
+			return nil, ungerr.UnprocessableEntityError("duplicate member profile IDs given")
+		}
+		members = append(members, mapper.GroupMemberRequestToEntity(req))
+	}
+	if !seen.Contains(creatorProfileID) {
+		members = append([]expenses.GroupMember{mapper.GroupMemberRequestToEntity(dto.GroupMemberRequest{ProfileID: creatorProfileID})}, members...)
+	}
+	return members, nil
+}
@@ -0,0 +1,409 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/core/logger"
+	"github.com/itsLeonB/cashback/internal/core/otel"
+	"github.com/itsLeonB/cashback/internal/core/service/queue"
+	"github.com/itsLeonB/cashback/internal/domain/dto"
+	"github.com/itsLeonB/cashback/internal/domain/entity"
+	"github.com/itsLeonB/cashback/internal/domain/entity/debts"
+	"github.com/itsLeonB/cashback/internal/domain/entity/expenses"
+	"github.com/itsLeonB/cashback/internal/domain/mapper"
+	"github.com/itsLeonB/cashback/internal/domain/message"
+	"github.com/itsLeonB/cashback/internal/domain/repository"
+	"github.com/itsLeonB/cashback/internal/domain/service/audit"
+	"github.com/itsLeonB/cashback/internal/domain/service/importer"
+	"github.com/itsLeonB/ezutil/v2"
+	"github.com/itsLeonB/go-crud"
+	"github.com/itsLeonB/ungerr"
+	"gorm.io/datatypes"
+)
+
+// importProgressInterval is how many rows are recorded between progress updates.
+const importProgressInterval = 20
+
+type importServiceImpl struct {
+	transactor        crud.Transactor
+	importRepo        crud.Repository[entity.Import]
+	debtRepo          repository.DebtTransactionRepository
+	profileSvc        ProfileService
+	friendshipSvc     FriendshipService
+	expenseSvc        GroupExpenseService
+	transferMethodSvc TransferMethodService
+	taskQueue         queue.TaskQueue
+	auditSvc          audit.Service
+	parsers           map[entity.ImportSource]importer.Parser
+}
+
+func NewImportService(
+	transactor crud.Transactor,
+	importRepo crud.Repository[entity.Import],
+	debtRepo repository.DebtTransactionRepository,
+	profileSvc ProfileService,
+	friendshipSvc FriendshipService,
+	expenseSvc GroupExpenseService,
+	transferMethodSvc TransferMethodService,
+	taskQueue queue.TaskQueue,
+	auditSvc audit.Service,
+) *importServiceImpl {
+	return &importServiceImpl{
+		transactor,
+		importRepo,
+		debtRepo,
+		profileSvc,
+		friendshipSvc,
+		expenseSvc,
+		transferMethodSvc,
+		taskQueue,
+		auditSvc,
+		importer.NewParserRegistry(),
+	}
+}
+
+func (is *importServiceImpl) Request(ctx context.Context, req dto.NewImportRequest) (dto.ImportResponse, error) {
+	ctx, span := otel.Tracer.Start(ctx, "ImportService.Request")
+	defer span.End()
+
+	if _, ok := is.parsers[req.Source]; !ok {
+		return dto.ImportResponse{}, ungerr.ValidationError(fmt.Sprintf("unsupported import source: %s", req.Source))
+	}
+
+	currency := req.Currency
+	if currency == "" {
+		profile, err := is.profileSvc.GetEntityByID(ctx, req.ProfileID)
+		if err != nil {
+			return dto.ImportResponse{}, err
+		}
+		currency = profile.HomeCurrency
+	}
+
+	var resp dto.ImportResponse
+	err := is.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		insertedImport, err := is.importRepo.Insert(ctx, entity.Import{
+			ProfileID: req.ProfileID,
+			Source:    req.Source,
+			Status:    entity.PendingImport,
+			DryRun:    req.DryRun,
+			SelfName:  req.SelfName,
+			Currency:  currency,
+			Content:   req.Content,
+			Preview:   datatypes.JSON("{}"),
+		})
+		if err != nil {
+			return err
+		}
+
+		if err = is.taskQueue.Enqueue(ctx, message.ImportRequested{ID: insertedImport.ID}); err != nil {
+			return err
+		}
+
+		resp = mapper.ImportToResponse(insertedImport, nil)
+		return nil
+	})
+	return resp, err
+}
+
+// Confirm records a previewed dry run for real, parsing the same file again.
+func (is *importServiceImpl) Confirm(ctx context.Context, profileID, id uuid.UUID) (dto.ImportResponse, error) {
+	ctx, span := otel.Tracer.Start(ctx, "ImportService.Confirm")
+	defer span.End()
+
+	var resp dto.ImportResponse
+	err := is.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		imp, err := is.getImport(ctx, profileID, id, true)
+		if err != nil {
+			return err
+		}
+		if imp.Status != entity.PreviewedImport {
+			return ungerr.UnprocessableEntityError(fmt.Sprintf("only previewed imports can be confirmed, import is %s", imp.Status))
+		}
+
+		imp.Status = entity.PendingImport
+		imp.DryRun = false
+		imp.CompletedAt = sql.NullTime{}
+		if imp, err = is.importRepo.Update(ctx, imp); err != nil {
+			return err
+		}
+
+		if err = is.taskQueue.Enqueue(ctx, message.ImportRequested{ID: imp.ID}); err != nil {
+			return err
+		}
+
+		resp = mapper.ImportToResponse(imp, nil)
+		return nil
+	})
+	return resp, err
+}
+
+func (is *importServiceImpl) GetByID(ctx context.Context, profileID, id uuid.UUID) (dto.ImportResponse, error) {
+	ctx, span := otel.Tracer.Start(ctx, "ImportService.GetByID")
+	defer span.End()
+
+	imp, err := is.getImport(ctx, profileID, id, false)
+	if err != nil {
+		return dto.ImportResponse{}, err
+	}
+
+	var preview *dto.ImportPreview
+	if imp.Status != entity.PendingImport {
+		parsedPreview, err := ezutil.Unmarshal[dto.ImportPreview](imp.Preview)
+		if err != nil {
+			return dto.ImportResponse{}, err
+		}
+		preview = &parsedPreview
+	}
+
+	return mapper.ImportToResponse(imp, preview), nil
+}
+
+// Process parses a pending import and, unless it is a dry run, records it row by row.
+// Rows are recorded in their own transactions so progress is visible while it runs, which also means
+// a failed import keeps the rows recorded before the failure and is not retried.
+func (is *importServiceImpl) Process(ctx context.Context, msg message.ImportRequested) error {
+	ctx, span := otel.Tracer.Start(ctx, "ImportService.Process")
+	defer span.End()
+
+	imp, err := is.getImport(ctx, uuid.Nil, msg.ID, false)
+	if err != nil {
+		return err
+	}
+	if imp.Status != entity.PendingImport {
+		logger.Infof("import %s is already %s, skipping", imp.ID, imp.Status)
+		return nil
+	}
+
+	plan, profileIDs, err := is.plan(ctx, imp)
+	if err != nil {
+		return is.fail(ctx, imp, err)
+	}
+
+	newFriends := slices.DeleteFunc(plan.Names(imp.SelfName), func(name string) bool {
+		_, ok := profileIDs[importer.NormalizeName(name)]
+		return ok
+	})
+	preview, err := json.Marshal(mapper.ImportPlanToPreview(plan, newFriends))
+	if err != nil {
+		return is.fail(ctx, imp, ungerr.Wrap(err, "error marshaling import preview"))
+	}
+
+	imp.Preview = datatypes.JSON(preview)
+	imp.TotalRows = len(plan.Operations)
+	imp.ProcessedRows = 0
+
+	if imp.DryRun {
+		imp.Status = entity.PreviewedImport
+		imp.CompletedAt = sql.NullTime{Time: time.Now(), Valid: true}
+		_, err = is.importRepo.Update(ctx, imp)
+		return err
+	}
+
+	imp.Status = entity.ProcessingImport
+	if imp, err = is.importRepo.Update(ctx, imp); err != nil {
+		return err
+	}
+
+	transferMethod, err := is.transferMethodSvc.GetByName(ctx, debts.ImportTransferMethod)
+	if err != nil {
+		return is.fail(ctx, imp, err)
+	}
+	groupExpenseTransferMethod, err := is.transferMethodSvc.GetByName(ctx, debts.GroupExpenseTransferMethod)
+	if err != nil {
+		return is.fail(ctx, imp, err)
+	}
+
+	for i, operation := range plan.Operations {
+		err = is.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+			if err := is.addNewFriends(ctx, imp.ProfileID, operation.Entry, profileIDs); err != nil {
+				return err
+			}
+
+			switch operation.Kind {
+			case importer.DebtOperation:
+				return is.recordDebt(ctx, imp.ProfileID, operation, profileIDs, transferMethod.ID)
+			case importer.GroupExpenseOperation:
+				return is.recordGroupExpense(ctx, imp.ProfileID, operation.Entry, profileIDs, groupExpenseTransferMethod.ID)
+			default:
+				return ungerr.Unknownf("unsupported import operation: %s", operation.Kind)
+			}
+		})
+		if err != nil {
+			return is.fail(ctx, imp, ungerr.Wrap(err, fmt.Sprintf("line %d", operation.Entry.Line)))
+		}
+
+		imp.ProcessedRows = i + 1
+		if imp.ProcessedRows%importProgressInterval == 0 {
+			if imp, err = is.importRepo.Update(ctx, imp); err != nil {
+				return err
+			}
+		}
+	}
+
+	imp.Status = entity.CompletedImport
+	imp.CompletedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	_, err = is.importRepo.Update(ctx, imp)
+	return err
+}
+
+// plan parses the import and matches the names in it to the user and their friends by name.
+func (is *importServiceImpl) plan(ctx context.Context, imp entity.Import) (importer.Plan, map[string]uuid.UUID, error) {
+	parser, ok := is.parsers[imp.Source]
+	if !ok {
+		return importer.Plan{}, nil, ungerr.ValidationError(fmt.Sprintf("unsupported import source: %s", imp.Source))
+	}
+
+	entries, rowErrors, err := parser.Parse(imp.Content, imp.Currency)
+	if err != nil {
+		return importer.Plan{}, nil, err
+	}
+
+	friendships, err := is.friendshipSvc.GetAll(ctx, imp.ProfileID)
+	if err != nil {
+		return importer.Plan{}, nil, err
+	}
+
+	profileIDs := map[string]uuid.UUID{importer.NormalizeName(imp.SelfName): imp.ProfileID}
+	for _, friendship := range friendships {
+		name := importer.NormalizeName(friendship.ProfileName)
+		if _, ok := profileIDs[name]; !ok {
+			profileIDs[name] = friendship.ProfileID
+		}
+	}
+
+	return importer.NewPlan(entries, rowErrors, imp.SelfName), profileIDs, nil
+}
+
+func (is *importServiceImpl) addNewFriends(ctx context.Context, profileID uuid.UUID, entry importer.Entry, profileIDs map[string]uuid.UUID) error {
+	for _, participant := range slices.Concat(entry.Payers, entry.Owers) {
+		name := importer.NormalizeName(participant.Name)
+		if _, ok := profileIDs[name]; ok {
+			continue
+		}
+
+		friendship, err := is.friendshipSvc.CreateAnonymous(ctx, dto.NewAnonymousFriendshipRequest{
+			ProfileID: profileID,
+			Name:      participant.Name,
+		})
+		if err != nil {
+			return err
+		}
+		profileIDs[name] = friendship.ProfileID
+	}
+	return nil
+}
+
+func (is *importServiceImpl) recordDebt(ctx context.Context, profileID uuid.UUID, operation importer.Operation, profileIDs map[string]uuid.UUID, transferMethodID uuid.UUID) error {
+	debt := debts.DebtTransaction{
+		LenderProfileID:   profileIDs[importer.NormalizeName(operation.Lender)],
+		BorrowerProfileID: profileIDs[importer.NormalizeName(operation.Borrower)],
+		Currency:          operation.Entry.Currency,
+		Amount:            operation.Amount,
+		TransferMethodID:  transferMethodID,
+		Description:       operation.Entry.Description,
+	}
+	debt.CreatedAt = operation.Entry.Date
+
+	insertedDebt, err := is.debtRepo.Insert(ctx, debt)
+	if err != nil {
+		return err
+	}
+
+	return recordDebtsCreated(ctx, is.auditSvc, profileID, []debts.DebtTransaction{insertedDebt})
+}
+
+func (is *importServiceImpl) recordGroupExpense(
+	ctx context.Context,
+	profileID uuid.UUID,
+	entry importer.Entry,
+	profileIDs map[string]uuid.UUID,
+	transferMethodID uuid.UUID,
+) error {
+	profileIDOf := func(participant importer.Participant) uuid.UUID {
+		return profileIDs[importer.NormalizeName(participant.Name)]
+	}
+
+	participantIDs := make([]uuid.UUID, 0, len(entry.Payers)+len(entry.Owers))
+	for _, participant := range slices.Concat(entry.Payers, entry.Owers) {
+		if id := profileIDOf(participant); !slices.Contains(participantIDs, id) {
+			participantIDs = append(participantIDs, id)
+		}
+	}
+
+	template := dto.RecurringGroupExpense{
+		Description:           entry.Description,
+		Currency:              entry.Currency,
+		ParticipantProfileIDs: participantIDs,
+		Items: []dto.RecurringExpenseItem{{
+			Name:      entry.Description,
+			Amount:    entry.Total,
+			Quantity:  1,
+			SplitMode: expenses.ExactSplit,
+			Participants: ezutil.MapSlice(entry.Owers, func(ower importer.Participant) dto.ItemParticipantRequest {
+				return dto.ItemParticipantRequest{ProfileID: profileIDOf(ower), Share: ower.Amount}
+			}),
+		}},
+	}
+	if len(entry.Payers) == 1 {
+		template.PayerProfileID = profileIDOf(entry.Payers[0])
+	} else {
+		template.Payers = ezutil.MapSlice(entry.Payers, func(payer importer.Participant) dto.ExpensePayerRequest {
+			return dto.ExpensePayerRequest{ProfileID: profileIDOf(payer), Amount: payer.Amount}
+		})
+	}
+
+	groupExpense, err := is.expenseSvc.CreateImported(ctx, profileID, template, entry.Date)
+	if err != nil {
+		return err
+	}
+
+	debtTransactions, err := mapper.GroupExpenseToDebtTransactions(groupExpense, transferMethodID)
+	if err != nil {
+		return err
+	}
+	if len(debtTransactions) == 0 {
+		return nil
+	}
+
+	for i := range debtTransactions {
+		debtTransactions[i].CreatedAt = entry.Date
+	}
+
+	insertedTransactions, err := is.debtRepo.InsertMany(ctx, debtTransactions)
+	if err != nil {
+		return err
+	}
+
+	return recordDebtsCreated(ctx, is.auditSvc, profileID, insertedTransactions)
+}
+
+func (is *importServiceImpl) fail(ctx context.Context, imp entity.Import, cause error) error {
+	logger.Errorf("error processing import %s: %v", imp.ID, cause)
+
+	imp.Status = entity.FailedImport
+	imp.ErrorMessage = sql.NullString{String: cause.Error(), Valid: true}
+	imp.CompletedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	_, err := is.importRepo.Update(ctx, imp)
+	return err
+}
+
+func (is *importServiceImpl) getImport(ctx context.Context, profileID, id uuid.UUID, forUpdate bool) (entity.Import, error) {
+	spec := crud.Specification[entity.Import]{}
+	spec.Model.ID = id
+	spec.Model.ProfileID = profileID
+	spec.ForUpdate = forUpdate
+	imp, err := is.importRepo.FindFirst(ctx, spec)
+	if err != nil {
+		return entity.Import{}, err
+	}
+	if imp.IsZero() {
+		return entity.Import{}, ungerr.NotFoundError(fmt.Sprintf("import with ID %s is not found", id))
+	}
+	return imp, nil
+}
@@ -0,0 +1,159 @@
+package importer
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/itsLeonB/cashback/internal/domain/entity"
+	"github.com/itsLeonB/ungerr"
+	"github.com/shopspring/decimal"
+)
+
+var csvColumns = []string{"date", "description", "amount", "paid_by", "split_with"}
+
+// csvParser reads the generic format: one row per expense with the columns
+// date, description, amount, paid_by, split_with and an optional currency.
+// split_with lists names separated by ";", split evenly unless every name carries
+// its own amount as "Name:12.50".
+type csvParser struct{}
+
+func newCSVParser() Parser {
+	return &csvParser{}
+}
+
+func (cp *csvParser) GetSource() entity.ImportSource {
+	return entity.CSVImport
+}
+
+func (cp *csvParser) Parse(content, defaultCurrency string) ([]Entry, []RowError, error) {
+	reader := csv.NewReader(strings.NewReader(content))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, nil, ungerr.ValidationError("file is empty or is not a valid CSV")
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, column := range csvColumns {
+		if _, ok := columns[column]; !ok {
+			return nil, nil, ungerr.ValidationError(fmt.Sprintf("missing column: %s", column))
+		}
+	}
+
+	var entries []Entry
+	var rowErrors []RowError
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		line, _ := reader.FieldPos(0)
+		if err != nil {
+			return nil, nil, ungerr.ValidationError(fmt.Sprintf("invalid CSV at line %d", line))
+		}
+
+		field := func(column string) string {
+			i, ok := columns[column]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		entry, reason := parseCSVRecord(field, defaultCurrency)
+		if reason != "" {
+			rowErrors = append(rowErrors, RowError{line, reason})
+			continue
+		}
+		entry.Line = line
+		entries = append(entries, entry)
+	}
+
+	return entries, rowErrors, nil
+}
+
+func parseCSVRecord(field func(string) string, defaultCurrency string) (Entry, string) {
+	date, ok := parseDate(field("date"))
+	if !ok {
+		return Entry{}, fmt.Sprintf("invalid date: %s", field("date"))
+	}
+
+	total, err := decimal.NewFromString(field("amount"))
+	if err != nil || !total.IsPositive() {
+		return Entry{}, fmt.Sprintf("invalid amount: %s", field("amount"))
+	}
+
+	payer := field("paid_by")
+	if payer == "" {
+		return Entry{}, "paid_by is empty"
+	}
+
+	owers, reason := parseSplitWith(field("split_with"), total)
+	if reason != "" {
+		return Entry{}, reason
+	}
+
+	currency := strings.ToUpper(field("currency"))
+	if currency == "" {
+		currency = defaultCurrency
+	}
+
+	return Entry{
+		Date:        date,
+		Description: field("description"),
+		Currency:    currency,
+		Total:       total,
+		Payers:      []Participant{{payer, total}},
+		Owers:       owers,
+	}, ""
+}
+
+func parseSplitWith(value string, total decimal.Decimal) ([]Participant, string) {
+	var owers []Participant
+	seen := make(map[string]bool)
+	withAmounts := 0
+	for part := range strings.SplitSeq(value, ";") {
+		if strings.TrimSpace(part) == "" {
+			continue
+		}
+
+		name, amountStr, hasAmount := strings.Cut(part, ":")
+		ower := Participant{Name: strings.TrimSpace(name)}
+		if seen[NormalizeName(ower.Name)] {
+			return nil, fmt.Sprintf("%s is listed more than once in split_with", ower.Name)
+		}
+		seen[NormalizeName(ower.Name)] = true
+		if hasAmount {
+			amount, err := decimal.NewFromString(strings.TrimSpace(amountStr))
+			if err != nil || amount.IsNegative() {
+				return nil, fmt.Sprintf("invalid amount for %s: %s", ower.Name, amountStr)
+			}
+			ower.Amount = amount
+			withAmounts++
+		}
+		owers = append(owers, ower)
+	}
+
+	switch {
+	case len(owers) == 0:
+		return nil, "split_with is empty"
+	case withAmounts == 0:
+		for i, amount := range splitEvenly(total, len(owers)) {
+			owers[i].Amount = amount
+		}
+	case withAmounts != len(owers):
+		return nil, "either all or none of split_with must have amounts"
+	case !sumAmounts(owers).Equal(total):
+		return nil, fmt.Sprintf("split amounts add up to %s, but the amount is %s", sumAmounts(owers), total)
+	}
+
+	return owers, ""
+}
@@ -0,0 +1,111 @@
+package importer
+
+import (
+	"log"
+	"strings"
+	"time"
+
+	"github.com/itsLeonB/cashback/internal/domain/entity"
+	"github.com/shopspring/decimal"
+)
+
+var namespace = "[ImportParser]"
+
+// Participant is a person named in an imported row, with what they paid or owe.
+type Participant struct {
+	Name   string
+	Amount decimal.Decimal
+}
+
+// Entry is one imported row, normalized so that paid and owed amounts both add up to Total.
+type Entry struct {
+	Line        int
+	Date        time.Time
+	Description string
+	Currency    string
+	Total       decimal.Decimal
+	IsPayment   bool
+	Payers      []Participant
+	Owers       []Participant
+}
+
+// RowError is a row that cannot be imported, kept so the preview can tell the user why.
+type RowError struct {
+	Line   int
+	Reason string
+}
+
+type Parser interface {
+	GetSource() entity.ImportSource
+	// Parse returns the valid rows along with the reasons the other rows were left out.
+	// An error means the file as a whole cannot be read, e.g. a missing column.
+	Parse(content, defaultCurrency string) ([]Entry, []RowError, error)
+}
+
+var initFuncs = []func() Parser{
+	newCSVParser,
+	newSplitwiseParser,
+}
+
+func NewParserRegistry() map[entity.ImportSource]Parser {
+	registry := make(map[entity.ImportSource]Parser)
+
+	for _, initFunc := range initFuncs {
+		if initFunc == nil {
+			log.Fatalf("%s initFunc is nil", namespace)
+		}
+
+		parser := initFunc()
+		if parser == nil {
+			log.Fatalf("%s parser is nil", namespace)
+		}
+
+		source := parser.GetSource()
+		if _, exists := registry[source]; exists {
+			log.Fatalf("%s duplicate parser for source: %s", namespace, source)
+		}
+
+		registry[source] = parser
+	}
+
+	return registry
+}
+
+// NormalizeName is how names from a file are matched against each other and against existing friends.
+func NormalizeName(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), " "))
+}
+
+func parseDate(value string) (time.Time, bool) {
+	for _, layout := range []string{time.DateOnly, time.RFC3339, "2006-01-02 15:04:05"} {
+		if date, err := time.Parse(layout, strings.TrimSpace(value)); err == nil {
+			return date, true
+		}
+	}
+	return time.Time{}, false
+}
+
+func sumAmounts(participants []Participant) decimal.Decimal {
+	total := decimal.Zero
+	for _, participant := range participants {
+		total = total.Add(participant.Amount)
+	}
+	return total
+}
+
+// splitEvenly divides total into n amounts rounded to 2 decimals, handing the leftover cents to the first ones.
+func splitEvenly(total decimal.Decimal, n int) []decimal.Decimal {
+	cent := decimal.NewFromFloat(0.01)
+	base := total.Div(decimal.NewFromInt(int64(n))).RoundDown(2)
+	leftover := total.Sub(base.Mul(decimal.NewFromInt(int64(n))))
+
+	amounts := make([]decimal.Decimal, n)
+	for i := range amounts {
+		amounts[i] = base
+		if leftover.IsPositive() {
+			amounts[i] = amounts[i].Add(cent)
+			leftover = leftover.Sub(cent)
+		}
+	}
+	return amounts
+}
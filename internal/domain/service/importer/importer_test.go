@@ -0,0 +1,137 @@
+package importer_test
+
+import (
+	"testing"
+
+	"github.com/itsLeonB/cashback/internal/domain/entity"
+	"github.com/itsLeonB/cashback/internal/domain/service/importer"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCSVParser(t *testing.T) {
+	parser := importer.NewParserRegistry()[entity.CSVImport]
+	content := "Date,Description,Amount,Paid_By,Split_With,Currency\n" +
+		"2024-01-05,Dinner,100,Me,Me;Budi;Citra,\n" +
+		"2024-01-06,Taxi,30,Budi,Me:20;Budi:10,usd\n" +
+		"2024-01-07,Broken,abc,Me,Budi,\n" +
+		"2024-01-08,Mismatch,30,Me,Me:10;Budi:10,\n" +
+		"2024-01-09,Twice,30,Me,Budi;budi,\n"
+
+	entries, rowErrors, err := parser.Parse(content, "IDR")
+	assert.NoError(t, err)
+	assert.Len(t, entries, 2)
+
+	dinner := entries[0]
+	assert.Equal(t, 2, dinner.Line)
+	assert.Equal(t, "IDR", dinner.Currency)
+	assert.Equal(t, []importer.Participant{{"Me", decimal.NewFromInt(100)}}, dinner.Payers)
+	assert.Equal(t, "33.34", dinner.Owers[0].Amount.StringFixed(2))
+	assert.Equal(t, "33.33", dinner.Owers[1].Amount.StringFixed(2))
+	assert.Equal(t, "33.33", dinner.Owers[2].Amount.StringFixed(2))
+
+	taxi := entries[1]
+	assert.Equal(t, "USD", taxi.Currency)
+	assert.True(t, taxi.Owers[0].Amount.Equal(decimal.NewFromInt(20)))
+
+	assert.Equal(t, []int{4, 5, 6}, []int{rowErrors[0].Line, rowErrors[1].Line, rowErrors[2].Line})
+
+	_, _, err = parser.Parse("date,description,amount\n", "IDR")
+	assert.Error(t, err)
+}
+
+func TestSplitwiseParser(t *testing.T) {
+	parser := importer.NewParserRegistry()[entity.SplitwiseImport]
+	content := "Date,Description,Category,Cost,Currency,Me,Budi,Citra\n" +
+		"\n" +
+		"2024-01-05,Groceries,Groceries,90.00,IDR,60.00,-30.00,-30.00\n" +
+		"2024-01-06,Payment,Payment,30.00,IDR,-30.00,30.00,0.00\n" +
+		"2024-01-07,Villa,Lodging,300.00,IDR,50.00,100.00,-150.00\n" +
+		"2024-01-08,Off,General,10.00,IDR,5.00,-4.00,0.00\n" +
+		"\n" +
+		"2024-01-31,Total balance, , ,IDR,80.00,70.00,-150.00\n"
+
+	entries, rowErrors, err := parser.Parse(content, "USD")
+	assert.NoError(t, err)
+	assert.Len(t, entries, 3)
+	assert.Len(t, rowErrors, 1)
+
+	groceries := entries[0]
+	assert.Equal(t, 3, groceries.Line)
+	assert.True(t, groceries.Total.Equal(decimal.NewFromInt(90)))
+	assert.Equal(t, "Me", groceries.Payers[0].Name)
+	assert.True(t, groceries.Payers[0].Amount.Equal(decimal.NewFromInt(90)))
+	assert.Len(t, groceries.Owers, 3)
+	assert.Equal(t, "Me", groceries.Owers[2].Name)
+	assert.True(t, groceries.Owers[2].Amount.Equal(decimal.NewFromInt(30)))
+
+	payment := entries[1]
+	assert.True(t, payment.IsPayment)
+	assert.Equal(t, "Budi", payment.Payers[0].Name)
+	assert.Len(t, payment.Owers, 1)
+
+	villa := entries[2]
+	assert.Len(t, villa.Payers, 2)
+	assert.True(t, villa.Total.Equal(decimal.NewFromInt(150)))
+
+	_, _, err = parser.Parse("Date,Description,Amount\n", "USD")
+	assert.Error(t, err)
+}
+
+func TestNewPlan(t *testing.T) {
+	amount := decimal.NewFromInt
+	entries := []importer.Entry{
+		{
+			Line:   2,
+			Total:  amount(100),
+			Payers: []importer.Participant{{"me", amount(100)}},
+			Owers:  []importer.Participant{{"Me", amount(50)}, {"Budi", amount(50)}},
+		},
+		{
+			Line:   3,
+			Total:  amount(90),
+			Payers: []importer.Participant{{"Budi", amount(90)}},
+			Owers:  []importer.Participant{{"Me", amount(30)}, {"Budi", amount(30)}, {"Citra", amount(30)}},
+		},
+		{
+			Line:      4,
+			Total:     amount(10),
+			IsPayment: true,
+			Payers:    []importer.Participant{{"Budi", amount(10)}},
+			Owers:     []importer.Participant{{"Citra", amount(10)}},
+		},
+		{
+			Line:   5,
+			Total:  amount(10),
+			Payers: []importer.Participant{{"Budi", amount(10)}},
+			Owers:  []importer.Participant{{"Citra", amount(10)}},
+		},
+		{
+			Line:   6,
+			Total:  amount(10),
+			Payers: []importer.Participant{{"Me", amount(10)}},
+			Owers:  []importer.Participant{{"Me", amount(10)}},
+		},
+	}
+
+	plan := importer.NewPlan(entries, []importer.RowError{{Line: 1, Reason: "bad"}}, " Me ")
+
+	assert.Len(t, plan.Operations, 3)
+	debt := plan.Operations[0]
+	assert.Equal(t, importer.DebtOperation, debt.Kind)
+	assert.Equal(t, "me", debt.Lender)
+	assert.Equal(t, "Budi", debt.Borrower)
+	assert.True(t, debt.Amount.Equal(amount(50)))
+
+	assert.Equal(t, importer.GroupExpenseOperation, plan.Operations[1].Kind)
+	assert.Equal(t, importer.GroupExpenseOperation, plan.Operations[2].Kind)
+	assert.Equal(t, 5, plan.Operations[2].Entry.Line)
+
+	assert.Equal(t, []importer.RowError{
+		{Line: 1, Reason: "bad"},
+		{Line: 4, Reason: "payment between other people"},
+		{Line: 6, Reason: "nobody else is involved"},
+	}, plan.Skipped)
+
+	assert.Equal(t, []string{"Budi", "Citra"}, plan.Names("me"))
+}
@@ -0,0 +1,101 @@
+package importer
+
+import (
+	"slices"
+
+	"github.com/shopspring/decimal"
+)
+
+type OperationKind string
+
+const (
+	DebtOperation         OperationKind = "DEBT"
+	GroupExpenseOperation OperationKind = "GROUP_EXPENSE"
+)
+
+// Operation is what an entry is recorded as. Lender, Borrower and Amount are only set for debts.
+type Operation struct {
+	Kind     OperationKind
+	Entry    Entry
+	Lender   string
+	Borrower string
+	Amount   decimal.Decimal
+}
+
+type Plan struct {
+	Operations []Operation
+	Skipped    []RowError
+}
+
+// NewPlan decides how each entry is recorded. A row between the user and one other person becomes
+// a debt transaction, and any other row with more people becomes a confirmed group expense.
+// Payments between two other people are left out, as they do not change anything for the user.
+func NewPlan(entries []Entry, rowErrors []RowError, selfName string) Plan {
+	self := NormalizeName(selfName)
+	plan := Plan{Skipped: slices.Clone(rowErrors)}
+
+	for _, entry := range entries {
+		operation, reason := classify(entry, self)
+		if reason != "" {
+			plan.Skipped = append(plan.Skipped, RowError{entry.Line, reason})
+			continue
+		}
+		plan.Operations = append(plan.Operations, operation)
+	}
+
+	slices.SortFunc(plan.Skipped, func(a, b RowError) int {
+		return a.Line - b.Line
+	})
+
+	return plan
+}
+
+// Names lists everyone in the planned operations other than the user, once each, in order of appearance.
+func (p Plan) Names(selfName string) []string {
+	seen := map[string]bool{NormalizeName(selfName): true}
+	var names []string
+	for _, operation := range p.Operations {
+		for _, participant := range slices.Concat(operation.Entry.Payers, operation.Entry.Owers) {
+			normalized := NormalizeName(participant.Name)
+			if !seen[normalized] {
+				seen[normalized] = true
+				names = append(names, participant.Name)
+			}
+		}
+	}
+	return names
+}
+
+func classify(entry Entry, self string) (Operation, string) {
+	names := make(map[string]bool)
+	for _, participant := range slices.Concat(entry.Payers, entry.Owers) {
+		names[NormalizeName(participant.Name)] = true
+	}
+	selfInvolved := names[self]
+
+	if len(names) < 2 {
+		return Operation{}, "nobody else is involved"
+	}
+
+	if len(names) == 2 && len(entry.Payers) == 1 {
+		lender := entry.Payers[0]
+		borrowers := slices.DeleteFunc(slices.Clone(entry.Owers), func(ower Participant) bool {
+			return NormalizeName(ower.Name) == NormalizeName(lender.Name)
+		})
+		if len(borrowers) == 1 && selfInvolved {
+			return Operation{
+				Kind:     DebtOperation,
+				Entry:    entry,
+				Lender:   lender.Name,
+				Borrower: borrowers[0].Name,
+				Amount:   borrowers[0].Amount,
+			}, ""
+		}
+	}
+
+	if entry.IsPayment && !selfInvolved {
+		return Operation{}, "payment between other people"
+	}
+
+	return Operation{Kind: GroupExpenseOperation, Entry: entry}, ""
+}
@@ -0,0 +1,157 @@
+package importer
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/itsLeonB/cashback/internal/domain/entity"
+	"github.com/itsLeonB/ungerr"
+	"github.com/shopspring/decimal"
+)
+
+// Columns preceding the per-member balances
+const (
+	splitwiseDateColumn = iota
+	splitwiseDescriptionColumn
+	splitwiseCategoryColumn
+	splitwiseCostColumn
+	splitwiseCurrencyColumn
+	splitwiseFixedColumns
+)
+
+const (
+	splitwisePayment      = "payment"
+	splitwiseTotalBalance = "total balance"
+)
+
+// splitwiseParser reads a Splitwise group export. After the fixed columns there is one column per member
+// holding their net balance on the row: positive for what they are owed, negative for what they owe.
+type splitwiseParser struct{}
+
+func newSplitwiseParser() Parser {
+	return &splitwiseParser{}
+}
+
+func (sp *splitwiseParser) GetSource() entity.ImportSource {
+	return entity.SplitwiseImport
+}
+
+func (sp *splitwiseParser) Parse(content, defaultCurrency string) ([]Entry, []RowError, error) {
+	reader := csv.NewReader(strings.NewReader(content))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, nil, ungerr.ValidationError("file is empty or is not a valid CSV")
+	}
+	if len(header) <= splitwiseFixedColumns || !strings.EqualFold(strings.TrimSpace(header[splitwiseDateColumn]), "date") {
+		return nil, nil, ungerr.ValidationError("not a Splitwise export, expected Date, Description, Category, Cost, Currency and a column per member")
+	}
+	members := header[splitwiseFixedColumns:]
+
+	var entries []Entry
+	var rowErrors []RowError
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		line, _ := reader.FieldPos(0)
+		if err != nil {
+			return nil, nil, ungerr.ValidationError(fmt.Sprintf("invalid CSV at line %d", line))
+		}
+		if len(record) > splitwiseDescriptionColumn && strings.EqualFold(strings.TrimSpace(record[splitwiseDescriptionColumn]), splitwiseTotalBalance) {
+			continue
+		}
+		if len(record) != len(header) {
+			rowErrors = append(rowErrors, RowError{line, fmt.Sprintf("expected %d columns, got %d", len(header), len(record))})
+			continue
+		}
+
+		entry, reason := parseSplitwiseRecord(record, members, defaultCurrency)
+		if reason != "" {
+			rowErrors = append(rowErrors, RowError{line, reason})
+			continue
+		}
+		entry.Line = line
+		entries = append(entries, entry)
+	}
+
+	return entries, rowErrors, nil
+}
+
+// parseSplitwiseRecord turns net balances back into payers and owers. With a single payer the cost is
+// known to be what they paid, so their own share is the cost minus what they are owed. Splitwise does not
+// export what each of several payers paid, so those rows keep only the net amounts changing hands.
+func parseSplitwiseRecord(record, members []string, defaultCurrency string) (Entry, string) {
+	date, ok := parseDate(record[splitwiseDateColumn])
+	if !ok {
+		return Entry{}, fmt.Sprintf("invalid date: %s", record[splitwiseDateColumn])
+	}
+
+	cost, err := decimal.NewFromString(strings.TrimSpace(record[splitwiseCostColumn]))
+	if err != nil || !cost.IsPositive() {
+		return Entry{}, fmt.Sprintf("invalid cost: %s", record[splitwiseCostColumn])
+	}
+
+	var payers, owers []Participant
+	netSum := decimal.Zero
+	for i, member := range members {
+		value := strings.TrimSpace(record[splitwiseFixedColumns+i])
+		if value == "" {
+			continue
+		}
+		net, err := decimal.NewFromString(value)
+		if err != nil {
+			return Entry{}, fmt.Sprintf("invalid balance for %s: %s", member, value)
+		}
+		netSum = netSum.Add(net)
+
+		participant := Participant{Name: strings.TrimSpace(member), Amount: net.Abs()}
+		switch net.Sign() {
+		case 1:
+			payers = append(payers, participant)
+		case -1:
+			owers = append(owers, participant)
+		}
+	}
+
+	if !netSum.IsZero() {
+		return Entry{}, fmt.Sprintf("balances add up to %s instead of 0", netSum)
+	}
+	if len(payers) == 0 {
+		return Entry{}, "nobody is owed anything"
+	}
+
+	total := sumAmounts(payers)
+	if len(payers) == 1 {
+		ownShare := cost.Sub(payers[0].Amount)
+		if ownShare.IsNegative() {
+			return Entry{}, fmt.Sprintf("cost %s is less than what %s is owed", cost, payers[0].Name)
+		}
+		if ownShare.IsPositive() {
+			owers = append(owers, Participant{payers[0].Name, ownShare})
+		}
+		payers[0].Amount = cost
+		total = cost
+	}
+
+	currency := strings.ToUpper(strings.TrimSpace(record[splitwiseCurrencyColumn]))
+	if currency == "" {
+		currency = defaultCurrency
+	}
+
+	return Entry{
+		Date:        date,
+		Description: strings.TrimSpace(record[splitwiseDescriptionColumn]),
+		Currency:    currency,
+		Total:       total,
+		IsPayment:   strings.EqualFold(strings.TrimSpace(record[splitwiseCategoryColumn]), splitwisePayment),
+		Payers:      payers,
+		Owers:       owers,
+	}, ""
+}
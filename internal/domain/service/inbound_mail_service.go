@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/itsLeonB/cashback/internal/core/otel"
+	"github.com/itsLeonB/cashback/internal/core/service/mail"
+	"github.com/itsLeonB/cashback/internal/domain/dto"
+	"github.com/itsLeonB/go-crud"
+	"github.com/itsLeonB/ungerr"
+)
+
+type inboundMailServiceImpl struct {
+	transactor crud.Transactor
+	userSvc    UserService
+	expenseSvc GroupExpenseService
+	billSvc    ExpenseBillService
+	authservID string
+}
+
+func NewInboundMailService(
+	transactor crud.Transactor,
+	userSvc UserService,
+	expenseSvc GroupExpenseService,
+	billSvc ExpenseBillService,
+	authservID string,
+) InboundMailService {
+	return &inboundMailServiceImpl{
+		transactor,
+		userSvc,
+		expenseSvc,
+		billSvc,
+		authservID,
+	}
+}
+
+// forwardPrefix matches the prefixes mail clients put on forwarded subjects, e.g. "Fwd: " or "FW: ".
+var forwardPrefix = regexp.MustCompile(`(?i)^\s*((fwd?|fw)\s*:\s*)+`)
+
+// Receive creates a draft expense for the sender with the receipt in the mail as its bill, which then
+// goes through extraction and parsing like an uploaded one. Only verified users can send receipts, and
+// only from mail the provider authenticated, since the From header alone can be forged.
+func (ims *inboundMailServiceImpl) Receive(ctx context.Context, raw []byte) (dto.InboundMailResponse, error) {
+	ctx, span := otel.Tracer.Start(ctx, "InboundMailService.Receive")
+	defer span.End()
+
+	inbound, err := mail.ParseInbound(raw, ims.authservID)
+	if err != nil {
+		return dto.InboundMailResponse{}, err
+	}
+
+	if !inbound.SenderAuthenticated {
+		return dto.InboundMailResponse{}, ungerr.ForbiddenError("mail sender is not authenticated")
+	}
+
+	receipt, ok := inbound.Receipt()
+	if !ok {
+		return dto.InboundMailResponse{}, ungerr.UnprocessableEntityError("mail has no receipt")
+	}
+
+	user, err := ims.userSvc.FindByEmail(ctx, inbound.From)
+	if err != nil {
+		return dto.InboundMailResponse{}, err
+	}
+	if user.IsZero() || !user.IsVerified() || user.Profile.IsZero() {
+		return dto.InboundMailResponse{}, ungerr.NotFoundError("sender is not a verified user")
+	}
+
+	var resp dto.InboundMailResponse
+	err = ims.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		expense, err := ims.expenseSvc.CreateDraft(ctx, dto.NewDraftRequest{
+			UserProfileID: user.Profile.ID,
+			Description:   strings.TrimSpace(forwardPrefix.ReplaceAllString(inbound.Subject, "")),
+		})
+		if err != nil {
+			return err
+		}
+
+		billID, err := ims.billSvc.Upload(ctx, dto.NewExpenseBillRequest{
+			ImageData:      receipt.Data,
+			ProfileID:      user.Profile.ID,
+			GroupExpenseID: expense.ID,
+			ContentType:    receipt.ContentType,
+			Filename:       receipt.Filename,
+			FileSize:       int64(len(receipt.Data)),
+		})
+		if err != nil {
+			return err
+		}
+
+		resp = dto.InboundMailResponse{GroupExpenseID: expense.ID, BillID: billID}
+		return nil
+	})
+	if err != nil {
+		return dto.InboundMailResponse{}, err
+	}
+
+	return resp, nil
+}
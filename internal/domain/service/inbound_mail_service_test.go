@@ -0,0 +1,29 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/itsLeonB/cashback/internal/domain/service"
+	"github.com/itsLeonB/cashback/internal/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInboundMailReceive_RejectsSpoofedSender(t *testing.T) {
+	// The mocks have no expectations, so looking up the spoofed sender or creating anything fails the test
+	svc := service.NewInboundMailService(
+		mocks.NewMockTransactor(t),
+		mocks.NewMockUserService(t),
+		nil,
+		nil,
+		"mx.example.net",
+	)
+
+	raw := "Authentication-Results: mx.example.net; dkim=pass header.d=attacker.test; spf=fail smtp.mailfrom=budi@example.com; dmarc=fail header.from=example.com\r\n" +
+		"From: Budi <budi@example.com>\r\nSubject: Fwd: receipt\r\n\r\nKopi Susu 25.000\r\n"
+
+	_, err := svc.Receive(context.Background(), []byte(raw))
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "not authenticated")
+}
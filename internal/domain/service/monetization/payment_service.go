@@ -12,8 +12,10 @@ import (
 	"github.com/itsLeonB/cashback/internal/core/otel"
 	"github.com/itsLeonB/cashback/internal/core/service/queue"
 	dto "github.com/itsLeonB/cashback/internal/domain/dto/monetization"
+	auditEntity "github.com/itsLeonB/cashback/internal/domain/entity"
 	entity "github.com/itsLeonB/cashback/internal/domain/entity/monetization"
 	mapper "github.com/itsLeonB/cashback/internal/domain/mapper/monetization"
+	"github.com/itsLeonB/cashback/internal/domain/service/audit"
 	"github.com/itsLeonB/cashback/internal/domain/service/monetization/payment"
 	"github.com/itsLeonB/ezutil/v2"
 	"github.com/itsLeonB/go-crud"
@@ -29,7 +31,7 @@ type PaymentService interface {
 	GetList(ctx context.Context) ([]dto.PaymentResponse, error)
 	GetOne(ctx context.Context, id uuid.UUID) (dto.PaymentResponse, error)
 	Update(ctx context.Context, req dto.UpdatePaymentRequest) (dto.PaymentResponse, error)
-	Delete(ctx context.Context, id uuid.UUID) (dto.PaymentResponse, error)
+	Delete(ctx context.Context, adminID, id uuid.UUID) (dto.PaymentResponse, error)
 }
 
 func NewPaymentService(
@@ -38,6 +40,7 @@ func NewPaymentService(
 	paymentRepo crud.Repository[entity.Payment],
 	taskQueue queue.TaskQueue,
 	subscriptionSvc SubscriptionService,
+	auditSvc audit.Service,
 ) *paymentService {
 	return &paymentService{
 		gateway,
@@ -45,6 +48,7 @@ func NewPaymentService(
 		paymentRepo,
 		taskQueue,
 		subscriptionSvc,
+		auditSvc,
 	}
 }
 
@@ -54,6 +58,7 @@ type paymentService struct {
 	paymentRepo     crud.Repository[entity.Payment]
 	taskQueue       queue.TaskQueue
 	subscriptionSvc SubscriptionService
+	auditSvc        audit.Service
 }
 
 func (ps *paymentService) isReady() error {
@@ -326,6 +331,8 @@ func (ps *paymentService) Update(ctx context.Context, req dto.UpdatePaymentReque
 			return err
 		}
 
+		before := mapper.PaymentToResponse(payment)
+
 		payment.Status = entity.PaymentStatus(req.Status)
 		payment.Amount = req.Amount
 		payment.Currency = req.Currency
@@ -349,12 +356,19 @@ func (ps *paymentService) Update(ctx context.Context, req dto.UpdatePaymentReque
 		}
 
 		resp = mapper.PaymentToResponse(updatedPayment)
-		return nil
+		return ps.auditSvc.Record(ctx, audit.Entry{
+			Actor:      audit.Admin(req.AdminID),
+			Action:     auditEntity.UpdateAuditAction,
+			EntityType: auditEntity.PaymentAuditEntity,
+			EntityID:   payment.ID,
+			Before:     before,
+			After:      resp,
+		})
 	})
 	return resp, err
 }
 
-func (ps *paymentService) Delete(ctx context.Context, id uuid.UUID) (dto.PaymentResponse, error) {
+func (ps *paymentService) Delete(ctx context.Context, adminID, id uuid.UUID) (dto.PaymentResponse, error) {
 	ctx, span := otel.Tracer.Start(ctx, "PaymentService.Delete")
 	defer span.End()
 
@@ -370,7 +384,13 @@ func (ps *paymentService) Delete(ctx context.Context, id uuid.UUID) (dto.Payment
 		}
 
 		resp = mapper.PaymentToResponse(payment)
-		return nil
+		return ps.auditSvc.Record(ctx, audit.Entry{
+			Actor:      audit.Admin(adminID),
+			Action:     auditEntity.DeleteAuditAction,
+			EntityType: auditEntity.PaymentAuditEntity,
+			EntityID:   payment.ID,
+			Before:     resp,
+		})
 	})
 	return resp, err
 }
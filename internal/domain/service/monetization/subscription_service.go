@@ -12,10 +12,12 @@ import (
 	"github.com/itsLeonB/cashback/internal/core/otel"
 	"github.com/itsLeonB/cashback/internal/core/service/queue"
 	dto "github.com/itsLeonB/cashback/internal/domain/dto/monetization"
+	auditEntity "github.com/itsLeonB/cashback/internal/domain/entity"
 	entity "github.com/itsLeonB/cashback/internal/domain/entity/monetization"
 	mapper "github.com/itsLeonB/cashback/internal/domain/mapper/monetization"
 	"github.com/itsLeonB/cashback/internal/domain/message"
 	repository "github.com/itsLeonB/cashback/internal/domain/repository/monetization"
+	"github.com/itsLeonB/cashback/internal/domain/service/audit"
 	"github.com/itsLeonB/ezutil/v2"
 	"github.com/itsLeonB/go-crud"
 	"github.com/itsLeonB/ungerr"
@@ -27,7 +29,7 @@ type SubscriptionService interface {
 	GetList(ctx context.Context) ([]dto.SubscriptionResponse, error)
 	GetOne(ctx context.Context, id uuid.UUID) (dto.SubscriptionResponse, error)
 	Update(ctx context.Context, req dto.UpdateSubscriptionRequest) (dto.SubscriptionResponse, error)
-	Delete(ctx context.Context, id uuid.UUID) (dto.SubscriptionResponse, error)
+	Delete(ctx context.Context, adminID, id uuid.UUID) (dto.SubscriptionResponse, error)
 
 	// Public
 	GetSubscribedDetails(ctx context.Context, profileID uuid.UUID) (dto.SubscriptionResponse, error)
@@ -47,6 +49,7 @@ type subscriptionService struct {
 	subscriptionRepo repository.SubscriptionRepository
 	planVersionRepo  crud.Repository[entity.PlanVersion]
 	taskQueue        queue.TaskQueue
+	auditSvc         audit.Service
 }
 
 func NewSubscriptionService(
@@ -54,12 +57,14 @@ func NewSubscriptionService(
 	repo repository.SubscriptionRepository,
 	planVersionRepo crud.Repository[entity.PlanVersion],
 	taskQueue queue.TaskQueue,
+	auditSvc audit.Service,
 ) *subscriptionService {
 	return &subscriptionService{
 		transactor,
 		repo,
 		planVersionRepo,
 		taskQueue,
+		auditSvc,
 	}
 }
 
@@ -87,12 +92,23 @@ func (ss *subscriptionService) Create(ctx context.Context, req dto.NewSubscripti
 		}
 	}
 
-	insertedSubscription, err := ss.subscriptionRepo.Insert(ctx, newSubscription)
-	if err != nil {
-		return dto.SubscriptionResponse{}, err
-	}
+	var resp dto.SubscriptionResponse
+	err := ss.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		insertedSubscription, err := ss.subscriptionRepo.Insert(ctx, newSubscription)
+		if err != nil {
+			return err
+		}
 
-	return mapper.SubscriptionToResponse(insertedSubscription, time.Now()), nil
+		resp = mapper.SubscriptionToResponse(insertedSubscription, time.Now())
+		return ss.auditSvc.Record(ctx, audit.Entry{
+			Actor:      audit.Admin(req.AdminID),
+			Action:     auditEntity.CreateAuditAction,
+			EntityType: auditEntity.SubscriptionAuditEntity,
+			EntityID:   insertedSubscription.ID,
+			After:      resp,
+		})
+	})
+	return resp, err
 }
 
 func (ss *subscriptionService) GetList(ctx context.Context) ([]dto.SubscriptionResponse, error) {
@@ -138,6 +154,8 @@ func (ss *subscriptionService) Update(ctx context.Context, req dto.UpdateSubscri
 			return err
 		}
 
+		before := mapper.SubscriptionToResponse(subscription, time.Now())
+
 		subscription.ProfileID = req.ProfileID
 		subscription.PlanVersionID = req.PlanVersionID
 		subscription.AutoRenew = req.AutoRenew
@@ -169,12 +187,19 @@ func (ss *subscriptionService) Update(ctx context.Context, req dto.UpdateSubscri
 		}
 
 		resp = mapper.SubscriptionToResponse(updatedSubscription, time.Now())
-		return nil
+		return ss.auditSvc.Record(ctx, audit.Entry{
+			Actor:      audit.Admin(req.AdminID),
+			Action:     auditEntity.UpdateAuditAction,
+			EntityType: auditEntity.SubscriptionAuditEntity,
+			EntityID:   subscription.ID,
+			Before:     before,
+			After:      resp,
+		})
 	})
 	return resp, err
 }
 
-func (ss *subscriptionService) Delete(ctx context.Context, id uuid.UUID) (dto.SubscriptionResponse, error) {
+func (ss *subscriptionService) Delete(ctx context.Context, adminID, id uuid.UUID) (dto.SubscriptionResponse, error) {
 	ctx, span := otel.Tracer.Start(ctx, "SubscriptionService.Delete")
 	defer span.End()
 
@@ -190,7 +215,13 @@ func (ss *subscriptionService) Delete(ctx context.Context, id uuid.UUID) (dto.Su
 		}
 
 		resp = mapper.SubscriptionToResponse(subscription, time.Now())
-		return nil
+		return ss.auditSvc.Record(ctx, audit.Entry{
+			Actor:      audit.Admin(adminID),
+			Action:     auditEntity.DeleteAuditAction,
+			EntityType: auditEntity.SubscriptionAuditEntity,
+			EntityID:   subscription.ID,
+			Before:     resp,
+		})
 	})
 	return resp, err
 }
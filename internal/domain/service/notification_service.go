@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/google/uuid"
 	"github.com/itsLeonB/cashback/internal/core/otel"
@@ -12,6 +13,7 @@ import (
 	"github.com/itsLeonB/cashback/internal/domain/message"
 	"github.com/itsLeonB/cashback/internal/domain/repository"
 	"github.com/itsLeonB/ezutil/v2"
+	"gorm.io/datatypes"
 )
 
 type notificationService struct {
@@ -20,6 +22,7 @@ type notificationService struct {
 	friendReqSvc FriendshipRequestService
 	friendSvc    FriendshipService
 	expenseSvc   GroupExpenseService
+	recurringSvc RecurringService
 	taskQueue    queue.TaskQueue
 }
 
@@ -29,6 +32,7 @@ func NewNotificationService(
 	friendReqSvc FriendshipRequestService,
 	friendSvc FriendshipService,
 	expenseSvc GroupExpenseService,
+	recurringSvc RecurringService,
 	taskQueue queue.TaskQueue,
 ) *notificationService {
 	return &notificationService{
@@ -37,6 +41,7 @@ func NewNotificationService(
 		friendReqSvc,
 		friendSvc,
 		expenseSvc,
+		recurringSvc,
 		taskQueue,
 	}
 }
@@ -91,6 +96,38 @@ func (ns *notificationService) HandleExpenseConfirmed(ctx context.Context, msg m
 	return nil
 }
 
+func (ns *notificationService) HandleRecurringExpenseCreated(ctx context.Context, msg message.RecurringExpenseCreated) error {
+	ctx, span := otel.Tracer.Start(ctx, "NotificationService.HandleRecurringExpenseCreated")
+	defer span.End()
+
+	return ns.publishNotification(ctx, func(ctx context.Context) (entity.Notification, error) {
+		return ns.recurringSvc.ConstructNotification(ctx, msg)
+	})
+}
+
+func (ns *notificationService) HandleSessionsRevoked(ctx context.Context, msg message.SessionsRevoked) error {
+	ctx, span := otel.Tracer.Start(ctx, "NotificationService.HandleSessionsRevoked")
+	defer span.End()
+
+	return ns.publishNotification(ctx, func(ctx context.Context) (entity.Notification, error) {
+		metadata, err := json.Marshal(message.SessionsRevokedMetadata{
+			UserAgent: msg.UserAgent,
+			Count:     msg.Count,
+		})
+		if err != nil {
+			return entity.Notification{}, err
+		}
+
+		return entity.Notification{
+			ProfileID:  msg.ProfileID,
+			Type:       msg.Type(),
+			EntityType: "session",
+			EntityID:   msg.SessionID,
+			Metadata:   datatypes.JSON(metadata),
+		}, nil
+	})
+}
+
 func (ns *notificationService) GetUnread(ctx context.Context, profileID uuid.UUID) ([]dto.NotificationResponse, error) {
 	ctx, span := otel.Tracer.Start(ctx, "NotificationService.GetUnread")
 	defer span.End()
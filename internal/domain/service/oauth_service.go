@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"time"
 
@@ -15,6 +16,12 @@ import (
 	"github.com/itsLeonB/ungerr"
 )
 
+// oauthState is kept in the state store between the redirect to the provider and its callback.
+type oauthState struct {
+	Session    string `json:"session"`
+	ClaimToken string `json:"claimToken,omitempty"`
+}
+
 type oauthServiceImpl struct {
 	transactor    auth.Transactor
 	providerSvc   oauth.ProviderService
@@ -45,7 +52,7 @@ func NewOAuthService(
 	}
 }
 
-func (as *oauthServiceImpl) GetOAuthURL(ctx context.Context, provider string) (string, error) {
+func (as *oauthServiceImpl) GetOAuthURL(ctx context.Context, provider, claimToken string) (string, error) {
 	ctx, span := otel.Tracer.Start(ctx, "OAuthService.GetOAuthURL")
 	defer span.End()
 
@@ -59,7 +66,12 @@ func (as *oauthServiceImpl) GetOAuthURL(ctx context.Context, provider string) (s
 		return "", err
 	}
 
-	if err = as.stateStore.Store(ctx, state, sessionStr, 5*time.Minute); err != nil {
+	value, err := json.Marshal(oauthState{Session: sessionStr, ClaimToken: claimToken})
+	if err != nil {
+		return "", ungerr.Wrap(err, "error marshaling oauth state")
+	}
+
+	if err = as.stateStore.Store(ctx, state, string(value), 5*time.Minute); err != nil {
 		return "", err
 	}
 
@@ -77,14 +89,18 @@ func (as *oauthServiceImpl) HandleOAuthCallback(ctx context.Context, data dto.OA
 		response dto.TokenResponse
 		user     auth.User
 		isNew    bool
+		state    oauthState
 	)
 	err := as.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
-		sessionStr, err := as.stateStore.VerifyAndDelete(ctx, data.State)
+		value, err := as.stateStore.VerifyAndDelete(ctx, data.State)
 		if err != nil {
 			return err
 		}
+		if err = json.Unmarshal([]byte(value), &state); err != nil {
+			return ungerr.Wrap(err, "error unmarshaling oauth state")
+		}
 
-		userInfo, err := as.providerSvc.HandleCallback(ctx, data.Provider, data.Code, sessionStr)
+		userInfo, err := as.providerSvc.HandleCallback(ctx, data.Provider, data.Code, state.Session)
 		if err != nil {
 			return err
 		}
@@ -102,14 +118,19 @@ func (as *oauthServiceImpl) HandleOAuthCallback(ctx context.Context, data dto.OA
 			}
 		}
 
-		response, err = as.sessionSvc.CreateTokenAndSession(ctx, user)
+		response, err = as.sessionSvc.CreateTokenAndSession(ctx, user, data.Device)
 		return err
 	})
 	if err != nil {
 		return dto.TokenResponse{}, err
 	}
 
-	if hookErr := as.hooks.CallAfterOAuthLogin(parentCtx, user.ID, data.Provider, isNew); hookErr != nil {
+	claims := map[string]any{}
+	if state.ClaimToken != "" {
+		claims["claimToken"] = state.ClaimToken
+	}
+
+	if hookErr := as.hooks.CallAfterOAuthLogin(parentCtx, user.ID, data.Provider, isNew, claims); hookErr != nil {
 		logger.Error(hookErr)
 	}
 
@@ -0,0 +1,211 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/core/otel"
+	"github.com/itsLeonB/cashback/internal/domain/dto"
+	"github.com/itsLeonB/cashback/internal/domain/entity/users"
+	"github.com/itsLeonB/cashback/internal/domain/service/auth"
+	"github.com/itsLeonB/go-crud"
+	"github.com/itsLeonB/ungerr"
+)
+
+// profileClaimTokenType tells claim links apart from other tokens signed with the same key.
+const profileClaimTokenType = "profile-claim"
+
+type profileClaimServiceImpl struct {
+	transactor    crud.Transactor
+	jwtService    auth.JWTService
+	friendshipSvc FriendshipService
+	profileSvc    ProfileService
+	claimURL      string
+	linkDuration  time.Duration
+}
+
+// NewProfileClaimService expects a JWT service whose tokens live at least as long as linkDuration.
+func NewProfileClaimService(
+	transactor crud.Transactor,
+	jwtService auth.JWTService,
+	friendshipSvc FriendshipService,
+	profileSvc ProfileService,
+	claimURL string,
+	linkDuration time.Duration,
+) ProfileClaimService {
+	return &profileClaimServiceImpl{
+		transactor,
+		jwtService,
+		friendshipSvc,
+		profileSvc,
+		claimURL,
+		linkDuration,
+	}
+}
+
+func (pcs *profileClaimServiceImpl) CreateLink(ctx context.Context, ownerProfileID, anonProfileID uuid.UUID) (dto.ProfileClaimLinkResponse, error) {
+	ctx, span := otel.Tracer.Start(ctx, "ProfileClaimService.CreateLink")
+	defer span.End()
+
+	if err := pcs.checkClaimable(ctx, ownerProfileID, anonProfileID); err != nil {
+		return dto.ProfileClaimLinkResponse{}, err
+	}
+
+	expiresAt := time.Now().Add(pcs.linkDuration).Truncate(time.Second)
+	token, err := pcs.jwtService.CreateToken(map[string]any{
+		"type":           profileClaimTokenType,
+		"ownerProfileId": ownerProfileID.String(),
+		"anonProfileId":  anonProfileID.String(),
+		"exp":            expiresAt.Unix(),
+	})
+	if err != nil {
+		return dto.ProfileClaimLinkResponse{}, err
+	}
+
+	return dto.ProfileClaimLinkResponse{
+		URL:       fmt.Sprintf("%s?token=%s", pcs.claimURL, url.QueryEscape(token)),
+		Token:     token,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+func (pcs *profileClaimServiceImpl) Preview(ctx context.Context, token string) (dto.ProfileClaimPreviewResponse, error) {
+	ctx, span := otel.Tracer.Start(ctx, "ProfileClaimService.Preview")
+	defer span.End()
+
+	claim, err := pcs.verify(token)
+	if err != nil {
+		return dto.ProfileClaimPreviewResponse{}, err
+	}
+
+	if err = pcs.checkClaimable(ctx, claim.OwnerProfileID, claim.AnonProfileID); err != nil {
+		return dto.ProfileClaimPreviewResponse{}, err
+	}
+
+	profiles, err := pcs.profileSvc.GetByIDs(ctx, []uuid.UUID{claim.OwnerProfileID, claim.AnonProfileID})
+	if err != nil {
+		return dto.ProfileClaimPreviewResponse{}, err
+	}
+
+	return dto.ProfileClaimPreviewResponse{
+		OwnerName:   profiles[claim.OwnerProfileID].Name,
+		OwnerAvatar: profiles[claim.OwnerProfileID].Avatar,
+		ProfileName: profiles[claim.AnonProfileID].Name,
+		ExpiresAt:   claim.ExpiresAt,
+	}, nil
+}
+
+func (pcs *profileClaimServiceImpl) Claim(ctx context.Context, profileID uuid.UUID, token string) (dto.ClaimProfileResponse, error) {
+	ctx, span := otel.Tracer.Start(ctx, "ProfileClaimService.Claim")
+	defer span.End()
+
+	claim, err := pcs.verify(token)
+	if err != nil {
+		return dto.ClaimProfileResponse{}, err
+	}
+
+	var response dto.ClaimProfileResponse
+	err = pcs.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		profile, err := pcs.profileSvc.GetByID(ctx, profileID)
+		if err != nil {
+			return err
+		}
+		if profile.IsAnonymous {
+			return ungerr.ForbiddenError("only registered users can claim a profile")
+		}
+		if profileID == claim.OwnerProfileID {
+			return ungerr.UnprocessableEntityError("cannot claim a profile you created")
+		}
+
+		if err = pcs.checkClaimable(ctx, claim.OwnerProfileID, claim.AnonProfileID); err != nil {
+			return err
+		}
+
+		isFriends, _, err := pcs.friendshipSvc.IsFriends(ctx, profileID, claim.OwnerProfileID)
+		if err != nil {
+			return err
+		}
+		if !isFriends {
+			if _, err = pcs.friendshipSvc.CreateReal(ctx, claim.OwnerProfileID, profileID); err != nil {
+				return err
+			}
+		}
+
+		if err = pcs.profileSvc.Associate(ctx, claim.OwnerProfileID, profileID, claim.AnonProfileID); err != nil {
+			return err
+		}
+
+		profiles, err := pcs.profileSvc.GetByIDs(ctx, []uuid.UUID{claim.OwnerProfileID, claim.AnonProfileID})
+		if err != nil {
+			return err
+		}
+
+		response = dto.ClaimProfileResponse{
+			Owner:          profiles[claim.OwnerProfileID],
+			ClaimedProfile: profiles[claim.AnonProfileID],
+		}
+		return nil
+	})
+	return response, err
+}
+
+// checkClaimable makes sure the owner still has the anonymous friend and nobody claimed it yet,
+// so links stop working once the friend is removed or the profile is taken.
+func (pcs *profileClaimServiceImpl) checkClaimable(ctx context.Context, ownerProfileID, anonProfileID uuid.UUID) error {
+	friendship, err := pcs.friendshipSvc.GetByProfileIDs(ctx, ownerProfileID, anonProfileID)
+	if err != nil {
+		return err
+	}
+	if friendship.Type != users.Anonymous {
+		return ungerr.UnprocessableEntityError("only anonymous friends can be claimed")
+	}
+
+	realProfileID, err := pcs.profileSvc.GetRealProfileID(ctx, anonProfileID)
+	if err != nil {
+		return err
+	}
+	if realProfileID != uuid.Nil {
+		return ungerr.ConflictError("profile is already claimed")
+	}
+
+	return nil
+}
+
+func (pcs *profileClaimServiceImpl) verify(token string) (dto.ProfileClaim, error) {
+	invalidErr := ungerr.UnprocessableEntityError("claim link is invalid or has expired")
+
+	claims, err := pcs.jwtService.VerifyToken(token)
+	if err != nil {
+		return dto.ProfileClaim{}, invalidErr
+	}
+	if tokenType, _ := claims.Data["type"].(string); tokenType != profileClaimTokenType {
+		return dto.ProfileClaim{}, invalidErr
+	}
+	exp, ok := claims.Data["exp"].(float64)
+	if !ok || time.Now().Unix() > int64(exp) {
+		return dto.ProfileClaim{}, invalidErr
+	}
+
+	ownerID, ownerErr := parseClaimID(claims.Data["ownerProfileId"])
+	anonID, anonErr := parseClaimID(claims.Data["anonProfileId"])
+	if ownerErr != nil || anonErr != nil {
+		return dto.ProfileClaim{}, invalidErr
+	}
+
+	return dto.ProfileClaim{
+		OwnerProfileID: ownerID,
+		AnonProfileID:  anonID,
+		ExpiresAt:      time.Unix(int64(exp), 0),
+	}, nil
+}
+
+func parseClaimID(value any) (uuid.UUID, error) {
+	str, ok := value.(string)
+	if !ok {
+		return uuid.Nil, ungerr.ValidationError("claim ID is not a string")
+	}
+	return uuid.Parse(str)
+}
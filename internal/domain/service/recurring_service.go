@@ -0,0 +1,332 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	mapset "github.com/deckarep/golang-set/v2"
+	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/core/logger"
+	"github.com/itsLeonB/cashback/internal/core/otel"
+	"github.com/itsLeonB/cashback/internal/core/service/queue"
+	"github.com/itsLeonB/cashback/internal/domain/dto"
+	"github.com/itsLeonB/cashback/internal/domain/entity"
+	"github.com/itsLeonB/cashback/internal/domain/entity/expenses"
+	"github.com/itsLeonB/cashback/internal/domain/mapper"
+	"github.com/itsLeonB/cashback/internal/domain/message"
+	"github.com/itsLeonB/cashback/internal/domain/repository"
+	"github.com/itsLeonB/cashback/internal/domain/service/expense"
+	"github.com/itsLeonB/ezutil/v2"
+	"github.com/itsLeonB/go-crud"
+	"github.com/itsLeonB/ungerr"
+	"github.com/robfig/cron/v3"
+	"gorm.io/datatypes"
+)
+
+type recurringServiceImpl struct {
+	transactor        crud.Transactor
+	templateRepo      repository.RecurringTemplateRepository
+	expenseSvc        GroupExpenseService
+	debtSvc           DebtService
+	friendshipSvc     FriendshipService
+	transferMethodSvc TransferMethodService
+	taskQueue         queue.TaskQueue
+}
+
+func NewRecurringService(
+	transactor crud.Transactor,
+	templateRepo repository.RecurringTemplateRepository,
+	expenseSvc GroupExpenseService,
+	debtSvc DebtService,
+	friendshipSvc FriendshipService,
+	transferMethodSvc TransferMethodService,
+	taskQueue queue.TaskQueue,
+) RecurringService {
+	return &recurringServiceImpl{
+		transactor,
+		templateRepo,
+		expenseSvc,
+		debtSvc,
+		friendshipSvc,
+		transferMethodSvc,
+		taskQueue,
+	}
+}
+
+func (rs *recurringServiceImpl) Create(ctx context.Context, req dto.NewRecurringTemplateRequest) (dto.RecurringTemplateResponse, error) {
+	ctx, span := otel.Tracer.Start(ctx, "RecurringService.Create")
+	defer span.End()
+
+	schedule, err := cron.ParseStandard(req.CronSpec)
+	if err != nil {
+		return dto.RecurringTemplateResponse{}, ungerr.ValidationError(fmt.Sprintf("invalid cron spec: %s", req.CronSpec))
+	}
+
+	var payload any
+	switch req.Kind {
+	case entity.GroupExpenseTemplate:
+		if err = validateGroupExpenseTemplate(req.CreatorProfileID, req.GroupExpense); err != nil {
+			return dto.RecurringTemplateResponse{}, err
+		}
+		payload = req.GroupExpense
+	case entity.DebtTemplate:
+		if err = rs.validateDebtTemplate(ctx, req.CreatorProfileID, req.Debt); err != nil {
+			return dto.RecurringTemplateResponse{}, err
+		}
+		payload = req.Debt
+	default:
+		return dto.RecurringTemplateResponse{}, ungerr.ValidationError(fmt.Sprintf("unsupported template kind: %s", req.Kind))
+	}
+
+	marshaledPayload, err := json.Marshal(payload)
+	if err != nil {
+		return dto.RecurringTemplateResponse{}, ungerr.Wrap(err, "error marshaling template payload")
+	}
+
+	insertedTemplate, err := rs.templateRepo.Insert(ctx, entity.RecurringTemplate{
+		CreatorProfileID: req.CreatorProfileID,
+		Kind:             req.Kind,
+		Name:             req.Name,
+		CronSpec:         req.CronSpec,
+		AutoConfirm:      req.AutoConfirm,
+		IsActive:         true,
+		NextRunAt:        schedule.Next(time.Now()),
+		Payload:          datatypes.JSON(marshaledPayload),
+	})
+	if err != nil {
+		return dto.RecurringTemplateResponse{}, err
+	}
+
+	return mapper.RecurringTemplateToResponse(insertedTemplate), nil
+}
+
+// validateGroupExpenseTemplate dry-runs the expense builder so a broken template is rejected upfront
+// instead of failing on every scheduled run.
+func validateGroupExpenseTemplate(creatorProfileID uuid.UUID, template *dto.RecurringGroupExpense) error {
+	if template == nil {
+		return ungerr.ValidationError("group expense template is required")
+	}
+
+	participantIDs := mapset.NewSet(template.ParticipantProfileIDs...)
+	participantIDs.Add(creatorProfileID)
+	groupExpense := mapper.RecurringGroupExpenseToEntity(*template)
+	groupExpense.Participants = ezutil.MapSlice(participantIDs.ToSlice(), func(id uuid.UUID) expenses.ExpenseParticipant {
+		return expenses.ExpenseParticipant{ParticipantProfileID: id}
+	})
+
+	_, err := expense.BuildFromTemplate(groupExpense)
+	return err
+}
+
+// validateDebtTemplate checks what recording the debt would check, so a template with an unknown
+// transfer method or a non-friend is rejected upfront instead of failing on every scheduled run.
+func (rs *recurringServiceImpl) validateDebtTemplate(ctx context.Context, creatorProfileID uuid.UUID, template *dto.NewDebtTransactionRequest) error {
+	if template == nil {
+		return ungerr.ValidationError("debt template is required")
+	}
+	if !template.Amount.IsPositive() {
+		return ungerr.ValidationError("amount must be greater than 0")
+	}
+	if template.FriendProfileID == creatorProfileID {
+		return ungerr.UnprocessableEntityError("cannot do self transactions")
+	}
+
+	isFriends, _, err := rs.friendshipSvc.IsFriends(ctx, creatorProfileID, template.FriendProfileID)
+	if err != nil {
+		return err
+	}
+	if !isFriends {
+		return ungerr.UnprocessableEntityError("both profiles are not friends")
+	}
+
+	_, err = rs.transferMethodSvc.GetByID(ctx, template.TransferMethodID)
+	return err
+}
+
+func (rs *recurringServiceImpl) GetAll(ctx context.Context, profileID uuid.UUID) ([]dto.RecurringTemplateResponse, error) {
+	ctx, span := otel.Tracer.Start(ctx, "RecurringService.GetAll")
+	defer span.End()
+
+	spec := crud.Specification[entity.RecurringTemplate]{}
+	spec.Model.CreatorProfileID = profileID
+	templates, err := rs.templateRepo.FindAll(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	return ezutil.MapSlice(templates, mapper.RecurringTemplateToResponse), nil
+}
+
+func (rs *recurringServiceImpl) Delete(ctx context.Context, profileID, id uuid.UUID) error {
+	ctx, span := otel.Tracer.Start(ctx, "RecurringService.Delete")
+	defer span.End()
+
+	return rs.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		template, err := rs.getTemplate(ctx, profileID, id, true)
+		if err != nil {
+			return err
+		}
+		return rs.templateRepo.Delete(ctx, template)
+	})
+}
+
+func (rs *recurringServiceImpl) RunDue(ctx context.Context) error {
+	ctx, span := otel.Tracer.Start(ctx, "RecurringService.RunDue")
+	defer span.End()
+
+	now := time.Now()
+	templates, err := rs.templateRepo.FindAllDue(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	if len(templates) == 0 {
+		logger.Info("no recurring templates due")
+		return nil
+	}
+
+	logger.Infof("%d recurring templates due", len(templates))
+
+	var runErr error
+	for _, template := range templates {
+		if err := rs.run(ctx, template.ID, now); err != nil {
+			runErr = errors.Join(runErr, ungerr.Wrap(err, fmt.Sprintf("error running recurring template %s", template.ID)))
+		}
+	}
+
+	return runErr
+}
+
+// run advances the template's schedule, then stamps it out once in a separate transaction. A template
+// whose payload no longer applies (e.g. a participant is no longer a friend) is still advanced, so it does
+// not fail on every tick, and a failed stamp is rolled back as a whole instead of leaving partial rows.
+// A debt is recorded in its own transaction, as it is announced once that commits.
+func (rs *recurringServiceImpl) run(ctx context.Context, id uuid.UUID, now time.Time) error {
+	var template entity.RecurringTemplate
+	var claimed bool
+	err := rs.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		template, err = rs.getTemplate(ctx, uuid.Nil, id, true)
+		if err != nil {
+			return err
+		}
+		if !template.IsActive || template.NextRunAt.After(now) {
+			// Already picked up by another run
+			return nil
+		}
+
+		schedule, err := cron.ParseStandard(template.CronSpec)
+		if err != nil {
+			return ungerr.Wrap(err, "error parsing cron spec")
+		}
+
+		template.NextRunAt = schedule.Next(now)
+		template.LastRunAt = sql.NullTime{Time: now, Valid: true}
+		if _, err = rs.templateRepo.Update(ctx, template); err != nil {
+			return err
+		}
+
+		claimed = true
+		return nil
+	})
+	if err != nil || !claimed {
+		return err
+	}
+
+	var createdExpense expenses.GroupExpense
+	switch template.Kind {
+	case entity.GroupExpenseTemplate:
+		err = rs.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+			var err error
+			createdExpense, err = rs.stampGroupExpense(ctx, template)
+			return err
+		})
+	case entity.DebtTemplate:
+		err = rs.stampDebt(ctx, template)
+	default:
+		err = ungerr.Unknownf("unsupported template kind: %s", template.Kind)
+	}
+	if err != nil {
+		logger.Errorf("error stamping out recurring template %s: %v", template.ID, err)
+		return nil
+	}
+	if createdExpense.IsZero() {
+		return nil
+	}
+
+	if template.AutoConfirm {
+		if _, err = rs.expenseSvc.ConfirmDraft(ctx, createdExpense.ID, template.CreatorProfileID, false); err == nil {
+			return nil
+		}
+		logger.Errorf("error auto-confirming expense %s from recurring template %s: %v", createdExpense.ID, template.ID, err)
+	}
+
+	go rs.taskQueue.AsyncEnqueue(ctx, message.RecurringExpenseCreated{
+		TemplateID:     template.ID,
+		GroupExpenseID: createdExpense.ID,
+	})
+
+	return nil
+}
+
+func (rs *recurringServiceImpl) stampGroupExpense(ctx context.Context, template entity.RecurringTemplate) (expenses.GroupExpense, error) {
+	payload, err := ezutil.Unmarshal[dto.RecurringGroupExpense](template.Payload)
+	if err != nil {
+		return expenses.GroupExpense{}, err
+	}
+	return rs.expenseSvc.CreateFromTemplate(ctx, template.CreatorProfileID, payload)
+}
+
+func (rs *recurringServiceImpl) stampDebt(ctx context.Context, template entity.RecurringTemplate) error {
+	payload, err := ezutil.Unmarshal[dto.NewDebtTransactionRequest](template.Payload)
+	if err != nil {
+		return err
+	}
+	payload.UserProfileID = template.CreatorProfileID
+	_, err = rs.debtSvc.RecordNewTransaction(ctx, payload)
+	return err
+}
+
+func (rs *recurringServiceImpl) ConstructNotification(ctx context.Context, msg message.RecurringExpenseCreated) (entity.Notification, error) {
+	ctx, span := otel.Tracer.Start(ctx, "RecurringService.ConstructNotification")
+	defer span.End()
+
+	template, err := rs.getTemplate(ctx, uuid.Nil, msg.TemplateID, false)
+	if err != nil {
+		return entity.Notification{}, err
+	}
+
+	metadata, err := json.Marshal(message.RecurringExpenseCreatedMetadata{
+		TemplateName: template.Name,
+	})
+	if err != nil {
+		return entity.Notification{}, err
+	}
+
+	return entity.Notification{
+		ProfileID:  template.CreatorProfileID,
+		Type:       msg.Type(),
+		EntityType: "group-expense",
+		EntityID:   msg.GroupExpenseID,
+		Metadata:   datatypes.JSON(metadata),
+	}, nil
+}
+
+func (rs *recurringServiceImpl) getTemplate(ctx context.Context, profileID, id uuid.UUID, forUpdate bool) (entity.RecurringTemplate, error) {
+	spec := crud.Specification[entity.RecurringTemplate]{}
+	spec.Model.ID = id
+	spec.Model.CreatorProfileID = profileID
+	spec.ForUpdate = forUpdate
+	template, err := rs.templateRepo.FindFirst(ctx, spec)
+	if err != nil {
+		return entity.RecurringTemplate{}, err
+	}
+	if template.IsZero() {
+		return entity.RecurringTemplate{}, ungerr.NotFoundError(fmt.Sprintf("recurring template with ID %s is not found", id))
+	}
+	return template, nil
+}
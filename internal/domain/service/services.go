@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/itsLeonB/cashback/internal/domain/dto"
@@ -29,24 +30,28 @@ type AuthService interface {
 	Register(ctx context.Context, request dto.RegisterRequest) (dto.RegisterResponse, error)
 	InternalLogin(ctx context.Context, request dto.InternalLoginRequest) (dto.TokenResponse, error)
 	VerifyToken(ctx context.Context, token string, fingerprint string) (bool, map[string]any, error)
-	VerifyRegistration(ctx context.Context, token string) (dto.TokenResponse, error)
+	VerifyRegistration(ctx context.Context, token string, device auth.Device) (dto.TokenResponse, error)
 	SendPasswordReset(ctx context.Context, email string) error
-	ResetPassword(ctx context.Context, token, newPassword string) (dto.TokenResponse, error)
+	ResetPassword(ctx context.Context, token, newPassword string, device auth.Device) (dto.TokenResponse, error)
 	Logout(ctx context.Context, sessionID uuid.UUID) error
 	Shutdown() error
 }
 
 type OAuthService interface {
-	GetOAuthURL(ctx context.Context, provider string) (string, error)
+	GetOAuthURL(ctx context.Context, provider, claimToken string) (string, error)
 	HandleOAuthCallback(ctx context.Context, data dto.OAuthCallbackData) (dto.TokenResponse, error)
 }
 
 type SessionService interface {
 	RefreshToken(ctx context.Context, request dto.RefreshTokenRequest) (dto.TokenResponse, error)
 
-	CreateTokenAndSession(ctx context.Context, user auth.User) (dto.TokenResponse, error)
+	CreateTokenAndSession(ctx context.Context, user auth.User, device auth.Device) (dto.TokenResponse, error)
 	RevokeSession(ctx context.Context, sessionID string) error
 	GetByID(ctx context.Context, id string) (auth.Session, error)
+
+	GetUserSessions(ctx context.Context, userID, currentSessionID string) ([]dto.SessionResponse, error)
+	RevokeUserSession(ctx context.Context, userID, currentSessionID, sessionID string) error
+	RevokeOtherSessions(ctx context.Context, userID, currentSessionID string) error
 }
 
 type ProfileService interface {
@@ -90,7 +95,7 @@ type FriendshipRequestService interface {
 }
 
 type FriendDetailsService interface {
-	GetDetails(ctx context.Context, profileID, friendshipID uuid.UUID) (dto.FriendDetailsResponse, error)
+	GetDetails(ctx context.Context, profileID, friendshipID uuid.UUID, inHomeCurrency bool) (dto.FriendDetailsResponse, error)
 	GetDetailsBySlug(ctx context.Context, slug string) (dto.FriendDetailsResponse, error)
 }
 
@@ -98,14 +103,84 @@ type DebtService interface {
 	RecordNewTransaction(ctx context.Context, request dto.NewDebtTransactionRequest) (dto.DebtTransactionResponse, error)
 	GetTransactions(ctx context.Context, userProfileID uuid.UUID) ([]dto.DebtTransactionResponse, error)
 	GetAllByProfileIDs(ctx context.Context, userProfileID, friendProfileID uuid.UUID) ([]debts.DebtTransaction, []uuid.UUID, error)
-	GetTransactionSummary(ctx context.Context, profileID uuid.UUID) (map[string]dto.FriendBalance, error)
+	GetTransactionSummary(ctx context.Context, profileID uuid.UUID, inHomeCurrency bool) (map[string]dto.FriendBalance, error)
 	GetNetBalancesByFriend(ctx context.Context, profileID uuid.UUID) (map[uuid.UUID]map[string]decimal.Decimal, error)
+	GetSettleUpPlan(ctx context.Context, req dto.SettleUpRequest) (map[string][]dto.SettleUpTransfer, error)
 	GetRecent(ctx context.Context, profileID uuid.UUID) ([]dto.DebtTransactionResponse, error)
+	UpdateLabels(ctx context.Context, req dto.LabelsRequest) (dto.DebtTransactionResponse, error)
 
 	ConstructNotification(ctx context.Context, msg message.DebtCreated) (entity.Notification, error)
 	ProcessConfirmedGroupExpense(ctx context.Context, groupExpense expenses.GroupExpense) error
 }
 
+type SettlementService interface {
+	Record(ctx context.Context, req dto.NewSettlementRequest) (dto.SettlementResponse, error)
+	GetByID(ctx context.Context, profileID, settlementID uuid.UUID) (dto.SettlementResponse, error)
+}
+
+type FxRateService interface {
+	Upload(ctx context.Context, req dto.UploadFxRatesRequest) ([]dto.FxRateResponse, error)
+	GetList(ctx context.Context, query dto.FxRateQuery) ([]dto.FxRateResponse, int64, error)
+	ConvertTransactions(ctx context.Context, transactions []debts.DebtTransaction, currency string) ([]debts.DebtTransaction, error)
+}
+
+type ExportService interface {
+	Request(ctx context.Context, req dto.NewExportRequest) (dto.ExportResponse, error)
+	GetByID(ctx context.Context, profileID, id uuid.UUID) (dto.ExportResponse, error)
+	Generate(ctx context.Context, msg message.ExportRequested) error
+}
+
+type ImportService interface {
+	Request(ctx context.Context, req dto.NewImportRequest) (dto.ImportResponse, error)
+	Confirm(ctx context.Context, profileID, id uuid.UUID) (dto.ImportResponse, error)
+	GetByID(ctx context.Context, profileID, id uuid.UUID) (dto.ImportResponse, error)
+	Process(ctx context.Context, msg message.ImportRequested) error
+}
+
+type RecurringService interface {
+	Create(ctx context.Context, req dto.NewRecurringTemplateRequest) (dto.RecurringTemplateResponse, error)
+	GetAll(ctx context.Context, profileID uuid.UUID) ([]dto.RecurringTemplateResponse, error)
+	Delete(ctx context.Context, profileID, id uuid.UUID) error
+	RunDue(ctx context.Context) error
+
+	ConstructNotification(ctx context.Context, msg message.RecurringExpenseCreated) (entity.Notification, error)
+}
+
+type CategoryService interface {
+	Create(ctx context.Context, req dto.NewCategoryRequest) (dto.CategoryResponse, error)
+	GetAll(ctx context.Context, profileID uuid.UUID) ([]dto.CategoryResponse, error)
+	Delete(ctx context.Context, profileID, id uuid.UUID) error
+
+	// GetUsable returns a default category or one the profile defined, and not found otherwise.
+	GetUsable(ctx context.Context, profileID, id uuid.UUID) (entity.Category, error)
+	GetDefaultByName(ctx context.Context, name string) (entity.Category, error)
+}
+
+type GroupService interface {
+	Create(ctx context.Context, req dto.NewGroupRequest) (dto.GroupResponse, error)
+	GetAll(ctx context.Context, profileID uuid.UUID) ([]dto.GroupResponse, error)
+	GetDetails(ctx context.Context, profileID, id uuid.UUID) (dto.GroupResponse, error)
+	Update(ctx context.Context, req dto.UpdateGroupRequest) (dto.GroupResponse, error)
+	SyncMembers(ctx context.Context, req dto.GroupMembersRequest) (dto.GroupResponse, error)
+	Delete(ctx context.Context, profileID, id uuid.UUID) error
+	GetBalances(ctx context.Context, profileID, id uuid.UUID) ([]dto.GroupBalanceResponse, error)
+	GetActivities(ctx context.Context, profileID, id uuid.UUID) ([]dto.GroupActivityResponse, error)
+
+	GetByID(ctx context.Context, id uuid.UUID) (expenses.Group, error)
+	// GetMembership returns the group if the profile is one of its members, and not found otherwise.
+	GetMembership(ctx context.Context, profileID, id uuid.UUID) (expenses.Group, error)
+}
+
+type ProfileClaimService interface {
+	CreateLink(ctx context.Context, ownerProfileID, anonProfileID uuid.UUID) (dto.ProfileClaimLinkResponse, error)
+	Preview(ctx context.Context, token string) (dto.ProfileClaimPreviewResponse, error)
+	Claim(ctx context.Context, profileID uuid.UUID, token string) (dto.ClaimProfileResponse, error)
+}
+
+type AnalyticsService interface {
+	GetSpending(ctx context.Context, req dto.SpendingRequest) (dto.SpendingResponse, error)
+}
+
 type TransferMethodService interface {
 	GetAll(ctx context.Context, filter debts.ParentFilter, profileID uuid.UUID) ([]dto.TransferMethodResponse, error)
 	GetByID(ctx context.Context, id uuid.UUID) (debts.TransferMethod, error)
@@ -117,21 +192,32 @@ type TransferMethodService interface {
 
 type GroupExpenseService interface {
 	CreateDraft(ctx context.Context, req dto.NewDraftRequest) (dto.GroupExpenseResponse, error)
+	CreateFromTemplate(ctx context.Context, creatorProfileID uuid.UUID, template dto.RecurringGroupExpense) (expenses.GroupExpense, error)
+	CreateImported(ctx context.Context, creatorProfileID uuid.UUID, template dto.RecurringGroupExpense, occurredAt time.Time) (expenses.GroupExpense, error)
 	GetAll(ctx context.Context, userProfileID uuid.UUID, ownership expenses.ExpenseOwnership, status expenses.ExpenseStatus) ([]dto.GroupExpenseResponse, error)
 	GetDetails(ctx context.Context, id, userProfileID uuid.UUID) (dto.GroupExpenseResponse, error)
 	ConfirmDraft(ctx context.Context, id, userProfileID uuid.UUID, dryRun bool) (dto.ExpenseConfirmationResponse, error)
 	Delete(ctx context.Context, userProfileID, id uuid.UUID) error
+	Amend(ctx context.Context, id, userProfileID uuid.UUID) (dto.GroupExpenseResponse, error)
 	SyncParticipants(ctx context.Context, req dto.ExpenseParticipantsRequest) error
 	GetRecent(ctx context.Context, profileID uuid.UUID) ([]dto.GroupExpenseResponse, error)
+	UpdateLabels(ctx context.Context, req dto.LabelsRequest) (dto.GroupExpenseResponse, error)
 
 	GetUnconfirmedForUpdate(ctx context.Context, profileID, id uuid.UUID) (expenses.GroupExpense, error)
 	ParseFromBillText(ctx context.Context, msg message.ExpenseBillTextExtracted) error
+	UpdateDraft(ctx context.Context, expense expenses.GroupExpense, request dto.NewGroupExpenseRequest) error
 	Recalculate(ctx context.Context, userProfileID, groupExpenseID uuid.UUID, amountChanged bool) error
 	GetByID(ctx context.Context, id uuid.UUID, forUpdate bool) (expenses.GroupExpense, error)
+	GetByIDs(ctx context.Context, ids []uuid.UUID) ([]expenses.GroupExpense, error)
 	ConstructNotifications(ctx context.Context, msg message.ExpenseConfirmed) ([]entity.Notification, error)
 	ProcessCallback(ctx context.Context, id uuid.UUID, callbackFn func(context.Context, expenses.GroupExpense) error) error
 }
 
+// InboundMailService turns receipts that users forward by email into draft expenses.
+type InboundMailService interface {
+	Receive(ctx context.Context, raw []byte) (dto.InboundMailResponse, error)
+}
+
 type ExpenseItemService interface {
 	Add(ctx context.Context, request dto.NewExpenseItemRequest) error
 	Update(ctx context.Context, request dto.UpdateExpenseItemRequest) error
@@ -151,6 +237,10 @@ type ExpenseBillService interface {
 	Cleanup(ctx context.Context) error
 	TriggerParsing(ctx context.Context, expenseID, billID uuid.UUID) error
 	SavePresigned(ctx context.Context, req dto.PresignedExpenseBillRequest) (dto.PresignedExpenseBillResponse, error)
+	// Upload stores a bill file received by the server and queues it for extraction, returning the bill ID.
+	Upload(ctx context.Context, req dto.NewExpenseBillRequest) (uuid.UUID, error)
+	// Review applies the user's decisions on a bill awaiting review to the draft.
+	Review(ctx context.Context, req dto.BillReviewRequest) (dto.ExpenseBillResponse, error)
 }
 
 type SubscriptionLimitService interface {
@@ -169,6 +259,8 @@ type NotificationService interface {
 	HandleFriendRequestSent(ctx context.Context, msg message.FriendRequestSent) error
 	HandleFriendRequestAccepted(ctx context.Context, msg message.FriendRequestAccepted) error
 	HandleExpenseConfirmed(ctx context.Context, msg message.ExpenseConfirmed) error
+	HandleRecurringExpenseCreated(ctx context.Context, msg message.RecurringExpenseCreated) error
+	HandleSessionsRevoked(ctx context.Context, msg message.SessionsRevoked) error
 
 	GetUnread(ctx context.Context, profileID uuid.UUID) ([]dto.NotificationResponse, error)
 	MarkAsRead(ctx context.Context, profileID, notificationID uuid.UUID) error
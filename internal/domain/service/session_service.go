@@ -6,23 +6,27 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"errors"
+	"slices"
 	"time"
 
+	"github.com/itsLeonB/cashback/internal/core/logger"
 	"github.com/itsLeonB/cashback/internal/core/otel"
 	"github.com/itsLeonB/cashback/internal/domain/dto"
 	"github.com/itsLeonB/cashback/internal/domain/mapper"
 	"github.com/itsLeonB/cashback/internal/domain/service/auth"
+	"github.com/itsLeonB/ezutil/v2"
 	"github.com/itsLeonB/ungerr"
 )
 
 type sessionService struct {
-	jwtService       auth.JWTService
-	users            auth.UserStore
-	transactor       auth.Transactor
-	sessions         auth.SessionStore
-	refreshTokens    auth.RefreshTokenStore
-	refreshTokenTTL  time.Duration
-	claimsBuilder    func(ctx context.Context, userID string, baseClaims map[string]any) (map[string]any, error)
+	jwtService      auth.JWTService
+	users           auth.UserStore
+	transactor      auth.Transactor
+	sessions        auth.SessionStore
+	refreshTokens   auth.RefreshTokenStore
+	refreshTokenTTL time.Duration
+	sessionCache    auth.SessionCache
+	hooks           AuthHooks
 }
 
 func NewSessionService(
@@ -32,7 +36,8 @@ func NewSessionService(
 	sessions auth.SessionStore,
 	refreshTokens auth.RefreshTokenStore,
 	refreshTokenTTL time.Duration,
-	claimsBuilder func(ctx context.Context, userID string, baseClaims map[string]any) (map[string]any, error),
+	sessionCache auth.SessionCache,
+	hooks AuthHooks,
 ) SessionService {
 	return &sessionService{
 		jwtService,
@@ -41,7 +46,8 @@ func NewSessionService(
 		sessions,
 		refreshTokens,
 		refreshTokenTTL,
-		claimsBuilder,
+		sessionCache,
+		hooks,
 	}
 }
 
@@ -80,8 +86,8 @@ func (ss *sessionService) RefreshToken(ctx context.Context, request dto.RefreshT
 		}
 		claims := mapper.SessionToAuthData(session, fgpHash)
 
-		if ss.claimsBuilder != nil {
-			builtClaims, err := ss.claimsBuilder(ctx, session.UserID, claims)
+		if ss.hooks.ClaimsBuilder != nil {
+			builtClaims, err := ss.hooks.ClaimsBuilder(ctx, session.UserID, claims)
 			if err != nil {
 				return err
 			}
@@ -100,12 +106,12 @@ func (ss *sessionService) RefreshToken(ctx context.Context, request dto.RefreshT
 	return response, err
 }
 
-func (ss *sessionService) CreateTokenAndSession(ctx context.Context, user auth.User) (dto.TokenResponse, error) {
+func (ss *sessionService) CreateTokenAndSession(ctx context.Context, user auth.User, device auth.Device) (dto.TokenResponse, error) {
 	ctx, span := otel.Tracer.Start(ctx, "SessionService.CreateTokenAndSession")
 	defer span.End()
 
 	// Create session with refresh token
-	session, refreshToken, err := ss.createSession(ctx, user.ID, ss.refreshTokenTTL)
+	session, refreshToken, err := ss.createSession(ctx, user.ID, device, ss.refreshTokenTTL)
 	if err != nil {
 		return dto.TokenResponse{}, err
 	}
@@ -116,8 +122,8 @@ func (ss *sessionService) CreateTokenAndSession(ctx context.Context, user auth.U
 	}
 	authData := mapper.SessionToAuthData(session, fgpHash)
 
-	if ss.claimsBuilder != nil {
-		builtClaims, err := ss.claimsBuilder(ctx, session.UserID, authData)
+	if ss.hooks.ClaimsBuilder != nil {
+		builtClaims, err := ss.hooks.ClaimsBuilder(ctx, session.UserID, authData)
 		if err != nil {
 			return dto.TokenResponse{}, err
 		}
@@ -145,14 +151,116 @@ func (ss *sessionService) RevokeSession(ctx context.Context, sessionID string) e
 	})
 }
 
+// GetUserSessions lists the user's active sessions, the current one first and the rest by last use
+func (ss *sessionService) GetUserSessions(ctx context.Context, userID, currentSessionID string) ([]dto.SessionResponse, error) {
+	ctx, span := otel.Tracer.Start(ctx, "SessionService.GetUserSessions")
+	defer span.End()
+
+	sessions, err := ss.sessions.FindActiveByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(sessions, func(a, b auth.Session) int {
+		switch {
+		case a.ID == currentSessionID:
+			return -1
+		case b.ID == currentSessionID:
+			return 1
+		default:
+			return b.LastUsedAt.Compare(a.LastUsedAt)
+		}
+	})
+
+	return ezutil.MapSlice(sessions, func(s auth.Session) dto.SessionResponse {
+		return mapper.SessionToResponse(s, currentSessionID)
+	}), nil
+}
+
+// RevokeUserSession signs one of the user's other sessions out
+func (ss *sessionService) RevokeUserSession(ctx context.Context, userID, currentSessionID, sessionID string) error {
+	ctx, span := otel.Tracer.Start(ctx, "SessionService.RevokeUserSession")
+	defer span.End()
+
+	if sessionID == currentSessionID {
+		return ungerr.UnprocessableEntityError("use logout to end the current session")
+	}
+
+	session, err := ss.sessions.GetByID(ctx, sessionID)
+	if err != nil {
+		if errors.Is(err, auth.ErrSessionNotFound) {
+			return ungerr.NotFoundError("session is not found")
+		}
+		return err
+	}
+	if session.UserID != userID {
+		return ungerr.NotFoundError("session is not found")
+	}
+
+	return ss.revokeAll(ctx, userID, []auth.Session{session})
+}
+
+// RevokeOtherSessions signs the user out everywhere except the current session
+func (ss *sessionService) RevokeOtherSessions(ctx context.Context, userID, currentSessionID string) error {
+	ctx, span := otel.Tracer.Start(ctx, "SessionService.RevokeOtherSessions")
+	defer span.End()
+
+	sessions, err := ss.sessions.FindActiveByUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	others := slices.DeleteFunc(sessions, func(s auth.Session) bool { return s.ID == currentSessionID })
+	return ss.revokeAll(ctx, userID, others)
+}
+
+// revokeAll deletes the sessions and drops them from the cache, so their access
+// tokens stop working right away instead of when the cache entries expire.
+func (ss *sessionService) revokeAll(ctx context.Context, userID string, sessions []auth.Session) error {
+	if len(sessions) == 0 {
+		return nil
+	}
+
+	for _, session := range sessions {
+		if err := ss.hooks.CallBeforeLogout(ctx, session.ID); err != nil {
+			logger.Error(err)
+		}
+	}
+
+	err := ss.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		for _, session := range sessions {
+			if err := ss.refreshTokens.DeleteBySession(ctx, session.ID); err != nil {
+				return err
+			}
+			if err := ss.sessions.Delete(ctx, session.ID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, session := range sessions {
+		ss.sessionCache.Delete(session.ID)
+	}
+
+	if err = ss.hooks.CallAfterSessionsRevoked(ctx, userID, sessions); err != nil {
+		logger.Error(err)
+	}
+
+	return nil
+}
+
 // createSession creates a new session with initial refresh token
-func (ss *sessionService) createSession(ctx context.Context, userID string, refreshTokenTTL time.Duration) (auth.Session, string, error) {
+func (ss *sessionService) createSession(ctx context.Context, userID string, device auth.Device, refreshTokenTTL time.Duration) (auth.Session, string, error) {
 	var session auth.Session
 	var refreshToken string
 
 	err := ss.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
 		var err error
-		session, err = ss.sessions.Create(ctx, userID)
+		session, err = ss.sessions.Create(ctx, userID, device)
 		if err != nil {
 			return err
 		}
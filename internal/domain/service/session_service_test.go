@@ -0,0 +1,176 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/domain/service"
+	"github.com/itsLeonB/cashback/internal/domain/service/auth"
+	"github.com/itsLeonB/ungerr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSessionStore struct {
+	auth.SessionStore
+	sessions map[string]auth.Session
+}
+
+func (f *fakeSessionStore) GetByID(_ context.Context, id string) (auth.Session, error) {
+	session, ok := f.sessions[id]
+	if !ok {
+		return auth.Session{}, auth.ErrSessionNotFound
+	}
+	return session, nil
+}
+
+func (f *fakeSessionStore) FindActiveByUser(_ context.Context, userID string) ([]auth.Session, error) {
+	var sessions []auth.Session
+	for _, session := range f.sessions {
+		if session.UserID == userID {
+			sessions = append(sessions, session)
+		}
+	}
+	return sessions, nil
+}
+
+func (f *fakeSessionStore) Delete(_ context.Context, id string) error {
+	delete(f.sessions, id)
+	return nil
+}
+
+type fakeRefreshTokenStore struct {
+	auth.RefreshTokenStore
+	deletedSessions []string
+}
+
+func (f *fakeRefreshTokenStore) DeleteBySession(_ context.Context, sessionID string) error {
+	f.deletedSessions = append(f.deletedSessions, sessionID)
+	return nil
+}
+
+type fakeSessionCache struct {
+	auth.SessionCache
+	deleted []string
+}
+
+func (f *fakeSessionCache) Delete(sessionID string) {
+	f.deleted = append(f.deleted, sessionID)
+}
+
+type inlineTransactor struct{}
+
+func (inlineTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+type sessionFixture struct {
+	svc           service.SessionService
+	sessions      *fakeSessionStore
+	refreshTokens *fakeRefreshTokenStore
+	cache         *fakeSessionCache
+	loggedOut     []string
+	revoked       []auth.Session
+}
+
+func newSessionFixture(sessions ...auth.Session) *sessionFixture {
+	f := &sessionFixture{
+		sessions:      &fakeSessionStore{sessions: make(map[string]auth.Session, len(sessions))},
+		refreshTokens: &fakeRefreshTokenStore{},
+		cache:         &fakeSessionCache{},
+	}
+	for _, session := range sessions {
+		f.sessions.sessions[session.ID] = session
+	}
+
+	hooks := service.AuthHooks{
+		BeforeLogout: func(_ context.Context, sessionID string) error {
+			f.loggedOut = append(f.loggedOut, sessionID)
+			return nil
+		},
+		AfterSessionsRevoked: func(_ context.Context, _ string, sessions []auth.Session) error {
+			f.revoked = append(f.revoked, sessions...)
+			return nil
+		},
+	}
+	f.svc = service.NewSessionService(nil, nil, inlineTransactor{}, f.sessions, f.refreshTokens, time.Hour, f.cache, hooks)
+	return f
+}
+
+func newSession(userID string, lastUsedAt time.Time) auth.Session {
+	return auth.Session{ID: uuid.NewString(), UserID: userID, LastUsedAt: lastUsedAt}
+}
+
+func TestGetUserSessions_CurrentFirstThenByLastUse(t *testing.T) {
+	userID := uuid.NewString()
+	now := time.Now()
+	current := newSession(userID, now.Add(-time.Hour))
+	older := newSession(userID, now.Add(-2*time.Hour))
+	recent := newSession(userID, now)
+	f := newSessionFixture(current, older, recent, newSession(uuid.NewString(), now))
+
+	sessions, err := f.svc.GetUserSessions(context.Background(), userID, current.ID)
+
+	require.NoError(t, err)
+	require.Len(t, sessions, 3)
+	assert.Equal(t, current.ID, sessions[0].ID)
+	assert.True(t, sessions[0].IsCurrent)
+	assert.Equal(t, recent.ID, sessions[1].ID)
+	assert.Equal(t, older.ID, sessions[2].ID)
+}
+
+func TestRevokeOtherSessions_KeepsCurrentSession(t *testing.T) {
+	userID := uuid.NewString()
+	current := newSession(userID, time.Now())
+	other := newSession(userID, time.Now())
+	stranger := newSession(uuid.NewString(), time.Now())
+	f := newSessionFixture(current, other, stranger)
+
+	err := f.svc.RevokeOtherSessions(context.Background(), userID, current.ID)
+
+	require.NoError(t, err)
+	assert.Contains(t, f.sessions.sessions, current.ID)
+	assert.Contains(t, f.sessions.sessions, stranger.ID)
+	assert.NotContains(t, f.sessions.sessions, other.ID)
+	assert.Equal(t, []string{other.ID}, f.refreshTokens.deletedSessions)
+	assert.Equal(t, []string{other.ID}, f.cache.deleted)
+	assert.Equal(t, []string{other.ID}, f.loggedOut)
+	assert.Equal(t, []auth.Session{other}, f.revoked)
+}
+
+func TestRevokeOtherSessions_NothingToRevoke(t *testing.T) {
+	userID := uuid.NewString()
+	current := newSession(userID, time.Now())
+	f := newSessionFixture(current)
+
+	err := f.svc.RevokeOtherSessions(context.Background(), userID, current.ID)
+
+	require.NoError(t, err)
+	assert.Empty(t, f.cache.deleted)
+	assert.Empty(t, f.revoked)
+}
+
+func TestRevokeUserSession_OtherUsersSessionIsNotFound(t *testing.T) {
+	stranger := newSession(uuid.NewString(), time.Now())
+	f := newSessionFixture(stranger)
+
+	err := f.svc.RevokeUserSession(context.Background(), uuid.NewString(), uuid.NewString(), stranger.ID)
+
+	var appErr ungerr.AppError
+	require.ErrorAs(t, err, &appErr)
+	assert.Contains(t, f.sessions.sessions, stranger.ID)
+	assert.Empty(t, f.cache.deleted)
+}
+
+func TestRevokeUserSession_RejectsCurrentSession(t *testing.T) {
+	userID := uuid.NewString()
+	current := newSession(userID, time.Now())
+	f := newSessionFixture(current)
+
+	err := f.svc.RevokeUserSession(context.Background(), userID, current.ID, current.ID)
+
+	require.Error(t, err)
+	assert.Contains(t, f.sessions.sessions, current.ID)
+}
@@ -0,0 +1,384 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	mapset "github.com/deckarep/golang-set/v2"
+	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/core/config"
+	"github.com/itsLeonB/cashback/internal/core/otel"
+	"github.com/itsLeonB/cashback/internal/core/service/queue"
+	"github.com/itsLeonB/cashback/internal/core/service/storage"
+	"github.com/itsLeonB/cashback/internal/core/util"
+	"github.com/itsLeonB/cashback/internal/domain/dto"
+	"github.com/itsLeonB/cashback/internal/domain/entity"
+	"github.com/itsLeonB/cashback/internal/domain/entity/debts"
+	"github.com/itsLeonB/cashback/internal/domain/mapper"
+	"github.com/itsLeonB/cashback/internal/domain/message"
+	"github.com/itsLeonB/cashback/internal/domain/repository"
+	"github.com/itsLeonB/cashback/internal/domain/service/audit"
+	"github.com/itsLeonB/go-crud"
+	"github.com/itsLeonB/ungerr"
+	"github.com/shopspring/decimal"
+)
+
+type settlementServiceImpl struct {
+	transactor                crud.Transactor
+	settlementRepo            crud.Repository[debts.Settlement]
+	debtTransactionRepo       repository.DebtTransactionRepository
+	profileTransferMethodRepo crud.Repository[debts.ProfileTransferMethod]
+	transferMethodSvc         TransferMethodService
+	friendshipSvc             FriendshipService
+	profileSvc                ProfileService
+	imageSvc                  storage.ImageService
+	taskQueue                 queue.TaskQueue
+	groupSvc                  GroupService
+	auditSvc                  audit.Service
+}
+
+func NewSettlementService(
+	transactor crud.Transactor,
+	settlementRepo crud.Repository[debts.Settlement],
+	debtTransactionRepo repository.DebtTransactionRepository,
+	profileTransferMethodRepo crud.Repository[debts.ProfileTransferMethod],
+	transferMethodSvc TransferMethodService,
+	friendshipSvc FriendshipService,
+	profileSvc ProfileService,
+	imageSvc storage.ImageService,
+	taskQueue queue.TaskQueue,
+	groupSvc GroupService,
+	auditSvc audit.Service,
+) SettlementService {
+	return &settlementServiceImpl{
+		transactor,
+		settlementRepo,
+		debtTransactionRepo,
+		profileTransferMethodRepo,
+		transferMethodSvc,
+		friendshipSvc,
+		profileSvc,
+		imageSvc,
+		taskQueue,
+		groupSvc,
+		auditSvc,
+	}
+}
+
+func (ss *settlementServiceImpl) Record(ctx context.Context, req dto.NewSettlementRequest) (dto.SettlementResponse, error) {
+	ctx, span := otel.Tracer.Start(ctx, "SettlementService.Record")
+	defer span.End()
+
+	if !req.Amount.IsPositive() {
+		return dto.SettlementResponse{}, ungerr.ValidationError("amount must be greater than 0")
+	}
+	if req.UserProfileID == req.FriendProfileID {
+		return dto.SettlementResponse{}, ungerr.UnprocessableEntityError("cannot do self transactions")
+	}
+
+	isFriends, _, err := ss.friendshipSvc.IsFriends(ctx, req.UserProfileID, req.FriendProfileID)
+	if err != nil {
+		return dto.SettlementResponse{}, err
+	}
+	if !isFriends {
+		return dto.SettlementResponse{}, ungerr.UnprocessableEntityError("both profiles are not friends")
+	}
+
+	payerID, payeeID := req.UserProfileID, req.FriendProfileID
+	if req.Direction == dto.IncomingDebt {
+		payerID, payeeID = req.FriendProfileID, req.UserProfileID
+	}
+
+	profilesByID, err := ss.profileSvc.GetByIDs(ctx, []uuid.UUID{payerID, payeeID})
+	if err != nil {
+		return dto.SettlementResponse{}, err
+	}
+
+	// a repayment within a group settles the group's balances
+	group, err := groupOfBoth(ctx, ss.groupSvc, req.GroupID, req.UserProfileID, req.FriendProfileID)
+	if err != nil {
+		return dto.SettlementResponse{}, err
+	}
+
+	currency := req.Currency
+	if currency == "" {
+		currency = group.DefaultCurrency
+	}
+	if currency == "" {
+		currency = profilesByID[req.UserProfileID].HomeCurrency
+	}
+
+	var response dto.SettlementResponse
+	var repaymentID uuid.UUID
+	err = ss.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		settlement := debts.Settlement{
+			PayerProfileID:   payerID,
+			PayeeProfileID:   payeeID,
+			CreatorProfileID: req.UserProfileID,
+			Currency:         currency,
+			Amount:           req.Amount,
+			Note:             req.Note,
+		}
+
+		if err := ss.populateTransferMethod(ctx, &settlement, profilesByID[payeeID], req); err != nil {
+			return err
+		}
+
+		covered, err := ss.getCoveredTransactions(ctx, settlement, profilesByID, req)
+		if err != nil {
+			return err
+		}
+
+		if req.ProofFilename != "" {
+			settlement.ProofImageName = util.GenerateObjectKey(req.ProofFilename)
+		}
+
+		inserted, err := ss.settlementRepo.Insert(ctx, settlement)
+		if err != nil {
+			return err
+		}
+
+		repayment, err := ss.debtTransactionRepo.Insert(ctx, debts.DebtTransaction{
+			LenderProfileID:   payerID,
+			BorrowerProfileID: payeeID,
+			Currency:          currency,
+			Amount:            req.Amount,
+			TransferMethodID:  settlement.TransferMethodID,
+			Description:       req.Note,
+			SettlementID:      uuid.NullUUID{UUID: inserted.ID, Valid: true},
+			GroupID:           uuid.NullUUID{UUID: group.ID, Valid: !group.IsZero()},
+		})
+		if err != nil {
+			return err
+		}
+
+		coveredIDs := make([]uuid.UUID, 0, len(covered))
+		for _, tx := range covered {
+			coveredIDs = append(coveredIDs, tx.ID)
+		}
+		if err = ss.debtTransactionRepo.MarkSettled(ctx, coveredIDs, inserted.ID); err != nil {
+			return err
+		}
+
+		inserted.TransferMethod = settlement.TransferMethod
+		inserted.ProfileTransferMethod = settlement.ProfileTransferMethod
+		inserted.SettledTransactions = covered
+		response = mapper.SettlementToResponse(req.UserProfileID, inserted, profilesByID)
+
+		repayment.TransferMethod = settlement.TransferMethod
+		if err = ss.recordAudit(ctx, req.UserProfileID, response, repayment, coveredIDs); err != nil {
+			return err
+		}
+
+		if inserted.ProofImageName != "" {
+			uploadURL, err := ss.imageSvc.GetUploadURL(proofFileID(inserted.ProofImageName))
+			if err != nil {
+				return err
+			}
+			response.ProofUploadURL = uploadURL
+		}
+
+		repaymentID = repayment.ID
+		return nil
+	})
+	if err != nil {
+		return dto.SettlementResponse{}, err
+	}
+
+	go ss.taskQueue.AsyncEnqueue(ctx, message.DebtCreated{
+		ID:               repaymentID,
+		CreatorProfileID: req.UserProfileID,
+	})
+
+	return response, nil
+}
+
+// settledSnapshot is the part of a debt a settlement changes when it covers the debt.
+type settledSnapshot struct {
+	SettledBySettlementID uuid.NullUUID `json:"settledBySettlementId"`
+}
+
+// recordAudit audits the settlement, its repayment debt and the debts it marked as settled.
+func (ss *settlementServiceImpl) recordAudit(
+	ctx context.Context,
+	profileID uuid.UUID,
+	settlement dto.SettlementResponse,
+	repayment debts.DebtTransaction,
+	coveredIDs []uuid.UUID,
+) error {
+	if err := ss.auditSvc.Record(ctx, audit.Entry{
+		Actor:      audit.Profile(profileID),
+		Action:     entity.CreateAuditAction,
+		EntityType: entity.SettlementAuditEntity,
+		EntityID:   settlement.ID,
+		After:      settlement,
+	}); err != nil {
+		return err
+	}
+
+	if err := recordDebtsCreated(ctx, ss.auditSvc, profileID, []debts.DebtTransaction{repayment}); err != nil {
+		return err
+	}
+
+	for _, id := range coveredIDs {
+		if err := ss.auditSvc.Record(ctx, audit.Entry{
+			Actor:      audit.Profile(profileID),
+			Action:     entity.UpdateAuditAction,
+			EntityType: entity.DebtAuditEntity,
+			EntityID:   id,
+			Before:     settledSnapshot{},
+			After:      settledSnapshot{uuid.NullUUID{UUID: settlement.ID, Valid: true}},
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (ss *settlementServiceImpl) GetByID(ctx context.Context, profileID, settlementID uuid.UUID) (dto.SettlementResponse, error) {
+	ctx, span := otel.Tracer.Start(ctx, "SettlementService.GetByID")
+	defer span.End()
+
+	spec := crud.Specification[debts.Settlement]{}
+	spec.Model.ID = settlementID
+	spec.PreloadRelations = []string{"TransferMethod", "ProfileTransferMethod", "SettledTransactions"}
+	settlement, err := ss.settlementRepo.FindFirst(ctx, spec)
+	if err != nil {
+		return dto.SettlementResponse{}, err
+	}
+	if settlement.IsZero() {
+		return dto.SettlementResponse{}, ungerr.NotFoundError(fmt.Sprintf("settlement with ID %s is not found", settlementID))
+	}
+
+	profileIDs, err := ss.profileSvc.GetAssociatedIDs(ctx, profileID)
+	if err != nil {
+		return dto.SettlementResponse{}, err
+	}
+	if !slices.Contains(profileIDs, settlement.PayerProfileID) && !slices.Contains(profileIDs, settlement.PayeeProfileID) {
+		return dto.SettlementResponse{}, ungerr.NotFoundError(fmt.Sprintf("settlement with ID %s is not found", settlementID))
+	}
+
+	profilesByID, err := ss.profileSvc.GetByIDs(ctx, []uuid.UUID{settlement.PayerProfileID, settlement.PayeeProfileID})
+	if err != nil {
+		return dto.SettlementResponse{}, err
+	}
+
+	response := mapper.SettlementToResponse(profileID, settlement, profilesByID)
+	if settlement.ProofImageName != "" {
+		proofURL, err := ss.imageSvc.GetURL(proofFileID(settlement.ProofImageName))
+		if err != nil {
+			return dto.SettlementResponse{}, err
+		}
+		response.ProofImageURL = proofURL
+	}
+
+	return response, nil
+}
+
+// populateTransferMethod resolves the transfer method either from one of the payee's
+// saved accounts or, when none is chosen (e.g. cash), from the given transfer method.
+func (ss *settlementServiceImpl) populateTransferMethod(ctx context.Context, settlement *debts.Settlement, payee dto.ProfileResponse, req dto.NewSettlementRequest) error {
+	if req.ProfileTransferMethodID == uuid.Nil {
+		if req.TransferMethodID == uuid.Nil {
+			return ungerr.ValidationError("either profileTransferMethodId or transferMethodId is required")
+		}
+		transferMethod, err := ss.transferMethodSvc.GetByID(ctx, req.TransferMethodID)
+		if err != nil {
+			return err
+		}
+		settlement.TransferMethodID = transferMethod.ID
+		settlement.TransferMethod = transferMethod
+		return nil
+	}
+
+	spec := crud.Specification[debts.ProfileTransferMethod]{}
+	spec.Model.ID = req.ProfileTransferMethodID
+	spec.PreloadRelations = []string{"Method"}
+	profileMethod, err := ss.profileTransferMethodRepo.FindFirst(ctx, spec)
+	if err != nil {
+		return err
+	}
+	if profileMethod.IsZero() || !slices.Contains(associatedProfileIDs(payee), profileMethod.ProfileID) {
+		return ungerr.NotFoundError(fmt.Sprintf("transfer method with ID %s is not found for the payee", req.ProfileTransferMethodID))
+	}
+
+	settlement.TransferMethodID = profileMethod.TransferMethodID
+	settlement.TransferMethod = profileMethod.Method
+	settlement.ProfileTransferMethodID = uuid.NullUUID{UUID: profileMethod.ID, Valid: true}
+	settlement.ProfileTransferMethod = profileMethod
+	return nil
+}
+
+// getCoveredTransactions locks and validates the debts that the settlement closes out.
+// Each of them must be an unsettled debt owed by the payer to the payee in the settlement's currency,
+// and together they must not exceed the settled amount.
+func (ss *settlementServiceImpl) getCoveredTransactions(
+	ctx context.Context,
+	settlement debts.Settlement,
+	profilesByID map[uuid.UUID]dto.ProfileResponse,
+	req dto.NewSettlementRequest,
+) ([]debts.DebtTransaction, error) {
+	payerIDs := mapset.NewSet(associatedProfileIDs(profilesByID[settlement.PayerProfileID])...)
+	payeeIDs := mapset.NewSet(associatedProfileIDs(profilesByID[settlement.PayeeProfileID])...)
+	isOwedByPayer := func(tx debts.DebtTransaction) bool {
+		return payerIDs.Contains(tx.BorrowerProfileID) && payeeIDs.Contains(tx.LenderProfileID)
+	}
+
+	coveredIDs := mapset.NewSet(req.DebtTransactionIDs...)
+	if len(req.GroupExpenseIDs) > 0 {
+		expenseTransactions, err := ss.debtTransactionRepo.FindAllByGroupExpenseIDs(ctx, req.GroupExpenseIDs)
+		if err != nil {
+			return nil, err
+		}
+		expensesWithDebts := mapset.NewSet[uuid.UUID]()
+		for _, tx := range expenseTransactions {
+			if isOwedByPayer(tx) && !tx.SettledBySettlementID.Valid {
+				coveredIDs.Add(tx.ID)
+				expensesWithDebts.Add(tx.GroupExpenseID.UUID)
+			}
+		}
+		for _, id := range req.GroupExpenseIDs {
+			if !expensesWithDebts.Contains(id) {
+				return nil, ungerr.UnprocessableEntityError(fmt.Sprintf("group expense %s has no outstanding debts to settle", id))
+			}
+		}
+	}
+
+	covered, err := ss.debtTransactionRepo.FindAllByIDs(ctx, coveredIDs.ToSlice(), true)
+	if err != nil {
+		return nil, err
+	}
+	if len(covered) != coveredIDs.Cardinality() {
+		return nil, ungerr.NotFoundError("some of the debt transactions to settle are not found")
+	}
+
+	total := decimal.Zero
+	for _, tx := range covered {
+		switch {
+		case !isOwedByPayer(tx):
+			return nil, ungerr.UnprocessableEntityError(fmt.Sprintf("debt transaction %s is not owed by the payer to the payee", tx.ID))
+		case tx.SettlementID.Valid:
+			return nil, ungerr.UnprocessableEntityError(fmt.Sprintf("debt transaction %s is a settlement payment", tx.ID))
+		case tx.SettledBySettlementID.Valid:
+			return nil, ungerr.ConflictError(fmt.Sprintf("debt transaction %s is already settled", tx.ID))
+		case tx.Currency != settlement.Currency:
+			return nil, ungerr.UnprocessableEntityError(fmt.Sprintf("debt transaction %s is not in %s", tx.ID, settlement.Currency))
+		}
+		total = total.Add(tx.Amount)
+	}
+
+	if total.GreaterThan(settlement.Amount) {
+		return nil, ungerr.UnprocessableEntityError(fmt.Sprintf("settled amount %s is less than the covered debts of %s", settlement.Amount, total))
+	}
+
+	return covered, nil
+}
+
+func proofFileID(objectKey string) storage.FileIdentifier {
+	return storage.FileIdentifier{
+		BucketName: config.Global.BucketNameSettlementProof,
+		ObjectKey:  objectKey,
+	}
+}
@@ -6,8 +6,11 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/itsLeonB/cashback/internal/domain/entity/debts"
+	"github.com/itsLeonB/cashback/internal/domain/entity/expenses"
 	"github.com/itsLeonB/cashback/internal/domain/entity/users"
 	"github.com/itsLeonB/go-crud"
 	mock "github.com/stretchr/testify/mock"
@@ -1633,3 +1636,3556 @@ func (_c *MockFriendshipRepository_Update_Call) RunAndReturn(run func(ctx contex
 	_c.Call.Return(run)
 	return _c
 }
+
+// NewMockGroupExpenseRepository creates a new instance of MockGroupExpenseRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGroupExpenseRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGroupExpenseRepository {
+	mock := &MockGroupExpenseRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGroupExpenseRepository is an autogenerated mock type for the GroupExpenseRepository type
+type MockGroupExpenseRepository struct {
+	mock.Mock
+}
+
+type MockGroupExpenseRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGroupExpenseRepository) EXPECT() *MockGroupExpenseRepository_Expecter {
+	return &MockGroupExpenseRepository_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function for the type MockGroupExpenseRepository
+func (_mock *MockGroupExpenseRepository) Delete(ctx context.Context, model expenses.GroupExpense) error {
+	ret := _mock.Called(ctx, model)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, expenses.GroupExpense) error); ok {
+		r0 = returnFunc(ctx, model)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockGroupExpenseRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockGroupExpenseRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - model expenses.GroupExpense
+func (_e *MockGroupExpenseRepository_Expecter) Delete(ctx interface{}, model interface{}) *MockGroupExpenseRepository_Delete_Call {
+	return &MockGroupExpenseRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, model)}
+}
+
+func (_c *MockGroupExpenseRepository_Delete_Call) Run(run func(ctx context.Context, model expenses.GroupExpense)) *MockGroupExpenseRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 expenses.GroupExpense
+		if args[1] != nil {
+			arg1 = args[1].(expenses.GroupExpense)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGroupExpenseRepository_Delete_Call) Return(err error) *MockGroupExpenseRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockGroupExpenseRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, model expenses.GroupExpense) error) *MockGroupExpenseRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteItemParticipants provides a mock function for the type MockGroupExpenseRepository
+func (_mock *MockGroupExpenseRepository) DeleteItemParticipants(ctx context.Context, expenseID uuid.UUID, newParticipantProfileIDs []uuid.UUID) error {
+	ret := _mock.Called(ctx, expenseID, newParticipantProfileIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteItemParticipants")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, []uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, expenseID, newParticipantProfileIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockGroupExpenseRepository_DeleteItemParticipants_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteItemParticipants'
+type MockGroupExpenseRepository_DeleteItemParticipants_Call struct {
+	*mock.Call
+}
+
+// DeleteItemParticipants is a helper method to define mock.On call
+//   - ctx context.Context
+//   - expenseID uuid.UUID
+//   - newParticipantProfileIDs []uuid.UUID
+func (_e *MockGroupExpenseRepository_Expecter) DeleteItemParticipants(ctx interface{}, expenseID interface{}, newParticipantProfileIDs interface{}) *MockGroupExpenseRepository_DeleteItemParticipants_Call {
+	return &MockGroupExpenseRepository_DeleteItemParticipants_Call{Call: _e.mock.On("DeleteItemParticipants", ctx, expenseID, newParticipantProfileIDs)}
+}
+
+func (_c *MockGroupExpenseRepository_DeleteItemParticipants_Call) Run(run func(ctx context.Context, expenseID uuid.UUID, newParticipantProfileIDs []uuid.UUID)) *MockGroupExpenseRepository_DeleteItemParticipants_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 []uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].([]uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGroupExpenseRepository_DeleteItemParticipants_Call) Return(err error) *MockGroupExpenseRepository_DeleteItemParticipants_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockGroupExpenseRepository_DeleteItemParticipants_Call) RunAndReturn(run func(ctx context.Context, expenseID uuid.UUID, newParticipantProfileIDs []uuid.UUID) error) *MockGroupExpenseRepository_DeleteItemParticipants_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteMany provides a mock function for the type MockGroupExpenseRepository
+func (_mock *MockGroupExpenseRepository) DeleteMany(ctx context.Context, models []expenses.GroupExpense) error {
+	ret := _mock.Called(ctx, models)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMany")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []expenses.GroupExpense) error); ok {
+		r0 = returnFunc(ctx, models)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockGroupExpenseRepository_DeleteMany_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMany'
+type MockGroupExpenseRepository_DeleteMany_Call struct {
+	*mock.Call
+}
+
+// DeleteMany is a helper method to define mock.On call
+//   - ctx context.Context
+//   - models []expenses.GroupExpense
+func (_e *MockGroupExpenseRepository_Expecter) DeleteMany(ctx interface{}, models interface{}) *MockGroupExpenseRepository_DeleteMany_Call {
+	return &MockGroupExpenseRepository_DeleteMany_Call{Call: _e.mock.On("DeleteMany", ctx, models)}
+}
+
+func (_c *MockGroupExpenseRepository_DeleteMany_Call) Run(run func(ctx context.Context, models []expenses.GroupExpense)) *MockGroupExpenseRepository_DeleteMany_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []expenses.GroupExpense
+		if args[1] != nil {
+			arg1 = args[1].([]expenses.GroupExpense)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGroupExpenseRepository_DeleteMany_Call) Return(err error) *MockGroupExpenseRepository_DeleteMany_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockGroupExpenseRepository_DeleteMany_Call) RunAndReturn(run func(ctx context.Context, models []expenses.GroupExpense) error) *MockGroupExpenseRepository_DeleteMany_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAll provides a mock function for the type MockGroupExpenseRepository
+func (_mock *MockGroupExpenseRepository) FindAll(ctx context.Context, spec crud.Specification[expenses.GroupExpense]) ([]expenses.GroupExpense, error) {
+	ret := _mock.Called(ctx, spec)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAll")
+	}
+
+	var r0 []expenses.GroupExpense
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, crud.Specification[expenses.GroupExpense]) ([]expenses.GroupExpense, error)); ok {
+		return returnFunc(ctx, spec)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, crud.Specification[expenses.GroupExpense]) []expenses.GroupExpense); ok {
+		r0 = returnFunc(ctx, spec)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]expenses.GroupExpense)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, crud.Specification[expenses.GroupExpense]) error); ok {
+		r1 = returnFunc(ctx, spec)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGroupExpenseRepository_FindAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAll'
+type MockGroupExpenseRepository_FindAll_Call struct {
+	*mock.Call
+}
+
+// FindAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spec crud.Specification[expenses.GroupExpense]
+func (_e *MockGroupExpenseRepository_Expecter) FindAll(ctx interface{}, spec interface{}) *MockGroupExpenseRepository_FindAll_Call {
+	return &MockGroupExpenseRepository_FindAll_Call{Call: _e.mock.On("FindAll", ctx, spec)}
+}
+
+func (_c *MockGroupExpenseRepository_FindAll_Call) Run(run func(ctx context.Context, spec crud.Specification[expenses.GroupExpense])) *MockGroupExpenseRepository_FindAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 crud.Specification[expenses.GroupExpense]
+		if args[1] != nil {
+			arg1 = args[1].(crud.Specification[expenses.GroupExpense])
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGroupExpenseRepository_FindAll_Call) Return(groupExpenses []expenses.GroupExpense, err error) *MockGroupExpenseRepository_FindAll_Call {
+	_c.Call.Return(groupExpenses, err)
+	return _c
+}
+
+func (_c *MockGroupExpenseRepository_FindAll_Call) RunAndReturn(run func(ctx context.Context, spec crud.Specification[expenses.GroupExpense]) ([]expenses.GroupExpense, error)) *MockGroupExpenseRepository_FindAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAllByGroupID provides a mock function for the type MockGroupExpenseRepository
+func (_mock *MockGroupExpenseRepository) FindAllByGroupID(ctx context.Context, groupID uuid.UUID, limit int) ([]expenses.GroupExpense, error) {
+	ret := _mock.Called(ctx, groupID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAllByGroupID")
+	}
+
+	var r0 []expenses.GroupExpense
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) ([]expenses.GroupExpense, error)); ok {
+		return returnFunc(ctx, groupID, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) []expenses.GroupExpense); ok {
+		r0 = returnFunc(ctx, groupID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]expenses.GroupExpense)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, int) error); ok {
+		r1 = returnFunc(ctx, groupID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGroupExpenseRepository_FindAllByGroupID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAllByGroupID'
+type MockGroupExpenseRepository_FindAllByGroupID_Call struct {
+	*mock.Call
+}
+
+// FindAllByGroupID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupID uuid.UUID
+//   - limit int
+func (_e *MockGroupExpenseRepository_Expecter) FindAllByGroupID(ctx interface{}, groupID interface{}, limit interface{}) *MockGroupExpenseRepository_FindAllByGroupID_Call {
+	return &MockGroupExpenseRepository_FindAllByGroupID_Call{Call: _e.mock.On("FindAllByGroupID", ctx, groupID, limit)}
+}
+
+func (_c *MockGroupExpenseRepository_FindAllByGroupID_Call) Run(run func(ctx context.Context, groupID uuid.UUID, limit int)) *MockGroupExpenseRepository_FindAllByGroupID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGroupExpenseRepository_FindAllByGroupID_Call) Return(groupExpenses []expenses.GroupExpense, err error) *MockGroupExpenseRepository_FindAllByGroupID_Call {
+	_c.Call.Return(groupExpenses, err)
+	return _c
+}
+
+func (_c *MockGroupExpenseRepository_FindAllByGroupID_Call) RunAndReturn(run func(ctx context.Context, groupID uuid.UUID, limit int) ([]expenses.GroupExpense, error)) *MockGroupExpenseRepository_FindAllByGroupID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAllByIDs provides a mock function for the type MockGroupExpenseRepository
+func (_mock *MockGroupExpenseRepository) FindAllByIDs(ctx context.Context, ids []uuid.UUID) ([]expenses.GroupExpense, error) {
+	ret := _mock.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAllByIDs")
+	}
+
+	var r0 []expenses.GroupExpense
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID) ([]expenses.GroupExpense, error)); ok {
+		return returnFunc(ctx, ids)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID) []expenses.GroupExpense); ok {
+		r0 = returnFunc(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]expenses.GroupExpense)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGroupExpenseRepository_FindAllByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAllByIDs'
+type MockGroupExpenseRepository_FindAllByIDs_Call struct {
+	*mock.Call
+}
+
+// FindAllByIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []uuid.UUID
+func (_e *MockGroupExpenseRepository_Expecter) FindAllByIDs(ctx interface{}, ids interface{}) *MockGroupExpenseRepository_FindAllByIDs_Call {
+	return &MockGroupExpenseRepository_FindAllByIDs_Call{Call: _e.mock.On("FindAllByIDs", ctx, ids)}
+}
+
+func (_c *MockGroupExpenseRepository_FindAllByIDs_Call) Run(run func(ctx context.Context, ids []uuid.UUID)) *MockGroupExpenseRepository_FindAllByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].([]uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGroupExpenseRepository_FindAllByIDs_Call) Return(groupExpenses []expenses.GroupExpense, err error) *MockGroupExpenseRepository_FindAllByIDs_Call {
+	_c.Call.Return(groupExpenses, err)
+	return _c
+}
+
+func (_c *MockGroupExpenseRepository_FindAllByIDs_Call) RunAndReturn(run func(ctx context.Context, ids []uuid.UUID) ([]expenses.GroupExpense, error)) *MockGroupExpenseRepository_FindAllByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAllByOwnership provides a mock function for the type MockGroupExpenseRepository
+func (_mock *MockGroupExpenseRepository) FindAllByOwnership(ctx context.Context, profileID uuid.UUID, ownership expenses.ExpenseOwnership, status expenses.ExpenseStatus, limit int) ([]expenses.GroupExpense, error) {
+	ret := _mock.Called(ctx, profileID, ownership, status, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAllByOwnership")
+	}
+
+	var r0 []expenses.GroupExpense
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, expenses.ExpenseOwnership, expenses.ExpenseStatus, int) ([]expenses.GroupExpense, error)); ok {
+		return returnFunc(ctx, profileID, ownership, status, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, expenses.ExpenseOwnership, expenses.ExpenseStatus, int) []expenses.GroupExpense); ok {
+		r0 = returnFunc(ctx, profileID, ownership, status, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]expenses.GroupExpense)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, expenses.ExpenseOwnership, expenses.ExpenseStatus, int) error); ok {
+		r1 = returnFunc(ctx, profileID, ownership, status, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGroupExpenseRepository_FindAllByOwnership_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAllByOwnership'
+type MockGroupExpenseRepository_FindAllByOwnership_Call struct {
+	*mock.Call
+}
+
+// FindAllByOwnership is a helper method to define mock.On call
+//   - ctx context.Context
+//   - profileID uuid.UUID
+//   - ownership expenses.ExpenseOwnership
+//   - status expenses.ExpenseStatus
+//   - limit int
+func (_e *MockGroupExpenseRepository_Expecter) FindAllByOwnership(ctx interface{}, profileID interface{}, ownership interface{}, status interface{}, limit interface{}) *MockGroupExpenseRepository_FindAllByOwnership_Call {
+	return &MockGroupExpenseRepository_FindAllByOwnership_Call{Call: _e.mock.On("FindAllByOwnership", ctx, profileID, ownership, status, limit)}
+}
+
+func (_c *MockGroupExpenseRepository_FindAllByOwnership_Call) Run(run func(ctx context.Context, profileID uuid.UUID, ownership expenses.ExpenseOwnership, status expenses.ExpenseStatus, limit int)) *MockGroupExpenseRepository_FindAllByOwnership_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 expenses.ExpenseOwnership
+		if args[2] != nil {
+			arg2 = args[2].(expenses.ExpenseOwnership)
+		}
+		var arg3 expenses.ExpenseStatus
+		if args[3] != nil {
+			arg3 = args[3].(expenses.ExpenseStatus)
+		}
+		var arg4 int
+		if args[4] != nil {
+			arg4 = args[4].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGroupExpenseRepository_FindAllByOwnership_Call) Return(groupExpenses []expenses.GroupExpense, err error) *MockGroupExpenseRepository_FindAllByOwnership_Call {
+	_c.Call.Return(groupExpenses, err)
+	return _c
+}
+
+func (_c *MockGroupExpenseRepository_FindAllByOwnership_Call) RunAndReturn(run func(ctx context.Context, profileID uuid.UUID, ownership expenses.ExpenseOwnership, status expenses.ExpenseStatus, limit int) ([]expenses.GroupExpense, error)) *MockGroupExpenseRepository_FindAllByOwnership_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindFirst provides a mock function for the type MockGroupExpenseRepository
+func (_mock *MockGroupExpenseRepository) FindFirst(ctx context.Context, spec crud.Specification[expenses.GroupExpense]) (expenses.GroupExpense, error) {
+	ret := _mock.Called(ctx, spec)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindFirst")
+	}
+
+	var r0 expenses.GroupExpense
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, crud.Specification[expenses.GroupExpense]) (expenses.GroupExpense, error)); ok {
+		return returnFunc(ctx, spec)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, crud.Specification[expenses.GroupExpense]) expenses.GroupExpense); ok {
+		r0 = returnFunc(ctx, spec)
+	} else {
+		r0 = ret.Get(0).(expenses.GroupExpense)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, crud.Specification[expenses.GroupExpense]) error); ok {
+		r1 = returnFunc(ctx, spec)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGroupExpenseRepository_FindFirst_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindFirst'
+type MockGroupExpenseRepository_FindFirst_Call struct {
+	*mock.Call
+}
+
+// FindFirst is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spec crud.Specification[expenses.GroupExpense]
+func (_e *MockGroupExpenseRepository_Expecter) FindFirst(ctx interface{}, spec interface{}) *MockGroupExpenseRepository_FindFirst_Call {
+	return &MockGroupExpenseRepository_FindFirst_Call{Call: _e.mock.On("FindFirst", ctx, spec)}
+}
+
+func (_c *MockGroupExpenseRepository_FindFirst_Call) Run(run func(ctx context.Context, spec crud.Specification[expenses.GroupExpense])) *MockGroupExpenseRepository_FindFirst_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 crud.Specification[expenses.GroupExpense]
+		if args[1] != nil {
+			arg1 = args[1].(crud.Specification[expenses.GroupExpense])
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGroupExpenseRepository_FindFirst_Call) Return(groupExpense expenses.GroupExpense, err error) *MockGroupExpenseRepository_FindFirst_Call {
+	_c.Call.Return(groupExpense, err)
+	return _c
+}
+
+func (_c *MockGroupExpenseRepository_FindFirst_Call) RunAndReturn(run func(ctx context.Context, spec crud.Specification[expenses.GroupExpense]) (expenses.GroupExpense, error)) *MockGroupExpenseRepository_FindFirst_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindRecentByProfileID provides a mock function for the type MockGroupExpenseRepository
+func (_mock *MockGroupExpenseRepository) FindRecentByProfileID(ctx context.Context, profileID uuid.UUID, limit int) ([]expenses.GroupExpense, error) {
+	ret := _mock.Called(ctx, profileID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindRecentByProfileID")
+	}
+
+	var r0 []expenses.GroupExpense
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) ([]expenses.GroupExpense, error)); ok {
+		return returnFunc(ctx, profileID, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) []expenses.GroupExpense); ok {
+		r0 = returnFunc(ctx, profileID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]expenses.GroupExpense)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, int) error); ok {
+		r1 = returnFunc(ctx, profileID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGroupExpenseRepository_FindRecentByProfileID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindRecentByProfileID'
+type MockGroupExpenseRepository_FindRecentByProfileID_Call struct {
+	*mock.Call
+}
+
+// FindRecentByProfileID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - profileID uuid.UUID
+//   - limit int
+func (_e *MockGroupExpenseRepository_Expecter) FindRecentByProfileID(ctx interface{}, profileID interface{}, limit interface{}) *MockGroupExpenseRepository_FindRecentByProfileID_Call {
+	return &MockGroupExpenseRepository_FindRecentByProfileID_Call{Call: _e.mock.On("FindRecentByProfileID", ctx, profileID, limit)}
+}
+
+func (_c *MockGroupExpenseRepository_FindRecentByProfileID_Call) Run(run func(ctx context.Context, profileID uuid.UUID, limit int)) *MockGroupExpenseRepository_FindRecentByProfileID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGroupExpenseRepository_FindRecentByProfileID_Call) Return(groupExpenses []expenses.GroupExpense, err error) *MockGroupExpenseRepository_FindRecentByProfileID_Call {
+	_c.Call.Return(groupExpenses, err)
+	return _c
+}
+
+func (_c *MockGroupExpenseRepository_FindRecentByProfileID_Call) RunAndReturn(run func(ctx context.Context, profileID uuid.UUID, limit int) ([]expenses.GroupExpense, error)) *MockGroupExpenseRepository_FindRecentByProfileID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGormInstance provides a mock function for the type MockGroupExpenseRepository
+func (_mock *MockGroupExpenseRepository) GetGormInstance(ctx context.Context) (*gorm.DB, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGormInstance")
+	}
+
+	var r0 *gorm.DB
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (*gorm.DB, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) *gorm.DB); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gorm.DB)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGroupExpenseRepository_GetGormInstance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGormInstance'
+type MockGroupExpenseRepository_GetGormInstance_Call struct {
+	*mock.Call
+}
+
+// GetGormInstance is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockGroupExpenseRepository_Expecter) GetGormInstance(ctx interface{}) *MockGroupExpenseRepository_GetGormInstance_Call {
+	return &MockGroupExpenseRepository_GetGormInstance_Call{Call: _e.mock.On("GetGormInstance", ctx)}
+}
+
+func (_c *MockGroupExpenseRepository_GetGormInstance_Call) Run(run func(ctx context.Context)) *MockGroupExpenseRepository_GetGormInstance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGroupExpenseRepository_GetGormInstance_Call) Return(dB *gorm.DB, err error) *MockGroupExpenseRepository_GetGormInstance_Call {
+	_c.Call.Return(dB, err)
+	return _c
+}
+
+func (_c *MockGroupExpenseRepository_GetGormInstance_Call) RunAndReturn(run func(ctx context.Context) (*gorm.DB, error)) *MockGroupExpenseRepository_GetGormInstance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Insert provides a mock function for the type MockGroupExpenseRepository
+func (_mock *MockGroupExpenseRepository) Insert(ctx context.Context, model expenses.GroupExpense) (expenses.GroupExpense, error) {
+	ret := _mock.Called(ctx, model)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Insert")
+	}
+
+	var r0 expenses.GroupExpense
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, expenses.GroupExpense) (expenses.GroupExpense, error)); ok {
+		return returnFunc(ctx, model)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, expenses.GroupExpense) expenses.GroupExpense); ok {
+		r0 = returnFunc(ctx, model)
+	} else {
+		r0 = ret.Get(0).(expenses.GroupExpense)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, expenses.GroupExpense) error); ok {
+		r1 = returnFunc(ctx, model)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGroupExpenseRepository_Insert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Insert'
+type MockGroupExpenseRepository_Insert_Call struct {
+	*mock.Call
+}
+
+// Insert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - model expenses.GroupExpense
+func (_e *MockGroupExpenseRepository_Expecter) Insert(ctx interface{}, model interface{}) *MockGroupExpenseRepository_Insert_Call {
+	return &MockGroupExpenseRepository_Insert_Call{Call: _e.mock.On("Insert", ctx, model)}
+}
+
+func (_c *MockGroupExpenseRepository_Insert_Call) Run(run func(ctx context.Context, model expenses.GroupExpense)) *MockGroupExpenseRepository_Insert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 expenses.GroupExpense
+		if args[1] != nil {
+			arg1 = args[1].(expenses.GroupExpense)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGroupExpenseRepository_Insert_Call) Return(groupExpense expenses.GroupExpense, err error) *MockGroupExpenseRepository_Insert_Call {
+	_c.Call.Return(groupExpense, err)
+	return _c
+}
+
+func (_c *MockGroupExpenseRepository_Insert_Call) RunAndReturn(run func(ctx context.Context, model expenses.GroupExpense) (expenses.GroupExpense, error)) *MockGroupExpenseRepository_Insert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InsertMany provides a mock function for the type MockGroupExpenseRepository
+func (_mock *MockGroupExpenseRepository) InsertMany(ctx context.Context, models []expenses.GroupExpense) ([]expenses.GroupExpense, error) {
+	ret := _mock.Called(ctx, models)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertMany")
+	}
+
+	var r0 []expenses.GroupExpense
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []expenses.GroupExpense) ([]expenses.GroupExpense, error)); ok {
+		return returnFunc(ctx, models)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []expenses.GroupExpense) []expenses.GroupExpense); ok {
+		r0 = returnFunc(ctx, models)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]expenses.GroupExpense)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []expenses.GroupExpense) error); ok {
+		r1 = returnFunc(ctx, models)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGroupExpenseRepository_InsertMany_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertMany'
+type MockGroupExpenseRepository_InsertMany_Call struct {
+	*mock.Call
+}
+
+// InsertMany is a helper method to define mock.On call
+//   - ctx context.Context
+//   - models []expenses.GroupExpense
+func (_e *MockGroupExpenseRepository_Expecter) InsertMany(ctx interface{}, models interface{}) *MockGroupExpenseRepository_InsertMany_Call {
+	return &MockGroupExpenseRepository_InsertMany_Call{Call: _e.mock.On("InsertMany", ctx, models)}
+}
+
+func (_c *MockGroupExpenseRepository_InsertMany_Call) Run(run func(ctx context.Context, models []expenses.GroupExpense)) *MockGroupExpenseRepository_InsertMany_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []expenses.GroupExpense
+		if args[1] != nil {
+			arg1 = args[1].([]expenses.GroupExpense)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGroupExpenseRepository_InsertMany_Call) Return(groupExpenses []expenses.GroupExpense, err error) *MockGroupExpenseRepository_InsertMany_Call {
+	_c.Call.Return(groupExpenses, err)
+	return _c
+}
+
+func (_c *MockGroupExpenseRepository_InsertMany_Call) RunAndReturn(run func(ctx context.Context, models []expenses.GroupExpense) ([]expenses.GroupExpense, error)) *MockGroupExpenseRepository_InsertMany_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveMany provides a mock function for the type MockGroupExpenseRepository
+func (_mock *MockGroupExpenseRepository) SaveMany(ctx context.Context, models []expenses.GroupExpense) ([]expenses.GroupExpense, error) {
+	ret := _mock.Called(ctx, models)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveMany")
+	}
+
+	var r0 []expenses.GroupExpense
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []expenses.GroupExpense) ([]expenses.GroupExpense, error)); ok {
+		return returnFunc(ctx, models)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []expenses.GroupExpense) []expenses.GroupExpense); ok {
+		r0 = returnFunc(ctx, models)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]expenses.GroupExpense)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []expenses.GroupExpense) error); ok {
+		r1 = returnFunc(ctx, models)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGroupExpenseRepository_SaveMany_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveMany'
+type MockGroupExpenseRepository_SaveMany_Call struct {
+	*mock.Call
+}
+
+// SaveMany is a helper method to define mock.On call
+//   - ctx context.Context
+//   - models []expenses.GroupExpense
+func (_e *MockGroupExpenseRepository_Expecter) SaveMany(ctx interface{}, models interface{}) *MockGroupExpenseRepository_SaveMany_Call {
+	return &MockGroupExpenseRepository_SaveMany_Call{Call: _e.mock.On("SaveMany", ctx, models)}
+}
+
+func (_c *MockGroupExpenseRepository_SaveMany_Call) Run(run func(ctx context.Context, models []expenses.GroupExpense)) *MockGroupExpenseRepository_SaveMany_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []expenses.GroupExpense
+		if args[1] != nil {
+			arg1 = args[1].([]expenses.GroupExpense)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGroupExpenseRepository_SaveMany_Call) Return(groupExpenses []expenses.GroupExpense, err error) *MockGroupExpenseRepository_SaveMany_Call {
+	_c.Call.Return(groupExpenses, err)
+	return _c
+}
+
+func (_c *MockGroupExpenseRepository_SaveMany_Call) RunAndReturn(run func(ctx context.Context, models []expenses.GroupExpense) ([]expenses.GroupExpense, error)) *MockGroupExpenseRepository_SaveMany_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SumShares provides a mock function for the type MockGroupExpenseRepository
+func (_mock *MockGroupExpenseRepository) SumShares(ctx context.Context, spec expenses.SpendingSpecification) ([]expenses.SpendingTotal, error) {
+	ret := _mock.Called(ctx, spec)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SumShares")
+	}
+
+	var r0 []expenses.SpendingTotal
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, expenses.SpendingSpecification) ([]expenses.SpendingTotal, error)); ok {
+		return returnFunc(ctx, spec)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, expenses.SpendingSpecification) []expenses.SpendingTotal); ok {
+		r0 = returnFunc(ctx, spec)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]expenses.SpendingTotal)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, expenses.SpendingSpecification) error); ok {
+		r1 = returnFunc(ctx, spec)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGroupExpenseRepository_SumShares_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SumShares'
+type MockGroupExpenseRepository_SumShares_Call struct {
+	*mock.Call
+}
+
+// SumShares is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spec expenses.SpendingSpecification
+func (_e *MockGroupExpenseRepository_Expecter) SumShares(ctx interface{}, spec interface{}) *MockGroupExpenseRepository_SumShares_Call {
+	return &MockGroupExpenseRepository_SumShares_Call{Call: _e.mock.On("SumShares", ctx, spec)}
+}
+
+func (_c *MockGroupExpenseRepository_SumShares_Call) Run(run func(ctx context.Context, spec expenses.SpendingSpecification)) *MockGroupExpenseRepository_SumShares_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 expenses.SpendingSpecification
+		if args[1] != nil {
+			arg1 = args[1].(expenses.SpendingSpecification)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGroupExpenseRepository_SumShares_Call) Return(spendingTotals []expenses.SpendingTotal, err error) *MockGroupExpenseRepository_SumShares_Call {
+	_c.Call.Return(spendingTotals, err)
+	return _c
+}
+
+func (_c *MockGroupExpenseRepository_SumShares_Call) RunAndReturn(run func(ctx context.Context, spec expenses.SpendingSpecification) ([]expenses.SpendingTotal, error)) *MockGroupExpenseRepository_SumShares_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SyncParticipants provides a mock function for the type MockGroupExpenseRepository
+func (_mock *MockGroupExpenseRepository) SyncParticipants(ctx context.Context, groupExpenseID uuid.UUID, participants []expenses.ExpenseParticipant) error {
+	ret := _mock.Called(ctx, groupExpenseID, participants)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SyncParticipants")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, []expenses.ExpenseParticipant) error); ok {
+		r0 = returnFunc(ctx, groupExpenseID, participants)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockGroupExpenseRepository_SyncParticipants_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SyncParticipants'
+type MockGroupExpenseRepository_SyncParticipants_Call struct {
+	*mock.Call
+}
+
+// SyncParticipants is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupExpenseID uuid.UUID
+//   - participants []expenses.ExpenseParticipant
+func (_e *MockGroupExpenseRepository_Expecter) SyncParticipants(ctx interface{}, groupExpenseID interface{}, participants interface{}) *MockGroupExpenseRepository_SyncParticipants_Call {
+	return &MockGroupExpenseRepository_SyncParticipants_Call{Call: _e.mock.On("SyncParticipants", ctx, groupExpenseID, participants)}
+}
+
+func (_c *MockGroupExpenseRepository_SyncParticipants_Call) Run(run func(ctx context.Context, groupExpenseID uuid.UUID, participants []expenses.ExpenseParticipant)) *MockGroupExpenseRepository_SyncParticipants_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 []expenses.ExpenseParticipant
+		if args[2] != nil {
+			arg2 = args[2].([]expenses.ExpenseParticipant)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGroupExpenseRepository_SyncParticipants_Call) Return(err error) *MockGroupExpenseRepository_SyncParticipants_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockGroupExpenseRepository_SyncParticipants_Call) RunAndReturn(run func(ctx context.Context, groupExpenseID uuid.UUID, participants []expenses.ExpenseParticipant) error) *MockGroupExpenseRepository_SyncParticipants_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SyncPayers provides a mock function for the type MockGroupExpenseRepository
+func (_mock *MockGroupExpenseRepository) SyncPayers(ctx context.Context, groupExpenseID uuid.UUID, payers []expenses.ExpensePayer) error {
+	ret := _mock.Called(ctx, groupExpenseID, payers)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SyncPayers")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, []expenses.ExpensePayer) error); ok {
+		r0 = returnFunc(ctx, groupExpenseID, payers)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockGroupExpenseRepository_SyncPayers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SyncPayers'
+type MockGroupExpenseRepository_SyncPayers_Call struct {
+	*mock.Call
+}
+
+// SyncPayers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupExpenseID uuid.UUID
+//   - payers []expenses.ExpensePayer
+func (_e *MockGroupExpenseRepository_Expecter) SyncPayers(ctx interface{}, groupExpenseID interface{}, payers interface{}) *MockGroupExpenseRepository_SyncPayers_Call {
+	return &MockGroupExpenseRepository_SyncPayers_Call{Call: _e.mock.On("SyncPayers", ctx, groupExpenseID, payers)}
+}
+
+func (_c *MockGroupExpenseRepository_SyncPayers_Call) Run(run func(ctx context.Context, groupExpenseID uuid.UUID, payers []expenses.ExpensePayer)) *MockGroupExpenseRepository_SyncPayers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 []expenses.ExpensePayer
+		if args[2] != nil {
+			arg2 = args[2].([]expenses.ExpensePayer)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGroupExpenseRepository_SyncPayers_Call) Return(err error) *MockGroupExpenseRepository_SyncPayers_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockGroupExpenseRepository_SyncPayers_Call) RunAndReturn(run func(ctx context.Context, groupExpenseID uuid.UUID, payers []expenses.ExpensePayer) error) *MockGroupExpenseRepository_SyncPayers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockGroupExpenseRepository
+func (_mock *MockGroupExpenseRepository) Update(ctx context.Context, model expenses.GroupExpense) (expenses.GroupExpense, error) {
+	ret := _mock.Called(ctx, model)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 expenses.GroupExpense
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, expenses.GroupExpense) (expenses.GroupExpense, error)); ok {
+		return returnFunc(ctx, model)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, expenses.GroupExpense) expenses.GroupExpense); ok {
+		r0 = returnFunc(ctx, model)
+	} else {
+		r0 = ret.Get(0).(expenses.GroupExpense)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, expenses.GroupExpense) error); ok {
+		r1 = returnFunc(ctx, model)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGroupExpenseRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockGroupExpenseRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - model expenses.GroupExpense
+func (_e *MockGroupExpenseRepository_Expecter) Update(ctx interface{}, model interface{}) *MockGroupExpenseRepository_Update_Call {
+	return &MockGroupExpenseRepository_Update_Call{Call: _e.mock.On("Update", ctx, model)}
+}
+
+func (_c *MockGroupExpenseRepository_Update_Call) Run(run func(ctx context.Context, model expenses.GroupExpense)) *MockGroupExpenseRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 expenses.GroupExpense
+		if args[1] != nil {
+			arg1 = args[1].(expenses.GroupExpense)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGroupExpenseRepository_Update_Call) Return(groupExpense expenses.GroupExpense, err error) *MockGroupExpenseRepository_Update_Call {
+	_c.Call.Return(groupExpense, err)
+	return _c
+}
+
+func (_c *MockGroupExpenseRepository_Update_Call) RunAndReturn(run func(ctx context.Context, model expenses.GroupExpense) (expenses.GroupExpense, error)) *MockGroupExpenseRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDebtTransactionRepository creates a new instance of MockDebtTransactionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDebtTransactionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDebtTransactionRepository {
+	mock := &MockDebtTransactionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockDebtTransactionRepository is an autogenerated mock type for the DebtTransactionRepository type
+type MockDebtTransactionRepository struct {
+	mock.Mock
+}
+
+type MockDebtTransactionRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDebtTransactionRepository) EXPECT() *MockDebtTransactionRepository_Expecter {
+	return &MockDebtTransactionRepository_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function for the type MockDebtTransactionRepository
+func (_mock *MockDebtTransactionRepository) Delete(ctx context.Context, model debts.DebtTransaction) error {
+	ret := _mock.Called(ctx, model)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, debts.DebtTransaction) error); ok {
+		r0 = returnFunc(ctx, model)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockDebtTransactionRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockDebtTransactionRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - model debts.DebtTransaction
+func (_e *MockDebtTransactionRepository_Expecter) Delete(ctx interface{}, model interface{}) *MockDebtTransactionRepository_Delete_Call {
+	return &MockDebtTransactionRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, model)}
+}
+
+func (_c *MockDebtTransactionRepository_Delete_Call) Run(run func(ctx context.Context, model debts.DebtTransaction)) *MockDebtTransactionRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 debts.DebtTransaction
+		if args[1] != nil {
+			arg1 = args[1].(debts.DebtTransaction)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDebtTransactionRepository_Delete_Call) Return(err error) *MockDebtTransactionRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockDebtTransactionRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, model debts.DebtTransaction) error) *MockDebtTransactionRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteMany provides a mock function for the type MockDebtTransactionRepository
+func (_mock *MockDebtTransactionRepository) DeleteMany(ctx context.Context, models []debts.DebtTransaction) error {
+	ret := _mock.Called(ctx, models)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMany")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []debts.DebtTransaction) error); ok {
+		r0 = returnFunc(ctx, models)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockDebtTransactionRepository_DeleteMany_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMany'
+type MockDebtTransactionRepository_DeleteMany_Call struct {
+	*mock.Call
+}
+
+// DeleteMany is a helper method to define mock.On call
+//   - ctx context.Context
+//   - models []debts.DebtTransaction
+func (_e *MockDebtTransactionRepository_Expecter) DeleteMany(ctx interface{}, models interface{}) *MockDebtTransactionRepository_DeleteMany_Call {
+	return &MockDebtTransactionRepository_DeleteMany_Call{Call: _e.mock.On("DeleteMany", ctx, models)}
+}
+
+func (_c *MockDebtTransactionRepository_DeleteMany_Call) Run(run func(ctx context.Context, models []debts.DebtTransaction)) *MockDebtTransactionRepository_DeleteMany_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []debts.DebtTransaction
+		if args[1] != nil {
+			arg1 = args[1].([]debts.DebtTransaction)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDebtTransactionRepository_DeleteMany_Call) Return(err error) *MockDebtTransactionRepository_DeleteMany_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockDebtTransactionRepository_DeleteMany_Call) RunAndReturn(run func(ctx context.Context, models []debts.DebtTransaction) error) *MockDebtTransactionRepository_DeleteMany_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAll provides a mock function for the type MockDebtTransactionRepository
+func (_mock *MockDebtTransactionRepository) FindAll(ctx context.Context, spec crud.Specification[debts.DebtTransaction]) ([]debts.DebtTransaction, error) {
+	ret := _mock.Called(ctx, spec)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAll")
+	}
+
+	var r0 []debts.DebtTransaction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, crud.Specification[debts.DebtTransaction]) ([]debts.DebtTransaction, error)); ok {
+		return returnFunc(ctx, spec)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, crud.Specification[debts.DebtTransaction]) []debts.DebtTransaction); ok {
+		r0 = returnFunc(ctx, spec)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]debts.DebtTransaction)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, crud.Specification[debts.DebtTransaction]) error); ok {
+		r1 = returnFunc(ctx, spec)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDebtTransactionRepository_FindAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAll'
+type MockDebtTransactionRepository_FindAll_Call struct {
+	*mock.Call
+}
+
+// FindAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spec crud.Specification[debts.DebtTransaction]
+func (_e *MockDebtTransactionRepository_Expecter) FindAll(ctx interface{}, spec interface{}) *MockDebtTransactionRepository_FindAll_Call {
+	return &MockDebtTransactionRepository_FindAll_Call{Call: _e.mock.On("FindAll", ctx, spec)}
+}
+
+func (_c *MockDebtTransactionRepository_FindAll_Call) Run(run func(ctx context.Context, spec crud.Specification[debts.DebtTransaction])) *MockDebtTransactionRepository_FindAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 crud.Specification[debts.DebtTransaction]
+		if args[1] != nil {
+			arg1 = args[1].(crud.Specification[debts.DebtTransaction])
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDebtTransactionRepository_FindAll_Call) Return(debtTransactions []debts.DebtTransaction, err error) *MockDebtTransactionRepository_FindAll_Call {
+	_c.Call.Return(debtTransactions, err)
+	return _c
+}
+
+func (_c *MockDebtTransactionRepository_FindAll_Call) RunAndReturn(run func(ctx context.Context, spec crud.Specification[debts.DebtTransaction]) ([]debts.DebtTransaction, error)) *MockDebtTransactionRepository_FindAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAllByGroupExpenseIDs provides a mock function for the type MockDebtTransactionRepository
+func (_mock *MockDebtTransactionRepository) FindAllByGroupExpenseIDs(ctx context.Context, groupExpenseIDs []uuid.UUID) ([]debts.DebtTransaction, error) {
+	ret := _mock.Called(ctx, groupExpenseIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAllByGroupExpenseIDs")
+	}
+
+	var r0 []debts.DebtTransaction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID) ([]debts.DebtTransaction, error)); ok {
+		return returnFunc(ctx, groupExpenseIDs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID) []debts.DebtTransaction); ok {
+		r0 = returnFunc(ctx, groupExpenseIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]debts.DebtTransaction)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, groupExpenseIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDebtTransactionRepository_FindAllByGroupExpenseIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAllByGroupExpenseIDs'
+type MockDebtTransactionRepository_FindAllByGroupExpenseIDs_Call struct {
+	*mock.Call
+}
+
+// FindAllByGroupExpenseIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupExpenseIDs []uuid.UUID
+func (_e *MockDebtTransactionRepository_Expecter) FindAllByGroupExpenseIDs(ctx interface{}, groupExpenseIDs interface{}) *MockDebtTransactionRepository_FindAllByGroupExpenseIDs_Call {
+	return &MockDebtTransactionRepository_FindAllByGroupExpenseIDs_Call{Call: _e.mock.On("FindAllByGroupExpenseIDs", ctx, groupExpenseIDs)}
+}
+
+func (_c *MockDebtTransactionRepository_FindAllByGroupExpenseIDs_Call) Run(run func(ctx context.Context, groupExpenseIDs []uuid.UUID)) *MockDebtTransactionRepository_FindAllByGroupExpenseIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].([]uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDebtTransactionRepository_FindAllByGroupExpenseIDs_Call) Return(debtTransactions []debts.DebtTransaction, err error) *MockDebtTransactionRepository_FindAllByGroupExpenseIDs_Call {
+	_c.Call.Return(debtTransactions, err)
+	return _c
+}
+
+func (_c *MockDebtTransactionRepository_FindAllByGroupExpenseIDs_Call) RunAndReturn(run func(ctx context.Context, groupExpenseIDs []uuid.UUID) ([]debts.DebtTransaction, error)) *MockDebtTransactionRepository_FindAllByGroupExpenseIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAllByGroupID provides a mock function for the type MockDebtTransactionRepository
+func (_mock *MockDebtTransactionRepository) FindAllByGroupID(ctx context.Context, groupID uuid.UUID, limit int) ([]debts.DebtTransaction, error) {
+	ret := _mock.Called(ctx, groupID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAllByGroupID")
+	}
+
+	var r0 []debts.DebtTransaction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) ([]debts.DebtTransaction, error)); ok {
+		return returnFunc(ctx, groupID, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) []debts.DebtTransaction); ok {
+		r0 = returnFunc(ctx, groupID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]debts.DebtTransaction)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, int) error); ok {
+		r1 = returnFunc(ctx, groupID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDebtTransactionRepository_FindAllByGroupID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAllByGroupID'
+type MockDebtTransactionRepository_FindAllByGroupID_Call struct {
+	*mock.Call
+}
+
+// FindAllByGroupID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupID uuid.UUID
+//   - limit int
+func (_e *MockDebtTransactionRepository_Expecter) FindAllByGroupID(ctx interface{}, groupID interface{}, limit interface{}) *MockDebtTransactionRepository_FindAllByGroupID_Call {
+	return &MockDebtTransactionRepository_FindAllByGroupID_Call{Call: _e.mock.On("FindAllByGroupID", ctx, groupID, limit)}
+}
+
+func (_c *MockDebtTransactionRepository_FindAllByGroupID_Call) Run(run func(ctx context.Context, groupID uuid.UUID, limit int)) *MockDebtTransactionRepository_FindAllByGroupID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDebtTransactionRepository_FindAllByGroupID_Call) Return(debtTransactions []debts.DebtTransaction, err error) *MockDebtTransactionRepository_FindAllByGroupID_Call {
+	_c.Call.Return(debtTransactions, err)
+	return _c
+}
+
+func (_c *MockDebtTransactionRepository_FindAllByGroupID_Call) RunAndReturn(run func(ctx context.Context, groupID uuid.UUID, limit int) ([]debts.DebtTransaction, error)) *MockDebtTransactionRepository_FindAllByGroupID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAllByIDs provides a mock function for the type MockDebtTransactionRepository
+func (_mock *MockDebtTransactionRepository) FindAllByIDs(ctx context.Context, ids []uuid.UUID, forUpdate bool) ([]debts.DebtTransaction, error) {
+	ret := _mock.Called(ctx, ids, forUpdate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAllByIDs")
+	}
+
+	var r0 []debts.DebtTransaction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID, bool) ([]debts.DebtTransaction, error)); ok {
+		return returnFunc(ctx, ids, forUpdate)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID, bool) []debts.DebtTransaction); ok {
+		r0 = returnFunc(ctx, ids, forUpdate)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]debts.DebtTransaction)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []uuid.UUID, bool) error); ok {
+		r1 = returnFunc(ctx, ids, forUpdate)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDebtTransactionRepository_FindAllByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAllByIDs'
+type MockDebtTransactionRepository_FindAllByIDs_Call struct {
+	*mock.Call
+}
+
+// FindAllByIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []uuid.UUID
+//   - forUpdate bool
+func (_e *MockDebtTransactionRepository_Expecter) FindAllByIDs(ctx interface{}, ids interface{}, forUpdate interface{}) *MockDebtTransactionRepository_FindAllByIDs_Call {
+	return &MockDebtTransactionRepository_FindAllByIDs_Call{Call: _e.mock.On("FindAllByIDs", ctx, ids, forUpdate)}
+}
+
+func (_c *MockDebtTransactionRepository_FindAllByIDs_Call) Run(run func(ctx context.Context, ids []uuid.UUID, forUpdate bool)) *MockDebtTransactionRepository_FindAllByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].([]uuid.UUID)
+		}
+		var arg2 bool
+		if args[2] != nil {
+			arg2 = args[2].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDebtTransactionRepository_FindAllByIDs_Call) Return(debtTransactions []debts.DebtTransaction, err error) *MockDebtTransactionRepository_FindAllByIDs_Call {
+	_c.Call.Return(debtTransactions, err)
+	return _c
+}
+
+func (_c *MockDebtTransactionRepository_FindAllByIDs_Call) RunAndReturn(run func(ctx context.Context, ids []uuid.UUID, forUpdate bool) ([]debts.DebtTransaction, error)) *MockDebtTransactionRepository_FindAllByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAllByMultipleProfileIDs provides a mock function for the type MockDebtTransactionRepository
+func (_mock *MockDebtTransactionRepository) FindAllByMultipleProfileIDs(ctx context.Context, userProfileIDs []uuid.UUID, friendProfileIDs []uuid.UUID) ([]debts.DebtTransaction, error) {
+	ret := _mock.Called(ctx, userProfileIDs, friendProfileIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAllByMultipleProfileIDs")
+	}
+
+	var r0 []debts.DebtTransaction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID, []uuid.UUID) ([]debts.DebtTransaction, error)); ok {
+		return returnFunc(ctx, userProfileIDs, friendProfileIDs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID, []uuid.UUID) []debts.DebtTransaction); ok {
+		r0 = returnFunc(ctx, userProfileIDs, friendProfileIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]debts.DebtTransaction)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []uuid.UUID, []uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userProfileIDs, friendProfileIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDebtTransactionRepository_FindAllByMultipleProfileIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAllByMultipleProfileIDs'
+type MockDebtTransactionRepository_FindAllByMultipleProfileIDs_Call struct {
+	*mock.Call
+}
+
+// FindAllByMultipleProfileIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userProfileIDs []uuid.UUID
+//   - friendProfileIDs []uuid.UUID
+func (_e *MockDebtTransactionRepository_Expecter) FindAllByMultipleProfileIDs(ctx interface{}, userProfileIDs interface{}, friendProfileIDs interface{}) *MockDebtTransactionRepository_FindAllByMultipleProfileIDs_Call {
+	return &MockDebtTransactionRepository_FindAllByMultipleProfileIDs_Call{Call: _e.mock.On("FindAllByMultipleProfileIDs", ctx, userProfileIDs, friendProfileIDs)}
+}
+
+func (_c *MockDebtTransactionRepository_FindAllByMultipleProfileIDs_Call) Run(run func(ctx context.Context, userProfileIDs []uuid.UUID, friendProfileIDs []uuid.UUID)) *MockDebtTransactionRepository_FindAllByMultipleProfileIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].([]uuid.UUID)
+		}
+		var arg2 []uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].([]uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDebtTransactionRepository_FindAllByMultipleProfileIDs_Call) Return(debtTransactions []debts.DebtTransaction, err error) *MockDebtTransactionRepository_FindAllByMultipleProfileIDs_Call {
+	_c.Call.Return(debtTransactions, err)
+	return _c
+}
+
+func (_c *MockDebtTransactionRepository_FindAllByMultipleProfileIDs_Call) RunAndReturn(run func(ctx context.Context, userProfileIDs []uuid.UUID, friendProfileIDs []uuid.UUID) ([]debts.DebtTransaction, error)) *MockDebtTransactionRepository_FindAllByMultipleProfileIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAllByProfileIDs provides a mock function for the type MockDebtTransactionRepository
+func (_mock *MockDebtTransactionRepository) FindAllByProfileIDs(ctx context.Context, profileIDs []uuid.UUID, limit int, debtsOnly bool) ([]debts.DebtTransaction, error) {
+	ret := _mock.Called(ctx, profileIDs, limit, debtsOnly)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAllByProfileIDs")
+	}
+
+	var r0 []debts.DebtTransaction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID, int, bool) ([]debts.DebtTransaction, error)); ok {
+		return returnFunc(ctx, profileIDs, limit, debtsOnly)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID, int, bool) []debts.DebtTransaction); ok {
+		r0 = returnFunc(ctx, profileIDs, limit, debtsOnly)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]debts.DebtTransaction)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []uuid.UUID, int, bool) error); ok {
+		r1 = returnFunc(ctx, profileIDs, limit, debtsOnly)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDebtTransactionRepository_FindAllByProfileIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAllByProfileIDs'
+type MockDebtTransactionRepository_FindAllByProfileIDs_Call struct {
+	*mock.Call
+}
+
+// FindAllByProfileIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - profileIDs []uuid.UUID
+//   - limit int
+//   - debtsOnly bool
+func (_e *MockDebtTransactionRepository_Expecter) FindAllByProfileIDs(ctx interface{}, profileIDs interface{}, limit interface{}, debtsOnly interface{}) *MockDebtTransactionRepository_FindAllByProfileIDs_Call {
+	return &MockDebtTransactionRepository_FindAllByProfileIDs_Call{Call: _e.mock.On("FindAllByProfileIDs", ctx, profileIDs, limit, debtsOnly)}
+}
+
+func (_c *MockDebtTransactionRepository_FindAllByProfileIDs_Call) Run(run func(ctx context.Context, profileIDs []uuid.UUID, limit int, debtsOnly bool)) *MockDebtTransactionRepository_FindAllByProfileIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].([]uuid.UUID)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 bool
+		if args[3] != nil {
+			arg3 = args[3].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDebtTransactionRepository_FindAllByProfileIDs_Call) Return(debtTransactions []debts.DebtTransaction, err error) *MockDebtTransactionRepository_FindAllByProfileIDs_Call {
+	_c.Call.Return(debtTransactions, err)
+	return _c
+}
+
+func (_c *MockDebtTransactionRepository_FindAllByProfileIDs_Call) RunAndReturn(run func(ctx context.Context, profileIDs []uuid.UUID, limit int, debtsOnly bool) ([]debts.DebtTransaction, error)) *MockDebtTransactionRepository_FindAllByProfileIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindFirst provides a mock function for the type MockDebtTransactionRepository
+func (_mock *MockDebtTransactionRepository) FindFirst(ctx context.Context, spec crud.Specification[debts.DebtTransaction]) (debts.DebtTransaction, error) {
+	ret := _mock.Called(ctx, spec)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindFirst")
+	}
+
+	var r0 debts.DebtTransaction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, crud.Specification[debts.DebtTransaction]) (debts.DebtTransaction, error)); ok {
+		return returnFunc(ctx, spec)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, crud.Specification[debts.DebtTransaction]) debts.DebtTransaction); ok {
+		r0 = returnFunc(ctx, spec)
+	} else {
+		r0 = ret.Get(0).(debts.DebtTransaction)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, crud.Specification[debts.DebtTransaction]) error); ok {
+		r1 = returnFunc(ctx, spec)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDebtTransactionRepository_FindFirst_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindFirst'
+type MockDebtTransactionRepository_FindFirst_Call struct {
+	*mock.Call
+}
+
+// FindFirst is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spec crud.Specification[debts.DebtTransaction]
+func (_e *MockDebtTransactionRepository_Expecter) FindFirst(ctx interface{}, spec interface{}) *MockDebtTransactionRepository_FindFirst_Call {
+	return &MockDebtTransactionRepository_FindFirst_Call{Call: _e.mock.On("FindFirst", ctx, spec)}
+}
+
+func (_c *MockDebtTransactionRepository_FindFirst_Call) Run(run func(ctx context.Context, spec crud.Specification[debts.DebtTransaction])) *MockDebtTransactionRepository_FindFirst_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 crud.Specification[debts.DebtTransaction]
+		if args[1] != nil {
+			arg1 = args[1].(crud.Specification[debts.DebtTransaction])
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDebtTransactionRepository_FindFirst_Call) Return(debtTransaction debts.DebtTransaction, err error) *MockDebtTransactionRepository_FindFirst_Call {
+	_c.Call.Return(debtTransaction, err)
+	return _c
+}
+
+func (_c *MockDebtTransactionRepository_FindFirst_Call) RunAndReturn(run func(ctx context.Context, spec crud.Specification[debts.DebtTransaction]) (debts.DebtTransaction, error)) *MockDebtTransactionRepository_FindFirst_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGormInstance provides a mock function for the type MockDebtTransactionRepository
+func (_mock *MockDebtTransactionRepository) GetGormInstance(ctx context.Context) (*gorm.DB, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGormInstance")
+	}
+
+	var r0 *gorm.DB
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (*gorm.DB, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) *gorm.DB); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gorm.DB)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDebtTransactionRepository_GetGormInstance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGormInstance'
+type MockDebtTransactionRepository_GetGormInstance_Call struct {
+	*mock.Call
+}
+
+// GetGormInstance is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockDebtTransactionRepository_Expecter) GetGormInstance(ctx interface{}) *MockDebtTransactionRepository_GetGormInstance_Call {
+	return &MockDebtTransactionRepository_GetGormInstance_Call{Call: _e.mock.On("GetGormInstance", ctx)}
+}
+
+func (_c *MockDebtTransactionRepository_GetGormInstance_Call) Run(run func(ctx context.Context)) *MockDebtTransactionRepository_GetGormInstance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDebtTransactionRepository_GetGormInstance_Call) Return(dB *gorm.DB, err error) *MockDebtTransactionRepository_GetGormInstance_Call {
+	_c.Call.Return(dB, err)
+	return _c
+}
+
+func (_c *MockDebtTransactionRepository_GetGormInstance_Call) RunAndReturn(run func(ctx context.Context) (*gorm.DB, error)) *MockDebtTransactionRepository_GetGormInstance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Insert provides a mock function for the type MockDebtTransactionRepository
+func (_mock *MockDebtTransactionRepository) Insert(ctx context.Context, model debts.DebtTransaction) (debts.DebtTransaction, error) {
+	ret := _mock.Called(ctx, model)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Insert")
+	}
+
+	var r0 debts.DebtTransaction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, debts.DebtTransaction) (debts.DebtTransaction, error)); ok {
+		return returnFunc(ctx, model)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, debts.DebtTransaction) debts.DebtTransaction); ok {
+		r0 = returnFunc(ctx, model)
+	} else {
+		r0 = ret.Get(0).(debts.DebtTransaction)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, debts.DebtTransaction) error); ok {
+		r1 = returnFunc(ctx, model)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDebtTransactionRepository_Insert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Insert'
+type MockDebtTransactionRepository_Insert_Call struct {
+	*mock.Call
+}
+
+// Insert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - model debts.DebtTransaction
+func (_e *MockDebtTransactionRepository_Expecter) Insert(ctx interface{}, model interface{}) *MockDebtTransactionRepository_Insert_Call {
+	return &MockDebtTransactionRepository_Insert_Call{Call: _e.mock.On("Insert", ctx, model)}
+}
+
+func (_c *MockDebtTransactionRepository_Insert_Call) Run(run func(ctx context.Context, model debts.DebtTransaction)) *MockDebtTransactionRepository_Insert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 debts.DebtTransaction
+		if args[1] != nil {
+			arg1 = args[1].(debts.DebtTransaction)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDebtTransactionRepository_Insert_Call) Return(debtTransaction debts.DebtTransaction, err error) *MockDebtTransactionRepository_Insert_Call {
+	_c.Call.Return(debtTransaction, err)
+	return _c
+}
+
+func (_c *MockDebtTransactionRepository_Insert_Call) RunAndReturn(run func(ctx context.Context, model debts.DebtTransaction) (debts.DebtTransaction, error)) *MockDebtTransactionRepository_Insert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InsertMany provides a mock function for the type MockDebtTransactionRepository
+func (_mock *MockDebtTransactionRepository) InsertMany(ctx context.Context, models []debts.DebtTransaction) ([]debts.DebtTransaction, error) {
+	ret := _mock.Called(ctx, models)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertMany")
+	}
+
+	var r0 []debts.DebtTransaction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []debts.DebtTransaction) ([]debts.DebtTransaction, error)); ok {
+		return returnFunc(ctx, models)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []debts.DebtTransaction) []debts.DebtTransaction); ok {
+		r0 = returnFunc(ctx, models)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]debts.DebtTransaction)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []debts.DebtTransaction) error); ok {
+		r1 = returnFunc(ctx, models)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDebtTransactionRepository_InsertMany_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertMany'
+type MockDebtTransactionRepository_InsertMany_Call struct {
+	*mock.Call
+}
+
+// InsertMany is a helper method to define mock.On call
+//   - ctx context.Context
+//   - models []debts.DebtTransaction
+func (_e *MockDebtTransactionRepository_Expecter) InsertMany(ctx interface{}, models interface{}) *MockDebtTransactionRepository_InsertMany_Call {
+	return &MockDebtTransactionRepository_InsertMany_Call{Call: _e.mock.On("InsertMany", ctx, models)}
+}
+
+func (_c *MockDebtTransactionRepository_InsertMany_Call) Run(run func(ctx context.Context, models []debts.DebtTransaction)) *MockDebtTransactionRepository_InsertMany_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []debts.DebtTransaction
+		if args[1] != nil {
+			arg1 = args[1].([]debts.DebtTransaction)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDebtTransactionRepository_InsertMany_Call) Return(debtTransactions []debts.DebtTransaction, err error) *MockDebtTransactionRepository_InsertMany_Call {
+	_c.Call.Return(debtTransactions, err)
+	return _c
+}
+
+func (_c *MockDebtTransactionRepository_InsertMany_Call) RunAndReturn(run func(ctx context.Context, models []debts.DebtTransaction) ([]debts.DebtTransaction, error)) *MockDebtTransactionRepository_InsertMany_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkSettled provides a mock function for the type MockDebtTransactionRepository
+func (_mock *MockDebtTransactionRepository) MarkSettled(ctx context.Context, ids []uuid.UUID, settlementID uuid.UUID) error {
+	ret := _mock.Called(ctx, ids, settlementID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkSettled")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, ids, settlementID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockDebtTransactionRepository_MarkSettled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkSettled'
+type MockDebtTransactionRepository_MarkSettled_Call struct {
+	*mock.Call
+}
+
+// MarkSettled is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []uuid.UUID
+//   - settlementID uuid.UUID
+func (_e *MockDebtTransactionRepository_Expecter) MarkSettled(ctx interface{}, ids interface{}, settlementID interface{}) *MockDebtTransactionRepository_MarkSettled_Call {
+	return &MockDebtTransactionRepository_MarkSettled_Call{Call: _e.mock.On("MarkSettled", ctx, ids, settlementID)}
+}
+
+func (_c *MockDebtTransactionRepository_MarkSettled_Call) Run(run func(ctx context.Context, ids []uuid.UUID, settlementID uuid.UUID)) *MockDebtTransactionRepository_MarkSettled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].([]uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDebtTransactionRepository_MarkSettled_Call) Return(err error) *MockDebtTransactionRepository_MarkSettled_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockDebtTransactionRepository_MarkSettled_Call) RunAndReturn(run func(ctx context.Context, ids []uuid.UUID, settlementID uuid.UUID) error) *MockDebtTransactionRepository_MarkSettled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveMany provides a mock function for the type MockDebtTransactionRepository
+func (_mock *MockDebtTransactionRepository) SaveMany(ctx context.Context, models []debts.DebtTransaction) ([]debts.DebtTransaction, error) {
+	ret := _mock.Called(ctx, models)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveMany")
+	}
+
+	var r0 []debts.DebtTransaction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []debts.DebtTransaction) ([]debts.DebtTransaction, error)); ok {
+		return returnFunc(ctx, models)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []debts.DebtTransaction) []debts.DebtTransaction); ok {
+		r0 = returnFunc(ctx, models)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]debts.DebtTransaction)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []debts.DebtTransaction) error); ok {
+		r1 = returnFunc(ctx, models)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDebtTransactionRepository_SaveMany_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveMany'
+type MockDebtTransactionRepository_SaveMany_Call struct {
+	*mock.Call
+}
+
+// SaveMany is a helper method to define mock.On call
+//   - ctx context.Context
+//   - models []debts.DebtTransaction
+func (_e *MockDebtTransactionRepository_Expecter) SaveMany(ctx interface{}, models interface{}) *MockDebtTransactionRepository_SaveMany_Call {
+	return &MockDebtTransactionRepository_SaveMany_Call{Call: _e.mock.On("SaveMany", ctx, models)}
+}
+
+func (_c *MockDebtTransactionRepository_SaveMany_Call) Run(run func(ctx context.Context, models []debts.DebtTransaction)) *MockDebtTransactionRepository_SaveMany_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []debts.DebtTransaction
+		if args[1] != nil {
+			arg1 = args[1].([]debts.DebtTransaction)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDebtTransactionRepository_SaveMany_Call) Return(debtTransactions []debts.DebtTransaction, err error) *MockDebtTransactionRepository_SaveMany_Call {
+	_c.Call.Return(debtTransactions, err)
+	return _c
+}
+
+func (_c *MockDebtTransactionRepository_SaveMany_Call) RunAndReturn(run func(ctx context.Context, models []debts.DebtTransaction) ([]debts.DebtTransaction, error)) *MockDebtTransactionRepository_SaveMany_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockDebtTransactionRepository
+func (_mock *MockDebtTransactionRepository) Update(ctx context.Context, model debts.DebtTransaction) (debts.DebtTransaction, error) {
+	ret := _mock.Called(ctx, model)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 debts.DebtTransaction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, debts.DebtTransaction) (debts.DebtTransaction, error)); ok {
+		return returnFunc(ctx, model)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, debts.DebtTransaction) debts.DebtTransaction); ok {
+		r0 = returnFunc(ctx, model)
+	} else {
+		r0 = ret.Get(0).(debts.DebtTransaction)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, debts.DebtTransaction) error); ok {
+		r1 = returnFunc(ctx, model)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDebtTransactionRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockDebtTransactionRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - model debts.DebtTransaction
+func (_e *MockDebtTransactionRepository_Expecter) Update(ctx interface{}, model interface{}) *MockDebtTransactionRepository_Update_Call {
+	return &MockDebtTransactionRepository_Update_Call{Call: _e.mock.On("Update", ctx, model)}
+}
+
+func (_c *MockDebtTransactionRepository_Update_Call) Run(run func(ctx context.Context, model debts.DebtTransaction)) *MockDebtTransactionRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 debts.DebtTransaction
+		if args[1] != nil {
+			arg1 = args[1].(debts.DebtTransaction)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDebtTransactionRepository_Update_Call) Return(debtTransaction debts.DebtTransaction, err error) *MockDebtTransactionRepository_Update_Call {
+	_c.Call.Return(debtTransaction, err)
+	return _c
+}
+
+func (_c *MockDebtTransactionRepository_Update_Call) RunAndReturn(run func(ctx context.Context, model debts.DebtTransaction) (debts.DebtTransaction, error)) *MockDebtTransactionRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockExpenseBillRepository creates a new instance of MockExpenseBillRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockExpenseBillRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockExpenseBillRepository {
+	mock := &MockExpenseBillRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockExpenseBillRepository is an autogenerated mock type for the ExpenseBillRepository type
+type MockExpenseBillRepository struct {
+	mock.Mock
+}
+
+type MockExpenseBillRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockExpenseBillRepository) EXPECT() *MockExpenseBillRepository_Expecter {
+	return &MockExpenseBillRepository_Expecter{mock: &_m.Mock}
+}
+
+// CountUploadedByDateRange provides a mock function for the type MockExpenseBillRepository
+func (_mock *MockExpenseBillRepository) CountUploadedByDateRange(ctx context.Context, profileID uuid.UUID, start time.Time, end time.Time) (int, error) {
+	ret := _mock.Called(ctx, profileID, start, end)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountUploadedByDateRange")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, time.Time) (int, error)); ok {
+		return returnFunc(ctx, profileID, start, end)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, time.Time) int); ok {
+		r0 = returnFunc(ctx, profileID, start, end)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time, time.Time) error); ok {
+		r1 = returnFunc(ctx, profileID, start, end)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExpenseBillRepository_CountUploadedByDateRange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountUploadedByDateRange'
+type MockExpenseBillRepository_CountUploadedByDateRange_Call struct {
+	*mock.Call
+}
+
+// CountUploadedByDateRange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - profileID uuid.UUID
+//   - start time.Time
+//   - end time.Time
+func (_e *MockExpenseBillRepository_Expecter) CountUploadedByDateRange(ctx interface{}, profileID interface{}, start interface{}, end interface{}) *MockExpenseBillRepository_CountUploadedByDateRange_Call {
+	return &MockExpenseBillRepository_CountUploadedByDateRange_Call{Call: _e.mock.On("CountUploadedByDateRange", ctx, profileID, start, end)}
+}
+
+func (_c *MockExpenseBillRepository_CountUploadedByDateRange_Call) Run(run func(ctx context.Context, profileID uuid.UUID, start time.Time, end time.Time)) *MockExpenseBillRepository_CountUploadedByDateRange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExpenseBillRepository_CountUploadedByDateRange_Call) Return(n int, err error) *MockExpenseBillRepository_CountUploadedByDateRange_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockExpenseBillRepository_CountUploadedByDateRange_Call) RunAndReturn(run func(ctx context.Context, profileID uuid.UUID, start time.Time, end time.Time) (int, error)) *MockExpenseBillRepository_CountUploadedByDateRange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockExpenseBillRepository
+func (_mock *MockExpenseBillRepository) Delete(ctx context.Context, model expenses.ExpenseBill) error {
+	ret := _mock.Called(ctx, model)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, expenses.ExpenseBill) error); ok {
+		r0 = returnFunc(ctx, model)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockExpenseBillRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockExpenseBillRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - model expenses.ExpenseBill
+func (_e *MockExpenseBillRepository_Expecter) Delete(ctx interface{}, model interface{}) *MockExpenseBillRepository_Delete_Call {
+	return &MockExpenseBillRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, model)}
+}
+
+func (_c *MockExpenseBillRepository_Delete_Call) Run(run func(ctx context.Context, model expenses.ExpenseBill)) *MockExpenseBillRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 expenses.ExpenseBill
+		if args[1] != nil {
+			arg1 = args[1].(expenses.ExpenseBill)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExpenseBillRepository_Delete_Call) Return(err error) *MockExpenseBillRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockExpenseBillRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, model expenses.ExpenseBill) error) *MockExpenseBillRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteMany provides a mock function for the type MockExpenseBillRepository
+func (_mock *MockExpenseBillRepository) DeleteMany(ctx context.Context, models []expenses.ExpenseBill) error {
+	ret := _mock.Called(ctx, models)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMany")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []expenses.ExpenseBill) error); ok {
+		r0 = returnFunc(ctx, models)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockExpenseBillRepository_DeleteMany_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMany'
+type MockExpenseBillRepository_DeleteMany_Call struct {
+	*mock.Call
+}
+
+// DeleteMany is a helper method to define mock.On call
+//   - ctx context.Context
+//   - models []expenses.ExpenseBill
+func (_e *MockExpenseBillRepository_Expecter) DeleteMany(ctx interface{}, models interface{}) *MockExpenseBillRepository_DeleteMany_Call {
+	return &MockExpenseBillRepository_DeleteMany_Call{Call: _e.mock.On("DeleteMany", ctx, models)}
+}
+
+func (_c *MockExpenseBillRepository_DeleteMany_Call) Run(run func(ctx context.Context, models []expenses.ExpenseBill)) *MockExpenseBillRepository_DeleteMany_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []expenses.ExpenseBill
+		if args[1] != nil {
+			arg1 = args[1].([]expenses.ExpenseBill)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExpenseBillRepository_DeleteMany_Call) Return(err error) *MockExpenseBillRepository_DeleteMany_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockExpenseBillRepository_DeleteMany_Call) RunAndReturn(run func(ctx context.Context, models []expenses.ExpenseBill) error) *MockExpenseBillRepository_DeleteMany_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAll provides a mock function for the type MockExpenseBillRepository
+func (_mock *MockExpenseBillRepository) FindAll(ctx context.Context, spec crud.Specification[expenses.ExpenseBill]) ([]expenses.ExpenseBill, error) {
+	ret := _mock.Called(ctx, spec)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAll")
+	}
+
+	var r0 []expenses.ExpenseBill
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, crud.Specification[expenses.ExpenseBill]) ([]expenses.ExpenseBill, error)); ok {
+		return returnFunc(ctx, spec)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, crud.Specification[expenses.ExpenseBill]) []expenses.ExpenseBill); ok {
+		r0 = returnFunc(ctx, spec)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]expenses.ExpenseBill)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, crud.Specification[expenses.ExpenseBill]) error); ok {
+		r1 = returnFunc(ctx, spec)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExpenseBillRepository_FindAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAll'
+type MockExpenseBillRepository_FindAll_Call struct {
+	*mock.Call
+}
+
+// FindAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spec crud.Specification[expenses.ExpenseBill]
+func (_e *MockExpenseBillRepository_Expecter) FindAll(ctx interface{}, spec interface{}) *MockExpenseBillRepository_FindAll_Call {
+	return &MockExpenseBillRepository_FindAll_Call{Call: _e.mock.On("FindAll", ctx, spec)}
+}
+
+func (_c *MockExpenseBillRepository_FindAll_Call) Run(run func(ctx context.Context, spec crud.Specification[expenses.ExpenseBill])) *MockExpenseBillRepository_FindAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 crud.Specification[expenses.ExpenseBill]
+		if args[1] != nil {
+			arg1 = args[1].(crud.Specification[expenses.ExpenseBill])
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExpenseBillRepository_FindAll_Call) Return(expenseBills []expenses.ExpenseBill, err error) *MockExpenseBillRepository_FindAll_Call {
+	_c.Call.Return(expenseBills, err)
+	return _c
+}
+
+func (_c *MockExpenseBillRepository_FindAll_Call) RunAndReturn(run func(ctx context.Context, spec crud.Specification[expenses.ExpenseBill]) ([]expenses.ExpenseBill, error)) *MockExpenseBillRepository_FindAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindFirst provides a mock function for the type MockExpenseBillRepository
+func (_mock *MockExpenseBillRepository) FindFirst(ctx context.Context, spec crud.Specification[expenses.ExpenseBill]) (expenses.ExpenseBill, error) {
+	ret := _mock.Called(ctx, spec)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindFirst")
+	}
+
+	var r0 expenses.ExpenseBill
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, crud.Specification[expenses.ExpenseBill]) (expenses.ExpenseBill, error)); ok {
+		return returnFunc(ctx, spec)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, crud.Specification[expenses.ExpenseBill]) expenses.ExpenseBill); ok {
+		r0 = returnFunc(ctx, spec)
+	} else {
+		r0 = ret.Get(0).(expenses.ExpenseBill)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, crud.Specification[expenses.ExpenseBill]) error); ok {
+		r1 = returnFunc(ctx, spec)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExpenseBillRepository_FindFirst_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindFirst'
+type MockExpenseBillRepository_FindFirst_Call struct {
+	*mock.Call
+}
+
+// FindFirst is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spec crud.Specification[expenses.ExpenseBill]
+func (_e *MockExpenseBillRepository_Expecter) FindFirst(ctx interface{}, spec interface{}) *MockExpenseBillRepository_FindFirst_Call {
+	return &MockExpenseBillRepository_FindFirst_Call{Call: _e.mock.On("FindFirst", ctx, spec)}
+}
+
+func (_c *MockExpenseBillRepository_FindFirst_Call) Run(run func(ctx context.Context, spec crud.Specification[expenses.ExpenseBill])) *MockExpenseBillRepository_FindFirst_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 crud.Specification[expenses.ExpenseBill]
+		if args[1] != nil {
+			arg1 = args[1].(crud.Specification[expenses.ExpenseBill])
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExpenseBillRepository_FindFirst_Call) Return(expenseBill expenses.ExpenseBill, err error) *MockExpenseBillRepository_FindFirst_Call {
+	_c.Call.Return(expenseBill, err)
+	return _c
+}
+
+func (_c *MockExpenseBillRepository_FindFirst_Call) RunAndReturn(run func(ctx context.Context, spec crud.Specification[expenses.ExpenseBill]) (expenses.ExpenseBill, error)) *MockExpenseBillRepository_FindFirst_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGormInstance provides a mock function for the type MockExpenseBillRepository
+func (_mock *MockExpenseBillRepository) GetGormInstance(ctx context.Context) (*gorm.DB, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGormInstance")
+	}
+
+	var r0 *gorm.DB
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (*gorm.DB, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) *gorm.DB); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gorm.DB)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExpenseBillRepository_GetGormInstance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGormInstance'
+type MockExpenseBillRepository_GetGormInstance_Call struct {
+	*mock.Call
+}
+
+// GetGormInstance is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockExpenseBillRepository_Expecter) GetGormInstance(ctx interface{}) *MockExpenseBillRepository_GetGormInstance_Call {
+	return &MockExpenseBillRepository_GetGormInstance_Call{Call: _e.mock.On("GetGormInstance", ctx)}
+}
+
+func (_c *MockExpenseBillRepository_GetGormInstance_Call) Run(run func(ctx context.Context)) *MockExpenseBillRepository_GetGormInstance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExpenseBillRepository_GetGormInstance_Call) Return(dB *gorm.DB, err error) *MockExpenseBillRepository_GetGormInstance_Call {
+	_c.Call.Return(dB, err)
+	return _c
+}
+
+func (_c *MockExpenseBillRepository_GetGormInstance_Call) RunAndReturn(run func(ctx context.Context) (*gorm.DB, error)) *MockExpenseBillRepository_GetGormInstance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Insert provides a mock function for the type MockExpenseBillRepository
+func (_mock *MockExpenseBillRepository) Insert(ctx context.Context, model expenses.ExpenseBill) (expenses.ExpenseBill, error) {
+	ret := _mock.Called(ctx, model)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Insert")
+	}
+
+	var r0 expenses.ExpenseBill
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, expenses.ExpenseBill) (expenses.ExpenseBill, error)); ok {
+		return returnFunc(ctx, model)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, expenses.ExpenseBill) expenses.ExpenseBill); ok {
+		r0 = returnFunc(ctx, model)
+	} else {
+		r0 = ret.Get(0).(expenses.ExpenseBill)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, expenses.ExpenseBill) error); ok {
+		r1 = returnFunc(ctx, model)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExpenseBillRepository_Insert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Insert'
+type MockExpenseBillRepository_Insert_Call struct {
+	*mock.Call
+}
+
+// Insert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - model expenses.ExpenseBill
+func (_e *MockExpenseBillRepository_Expecter) Insert(ctx interface{}, model interface{}) *MockExpenseBillRepository_Insert_Call {
+	return &MockExpenseBillRepository_Insert_Call{Call: _e.mock.On("Insert", ctx, model)}
+}
+
+func (_c *MockExpenseBillRepository_Insert_Call) Run(run func(ctx context.Context, model expenses.ExpenseBill)) *MockExpenseBillRepository_Insert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 expenses.ExpenseBill
+		if args[1] != nil {
+			arg1 = args[1].(expenses.ExpenseBill)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExpenseBillRepository_Insert_Call) Return(expenseBill expenses.ExpenseBill, err error) *MockExpenseBillRepository_Insert_Call {
+	_c.Call.Return(expenseBill, err)
+	return _c
+}
+
+func (_c *MockExpenseBillRepository_Insert_Call) RunAndReturn(run func(ctx context.Context, model expenses.ExpenseBill) (expenses.ExpenseBill, error)) *MockExpenseBillRepository_Insert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InsertMany provides a mock function for the type MockExpenseBillRepository
+func (_mock *MockExpenseBillRepository) InsertMany(ctx context.Context, models []expenses.ExpenseBill) ([]expenses.ExpenseBill, error) {
+	ret := _mock.Called(ctx, models)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertMany")
+	}
+
+	var r0 []expenses.ExpenseBill
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []expenses.ExpenseBill) ([]expenses.ExpenseBill, error)); ok {
+		return returnFunc(ctx, models)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []expenses.ExpenseBill) []expenses.ExpenseBill); ok {
+		r0 = returnFunc(ctx, models)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]expenses.ExpenseBill)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []expenses.ExpenseBill) error); ok {
+		r1 = returnFunc(ctx, models)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExpenseBillRepository_InsertMany_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertMany'
+type MockExpenseBillRepository_InsertMany_Call struct {
+	*mock.Call
+}
+
+// InsertMany is a helper method to define mock.On call
+//   - ctx context.Context
+//   - models []expenses.ExpenseBill
+func (_e *MockExpenseBillRepository_Expecter) InsertMany(ctx interface{}, models interface{}) *MockExpenseBillRepository_InsertMany_Call {
+	return &MockExpenseBillRepository_InsertMany_Call{Call: _e.mock.On("InsertMany", ctx, models)}
+}
+
+func (_c *MockExpenseBillRepository_InsertMany_Call) Run(run func(ctx context.Context, models []expenses.ExpenseBill)) *MockExpenseBillRepository_InsertMany_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []expenses.ExpenseBill
+		if args[1] != nil {
+			arg1 = args[1].([]expenses.ExpenseBill)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExpenseBillRepository_InsertMany_Call) Return(expenseBills []expenses.ExpenseBill, err error) *MockExpenseBillRepository_InsertMany_Call {
+	_c.Call.Return(expenseBills, err)
+	return _c
+}
+
+func (_c *MockExpenseBillRepository_InsertMany_Call) RunAndReturn(run func(ctx context.Context, models []expenses.ExpenseBill) ([]expenses.ExpenseBill, error)) *MockExpenseBillRepository_InsertMany_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveMany provides a mock function for the type MockExpenseBillRepository
+func (_mock *MockExpenseBillRepository) SaveMany(ctx context.Context, models []expenses.ExpenseBill) ([]expenses.ExpenseBill, error) {
+	ret := _mock.Called(ctx, models)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveMany")
+	}
+
+	var r0 []expenses.ExpenseBill
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []expenses.ExpenseBill) ([]expenses.ExpenseBill, error)); ok {
+		return returnFunc(ctx, models)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []expenses.ExpenseBill) []expenses.ExpenseBill); ok {
+		r0 = returnFunc(ctx, models)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]expenses.ExpenseBill)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []expenses.ExpenseBill) error); ok {
+		r1 = returnFunc(ctx, models)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExpenseBillRepository_SaveMany_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveMany'
+type MockExpenseBillRepository_SaveMany_Call struct {
+	*mock.Call
+}
+
+// SaveMany is a helper method to define mock.On call
+//   - ctx context.Context
+//   - models []expenses.ExpenseBill
+func (_e *MockExpenseBillRepository_Expecter) SaveMany(ctx interface{}, models interface{}) *MockExpenseBillRepository_SaveMany_Call {
+	return &MockExpenseBillRepository_SaveMany_Call{Call: _e.mock.On("SaveMany", ctx, models)}
+}
+
+func (_c *MockExpenseBillRepository_SaveMany_Call) Run(run func(ctx context.Context, models []expenses.ExpenseBill)) *MockExpenseBillRepository_SaveMany_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []expenses.ExpenseBill
+		if args[1] != nil {
+			arg1 = args[1].([]expenses.ExpenseBill)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExpenseBillRepository_SaveMany_Call) Return(expenseBills []expenses.ExpenseBill, err error) *MockExpenseBillRepository_SaveMany_Call {
+	_c.Call.Return(expenseBills, err)
+	return _c
+}
+
+func (_c *MockExpenseBillRepository_SaveMany_Call) RunAndReturn(run func(ctx context.Context, models []expenses.ExpenseBill) ([]expenses.ExpenseBill, error)) *MockExpenseBillRepository_SaveMany_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockExpenseBillRepository
+func (_mock *MockExpenseBillRepository) Update(ctx context.Context, model expenses.ExpenseBill) (expenses.ExpenseBill, error) {
+	ret := _mock.Called(ctx, model)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 expenses.ExpenseBill
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, expenses.ExpenseBill) (expenses.ExpenseBill, error)); ok {
+		return returnFunc(ctx, model)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, expenses.ExpenseBill) expenses.ExpenseBill); ok {
+		r0 = returnFunc(ctx, model)
+	} else {
+		r0 = ret.Get(0).(expenses.ExpenseBill)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, expenses.ExpenseBill) error); ok {
+		r1 = returnFunc(ctx, model)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExpenseBillRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockExpenseBillRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - model expenses.ExpenseBill
+func (_e *MockExpenseBillRepository_Expecter) Update(ctx interface{}, model interface{}) *MockExpenseBillRepository_Update_Call {
+	return &MockExpenseBillRepository_Update_Call{Call: _e.mock.On("Update", ctx, model)}
+}
+
+func (_c *MockExpenseBillRepository_Update_Call) Run(run func(ctx context.Context, model expenses.ExpenseBill)) *MockExpenseBillRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 expenses.ExpenseBill
+		if args[1] != nil {
+			arg1 = args[1].(expenses.ExpenseBill)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExpenseBillRepository_Update_Call) Return(expenseBill expenses.ExpenseBill, err error) *MockExpenseBillRepository_Update_Call {
+	_c.Call.Return(expenseBill, err)
+	return _c
+}
+
+func (_c *MockExpenseBillRepository_Update_Call) RunAndReturn(run func(ctx context.Context, model expenses.ExpenseBill) (expenses.ExpenseBill, error)) *MockExpenseBillRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockOtherFeeRepository creates a new instance of MockOtherFeeRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockOtherFeeRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockOtherFeeRepository {
+	mock := &MockOtherFeeRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockOtherFeeRepository is an autogenerated mock type for the OtherFeeRepository type
+type MockOtherFeeRepository struct {
+	mock.Mock
+}
+
+type MockOtherFeeRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockOtherFeeRepository) EXPECT() *MockOtherFeeRepository_Expecter {
+	return &MockOtherFeeRepository_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function for the type MockOtherFeeRepository
+func (_mock *MockOtherFeeRepository) Delete(ctx context.Context, model expenses.OtherFee) error {
+	ret := _mock.Called(ctx, model)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, expenses.OtherFee) error); ok {
+		r0 = returnFunc(ctx, model)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockOtherFeeRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockOtherFeeRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - model expenses.OtherFee
+func (_e *MockOtherFeeRepository_Expecter) Delete(ctx interface{}, model interface{}) *MockOtherFeeRepository_Delete_Call {
+	return &MockOtherFeeRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, model)}
+}
+
+func (_c *MockOtherFeeRepository_Delete_Call) Run(run func(ctx context.Context, model expenses.OtherFee)) *MockOtherFeeRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 expenses.OtherFee
+		if args[1] != nil {
+			arg1 = args[1].(expenses.OtherFee)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOtherFeeRepository_Delete_Call) Return(err error) *MockOtherFeeRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockOtherFeeRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, model expenses.OtherFee) error) *MockOtherFeeRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteMany provides a mock function for the type MockOtherFeeRepository
+func (_mock *MockOtherFeeRepository) DeleteMany(ctx context.Context, models []expenses.OtherFee) error {
+	ret := _mock.Called(ctx, models)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMany")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []expenses.OtherFee) error); ok {
+		r0 = returnFunc(ctx, models)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockOtherFeeRepository_DeleteMany_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMany'
+type MockOtherFeeRepository_DeleteMany_Call struct {
+	*mock.Call
+}
+
+// DeleteMany is a helper method to define mock.On call
+//   - ctx context.Context
+//   - models []expenses.OtherFee
+func (_e *MockOtherFeeRepository_Expecter) DeleteMany(ctx interface{}, models interface{}) *MockOtherFeeRepository_DeleteMany_Call {
+	return &MockOtherFeeRepository_DeleteMany_Call{Call: _e.mock.On("DeleteMany", ctx, models)}
+}
+
+func (_c *MockOtherFeeRepository_DeleteMany_Call) Run(run func(ctx context.Context, models []expenses.OtherFee)) *MockOtherFeeRepository_DeleteMany_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []expenses.OtherFee
+		if args[1] != nil {
+			arg1 = args[1].([]expenses.OtherFee)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOtherFeeRepository_DeleteMany_Call) Return(err error) *MockOtherFeeRepository_DeleteMany_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockOtherFeeRepository_DeleteMany_Call) RunAndReturn(run func(ctx context.Context, models []expenses.OtherFee) error) *MockOtherFeeRepository_DeleteMany_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAll provides a mock function for the type MockOtherFeeRepository
+func (_mock *MockOtherFeeRepository) FindAll(ctx context.Context, spec crud.Specification[expenses.OtherFee]) ([]expenses.OtherFee, error) {
+	ret := _mock.Called(ctx, spec)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAll")
+	}
+
+	var r0 []expenses.OtherFee
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, crud.Specification[expenses.OtherFee]) ([]expenses.OtherFee, error)); ok {
+		return returnFunc(ctx, spec)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, crud.Specification[expenses.OtherFee]) []expenses.OtherFee); ok {
+		r0 = returnFunc(ctx, spec)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]expenses.OtherFee)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, crud.Specification[expenses.OtherFee]) error); ok {
+		r1 = returnFunc(ctx, spec)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOtherFeeRepository_FindAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAll'
+type MockOtherFeeRepository_FindAll_Call struct {
+	*mock.Call
+}
+
+// FindAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spec crud.Specification[expenses.OtherFee]
+func (_e *MockOtherFeeRepository_Expecter) FindAll(ctx interface{}, spec interface{}) *MockOtherFeeRepository_FindAll_Call {
+	return &MockOtherFeeRepository_FindAll_Call{Call: _e.mock.On("FindAll", ctx, spec)}
+}
+
+func (_c *MockOtherFeeRepository_FindAll_Call) Run(run func(ctx context.Context, spec crud.Specification[expenses.OtherFee])) *MockOtherFeeRepository_FindAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 crud.Specification[expenses.OtherFee]
+		if args[1] != nil {
+			arg1 = args[1].(crud.Specification[expenses.OtherFee])
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOtherFeeRepository_FindAll_Call) Return(otherFees []expenses.OtherFee, err error) *MockOtherFeeRepository_FindAll_Call {
+	_c.Call.Return(otherFees, err)
+	return _c
+}
+
+func (_c *MockOtherFeeRepository_FindAll_Call) RunAndReturn(run func(ctx context.Context, spec crud.Specification[expenses.OtherFee]) ([]expenses.OtherFee, error)) *MockOtherFeeRepository_FindAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindFirst provides a mock function for the type MockOtherFeeRepository
+func (_mock *MockOtherFeeRepository) FindFirst(ctx context.Context, spec crud.Specification[expenses.OtherFee]) (expenses.OtherFee, error) {
+	ret := _mock.Called(ctx, spec)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindFirst")
+	}
+
+	var r0 expenses.OtherFee
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, crud.Specification[expenses.OtherFee]) (expenses.OtherFee, error)); ok {
+		return returnFunc(ctx, spec)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, crud.Specification[expenses.OtherFee]) expenses.OtherFee); ok {
+		r0 = returnFunc(ctx, spec)
+	} else {
+		r0 = ret.Get(0).(expenses.OtherFee)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, crud.Specification[expenses.OtherFee]) error); ok {
+		r1 = returnFunc(ctx, spec)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOtherFeeRepository_FindFirst_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindFirst'
+type MockOtherFeeRepository_FindFirst_Call struct {
+	*mock.Call
+}
+
+// FindFirst is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spec crud.Specification[expenses.OtherFee]
+func (_e *MockOtherFeeRepository_Expecter) FindFirst(ctx interface{}, spec interface{}) *MockOtherFeeRepository_FindFirst_Call {
+	return &MockOtherFeeRepository_FindFirst_Call{Call: _e.mock.On("FindFirst", ctx, spec)}
+}
+
+func (_c *MockOtherFeeRepository_FindFirst_Call) Run(run func(ctx context.Context, spec crud.Specification[expenses.OtherFee])) *MockOtherFeeRepository_FindFirst_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 crud.Specification[expenses.OtherFee]
+		if args[1] != nil {
+			arg1 = args[1].(crud.Specification[expenses.OtherFee])
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOtherFeeRepository_FindFirst_Call) Return(otherFee expenses.OtherFee, err error) *MockOtherFeeRepository_FindFirst_Call {
+	_c.Call.Return(otherFee, err)
+	return _c
+}
+
+func (_c *MockOtherFeeRepository_FindFirst_Call) RunAndReturn(run func(ctx context.Context, spec crud.Specification[expenses.OtherFee]) (expenses.OtherFee, error)) *MockOtherFeeRepository_FindFirst_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGormInstance provides a mock function for the type MockOtherFeeRepository
+func (_mock *MockOtherFeeRepository) GetGormInstance(ctx context.Context) (*gorm.DB, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGormInstance")
+	}
+
+	var r0 *gorm.DB
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (*gorm.DB, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) *gorm.DB); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gorm.DB)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOtherFeeRepository_GetGormInstance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGormInstance'
+type MockOtherFeeRepository_GetGormInstance_Call struct {
+	*mock.Call
+}
+
+// GetGormInstance is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockOtherFeeRepository_Expecter) GetGormInstance(ctx interface{}) *MockOtherFeeRepository_GetGormInstance_Call {
+	return &MockOtherFeeRepository_GetGormInstance_Call{Call: _e.mock.On("GetGormInstance", ctx)}
+}
+
+func (_c *MockOtherFeeRepository_GetGormInstance_Call) Run(run func(ctx context.Context)) *MockOtherFeeRepository_GetGormInstance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOtherFeeRepository_GetGormInstance_Call) Return(dB *gorm.DB, err error) *MockOtherFeeRepository_GetGormInstance_Call {
+	_c.Call.Return(dB, err)
+	return _c
+}
+
+func (_c *MockOtherFeeRepository_GetGormInstance_Call) RunAndReturn(run func(ctx context.Context) (*gorm.DB, error)) *MockOtherFeeRepository_GetGormInstance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Insert provides a mock function for the type MockOtherFeeRepository
+func (_mock *MockOtherFeeRepository) Insert(ctx context.Context, model expenses.OtherFee) (expenses.OtherFee, error) {
+	ret := _mock.Called(ctx, model)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Insert")
+	}
+
+	var r0 expenses.OtherFee
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, expenses.OtherFee) (expenses.OtherFee, error)); ok {
+		return returnFunc(ctx, model)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, expenses.OtherFee) expenses.OtherFee); ok {
+		r0 = returnFunc(ctx, model)
+	} else {
+		r0 = ret.Get(0).(expenses.OtherFee)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, expenses.OtherFee) error); ok {
+		r1 = returnFunc(ctx, model)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOtherFeeRepository_Insert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Insert'
+type MockOtherFeeRepository_Insert_Call struct {
+	*mock.Call
+}
+
+// Insert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - model expenses.OtherFee
+func (_e *MockOtherFeeRepository_Expecter) Insert(ctx interface{}, model interface{}) *MockOtherFeeRepository_Insert_Call {
+	return &MockOtherFeeRepository_Insert_Call{Call: _e.mock.On("Insert", ctx, model)}
+}
+
+func (_c *MockOtherFeeRepository_Insert_Call) Run(run func(ctx context.Context, model expenses.OtherFee)) *MockOtherFeeRepository_Insert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 expenses.OtherFee
+		if args[1] != nil {
+			arg1 = args[1].(expenses.OtherFee)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOtherFeeRepository_Insert_Call) Return(otherFee expenses.OtherFee, err error) *MockOtherFeeRepository_Insert_Call {
+	_c.Call.Return(otherFee, err)
+	return _c
+}
+
+func (_c *MockOtherFeeRepository_Insert_Call) RunAndReturn(run func(ctx context.Context, model expenses.OtherFee) (expenses.OtherFee, error)) *MockOtherFeeRepository_Insert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InsertMany provides a mock function for the type MockOtherFeeRepository
+func (_mock *MockOtherFeeRepository) InsertMany(ctx context.Context, models []expenses.OtherFee) ([]expenses.OtherFee, error) {
+	ret := _mock.Called(ctx, models)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertMany")
+	}
+
+	var r0 []expenses.OtherFee
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []expenses.OtherFee) ([]expenses.OtherFee, error)); ok {
+		return returnFunc(ctx, models)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []expenses.OtherFee) []expenses.OtherFee); ok {
+		r0 = returnFunc(ctx, models)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]expenses.OtherFee)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []expenses.OtherFee) error); ok {
+		r1 = returnFunc(ctx, models)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOtherFeeRepository_InsertMany_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertMany'
+type MockOtherFeeRepository_InsertMany_Call struct {
+	*mock.Call
+}
+
+// InsertMany is a helper method to define mock.On call
+//   - ctx context.Context
+//   - models []expenses.OtherFee
+func (_e *MockOtherFeeRepository_Expecter) InsertMany(ctx interface{}, models interface{}) *MockOtherFeeRepository_InsertMany_Call {
+	return &MockOtherFeeRepository_InsertMany_Call{Call: _e.mock.On("InsertMany", ctx, models)}
+}
+
+func (_c *MockOtherFeeRepository_InsertMany_Call) Run(run func(ctx context.Context, models []expenses.OtherFee)) *MockOtherFeeRepository_InsertMany_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []expenses.OtherFee
+		if args[1] != nil {
+			arg1 = args[1].([]expenses.OtherFee)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOtherFeeRepository_InsertMany_Call) Return(otherFees []expenses.OtherFee, err error) *MockOtherFeeRepository_InsertMany_Call {
+	_c.Call.Return(otherFees, err)
+	return _c
+}
+
+func (_c *MockOtherFeeRepository_InsertMany_Call) RunAndReturn(run func(ctx context.Context, models []expenses.OtherFee) ([]expenses.OtherFee, error)) *MockOtherFeeRepository_InsertMany_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveMany provides a mock function for the type MockOtherFeeRepository
+func (_mock *MockOtherFeeRepository) SaveMany(ctx context.Context, models []expenses.OtherFee) ([]expenses.OtherFee, error) {
+	ret := _mock.Called(ctx, models)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveMany")
+	}
+
+	var r0 []expenses.OtherFee
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []expenses.OtherFee) ([]expenses.OtherFee, error)); ok {
+		return returnFunc(ctx, models)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []expenses.OtherFee) []expenses.OtherFee); ok {
+		r0 = returnFunc(ctx, models)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]expenses.OtherFee)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []expenses.OtherFee) error); ok {
+		r1 = returnFunc(ctx, models)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOtherFeeRepository_SaveMany_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveMany'
+type MockOtherFeeRepository_SaveMany_Call struct {
+	*mock.Call
+}
+
+// SaveMany is a helper method to define mock.On call
+//   - ctx context.Context
+//   - models []expenses.OtherFee
+func (_e *MockOtherFeeRepository_Expecter) SaveMany(ctx interface{}, models interface{}) *MockOtherFeeRepository_SaveMany_Call {
+	return &MockOtherFeeRepository_SaveMany_Call{Call: _e.mock.On("SaveMany", ctx, models)}
+}
+
+func (_c *MockOtherFeeRepository_SaveMany_Call) Run(run func(ctx context.Context, models []expenses.OtherFee)) *MockOtherFeeRepository_SaveMany_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []expenses.OtherFee
+		if args[1] != nil {
+			arg1 = args[1].([]expenses.OtherFee)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOtherFeeRepository_SaveMany_Call) Return(otherFees []expenses.OtherFee, err error) *MockOtherFeeRepository_SaveMany_Call {
+	_c.Call.Return(otherFees, err)
+	return _c
+}
+
+func (_c *MockOtherFeeRepository_SaveMany_Call) RunAndReturn(run func(ctx context.Context, models []expenses.OtherFee) ([]expenses.OtherFee, error)) *MockOtherFeeRepository_SaveMany_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SyncParticipants provides a mock function for the type MockOtherFeeRepository
+func (_mock *MockOtherFeeRepository) SyncParticipants(ctx context.Context, feeID uuid.UUID, participants []expenses.FeeParticipant) error {
+	ret := _mock.Called(ctx, feeID, participants)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SyncParticipants")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, []expenses.FeeParticipant) error); ok {
+		r0 = returnFunc(ctx, feeID, participants)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockOtherFeeRepository_SyncParticipants_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SyncParticipants'
+type MockOtherFeeRepository_SyncParticipants_Call struct {
+	*mock.Call
+}
+
+// SyncParticipants is a helper method to define mock.On call
+//   - ctx context.Context
+//   - feeID uuid.UUID
+//   - participants []expenses.FeeParticipant
+func (_e *MockOtherFeeRepository_Expecter) SyncParticipants(ctx interface{}, feeID interface{}, participants interface{}) *MockOtherFeeRepository_SyncParticipants_Call {
+	return &MockOtherFeeRepository_SyncParticipants_Call{Call: _e.mock.On("SyncParticipants", ctx, feeID, participants)}
+}
+
+func (_c *MockOtherFeeRepository_SyncParticipants_Call) Run(run func(ctx context.Context, feeID uuid.UUID, participants []expenses.FeeParticipant)) *MockOtherFeeRepository_SyncParticipants_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 []expenses.FeeParticipant
+		if args[2] != nil {
+			arg2 = args[2].([]expenses.FeeParticipant)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOtherFeeRepository_SyncParticipants_Call) Return(err error) *MockOtherFeeRepository_SyncParticipants_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockOtherFeeRepository_SyncParticipants_Call) RunAndReturn(run func(ctx context.Context, feeID uuid.UUID, participants []expenses.FeeParticipant) error) *MockOtherFeeRepository_SyncParticipants_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockOtherFeeRepository
+func (_mock *MockOtherFeeRepository) Update(ctx context.Context, model expenses.OtherFee) (expenses.OtherFee, error) {
+	ret := _mock.Called(ctx, model)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 expenses.OtherFee
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, expenses.OtherFee) (expenses.OtherFee, error)); ok {
+		return returnFunc(ctx, model)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, expenses.OtherFee) expenses.OtherFee); ok {
+		r0 = returnFunc(ctx, model)
+	} else {
+		r0 = ret.Get(0).(expenses.OtherFee)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, expenses.OtherFee) error); ok {
+		r1 = returnFunc(ctx, model)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOtherFeeRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockOtherFeeRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - model expenses.OtherFee
+func (_e *MockOtherFeeRepository_Expecter) Update(ctx interface{}, model interface{}) *MockOtherFeeRepository_Update_Call {
+	return &MockOtherFeeRepository_Update_Call{Call: _e.mock.On("Update", ctx, model)}
+}
+
+func (_c *MockOtherFeeRepository_Update_Call) Run(run func(ctx context.Context, model expenses.OtherFee)) *MockOtherFeeRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 expenses.OtherFee
+		if args[1] != nil {
+			arg1 = args[1].(expenses.OtherFee)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOtherFeeRepository_Update_Call) Return(otherFee expenses.OtherFee, err error) *MockOtherFeeRepository_Update_Call {
+	_c.Call.Return(otherFee, err)
+	return _c
+}
+
+func (_c *MockOtherFeeRepository_Update_Call) RunAndReturn(run func(ctx context.Context, model expenses.OtherFee) (expenses.OtherFee, error)) *MockOtherFeeRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
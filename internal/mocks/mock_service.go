@@ -6,9 +6,12 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/itsLeonB/cashback/internal/domain/dto"
+	"github.com/itsLeonB/cashback/internal/domain/entity"
+	"github.com/itsLeonB/cashback/internal/domain/entity/expenses"
 	"github.com/itsLeonB/cashback/internal/domain/entity/users"
 	"github.com/itsLeonB/cashback/internal/domain/message"
 	"github.com/itsLeonB/cashback/internal/domain/service/auth"
@@ -748,8 +751,8 @@ func (_c *MockAuthService_Register_Call) RunAndReturn(run func(ctx context.Conte
 }
 
 // ResetPassword provides a mock function for the type MockAuthService
-func (_mock *MockAuthService) ResetPassword(ctx context.Context, token string, newPassword string) (dto.TokenResponse, error) {
-	ret := _mock.Called(ctx, token, newPassword)
+func (_mock *MockAuthService) ResetPassword(ctx context.Context, token string, newPassword string, device auth.Device) (dto.TokenResponse, error) {
+	ret := _mock.Called(ctx, token, newPassword, device)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ResetPassword")
@@ -757,16 +760,16 @@ func (_mock *MockAuthService) ResetPassword(ctx context.Context, token string, n
 
 	var r0 dto.TokenResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (dto.TokenResponse, error)); ok {
-		return returnFunc(ctx, token, newPassword)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, auth.Device) (dto.TokenResponse, error)); ok {
+		return returnFunc(ctx, token, newPassword, device)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) dto.TokenResponse); ok {
-		r0 = returnFunc(ctx, token, newPassword)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, auth.Device) dto.TokenResponse); ok {
+		r0 = returnFunc(ctx, token, newPassword, device)
 	} else {
 		r0 = ret.Get(0).(dto.TokenResponse)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = returnFunc(ctx, token, newPassword)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, auth.Device) error); ok {
+		r1 = returnFunc(ctx, token, newPassword, device)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -782,11 +785,12 @@ type MockAuthService_ResetPassword_Call struct {
 //   - ctx context.Context
 //   - token string
 //   - newPassword string
-func (_e *MockAuthService_Expecter) ResetPassword(ctx interface{}, token interface{}, newPassword interface{}) *MockAuthService_ResetPassword_Call {
-	return &MockAuthService_ResetPassword_Call{Call: _e.mock.On("ResetPassword", ctx, token, newPassword)}
+//   - device auth.Device
+func (_e *MockAuthService_Expecter) ResetPassword(ctx interface{}, token interface{}, newPassword interface{}, device interface{}) *MockAuthService_ResetPassword_Call {
+	return &MockAuthService_ResetPassword_Call{Call: _e.mock.On("ResetPassword", ctx, token, newPassword, device)}
 }
 
-func (_c *MockAuthService_ResetPassword_Call) Run(run func(ctx context.Context, token string, newPassword string)) *MockAuthService_ResetPassword_Call {
+func (_c *MockAuthService_ResetPassword_Call) Run(run func(ctx context.Context, token string, newPassword string, device auth.Device)) *MockAuthService_ResetPassword_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -800,10 +804,15 @@ func (_c *MockAuthService_ResetPassword_Call) Run(run func(ctx context.Context,
 		if args[2] != nil {
 			arg2 = args[2].(string)
 		}
+		var arg3 auth.Device
+		if args[3] != nil {
+			arg3 = args[3].(auth.Device)
+		}
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3,
 		)
 	})
 	return _c
@@ -814,7 +823,7 @@ func (_c *MockAuthService_ResetPassword_Call) Return(tokenResponse dto.TokenResp
 	return _c
 }
 
-func (_c *MockAuthService_ResetPassword_Call) RunAndReturn(run func(ctx context.Context, token string, newPassword string) (dto.TokenResponse, error)) *MockAuthService_ResetPassword_Call {
+func (_c *MockAuthService_ResetPassword_Call) RunAndReturn(run func(ctx context.Context, token string, newPassword string, device auth.Device) (dto.TokenResponse, error)) *MockAuthService_ResetPassword_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -921,8 +930,8 @@ func (_c *MockAuthService_Shutdown_Call) RunAndReturn(run func() error) *MockAut
 }
 
 // VerifyRegistration provides a mock function for the type MockAuthService
-func (_mock *MockAuthService) VerifyRegistration(ctx context.Context, token string) (dto.TokenResponse, error) {
-	ret := _mock.Called(ctx, token)
+func (_mock *MockAuthService) VerifyRegistration(ctx context.Context, token string, device auth.Device) (dto.TokenResponse, error) {
+	ret := _mock.Called(ctx, token, device)
 
 	if len(ret) == 0 {
 		panic("no return value specified for VerifyRegistration")
@@ -930,16 +939,16 @@ func (_mock *MockAuthService) VerifyRegistration(ctx context.Context, token stri
 
 	var r0 dto.TokenResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (dto.TokenResponse, error)); ok {
-		return returnFunc(ctx, token)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, auth.Device) (dto.TokenResponse, error)); ok {
+		return returnFunc(ctx, token, device)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) dto.TokenResponse); ok {
-		r0 = returnFunc(ctx, token)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, auth.Device) dto.TokenResponse); ok {
+		r0 = returnFunc(ctx, token, device)
 	} else {
 		r0 = ret.Get(0).(dto.TokenResponse)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, token)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, auth.Device) error); ok {
+		r1 = returnFunc(ctx, token, device)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -954,11 +963,12 @@ type MockAuthService_VerifyRegistration_Call struct {
 // VerifyRegistration is a helper method to define mock.On call
 //   - ctx context.Context
 //   - token string
-func (_e *MockAuthService_Expecter) VerifyRegistration(ctx interface{}, token interface{}) *MockAuthService_VerifyRegistration_Call {
-	return &MockAuthService_VerifyRegistration_Call{Call: _e.mock.On("VerifyRegistration", ctx, token)}
+//   - device auth.Device
+func (_e *MockAuthService_Expecter) VerifyRegistration(ctx interface{}, token interface{}, device interface{}) *MockAuthService_VerifyRegistration_Call {
+	return &MockAuthService_VerifyRegistration_Call{Call: _e.mock.On("VerifyRegistration", ctx, token, device)}
 }
 
-func (_c *MockAuthService_VerifyRegistration_Call) Run(run func(ctx context.Context, token string)) *MockAuthService_VerifyRegistration_Call {
+func (_c *MockAuthService_VerifyRegistration_Call) Run(run func(ctx context.Context, token string, device auth.Device)) *MockAuthService_VerifyRegistration_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -968,9 +978,14 @@ func (_c *MockAuthService_VerifyRegistration_Call) Run(run func(ctx context.Cont
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
+		var arg2 auth.Device
+		if args[2] != nil {
+			arg2 = args[2].(auth.Device)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -981,7 +996,7 @@ func (_c *MockAuthService_VerifyRegistration_Call) Return(tokenResponse dto.Toke
 	return _c
 }
 
-func (_c *MockAuthService_VerifyRegistration_Call) RunAndReturn(run func(ctx context.Context, token string) (dto.TokenResponse, error)) *MockAuthService_VerifyRegistration_Call {
+func (_c *MockAuthService_VerifyRegistration_Call) RunAndReturn(run func(ctx context.Context, token string, device auth.Device) (dto.TokenResponse, error)) *MockAuthService_VerifyRegistration_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1094,8 +1109,8 @@ func (_m *MockSessionService) EXPECT() *MockSessionService_Expecter {
 }
 
 // CreateTokenAndSession provides a mock function for the type MockSessionService
-func (_mock *MockSessionService) CreateTokenAndSession(ctx context.Context, user auth.User) (dto.TokenResponse, error) {
-	ret := _mock.Called(ctx, user)
+func (_mock *MockSessionService) CreateTokenAndSession(ctx context.Context, user auth.User, device auth.Device) (dto.TokenResponse, error) {
+	ret := _mock.Called(ctx, user, device)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateTokenAndSession")
@@ -1103,16 +1118,16 @@ func (_mock *MockSessionService) CreateTokenAndSession(ctx context.Context, user
 
 	var r0 dto.TokenResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, auth.User) (dto.TokenResponse, error)); ok {
-		return returnFunc(ctx, user)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, auth.User, auth.Device) (dto.TokenResponse, error)); ok {
+		return returnFunc(ctx, user, device)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, auth.User) dto.TokenResponse); ok {
-		r0 = returnFunc(ctx, user)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, auth.User, auth.Device) dto.TokenResponse); ok {
+		r0 = returnFunc(ctx, user, device)
 	} else {
 		r0 = ret.Get(0).(dto.TokenResponse)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, auth.User) error); ok {
-		r1 = returnFunc(ctx, user)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, auth.User, auth.Device) error); ok {
+		r1 = returnFunc(ctx, user, device)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1127,11 +1142,12 @@ type MockSessionService_CreateTokenAndSession_Call struct {
 // CreateTokenAndSession is a helper method to define mock.On call
 //   - ctx context.Context
 //   - user auth.User
-func (_e *MockSessionService_Expecter) CreateTokenAndSession(ctx interface{}, user interface{}) *MockSessionService_CreateTokenAndSession_Call {
-	return &MockSessionService_CreateTokenAndSession_Call{Call: _e.mock.On("CreateTokenAndSession", ctx, user)}
+//   - device auth.Device
+func (_e *MockSessionService_Expecter) CreateTokenAndSession(ctx interface{}, user interface{}, device interface{}) *MockSessionService_CreateTokenAndSession_Call {
+	return &MockSessionService_CreateTokenAndSession_Call{Call: _e.mock.On("CreateTokenAndSession", ctx, user, device)}
 }
 
-func (_c *MockSessionService_CreateTokenAndSession_Call) Run(run func(ctx context.Context, user auth.User)) *MockSessionService_CreateTokenAndSession_Call {
+func (_c *MockSessionService_CreateTokenAndSession_Call) Run(run func(ctx context.Context, user auth.User, device auth.Device)) *MockSessionService_CreateTokenAndSession_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1141,9 +1157,14 @@ func (_c *MockSessionService_CreateTokenAndSession_Call) Run(run func(ctx contex
 		if args[1] != nil {
 			arg1 = args[1].(auth.User)
 		}
+		var arg2 auth.Device
+		if args[2] != nil {
+			arg2 = args[2].(auth.Device)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -1154,7 +1175,7 @@ func (_c *MockSessionService_CreateTokenAndSession_Call) Return(tokenResponse dt
 	return _c
 }
 
-func (_c *MockSessionService_CreateTokenAndSession_Call) RunAndReturn(run func(ctx context.Context, user auth.User) (dto.TokenResponse, error)) *MockSessionService_CreateTokenAndSession_Call {
+func (_c *MockSessionService_CreateTokenAndSession_Call) RunAndReturn(run func(ctx context.Context, user auth.User, device auth.Device) (dto.TokenResponse, error)) *MockSessionService_CreateTokenAndSession_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1225,6 +1246,80 @@ func (_c *MockSessionService_GetByID_Call) RunAndReturn(run func(ctx context.Con
 	return _c
 }
 
+// GetUserSessions provides a mock function for the type MockSessionService
+func (_mock *MockSessionService) GetUserSessions(ctx context.Context, userID string, currentSessionID string) ([]dto.SessionResponse, error) {
+	ret := _mock.Called(ctx, userID, currentSessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserSessions")
+	}
+
+	var r0 []dto.SessionResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) ([]dto.SessionResponse, error)); ok {
+		return returnFunc(ctx, userID, currentSessionID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) []dto.SessionResponse); ok {
+		r0 = returnFunc(ctx, userID, currentSessionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.SessionResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, userID, currentSessionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSessionService_GetUserSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserSessions'
+type MockSessionService_GetUserSessions_Call struct {
+	*mock.Call
+}
+
+// GetUserSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - currentSessionID string
+func (_e *MockSessionService_Expecter) GetUserSessions(ctx interface{}, userID interface{}, currentSessionID interface{}) *MockSessionService_GetUserSessions_Call {
+	return &MockSessionService_GetUserSessions_Call{Call: _e.mock.On("GetUserSessions", ctx, userID, currentSessionID)}
+}
+
+func (_c *MockSessionService_GetUserSessions_Call) Run(run func(ctx context.Context, userID string, currentSessionID string)) *MockSessionService_GetUserSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionService_GetUserSessions_Call) Return(sessionResponses []dto.SessionResponse, err error) *MockSessionService_GetUserSessions_Call {
+	_c.Call.Return(sessionResponses, err)
+	return _c
+}
+
+func (_c *MockSessionService_GetUserSessions_Call) RunAndReturn(run func(ctx context.Context, userID string, currentSessionID string) ([]dto.SessionResponse, error)) *MockSessionService_GetUserSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RefreshToken provides a mock function for the type MockSessionService
 func (_mock *MockSessionService) RefreshToken(ctx context.Context, request dto.RefreshTokenRequest) (dto.TokenResponse, error) {
 	ret := _mock.Called(ctx, request)
@@ -1291,6 +1386,69 @@ func (_c *MockSessionService_RefreshToken_Call) RunAndReturn(run func(ctx contex
 	return _c
 }
 
+// RevokeOtherSessions provides a mock function for the type MockSessionService
+func (_mock *MockSessionService) RevokeOtherSessions(ctx context.Context, userID string, currentSessionID string) error {
+	ret := _mock.Called(ctx, userID, currentSessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeOtherSessions")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, userID, currentSessionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionService_RevokeOtherSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeOtherSessions'
+type MockSessionService_RevokeOtherSessions_Call struct {
+	*mock.Call
+}
+
+// RevokeOtherSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - currentSessionID string
+func (_e *MockSessionService_Expecter) RevokeOtherSessions(ctx interface{}, userID interface{}, currentSessionID interface{}) *MockSessionService_RevokeOtherSessions_Call {
+	return &MockSessionService_RevokeOtherSessions_Call{Call: _e.mock.On("RevokeOtherSessions", ctx, userID, currentSessionID)}
+}
+
+func (_c *MockSessionService_RevokeOtherSessions_Call) Run(run func(ctx context.Context, userID string, currentSessionID string)) *MockSessionService_RevokeOtherSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionService_RevokeOtherSessions_Call) Return(err error) *MockSessionService_RevokeOtherSessions_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionService_RevokeOtherSessions_Call) RunAndReturn(run func(ctx context.Context, userID string, currentSessionID string) error) *MockSessionService_RevokeOtherSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RevokeSession provides a mock function for the type MockSessionService
 func (_mock *MockSessionService) RevokeSession(ctx context.Context, sessionID string) error {
 	ret := _mock.Called(ctx, sessionID)
@@ -1348,6 +1506,75 @@ func (_c *MockSessionService_RevokeSession_Call) RunAndReturn(run func(ctx conte
 	return _c
 }
 
+// RevokeUserSession provides a mock function for the type MockSessionService
+func (_mock *MockSessionService) RevokeUserSession(ctx context.Context, userID string, currentSessionID string, sessionID string) error {
+	ret := _mock.Called(ctx, userID, currentSessionID, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeUserSession")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = returnFunc(ctx, userID, currentSessionID, sessionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionService_RevokeUserSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeUserSession'
+type MockSessionService_RevokeUserSession_Call struct {
+	*mock.Call
+}
+
+// RevokeUserSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - currentSessionID string
+//   - sessionID string
+func (_e *MockSessionService_Expecter) RevokeUserSession(ctx interface{}, userID interface{}, currentSessionID interface{}, sessionID interface{}) *MockSessionService_RevokeUserSession_Call {
+	return &MockSessionService_RevokeUserSession_Call{Call: _e.mock.On("RevokeUserSession", ctx, userID, currentSessionID, sessionID)}
+}
+
+func (_c *MockSessionService_RevokeUserSession_Call) Run(run func(ctx context.Context, userID string, currentSessionID string, sessionID string)) *MockSessionService_RevokeUserSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionService_RevokeUserSession_Call) Return(err error) *MockSessionService_RevokeUserSession_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionService_RevokeUserSession_Call) RunAndReturn(run func(ctx context.Context, userID string, currentSessionID string, sessionID string) error) *MockSessionService_RevokeUserSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockProfileService creates a new instance of MockProfileService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockProfileService(t interface {
@@ -2325,3 +2552,2931 @@ func (_c *MockSubscriptionLimitService_GetCurrent_Call) RunAndReturn(run func(ct
 	_c.Call.Return(run)
 	return _c
 }
+
+// NewMockGroupExpenseService creates a new instance of MockGroupExpenseService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGroupExpenseService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGroupExpenseService {
+	mock := &MockGroupExpenseService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGroupExpenseService is an autogenerated mock type for the GroupExpenseService type
+type MockGroupExpenseService struct {
+	mock.Mock
+}
+
+type MockGroupExpenseService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGroupExpenseService) EXPECT() *MockGroupExpenseService_Expecter {
+	return &MockGroupExpenseService_Expecter{mock: &_m.Mock}
+}
+
+// Amend provides a mock function for the type MockGroupExpenseService
+func (_mock *MockGroupExpenseService) Amend(ctx context.Context, id uuid.UUID, userProfileID uuid.UUID) (dto.GroupExpenseResponse, error) {
+	ret := _mock.Called(ctx, id, userProfileID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Amend")
+	}
+
+	var r0 dto.GroupExpenseResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (dto.GroupExpenseResponse, error)); ok {
+		return returnFunc(ctx, id, userProfileID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) dto.GroupExpenseResponse); ok {
+		r0 = returnFunc(ctx, id, userProfileID)
+	} else {
+		r0 = ret.Get(0).(dto.GroupExpenseResponse)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id, userProfileID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGroupExpenseService_Amend_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Amend'
+type MockGroupExpenseService_Amend_Call struct {
+	*mock.Call
+}
+
+// Amend is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - userProfileID uuid.UUID
+func (_e *MockGroupExpenseService_Expecter) Amend(ctx interface{}, id interface{}, userProfileID interface{}) *MockGroupExpenseService_Amend_Call {
+	return &MockGroupExpenseService_Amend_Call{Call: _e.mock.On("Amend", ctx, id, userProfileID)}
+}
+
+func (_c *MockGroupExpenseService_Amend_Call) Run(run func(ctx context.Context, id uuid.UUID, userProfileID uuid.UUID)) *MockGroupExpenseService_Amend_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGroupExpenseService_Amend_Call) Return(groupExpenseResponse dto.GroupExpenseResponse, err error) *MockGroupExpenseService_Amend_Call {
+	_c.Call.Return(groupExpenseResponse, err)
+	return _c
+}
+
+func (_c *MockGroupExpenseService_Amend_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, userProfileID uuid.UUID) (dto.GroupExpenseResponse, error)) *MockGroupExpenseService_Amend_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConfirmDraft provides a mock function for the type MockGroupExpenseService
+func (_mock *MockGroupExpenseService) ConfirmDraft(ctx context.Context, id uuid.UUID, userProfileID uuid.UUID, dryRun bool) (dto.ExpenseConfirmationResponse, error) {
+	ret := _mock.Called(ctx, id, userProfileID, dryRun)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConfirmDraft")
+	}
+
+	var r0 dto.ExpenseConfirmationResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, bool) (dto.ExpenseConfirmationResponse, error)); ok {
+		return returnFunc(ctx, id, userProfileID, dryRun)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, bool) dto.ExpenseConfirmationResponse); ok {
+		r0 = returnFunc(ctx, id, userProfileID, dryRun)
+	} else {
+		r0 = ret.Get(0).(dto.ExpenseConfirmationResponse)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, bool) error); ok {
+		r1 = returnFunc(ctx, id, userProfileID, dryRun)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGroupExpenseService_ConfirmDraft_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConfirmDraft'
+type MockGroupExpenseService_ConfirmDraft_Call struct {
+	*mock.Call
+}
+
+// ConfirmDraft is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - userProfileID uuid.UUID
+//   - dryRun bool
+func (_e *MockGroupExpenseService_Expecter) ConfirmDraft(ctx interface{}, id interface{}, userProfileID interface{}, dryRun interface{}) *MockGroupExpenseService_ConfirmDraft_Call {
+	return &MockGroupExpenseService_ConfirmDraft_Call{Call: _e.mock.On("ConfirmDraft", ctx, id, userProfileID, dryRun)}
+}
+
+func (_c *MockGroupExpenseService_ConfirmDraft_Call) Run(run func(ctx context.Context, id uuid.UUID, userProfileID uuid.UUID, dryRun bool)) *MockGroupExpenseService_ConfirmDraft_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		var arg3 bool
+		if args[3] != nil {
+			arg3 = args[3].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGroupExpenseService_ConfirmDraft_Call) Return(expenseConfirmationResponse dto.ExpenseConfirmationResponse, err error) *MockGroupExpenseService_ConfirmDraft_Call {
+	_c.Call.Return(expenseConfirmationResponse, err)
+	return _c
+}
+
+func (_c *MockGroupExpenseService_ConfirmDraft_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, userProfileID uuid.UUID, dryRun bool) (dto.ExpenseConfirmationResponse, error)) *MockGroupExpenseService_ConfirmDraft_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConstructNotifications provides a mock function for the type MockGroupExpenseService
+func (_mock *MockGroupExpenseService) ConstructNotifications(ctx context.Context, msg message.ExpenseConfirmed) ([]entity.Notification, error) {
+	ret := _mock.Called(ctx, msg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConstructNotifications")
+	}
+
+	var r0 []entity.Notification
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, message.ExpenseConfirmed) ([]entity.Notification, error)); ok {
+		return returnFunc(ctx, msg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, message.ExpenseConfirmed) []entity.Notification); ok {
+		r0 = returnFunc(ctx, msg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Notification)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, message.ExpenseConfirmed) error); ok {
+		r1 = returnFunc(ctx, msg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGroupExpenseService_ConstructNotifications_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConstructNotifications'
+type MockGroupExpenseService_ConstructNotifications_Call struct {
+	*mock.Call
+}
+
+// ConstructNotifications is a helper method to define mock.On call
+//   - ctx context.Context
+//   - msg message.ExpenseConfirmed
+func (_e *MockGroupExpenseService_Expecter) ConstructNotifications(ctx interface{}, msg interface{}) *MockGroupExpenseService_ConstructNotifications_Call {
+	return &MockGroupExpenseService_ConstructNotifications_Call{Call: _e.mock.On("ConstructNotifications", ctx, msg)}
+}
+
+func (_c *MockGroupExpenseService_ConstructNotifications_Call) Run(run func(ctx context.Context, msg message.ExpenseConfirmed)) *MockGroupExpenseService_ConstructNotifications_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 message.ExpenseConfirmed
+		if args[1] != nil {
+			arg1 = args[1].(message.ExpenseConfirmed)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGroupExpenseService_ConstructNotifications_Call) Return(notifications []entity.Notification, err error) *MockGroupExpenseService_ConstructNotifications_Call {
+	_c.Call.Return(notifications, err)
+	return _c
+}
+
+func (_c *MockGroupExpenseService_ConstructNotifications_Call) RunAndReturn(run func(ctx context.Context, msg message.ExpenseConfirmed) ([]entity.Notification, error)) *MockGroupExpenseService_ConstructNotifications_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateDraft provides a mock function for the type MockGroupExpenseService
+func (_mock *MockGroupExpenseService) CreateDraft(ctx context.Context, req dto.NewDraftRequest) (dto.GroupExpenseResponse, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDraft")
+	}
+
+	var r0 dto.GroupExpenseResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.NewDraftRequest) (dto.GroupExpenseResponse, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.NewDraftRequest) dto.GroupExpenseResponse); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Get(0).(dto.GroupExpenseResponse)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dto.NewDraftRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGroupExpenseService_CreateDraft_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDraft'
+type MockGroupExpenseService_CreateDraft_Call struct {
+	*mock.Call
+}
+
+// CreateDraft is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req dto.NewDraftRequest
+func (_e *MockGroupExpenseService_Expecter) CreateDraft(ctx interface{}, req interface{}) *MockGroupExpenseService_CreateDraft_Call {
+	return &MockGroupExpenseService_CreateDraft_Call{Call: _e.mock.On("CreateDraft", ctx, req)}
+}
+
+func (_c *MockGroupExpenseService_CreateDraft_Call) Run(run func(ctx context.Context, req dto.NewDraftRequest)) *MockGroupExpenseService_CreateDraft_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 dto.NewDraftRequest
+		if args[1] != nil {
+			arg1 = args[1].(dto.NewDraftRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGroupExpenseService_CreateDraft_Call) Return(groupExpenseResponse dto.GroupExpenseResponse, err error) *MockGroupExpenseService_CreateDraft_Call {
+	_c.Call.Return(groupExpenseResponse, err)
+	return _c
+}
+
+func (_c *MockGroupExpenseService_CreateDraft_Call) RunAndReturn(run func(ctx context.Context, req dto.NewDraftRequest) (dto.GroupExpenseResponse, error)) *MockGroupExpenseService_CreateDraft_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateFromTemplate provides a mock function for the type MockGroupExpenseService
+func (_mock *MockGroupExpenseService) CreateFromTemplate(ctx context.Context, creatorProfileID uuid.UUID, template dto.RecurringGroupExpense) (expenses.GroupExpense, error) {
+	ret := _mock.Called(ctx, creatorProfileID, template)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateFromTemplate")
+	}
+
+	var r0 expenses.GroupExpense
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, dto.RecurringGroupExpense) (expenses.GroupExpense, error)); ok {
+		return returnFunc(ctx, creatorProfileID, template)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, dto.RecurringGroupExpense) expenses.GroupExpense); ok {
+		r0 = returnFunc(ctx, creatorProfileID, template)
+	} else {
+		r0 = ret.Get(0).(expenses.GroupExpense)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, dto.RecurringGroupExpense) error); ok {
+		r1 = returnFunc(ctx, creatorProfileID, template)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGroupExpenseService_CreateFromTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateFromTemplate'
+type MockGroupExpenseService_CreateFromTemplate_Call struct {
+	*mock.Call
+}
+
+// CreateFromTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - creatorProfileID uuid.UUID
+//   - template dto.RecurringGroupExpense
+func (_e *MockGroupExpenseService_Expecter) CreateFromTemplate(ctx interface{}, creatorProfileID interface{}, template interface{}) *MockGroupExpenseService_CreateFromTemplate_Call {
+	return &MockGroupExpenseService_CreateFromTemplate_Call{Call: _e.mock.On("CreateFromTemplate", ctx, creatorProfileID, template)}
+}
+
+func (_c *MockGroupExpenseService_CreateFromTemplate_Call) Run(run func(ctx context.Context, creatorProfileID uuid.UUID, template dto.RecurringGroupExpense)) *MockGroupExpenseService_CreateFromTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 dto.RecurringGroupExpense
+		if args[2] != nil {
+			arg2 = args[2].(dto.RecurringGroupExpense)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGroupExpenseService_CreateFromTemplate_Call) Return(groupExpense expenses.GroupExpense, err error) *MockGroupExpenseService_CreateFromTemplate_Call {
+	_c.Call.Return(groupExpense, err)
+	return _c
+}
+
+func (_c *MockGroupExpenseService_CreateFromTemplate_Call) RunAndReturn(run func(ctx context.Context, creatorProfileID uuid.UUID, template dto.RecurringGroupExpense) (expenses.GroupExpense, error)) *MockGroupExpenseService_CreateFromTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateImported provides a mock function for the type MockGroupExpenseService
+func (_mock *MockGroupExpenseService) CreateImported(ctx context.Context, creatorProfileID uuid.UUID, template dto.RecurringGroupExpense, occurredAt time.Time) (expenses.GroupExpense, error) {
+	ret := _mock.Called(ctx, creatorProfileID, template, occurredAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateImported")
+	}
+
+	var r0 expenses.GroupExpense
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, dto.RecurringGroupExpense, time.Time) (expenses.GroupExpense, error)); ok {
+		return returnFunc(ctx, creatorProfileID, template, occurredAt)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, dto.RecurringGroupExpense, time.Time) expenses.GroupExpense); ok {
+		r0 = returnFunc(ctx, creatorProfileID, template, occurredAt)
+	} else {
+		r0 = ret.Get(0).(expenses.GroupExpense)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, dto.RecurringGroupExpense, time.Time) error); ok {
+		r1 = returnFunc(ctx, creatorProfileID, template, occurredAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGroupExpenseService_CreateImported_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateImported'
+type MockGroupExpenseService_CreateImported_Call struct {
+	*mock.Call
+}
+
+// CreateImported is a helper method to define mock.On call
+//   - ctx context.Context
+//   - creatorProfileID uuid.UUID
+//   - template dto.RecurringGroupExpense
+//   - occurredAt time.Time
+func (_e *MockGroupExpenseService_Expecter) CreateImported(ctx interface{}, creatorProfileID interface{}, template interface{}, occurredAt interface{}) *MockGroupExpenseService_CreateImported_Call {
+	return &MockGroupExpenseService_CreateImported_Call{Call: _e.mock.On("CreateImported", ctx, creatorProfileID, template, occurredAt)}
+}
+
+func (_c *MockGroupExpenseService_CreateImported_Call) Run(run func(ctx context.Context, creatorProfileID uuid.UUID, template dto.RecurringGroupExpense, occurredAt time.Time)) *MockGroupExpenseService_CreateImported_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 dto.RecurringGroupExpense
+		if args[2] != nil {
+			arg2 = args[2].(dto.RecurringGroupExpense)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGroupExpenseService_CreateImported_Call) Return(groupExpense expenses.GroupExpense, err error) *MockGroupExpenseService_CreateImported_Call {
+	_c.Call.Return(groupExpense, err)
+	return _c
+}
+
+func (_c *MockGroupExpenseService_CreateImported_Call) RunAndReturn(run func(ctx context.Context, creatorProfileID uuid.UUID, template dto.RecurringGroupExpense, occurredAt time.Time) (expenses.GroupExpense, error)) *MockGroupExpenseService_CreateImported_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockGroupExpenseService
+func (_mock *MockGroupExpenseService) Delete(ctx context.Context, userProfileID uuid.UUID, id uuid.UUID) error {
+	ret := _mock.Called(ctx, userProfileID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, userProfileID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockGroupExpenseService_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockGroupExpenseService_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userProfileID uuid.UUID
+//   - id uuid.UUID
+func (_e *MockGroupExpenseService_Expecter) Delete(ctx interface{}, userProfileID interface{}, id interface{}) *MockGroupExpenseService_Delete_Call {
+	return &MockGroupExpenseService_Delete_Call{Call: _e.mock.On("Delete", ctx, userProfileID, id)}
+}
+
+func (_c *MockGroupExpenseService_Delete_Call) Run(run func(ctx context.Context, userProfileID uuid.UUID, id uuid.UUID)) *MockGroupExpenseService_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGroupExpenseService_Delete_Call) Return(err error) *MockGroupExpenseService_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockGroupExpenseService_Delete_Call) RunAndReturn(run func(ctx context.Context, userProfileID uuid.UUID, id uuid.UUID) error) *MockGroupExpenseService_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function for the type MockGroupExpenseService
+func (_mock *MockGroupExpenseService) GetAll(ctx context.Context, userProfileID uuid.UUID, ownership expenses.ExpenseOwnership, status expenses.ExpenseStatus) ([]dto.GroupExpenseResponse, error) {
+	ret := _mock.Called(ctx, userProfileID, ownership, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []dto.GroupExpenseResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, expenses.ExpenseOwnership, expenses.ExpenseStatus) ([]dto.GroupExpenseResponse, error)); ok {
+		return returnFunc(ctx, userProfileID, ownership, status)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, expenses.ExpenseOwnership, expenses.ExpenseStatus) []dto.GroupExpenseResponse); ok {
+		r0 = returnFunc(ctx, userProfileID, ownership, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.GroupExpenseResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, expenses.ExpenseOwnership, expenses.ExpenseStatus) error); ok {
+		r1 = returnFunc(ctx, userProfileID, ownership, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGroupExpenseService_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type MockGroupExpenseService_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userProfileID uuid.UUID
+//   - ownership expenses.ExpenseOwnership
+//   - status expenses.ExpenseStatus
+func (_e *MockGroupExpenseService_Expecter) GetAll(ctx interface{}, userProfileID interface{}, ownership interface{}, status interface{}) *MockGroupExpenseService_GetAll_Call {
+	return &MockGroupExpenseService_GetAll_Call{Call: _e.mock.On("GetAll", ctx, userProfileID, ownership, status)}
+}
+
+func (_c *MockGroupExpenseService_GetAll_Call) Run(run func(ctx context.Context, userProfileID uuid.UUID, ownership expenses.ExpenseOwnership, status expenses.ExpenseStatus)) *MockGroupExpenseService_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 expenses.ExpenseOwnership
+		if args[2] != nil {
+			arg2 = args[2].(expenses.ExpenseOwnership)
+		}
+		var arg3 expenses.ExpenseStatus
+		if args[3] != nil {
+			arg3 = args[3].(expenses.ExpenseStatus)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGroupExpenseService_GetAll_Call) Return(groupExpenseResponses []dto.GroupExpenseResponse, err error) *MockGroupExpenseService_GetAll_Call {
+	_c.Call.Return(groupExpenseResponses, err)
+	return _c
+}
+
+func (_c *MockGroupExpenseService_GetAll_Call) RunAndReturn(run func(ctx context.Context, userProfileID uuid.UUID, ownership expenses.ExpenseOwnership, status expenses.ExpenseStatus) ([]dto.GroupExpenseResponse, error)) *MockGroupExpenseService_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockGroupExpenseService
+func (_mock *MockGroupExpenseService) GetByID(ctx context.Context, id uuid.UUID, forUpdate bool) (expenses.GroupExpense, error) {
+	ret := _mock.Called(ctx, id, forUpdate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 expenses.GroupExpense
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool) (expenses.GroupExpense, error)); ok {
+		return returnFunc(ctx, id, forUpdate)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool) expenses.GroupExpense); ok {
+		r0 = returnFunc(ctx, id, forUpdate)
+	} else {
+		r0 = ret.Get(0).(expenses.GroupExpense)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, bool) error); ok {
+		r1 = returnFunc(ctx, id, forUpdate)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGroupExpenseService_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockGroupExpenseService_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - forUpdate bool
+func (_e *MockGroupExpenseService_Expecter) GetByID(ctx interface{}, id interface{}, forUpdate interface{}) *MockGroupExpenseService_GetByID_Call {
+	return &MockGroupExpenseService_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id, forUpdate)}
+}
+
+func (_c *MockGroupExpenseService_GetByID_Call) Run(run func(ctx context.Context, id uuid.UUID, forUpdate bool)) *MockGroupExpenseService_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 bool
+		if args[2] != nil {
+			arg2 = args[2].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGroupExpenseService_GetByID_Call) Return(groupExpense expenses.GroupExpense, err error) *MockGroupExpenseService_GetByID_Call {
+	_c.Call.Return(groupExpense, err)
+	return _c
+}
+
+func (_c *MockGroupExpenseService_GetByID_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, forUpdate bool) (expenses.GroupExpense, error)) *MockGroupExpenseService_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByIDs provides a mock function for the type MockGroupExpenseService
+func (_mock *MockGroupExpenseService) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]expenses.GroupExpense, error) {
+	ret := _mock.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByIDs")
+	}
+
+	var r0 []expenses.GroupExpense
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID) ([]expenses.GroupExpense, error)); ok {
+		return returnFunc(ctx, ids)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID) []expenses.GroupExpense); ok {
+		r0 = returnFunc(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]expenses.GroupExpense)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGroupExpenseService_GetByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByIDs'
+type MockGroupExpenseService_GetByIDs_Call struct {
+	*mock.Call
+}
+
+// GetByIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []uuid.UUID
+func (_e *MockGroupExpenseService_Expecter) GetByIDs(ctx interface{}, ids interface{}) *MockGroupExpenseService_GetByIDs_Call {
+	return &MockGroupExpenseService_GetByIDs_Call{Call: _e.mock.On("GetByIDs", ctx, ids)}
+}
+
+func (_c *MockGroupExpenseService_GetByIDs_Call) Run(run func(ctx context.Context, ids []uuid.UUID)) *MockGroupExpenseService_GetByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].([]uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGroupExpenseService_GetByIDs_Call) Return(groupExpenses []expenses.GroupExpense, err error) *MockGroupExpenseService_GetByIDs_Call {
+	_c.Call.Return(groupExpenses, err)
+	return _c
+}
+
+func (_c *MockGroupExpenseService_GetByIDs_Call) RunAndReturn(run func(ctx context.Context, ids []uuid.UUID) ([]expenses.GroupExpense, error)) *MockGroupExpenseService_GetByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDetails provides a mock function for the type MockGroupExpenseService
+func (_mock *MockGroupExpenseService) GetDetails(ctx context.Context, id uuid.UUID, userProfileID uuid.UUID) (dto.GroupExpenseResponse, error) {
+	ret := _mock.Called(ctx, id, userProfileID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDetails")
+	}
+
+	var r0 dto.GroupExpenseResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (dto.GroupExpenseResponse, error)); ok {
+		return returnFunc(ctx, id, userProfileID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) dto.GroupExpenseResponse); ok {
+		r0 = returnFunc(ctx, id, userProfileID)
+	} else {
+		r0 = ret.Get(0).(dto.GroupExpenseResponse)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id, userProfileID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGroupExpenseService_GetDetails_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDetails'
+type MockGroupExpenseService_GetDetails_Call struct {
+	*mock.Call
+}
+
+// GetDetails is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - userProfileID uuid.UUID
+func (_e *MockGroupExpenseService_Expecter) GetDetails(ctx interface{}, id interface{}, userProfileID interface{}) *MockGroupExpenseService_GetDetails_Call {
+	return &MockGroupExpenseService_GetDetails_Call{Call: _e.mock.On("GetDetails", ctx, id, userProfileID)}
+}
+
+func (_c *MockGroupExpenseService_GetDetails_Call) Run(run func(ctx context.Context, id uuid.UUID, userProfileID uuid.UUID)) *MockGroupExpenseService_GetDetails_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGroupExpenseService_GetDetails_Call) Return(groupExpenseResponse dto.GroupExpenseResponse, err error) *MockGroupExpenseService_GetDetails_Call {
+	_c.Call.Return(groupExpenseResponse, err)
+	return _c
+}
+
+func (_c *MockGroupExpenseService_GetDetails_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, userProfileID uuid.UUID) (dto.GroupExpenseResponse, error)) *MockGroupExpenseService_GetDetails_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRecent provides a mock function for the type MockGroupExpenseService
+func (_mock *MockGroupExpenseService) GetRecent(ctx context.Context, profileID uuid.UUID) ([]dto.GroupExpenseResponse, error) {
+	ret := _mock.Called(ctx, profileID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRecent")
+	}
+
+	var r0 []dto.GroupExpenseResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]dto.GroupExpenseResponse, error)); ok {
+		return returnFunc(ctx, profileID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []dto.GroupExpenseResponse); ok {
+		r0 = returnFunc(ctx, profileID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.GroupExpenseResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, profileID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGroupExpenseService_GetRecent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecent'
+type MockGroupExpenseService_GetRecent_Call struct {
+	*mock.Call
+}
+
+// GetRecent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - profileID uuid.UUID
+func (_e *MockGroupExpenseService_Expecter) GetRecent(ctx interface{}, profileID interface{}) *MockGroupExpenseService_GetRecent_Call {
+	return &MockGroupExpenseService_GetRecent_Call{Call: _e.mock.On("GetRecent", ctx, profileID)}
+}
+
+func (_c *MockGroupExpenseService_GetRecent_Call) Run(run func(ctx context.Context, profileID uuid.UUID)) *MockGroupExpenseService_GetRecent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGroupExpenseService_GetRecent_Call) Return(groupExpenseResponses []dto.GroupExpenseResponse, err error) *MockGroupExpenseService_GetRecent_Call {
+	_c.Call.Return(groupExpenseResponses, err)
+	return _c
+}
+
+func (_c *MockGroupExpenseService_GetRecent_Call) RunAndReturn(run func(ctx context.Context, profileID uuid.UUID) ([]dto.GroupExpenseResponse, error)) *MockGroupExpenseService_GetRecent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUnconfirmedForUpdate provides a mock function for the type MockGroupExpenseService
+func (_mock *MockGroupExpenseService) GetUnconfirmedForUpdate(ctx context.Context, profileID uuid.UUID, id uuid.UUID) (expenses.GroupExpense, error) {
+	ret := _mock.Called(ctx, profileID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUnconfirmedForUpdate")
+	}
+
+	var r0 expenses.GroupExpense
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (expenses.GroupExpense, error)); ok {
+		return returnFunc(ctx, profileID, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) expenses.GroupExpense); ok {
+		r0 = returnFunc(ctx, profileID, id)
+	} else {
+		r0 = ret.Get(0).(expenses.GroupExpense)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, profileID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGroupExpenseService_GetUnconfirmedForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUnconfirmedForUpdate'
+type MockGroupExpenseService_GetUnconfirmedForUpdate_Call struct {
+	*mock.Call
+}
+
+// GetUnconfirmedForUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - profileID uuid.UUID
+//   - id uuid.UUID
+func (_e *MockGroupExpenseService_Expecter) GetUnconfirmedForUpdate(ctx interface{}, profileID interface{}, id interface{}) *MockGroupExpenseService_GetUnconfirmedForUpdate_Call {
+	return &MockGroupExpenseService_GetUnconfirmedForUpdate_Call{Call: _e.mock.On("GetUnconfirmedForUpdate", ctx, profileID, id)}
+}
+
+func (_c *MockGroupExpenseService_GetUnconfirmedForUpdate_Call) Run(run func(ctx context.Context, profileID uuid.UUID, id uuid.UUID)) *MockGroupExpenseService_GetUnconfirmedForUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGroupExpenseService_GetUnconfirmedForUpdate_Call) Return(groupExpense expenses.GroupExpense, err error) *MockGroupExpenseService_GetUnconfirmedForUpdate_Call {
+	_c.Call.Return(groupExpense, err)
+	return _c
+}
+
+func (_c *MockGroupExpenseService_GetUnconfirmedForUpdate_Call) RunAndReturn(run func(ctx context.Context, profileID uuid.UUID, id uuid.UUID) (expenses.GroupExpense, error)) *MockGroupExpenseService_GetUnconfirmedForUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ParseFromBillText provides a mock function for the type MockGroupExpenseService
+func (_mock *MockGroupExpenseService) ParseFromBillText(ctx context.Context, msg message.ExpenseBillTextExtracted) error {
+	ret := _mock.Called(ctx, msg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ParseFromBillText")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, message.ExpenseBillTextExtracted) error); ok {
+		r0 = returnFunc(ctx, msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockGroupExpenseService_ParseFromBillText_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ParseFromBillText'
+type MockGroupExpenseService_ParseFromBillText_Call struct {
+	*mock.Call
+}
+
+// ParseFromBillText is a helper method to define mock.On call
+//   - ctx context.Context
+//   - msg message.ExpenseBillTextExtracted
+func (_e *MockGroupExpenseService_Expecter) ParseFromBillText(ctx interface{}, msg interface{}) *MockGroupExpenseService_ParseFromBillText_Call {
+	return &MockGroupExpenseService_ParseFromBillText_Call{Call: _e.mock.On("ParseFromBillText", ctx, msg)}
+}
+
+func (_c *MockGroupExpenseService_ParseFromBillText_Call) Run(run func(ctx context.Context, msg message.ExpenseBillTextExtracted)) *MockGroupExpenseService_ParseFromBillText_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 message.ExpenseBillTextExtracted
+		if args[1] != nil {
+			arg1 = args[1].(message.ExpenseBillTextExtracted)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGroupExpenseService_ParseFromBillText_Call) Return(err error) *MockGroupExpenseService_ParseFromBillText_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockGroupExpenseService_ParseFromBillText_Call) RunAndReturn(run func(ctx context.Context, msg message.ExpenseBillTextExtracted) error) *MockGroupExpenseService_ParseFromBillText_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProcessCallback provides a mock function for the type MockGroupExpenseService
+func (_mock *MockGroupExpenseService) ProcessCallback(ctx context.Context, id uuid.UUID, callbackFn func(context.Context, expenses.GroupExpense) error) error {
+	ret := _mock.Called(ctx, id, callbackFn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProcessCallback")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, func(context.Context, expenses.GroupExpense) error) error); ok {
+		r0 = returnFunc(ctx, id, callbackFn)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockGroupExpenseService_ProcessCallback_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProcessCallback'
+type MockGroupExpenseService_ProcessCallback_Call struct {
+	*mock.Call
+}
+
+// ProcessCallback is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - callbackFn func(context.Context, expenses.GroupExpense) error
+func (_e *MockGroupExpenseService_Expecter) ProcessCallback(ctx interface{}, id interface{}, callbackFn interface{}) *MockGroupExpenseService_ProcessCallback_Call {
+	return &MockGroupExpenseService_ProcessCallback_Call{Call: _e.mock.On("ProcessCallback", ctx, id, callbackFn)}
+}
+
+func (_c *MockGroupExpenseService_ProcessCallback_Call) Run(run func(ctx context.Context, id uuid.UUID, callbackFn func(context.Context, expenses.GroupExpense) error)) *MockGroupExpenseService_ProcessCallback_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 func(context.Context, expenses.GroupExpense) error
+		if args[2] != nil {
+			arg2 = args[2].(func(context.Context, expenses.GroupExpense) error)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGroupExpenseService_ProcessCallback_Call) Return(err error) *MockGroupExpenseService_ProcessCallback_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockGroupExpenseService_ProcessCallback_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, callbackFn func(context.Context, expenses.GroupExpense) error) error) *MockGroupExpenseService_ProcessCallback_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Recalculate provides a mock function for the type MockGroupExpenseService
+func (_mock *MockGroupExpenseService) Recalculate(ctx context.Context, userProfileID uuid.UUID, groupExpenseID uuid.UUID, amountChanged bool) error {
+	ret := _mock.Called(ctx, userProfileID, groupExpenseID, amountChanged)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Recalculate")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, bool) error); ok {
+		r0 = returnFunc(ctx, userProfileID, groupExpenseID, amountChanged)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockGroupExpenseService_Recalculate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Recalculate'
+type MockGroupExpenseService_Recalculate_Call struct {
+	*mock.Call
+}
+
+// Recalculate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userProfileID uuid.UUID
+//   - groupExpenseID uuid.UUID
+//   - amountChanged bool
+func (_e *MockGroupExpenseService_Expecter) Recalculate(ctx interface{}, userProfileID interface{}, groupExpenseID interface{}, amountChanged interface{}) *MockGroupExpenseService_Recalculate_Call {
+	return &MockGroupExpenseService_Recalculate_Call{Call: _e.mock.On("Recalculate", ctx, userProfileID, groupExpenseID, amountChanged)}
+}
+
+func (_c *MockGroupExpenseService_Recalculate_Call) Run(run func(ctx context.Context, userProfileID uuid.UUID, groupExpenseID uuid.UUID, amountChanged bool)) *MockGroupExpenseService_Recalculate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		var arg3 bool
+		if args[3] != nil {
+			arg3 = args[3].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGroupExpenseService_Recalculate_Call) Return(err error) *MockGroupExpenseService_Recalculate_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockGroupExpenseService_Recalculate_Call) RunAndReturn(run func(ctx context.Context, userProfileID uuid.UUID, groupExpenseID uuid.UUID, amountChanged bool) error) *MockGroupExpenseService_Recalculate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SyncParticipants provides a mock function for the type MockGroupExpenseService
+func (_mock *MockGroupExpenseService) SyncParticipants(ctx context.Context, req dto.ExpenseParticipantsRequest) error {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SyncParticipants")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.ExpenseParticipantsRequest) error); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockGroupExpenseService_SyncParticipants_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SyncParticipants'
+type MockGroupExpenseService_SyncParticipants_Call struct {
+	*mock.Call
+}
+
+// SyncParticipants is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req dto.ExpenseParticipantsRequest
+func (_e *MockGroupExpenseService_Expecter) SyncParticipants(ctx interface{}, req interface{}) *MockGroupExpenseService_SyncParticipants_Call {
+	return &MockGroupExpenseService_SyncParticipants_Call{Call: _e.mock.On("SyncParticipants", ctx, req)}
+}
+
+func (_c *MockGroupExpenseService_SyncParticipants_Call) Run(run func(ctx context.Context, req dto.ExpenseParticipantsRequest)) *MockGroupExpenseService_SyncParticipants_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 dto.ExpenseParticipantsRequest
+		if args[1] != nil {
+			arg1 = args[1].(dto.ExpenseParticipantsRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGroupExpenseService_SyncParticipants_Call) Return(err error) *MockGroupExpenseService_SyncParticipants_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockGroupExpenseService_SyncParticipants_Call) RunAndReturn(run func(ctx context.Context, req dto.ExpenseParticipantsRequest) error) *MockGroupExpenseService_SyncParticipants_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDraft provides a mock function for the type MockGroupExpenseService
+func (_mock *MockGroupExpenseService) UpdateDraft(ctx context.Context, expense expenses.GroupExpense, request dto.NewGroupExpenseRequest) error {
+	ret := _mock.Called(ctx, expense, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDraft")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, expenses.GroupExpense, dto.NewGroupExpenseRequest) error); ok {
+		r0 = returnFunc(ctx, expense, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockGroupExpenseService_UpdateDraft_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDraft'
+type MockGroupExpenseService_UpdateDraft_Call struct {
+	*mock.Call
+}
+
+// UpdateDraft is a helper method to define mock.On call
+//   - ctx context.Context
+//   - expense expenses.GroupExpense
+//   - request dto.NewGroupExpenseRequest
+func (_e *MockGroupExpenseService_Expecter) UpdateDraft(ctx interface{}, expense interface{}, request interface{}) *MockGroupExpenseService_UpdateDraft_Call {
+	return &MockGroupExpenseService_UpdateDraft_Call{Call: _e.mock.On("UpdateDraft", ctx, expense, request)}
+}
+
+func (_c *MockGroupExpenseService_UpdateDraft_Call) Run(run func(ctx context.Context, expense expenses.GroupExpense, request dto.NewGroupExpenseRequest)) *MockGroupExpenseService_UpdateDraft_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 expenses.GroupExpense
+		if args[1] != nil {
+			arg1 = args[1].(expenses.GroupExpense)
+		}
+		var arg2 dto.NewGroupExpenseRequest
+		if args[2] != nil {
+			arg2 = args[2].(dto.NewGroupExpenseRequest)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGroupExpenseService_UpdateDraft_Call) Return(err error) *MockGroupExpenseService_UpdateDraft_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockGroupExpenseService_UpdateDraft_Call) RunAndReturn(run func(ctx context.Context, expense expenses.GroupExpense, request dto.NewGroupExpenseRequest) error) *MockGroupExpenseService_UpdateDraft_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateLabels provides a mock function for the type MockGroupExpenseService
+func (_mock *MockGroupExpenseService) UpdateLabels(ctx context.Context, req dto.LabelsRequest) (dto.GroupExpenseResponse, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLabels")
+	}
+
+	var r0 dto.GroupExpenseResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.LabelsRequest) (dto.GroupExpenseResponse, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.LabelsRequest) dto.GroupExpenseResponse); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Get(0).(dto.GroupExpenseResponse)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dto.LabelsRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGroupExpenseService_UpdateLabels_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateLabels'
+type MockGroupExpenseService_UpdateLabels_Call struct {
+	*mock.Call
+}
+
+// UpdateLabels is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req dto.LabelsRequest
+func (_e *MockGroupExpenseService_Expecter) UpdateLabels(ctx interface{}, req interface{}) *MockGroupExpenseService_UpdateLabels_Call {
+	return &MockGroupExpenseService_UpdateLabels_Call{Call: _e.mock.On("UpdateLabels", ctx, req)}
+}
+
+func (_c *MockGroupExpenseService_UpdateLabels_Call) Run(run func(ctx context.Context, req dto.LabelsRequest)) *MockGroupExpenseService_UpdateLabels_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 dto.LabelsRequest
+		if args[1] != nil {
+			arg1 = args[1].(dto.LabelsRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGroupExpenseService_UpdateLabels_Call) Return(groupExpenseResponse dto.GroupExpenseResponse, err error) *MockGroupExpenseService_UpdateLabels_Call {
+	_c.Call.Return(groupExpenseResponse, err)
+	return _c
+}
+
+func (_c *MockGroupExpenseService_UpdateLabels_Call) RunAndReturn(run func(ctx context.Context, req dto.LabelsRequest) (dto.GroupExpenseResponse, error)) *MockGroupExpenseService_UpdateLabels_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockFriendshipService creates a new instance of MockFriendshipService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockFriendshipService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockFriendshipService {
+	mock := &MockFriendshipService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockFriendshipService is an autogenerated mock type for the FriendshipService type
+type MockFriendshipService struct {
+	mock.Mock
+}
+
+type MockFriendshipService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockFriendshipService) EXPECT() *MockFriendshipService_Expecter {
+	return &MockFriendshipService_Expecter{mock: &_m.Mock}
+}
+
+// ConstructNotification provides a mock function for the type MockFriendshipService
+func (_mock *MockFriendshipService) ConstructNotification(ctx context.Context, msg message.FriendRequestAccepted) (entity.Notification, error) {
+	ret := _mock.Called(ctx, msg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConstructNotification")
+	}
+
+	var r0 entity.Notification
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, message.FriendRequestAccepted) (entity.Notification, error)); ok {
+		return returnFunc(ctx, msg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, message.FriendRequestAccepted) entity.Notification); ok {
+		r0 = returnFunc(ctx, msg)
+	} else {
+		r0 = ret.Get(0).(entity.Notification)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, message.FriendRequestAccepted) error); ok {
+		r1 = returnFunc(ctx, msg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFriendshipService_ConstructNotification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConstructNotification'
+type MockFriendshipService_ConstructNotification_Call struct {
+	*mock.Call
+}
+
+// ConstructNotification is a helper method to define mock.On call
+//   - ctx context.Context
+//   - msg message.FriendRequestAccepted
+func (_e *MockFriendshipService_Expecter) ConstructNotification(ctx interface{}, msg interface{}) *MockFriendshipService_ConstructNotification_Call {
+	return &MockFriendshipService_ConstructNotification_Call{Call: _e.mock.On("ConstructNotification", ctx, msg)}
+}
+
+func (_c *MockFriendshipService_ConstructNotification_Call) Run(run func(ctx context.Context, msg message.FriendRequestAccepted)) *MockFriendshipService_ConstructNotification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 message.FriendRequestAccepted
+		if args[1] != nil {
+			arg1 = args[1].(message.FriendRequestAccepted)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFriendshipService_ConstructNotification_Call) Return(notification entity.Notification, err error) *MockFriendshipService_ConstructNotification_Call {
+	_c.Call.Return(notification, err)
+	return _c
+}
+
+func (_c *MockFriendshipService_ConstructNotification_Call) RunAndReturn(run func(ctx context.Context, msg message.FriendRequestAccepted) (entity.Notification, error)) *MockFriendshipService_ConstructNotification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateAnonymous provides a mock function for the type MockFriendshipService
+func (_mock *MockFriendshipService) CreateAnonymous(ctx context.Context, request dto.NewAnonymousFriendshipRequest) (dto.FriendshipResponse, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAnonymous")
+	}
+
+	var r0 dto.FriendshipResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.NewAnonymousFriendshipRequest) (dto.FriendshipResponse, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.NewAnonymousFriendshipRequest) dto.FriendshipResponse); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		r0 = ret.Get(0).(dto.FriendshipResponse)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dto.NewAnonymousFriendshipRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFriendshipService_CreateAnonymous_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAnonymous'
+type MockFriendshipService_CreateAnonymous_Call struct {
+	*mock.Call
+}
+
+// CreateAnonymous is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request dto.NewAnonymousFriendshipRequest
+func (_e *MockFriendshipService_Expecter) CreateAnonymous(ctx interface{}, request interface{}) *MockFriendshipService_CreateAnonymous_Call {
+	return &MockFriendshipService_CreateAnonymous_Call{Call: _e.mock.On("CreateAnonymous", ctx, request)}
+}
+
+func (_c *MockFriendshipService_CreateAnonymous_Call) Run(run func(ctx context.Context, request dto.NewAnonymousFriendshipRequest)) *MockFriendshipService_CreateAnonymous_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 dto.NewAnonymousFriendshipRequest
+		if args[1] != nil {
+			arg1 = args[1].(dto.NewAnonymousFriendshipRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFriendshipService_CreateAnonymous_Call) Return(friendshipResponse dto.FriendshipResponse, err error) *MockFriendshipService_CreateAnonymous_Call {
+	_c.Call.Return(friendshipResponse, err)
+	return _c
+}
+
+func (_c *MockFriendshipService_CreateAnonymous_Call) RunAndReturn(run func(ctx context.Context, request dto.NewAnonymousFriendshipRequest) (dto.FriendshipResponse, error)) *MockFriendshipService_CreateAnonymous_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateReal provides a mock function for the type MockFriendshipService
+func (_mock *MockFriendshipService) CreateReal(ctx context.Context, userProfileID uuid.UUID, friendProfileID uuid.UUID) (dto.FriendshipResponse, error) {
+	ret := _mock.Called(ctx, userProfileID, friendProfileID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateReal")
+	}
+
+	var r0 dto.FriendshipResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (dto.FriendshipResponse, error)); ok {
+		return returnFunc(ctx, userProfileID, friendProfileID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) dto.FriendshipResponse); ok {
+		r0 = returnFunc(ctx, userProfileID, friendProfileID)
+	} else {
+		r0 = ret.Get(0).(dto.FriendshipResponse)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userProfileID, friendProfileID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFriendshipService_CreateReal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateReal'
+type MockFriendshipService_CreateReal_Call struct {
+	*mock.Call
+}
+
+// CreateReal is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userProfileID uuid.UUID
+//   - friendProfileID uuid.UUID
+func (_e *MockFriendshipService_Expecter) CreateReal(ctx interface{}, userProfileID interface{}, friendProfileID interface{}) *MockFriendshipService_CreateReal_Call {
+	return &MockFriendshipService_CreateReal_Call{Call: _e.mock.On("CreateReal", ctx, userProfileID, friendProfileID)}
+}
+
+func (_c *MockFriendshipService_CreateReal_Call) Run(run func(ctx context.Context, userProfileID uuid.UUID, friendProfileID uuid.UUID)) *MockFriendshipService_CreateReal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFriendshipService_CreateReal_Call) Return(friendshipResponse dto.FriendshipResponse, err error) *MockFriendshipService_CreateReal_Call {
+	_c.Call.Return(friendshipResponse, err)
+	return _c
+}
+
+func (_c *MockFriendshipService_CreateReal_Call) RunAndReturn(run func(ctx context.Context, userProfileID uuid.UUID, friendProfileID uuid.UUID) (dto.FriendshipResponse, error)) *MockFriendshipService_CreateReal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function for the type MockFriendshipService
+func (_mock *MockFriendshipService) GetAll(ctx context.Context, profileID uuid.UUID) ([]dto.FriendshipResponse, error) {
+	ret := _mock.Called(ctx, profileID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []dto.FriendshipResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]dto.FriendshipResponse, error)); ok {
+		return returnFunc(ctx, profileID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []dto.FriendshipResponse); ok {
+		r0 = returnFunc(ctx, profileID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.FriendshipResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, profileID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFriendshipService_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type MockFriendshipService_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - profileID uuid.UUID
+func (_e *MockFriendshipService_Expecter) GetAll(ctx interface{}, profileID interface{}) *MockFriendshipService_GetAll_Call {
+	return &MockFriendshipService_GetAll_Call{Call: _e.mock.On("GetAll", ctx, profileID)}
+}
+
+func (_c *MockFriendshipService_GetAll_Call) Run(run func(ctx context.Context, profileID uuid.UUID)) *MockFriendshipService_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFriendshipService_GetAll_Call) Return(friendshipResponses []dto.FriendshipResponse, err error) *MockFriendshipService_GetAll_Call {
+	_c.Call.Return(friendshipResponses, err)
+	return _c
+}
+
+func (_c *MockFriendshipService_GetAll_Call) RunAndReturn(run func(ctx context.Context, profileID uuid.UUID) ([]dto.FriendshipResponse, error)) *MockFriendshipService_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByProfileIDs provides a mock function for the type MockFriendshipService
+func (_mock *MockFriendshipService) GetByProfileIDs(ctx context.Context, profileID1 uuid.UUID, profileID2 uuid.UUID) (users.Friendship, error) {
+	ret := _mock.Called(ctx, profileID1, profileID2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByProfileIDs")
+	}
+
+	var r0 users.Friendship
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (users.Friendship, error)); ok {
+		return returnFunc(ctx, profileID1, profileID2)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) users.Friendship); ok {
+		r0 = returnFunc(ctx, profileID1, profileID2)
+	} else {
+		r0 = ret.Get(0).(users.Friendship)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, profileID1, profileID2)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFriendshipService_GetByProfileIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByProfileIDs'
+type MockFriendshipService_GetByProfileIDs_Call struct {
+	*mock.Call
+}
+
+// GetByProfileIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - profileID1 uuid.UUID
+//   - profileID2 uuid.UUID
+func (_e *MockFriendshipService_Expecter) GetByProfileIDs(ctx interface{}, profileID1 interface{}, profileID2 interface{}) *MockFriendshipService_GetByProfileIDs_Call {
+	return &MockFriendshipService_GetByProfileIDs_Call{Call: _e.mock.On("GetByProfileIDs", ctx, profileID1, profileID2)}
+}
+
+func (_c *MockFriendshipService_GetByProfileIDs_Call) Run(run func(ctx context.Context, profileID1 uuid.UUID, profileID2 uuid.UUID)) *MockFriendshipService_GetByProfileIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFriendshipService_GetByProfileIDs_Call) Return(friendship users.Friendship, err error) *MockFriendshipService_GetByProfileIDs_Call {
+	_c.Call.Return(friendship, err)
+	return _c
+}
+
+func (_c *MockFriendshipService_GetByProfileIDs_Call) RunAndReturn(run func(ctx context.Context, profileID1 uuid.UUID, profileID2 uuid.UUID) (users.Friendship, error)) *MockFriendshipService_GetByProfileIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDetails provides a mock function for the type MockFriendshipService
+func (_mock *MockFriendshipService) GetDetails(ctx context.Context, profileID uuid.UUID, friendshipID uuid.UUID) (dto.FriendDetails, error) {
+	ret := _mock.Called(ctx, profileID, friendshipID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDetails")
+	}
+
+	var r0 dto.FriendDetails
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (dto.FriendDetails, error)); ok {
+		return returnFunc(ctx, profileID, friendshipID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) dto.FriendDetails); ok {
+		r0 = returnFunc(ctx, profileID, friendshipID)
+	} else {
+		r0 = ret.Get(0).(dto.FriendDetails)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, profileID, friendshipID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFriendshipService_GetDetails_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDetails'
+type MockFriendshipService_GetDetails_Call struct {
+	*mock.Call
+}
+
+// GetDetails is a helper method to define mock.On call
+//   - ctx context.Context
+//   - profileID uuid.UUID
+//   - friendshipID uuid.UUID
+func (_e *MockFriendshipService_Expecter) GetDetails(ctx interface{}, profileID interface{}, friendshipID interface{}) *MockFriendshipService_GetDetails_Call {
+	return &MockFriendshipService_GetDetails_Call{Call: _e.mock.On("GetDetails", ctx, profileID, friendshipID)}
+}
+
+func (_c *MockFriendshipService_GetDetails_Call) Run(run func(ctx context.Context, profileID uuid.UUID, friendshipID uuid.UUID)) *MockFriendshipService_GetDetails_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFriendshipService_GetDetails_Call) Return(friendDetails dto.FriendDetails, err error) *MockFriendshipService_GetDetails_Call {
+	_c.Call.Return(friendDetails, err)
+	return _c
+}
+
+func (_c *MockFriendshipService_GetDetails_Call) RunAndReturn(run func(ctx context.Context, profileID uuid.UUID, friendshipID uuid.UUID) (dto.FriendDetails, error)) *MockFriendshipService_GetDetails_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsFriends provides a mock function for the type MockFriendshipService
+func (_mock *MockFriendshipService) IsFriends(ctx context.Context, profileID1 uuid.UUID, profileID2 uuid.UUID) (bool, bool, error) {
+	ret := _mock.Called(ctx, profileID1, profileID2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsFriends")
+	}
+
+	var r0 bool
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (bool, bool, error)); ok {
+		return returnFunc(ctx, profileID1, profileID2)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) bool); ok {
+		r0 = returnFunc(ctx, profileID1, profileID2)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) bool); ok {
+		r1 = returnFunc(ctx, profileID1, profileID2)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r2 = returnFunc(ctx, profileID1, profileID2)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockFriendshipService_IsFriends_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsFriends'
+type MockFriendshipService_IsFriends_Call struct {
+	*mock.Call
+}
+
+// IsFriends is a helper method to define mock.On call
+//   - ctx context.Context
+//   - profileID1 uuid.UUID
+//   - profileID2 uuid.UUID
+func (_e *MockFriendshipService_Expecter) IsFriends(ctx interface{}, profileID1 interface{}, profileID2 interface{}) *MockFriendshipService_IsFriends_Call {
+	return &MockFriendshipService_IsFriends_Call{Call: _e.mock.On("IsFriends", ctx, profileID1, profileID2)}
+}
+
+func (_c *MockFriendshipService_IsFriends_Call) Run(run func(ctx context.Context, profileID1 uuid.UUID, profileID2 uuid.UUID)) *MockFriendshipService_IsFriends_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFriendshipService_IsFriends_Call) Return(b bool, b1 bool, err error) *MockFriendshipService_IsFriends_Call {
+	_c.Call.Return(b, b1, err)
+	return _c
+}
+
+func (_c *MockFriendshipService_IsFriends_Call) RunAndReturn(run func(ctx context.Context, profileID1 uuid.UUID, profileID2 uuid.UUID) (bool, bool, error)) *MockFriendshipService_IsFriends_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCategoryService creates a new instance of MockCategoryService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCategoryService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCategoryService {
+	mock := &MockCategoryService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCategoryService is an autogenerated mock type for the CategoryService type
+type MockCategoryService struct {
+	mock.Mock
+}
+
+type MockCategoryService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCategoryService) EXPECT() *MockCategoryService_Expecter {
+	return &MockCategoryService_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockCategoryService
+func (_mock *MockCategoryService) Create(ctx context.Context, req dto.NewCategoryRequest) (dto.CategoryResponse, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 dto.CategoryResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.NewCategoryRequest) (dto.CategoryResponse, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.NewCategoryRequest) dto.CategoryResponse); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Get(0).(dto.CategoryResponse)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dto.NewCategoryRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCategoryService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockCategoryService_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req dto.NewCategoryRequest
+func (_e *MockCategoryService_Expecter) Create(ctx interface{}, req interface{}) *MockCategoryService_Create_Call {
+	return &MockCategoryService_Create_Call{Call: _e.mock.On("Create", ctx, req)}
+}
+
+func (_c *MockCategoryService_Create_Call) Run(run func(ctx context.Context, req dto.NewCategoryRequest)) *MockCategoryService_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 dto.NewCategoryRequest
+		if args[1] != nil {
+			arg1 = args[1].(dto.NewCategoryRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCategoryService_Create_Call) Return(categoryResponse dto.CategoryResponse, err error) *MockCategoryService_Create_Call {
+	_c.Call.Return(categoryResponse, err)
+	return _c
+}
+
+func (_c *MockCategoryService_Create_Call) RunAndReturn(run func(ctx context.Context, req dto.NewCategoryRequest) (dto.CategoryResponse, error)) *MockCategoryService_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockCategoryService
+func (_mock *MockCategoryService) Delete(ctx context.Context, profileID uuid.UUID, id uuid.UUID) error {
+	ret := _mock.Called(ctx, profileID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, profileID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCategoryService_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockCategoryService_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - profileID uuid.UUID
+//   - id uuid.UUID
+func (_e *MockCategoryService_Expecter) Delete(ctx interface{}, profileID interface{}, id interface{}) *MockCategoryService_Delete_Call {
+	return &MockCategoryService_Delete_Call{Call: _e.mock.On("Delete", ctx, profileID, id)}
+}
+
+func (_c *MockCategoryService_Delete_Call) Run(run func(ctx context.Context, profileID uuid.UUID, id uuid.UUID)) *MockCategoryService_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCategoryService_Delete_Call) Return(err error) *MockCategoryService_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCategoryService_Delete_Call) RunAndReturn(run func(ctx context.Context, profileID uuid.UUID, id uuid.UUID) error) *MockCategoryService_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function for the type MockCategoryService
+func (_mock *MockCategoryService) GetAll(ctx context.Context, profileID uuid.UUID) ([]dto.CategoryResponse, error) {
+	ret := _mock.Called(ctx, profileID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []dto.CategoryResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]dto.CategoryResponse, error)); ok {
+		return returnFunc(ctx, profileID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []dto.CategoryResponse); ok {
+		r0 = returnFunc(ctx, profileID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.CategoryResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, profileID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCategoryService_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type MockCategoryService_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - profileID uuid.UUID
+func (_e *MockCategoryService_Expecter) GetAll(ctx interface{}, profileID interface{}) *MockCategoryService_GetAll_Call {
+	return &MockCategoryService_GetAll_Call{Call: _e.mock.On("GetAll", ctx, profileID)}
+}
+
+func (_c *MockCategoryService_GetAll_Call) Run(run func(ctx context.Context, profileID uuid.UUID)) *MockCategoryService_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCategoryService_GetAll_Call) Return(categoryResponses []dto.CategoryResponse, err error) *MockCategoryService_GetAll_Call {
+	_c.Call.Return(categoryResponses, err)
+	return _c
+}
+
+func (_c *MockCategoryService_GetAll_Call) RunAndReturn(run func(ctx context.Context, profileID uuid.UUID) ([]dto.CategoryResponse, error)) *MockCategoryService_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDefaultByName provides a mock function for the type MockCategoryService
+func (_mock *MockCategoryService) GetDefaultByName(ctx context.Context, name string) (entity.Category, error) {
+	ret := _mock.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDefaultByName")
+	}
+
+	var r0 entity.Category
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (entity.Category, error)); ok {
+		return returnFunc(ctx, name)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) entity.Category); ok {
+		r0 = returnFunc(ctx, name)
+	} else {
+		r0 = ret.Get(0).(entity.Category)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCategoryService_GetDefaultByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDefaultByName'
+type MockCategoryService_GetDefaultByName_Call struct {
+	*mock.Call
+}
+
+// GetDefaultByName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *MockCategoryService_Expecter) GetDefaultByName(ctx interface{}, name interface{}) *MockCategoryService_GetDefaultByName_Call {
+	return &MockCategoryService_GetDefaultByName_Call{Call: _e.mock.On("GetDefaultByName", ctx, name)}
+}
+
+func (_c *MockCategoryService_GetDefaultByName_Call) Run(run func(ctx context.Context, name string)) *MockCategoryService_GetDefaultByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCategoryService_GetDefaultByName_Call) Return(category entity.Category, err error) *MockCategoryService_GetDefaultByName_Call {
+	_c.Call.Return(category, err)
+	return _c
+}
+
+func (_c *MockCategoryService_GetDefaultByName_Call) RunAndReturn(run func(ctx context.Context, name string) (entity.Category, error)) *MockCategoryService_GetDefaultByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUsable provides a mock function for the type MockCategoryService
+func (_mock *MockCategoryService) GetUsable(ctx context.Context, profileID uuid.UUID, id uuid.UUID) (entity.Category, error) {
+	ret := _mock.Called(ctx, profileID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUsable")
+	}
+
+	var r0 entity.Category
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (entity.Category, error)); ok {
+		return returnFunc(ctx, profileID, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) entity.Category); ok {
+		r0 = returnFunc(ctx, profileID, id)
+	} else {
+		r0 = ret.Get(0).(entity.Category)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, profileID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCategoryService_GetUsable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUsable'
+type MockCategoryService_GetUsable_Call struct {
+	*mock.Call
+}
+
+// GetUsable is a helper method to define mock.On call
+//   - ctx context.Context
+//   - profileID uuid.UUID
+//   - id uuid.UUID
+func (_e *MockCategoryService_Expecter) GetUsable(ctx interface{}, profileID interface{}, id interface{}) *MockCategoryService_GetUsable_Call {
+	return &MockCategoryService_GetUsable_Call{Call: _e.mock.On("GetUsable", ctx, profileID, id)}
+}
+
+func (_c *MockCategoryService_GetUsable_Call) Run(run func(ctx context.Context, profileID uuid.UUID, id uuid.UUID)) *MockCategoryService_GetUsable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCategoryService_GetUsable_Call) Return(category entity.Category, err error) *MockCategoryService_GetUsable_Call {
+	_c.Call.Return(category, err)
+	return _c
+}
+
+func (_c *MockCategoryService_GetUsable_Call) RunAndReturn(run func(ctx context.Context, profileID uuid.UUID, id uuid.UUID) (entity.Category, error)) *MockCategoryService_GetUsable_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGroupService creates a new instance of MockGroupService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGroupService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGroupService {
+	mock := &MockGroupService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGroupService is an autogenerated mock type for the GroupService type
+type MockGroupService struct {
+	mock.Mock
+}
+
+type MockGroupService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGroupService) EXPECT() *MockGroupService_Expecter {
+	return &MockGroupService_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockGroupService
+func (_mock *MockGroupService) Create(ctx context.Context, req dto.NewGroupRequest) (dto.GroupResponse, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 dto.GroupResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.NewGroupRequest) (dto.GroupResponse, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.NewGroupRequest) dto.GroupResponse); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Get(0).(dto.GroupResponse)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dto.NewGroupRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGroupService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockGroupService_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req dto.NewGroupRequest
+func (_e *MockGroupService_Expecter) Create(ctx interface{}, req interface{}) *MockGroupService_Create_Call {
+	return &MockGroupService_Create_Call{Call: _e.mock.On("Create", ctx, req)}
+}
+
+func (_c *MockGroupService_Create_Call) Run(run func(ctx context.Context, req dto.NewGroupRequest)) *MockGroupService_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 dto.NewGroupRequest
+		if args[1] != nil {
+			arg1 = args[1].(dto.NewGroupRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGroupService_Create_Call) Return(groupResponse dto.GroupResponse, err error) *MockGroupService_Create_Call {
+	_c.Call.Return(groupResponse, err)
+	return _c
+}
+
+func (_c *MockGroupService_Create_Call) RunAndReturn(run func(ctx context.Context, req dto.NewGroupRequest) (dto.GroupResponse, error)) *MockGroupService_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockGroupService
+func (_mock *MockGroupService) Delete(ctx context.Context, profileID uuid.UUID, id uuid.UUID) error {
+	ret := _mock.Called(ctx, profileID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, profileID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockGroupService_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockGroupService_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - profileID uuid.UUID
+//   - id uuid.UUID
+func (_e *MockGroupService_Expecter) Delete(ctx interface{}, profileID interface{}, id interface{}) *MockGroupService_Delete_Call {
+	return &MockGroupService_Delete_Call{Call: _e.mock.On("Delete", ctx, profileID, id)}
+}
+
+func (_c *MockGroupService_Delete_Call) Run(run func(ctx context.Context, profileID uuid.UUID, id uuid.UUID)) *MockGroupService_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGroupService_Delete_Call) Return(err error) *MockGroupService_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockGroupService_Delete_Call) RunAndReturn(run func(ctx context.Context, profileID uuid.UUID, id uuid.UUID) error) *MockGroupService_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetActivities provides a mock function for the type MockGroupService
+func (_mock *MockGroupService) GetActivities(ctx context.Context, profileID uuid.UUID, id uuid.UUID) ([]dto.GroupActivityResponse, error) {
+	ret := _mock.Called(ctx, profileID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActivities")
+	}
+
+	var r0 []dto.GroupActivityResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) ([]dto.GroupActivityResponse, error)); ok {
+		return returnFunc(ctx, profileID, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) []dto.GroupActivityResponse); ok {
+		r0 = returnFunc(ctx, profileID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.GroupActivityResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, profileID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGroupService_GetActivities_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActivities'
+type MockGroupService_GetActivities_Call struct {
+	*mock.Call
+}
+
+// GetActivities is a helper method to define mock.On call
+//   - ctx context.Context
+//   - profileID uuid.UUID
+//   - id uuid.UUID
+func (_e *MockGroupService_Expecter) GetActivities(ctx interface{}, profileID interface{}, id interface{}) *MockGroupService_GetActivities_Call {
+	return &MockGroupService_GetActivities_Call{Call: _e.mock.On("GetActivities", ctx, profileID, id)}
+}
+
+func (_c *MockGroupService_GetActivities_Call) Run(run func(ctx context.Context, profileID uuid.UUID, id uuid.UUID)) *MockGroupService_GetActivities_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGroupService_GetActivities_Call) Return(groupActivityResponses []dto.GroupActivityResponse, err error) *MockGroupService_GetActivities_Call {
+	_c.Call.Return(groupActivityResponses, err)
+	return _c
+}
+
+func (_c *MockGroupService_GetActivities_Call) RunAndReturn(run func(ctx context.Context, profileID uuid.UUID, id uuid.UUID) ([]dto.GroupActivityResponse, error)) *MockGroupService_GetActivities_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function for the type MockGroupService
+func (_mock *MockGroupService) GetAll(ctx context.Context, profileID uuid.UUID) ([]dto.GroupResponse, error) {
+	ret := _mock.Called(ctx, profileID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []dto.GroupResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]dto.GroupResponse, error)); ok {
+		return returnFunc(ctx, profileID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []dto.GroupResponse); ok {
+		r0 = returnFunc(ctx, profileID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.GroupResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, profileID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGroupService_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type MockGroupService_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - profileID uuid.UUID
+func (_e *MockGroupService_Expecter) GetAll(ctx interface{}, profileID interface{}) *MockGroupService_GetAll_Call {
+	return &MockGroupService_GetAll_Call{Call: _e.mock.On("GetAll", ctx, profileID)}
+}
+
+func (_c *MockGroupService_GetAll_Call) Run(run func(ctx context.Context, profileID uuid.UUID)) *MockGroupService_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGroupService_GetAll_Call) Return(groupResponses []dto.GroupResponse, err error) *MockGroupService_GetAll_Call {
+	_c.Call.Return(groupResponses, err)
+	return _c
+}
+
+func (_c *MockGroupService_GetAll_Call) RunAndReturn(run func(ctx context.Context, profileID uuid.UUID) ([]dto.GroupResponse, error)) *MockGroupService_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBalances provides a mock function for the type MockGroupService
+func (_mock *MockGroupService) GetBalances(ctx context.Context, profileID uuid.UUID, id uuid.UUID) ([]dto.GroupBalanceResponse, error) {
+	ret := _mock.Called(ctx, profileID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBalances")
+	}
+
+	var r0 []dto.GroupBalanceResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) ([]dto.GroupBalanceResponse, error)); ok {
+		return returnFunc(ctx, profileID, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) []dto.GroupBalanceResponse); ok {
+		r0 = returnFunc(ctx, profileID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.GroupBalanceResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, profileID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGroupService_GetBalances_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBalances'
+type MockGroupService_GetBalances_Call struct {
+	*mock.Call
+}
+
+// GetBalances is a helper method to define mock.On call
+//   - ctx context.Context
+//   - profileID uuid.UUID
+//   - id uuid.UUID
+func (_e *MockGroupService_Expecter) GetBalances(ctx interface{}, profileID interface{}, id interface{}) *MockGroupService_GetBalances_Call {
+	return &MockGroupService_GetBalances_Call{Call: _e.mock.On("GetBalances", ctx, profileID, id)}
+}
+
+func (_c *MockGroupService_GetBalances_Call) Run(run func(ctx context.Context, profileID uuid.UUID, id uuid.UUID)) *MockGroupService_GetBalances_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGroupService_GetBalances_Call) Return(groupBalanceResponses []dto.GroupBalanceResponse, err error) *MockGroupService_GetBalances_Call {
+	_c.Call.Return(groupBalanceResponses, err)
+	return _c
+}
+
+func (_c *MockGroupService_GetBalances_Call) RunAndReturn(run func(ctx context.Context, profileID uuid.UUID, id uuid.UUID) ([]dto.GroupBalanceResponse, error)) *MockGroupService_GetBalances_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockGroupService
+func (_mock *MockGroupService) GetByID(ctx context.Context, id uuid.UUID) (expenses.Group, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 expenses.Group
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (expenses.Group, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) expenses.Group); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(expenses.Group)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGroupService_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockGroupService_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockGroupService_Expecter) GetByID(ctx interface{}, id interface{}) *MockGroupService_GetByID_Call {
+	return &MockGroupService_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *MockGroupService_GetByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockGroupService_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGroupService_GetByID_Call) Return(group expenses.Group, err error) *MockGroupService_GetByID_Call {
+	_c.Call.Return(group, err)
+	return _c
+}
+
+func (_c *MockGroupService_GetByID_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (expenses.Group, error)) *MockGroupService_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDetails provides a mock function for the type MockGroupService
+func (_mock *MockGroupService) GetDetails(ctx context.Context, profileID uuid.UUID, id uuid.UUID) (dto.GroupResponse, error) {
+	ret := _mock.Called(ctx, profileID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDetails")
+	}
+
+	var r0 dto.GroupResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (dto.GroupResponse, error)); ok {
+		return returnFunc(ctx, profileID, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) dto.GroupResponse); ok {
+		r0 = returnFunc(ctx, profileID, id)
+	} else {
+		r0 = ret.Get(0).(dto.GroupResponse)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, profileID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGroupService_GetDetails_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDetails'
+type MockGroupService_GetDetails_Call struct {
+	*mock.Call
+}
+
+// GetDetails is a helper method to define mock.On call
+//   - ctx context.Context
+//   - profileID uuid.UUID
+//   - id uuid.UUID
+func (_e *MockGroupService_Expecter) GetDetails(ctx interface{}, profileID interface{}, id interface{}) *MockGroupService_GetDetails_Call {
+	return &MockGroupService_GetDetails_Call{Call: _e.mock.On("GetDetails", ctx, profileID, id)}
+}
+
+func (_c *MockGroupService_GetDetails_Call) Run(run func(ctx context.Context, profileID uuid.UUID, id uuid.UUID)) *MockGroupService_GetDetails_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGroupService_GetDetails_Call) Return(groupResponse dto.GroupResponse, err error) *MockGroupService_GetDetails_Call {
+	_c.Call.Return(groupResponse, err)
+	return _c
+}
+
+func (_c *MockGroupService_GetDetails_Call) RunAndReturn(run func(ctx context.Context, profileID uuid.UUID, id uuid.UUID) (dto.GroupResponse, error)) *MockGroupService_GetDetails_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMembership provides a mock function for the type MockGroupService
+func (_mock *MockGroupService) GetMembership(ctx context.Context, profileID uuid.UUID, id uuid.UUID) (expenses.Group, error) {
+	ret := _mock.Called(ctx, profileID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMembership")
+	}
+
+	var r0 expenses.Group
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (expenses.Group, error)); ok {
+		return returnFunc(ctx, profileID, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) expenses.Group); ok {
+		r0 = returnFunc(ctx, profileID, id)
+	} else {
+		r0 = ret.Get(0).(expenses.Group)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, profileID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGroupService_GetMembership_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMembership'
+type MockGroupService_GetMembership_Call struct {
+	*mock.Call
+}
+
+// GetMembership is a helper method to define mock.On call
+//   - ctx context.Context
+//   - profileID uuid.UUID
+//   - id uuid.UUID
+func (_e *MockGroupService_Expecter) GetMembership(ctx interface{}, profileID interface{}, id interface{}) *MockGroupService_GetMembership_Call {
+	return &MockGroupService_GetMembership_Call{Call: _e.mock.On("GetMembership", ctx, profileID, id)}
+}
+
+func (_c *MockGroupService_GetMembership_Call) Run(run func(ctx context.Context, profileID uuid.UUID, id uuid.UUID)) *MockGroupService_GetMembership_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGroupService_GetMembership_Call) Return(group expenses.Group, err error) *MockGroupService_GetMembership_Call {
+	_c.Call.Return(group, err)
+	return _c
+}
+
+func (_c *MockGroupService_GetMembership_Call) RunAndReturn(run func(ctx context.Context, profileID uuid.UUID, id uuid.UUID) (expenses.Group, error)) *MockGroupService_GetMembership_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SyncMembers provides a mock function for the type MockGroupService
+func (_mock *MockGroupService) SyncMembers(ctx context.Context, req dto.GroupMembersRequest) (dto.GroupResponse, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SyncMembers")
+	}
+
+	var r0 dto.GroupResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.GroupMembersRequest) (dto.GroupResponse, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.GroupMembersRequest) dto.GroupResponse); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Get(0).(dto.GroupResponse)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dto.GroupMembersRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGroupService_SyncMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SyncMembers'
+type MockGroupService_SyncMembers_Call struct {
+	*mock.Call
+}
+
+// SyncMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req dto.GroupMembersRequest
+func (_e *MockGroupService_Expecter) SyncMembers(ctx interface{}, req interface{}) *MockGroupService_SyncMembers_Call {
+	return &MockGroupService_SyncMembers_Call{Call: _e.mock.On("SyncMembers", ctx, req)}
+}
+
+func (_c *MockGroupService_SyncMembers_Call) Run(run func(ctx context.Context, req dto.GroupMembersRequest)) *MockGroupService_SyncMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 dto.GroupMembersRequest
+		if args[1] != nil {
+			arg1 = args[1].(dto.GroupMembersRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGroupService_SyncMembers_Call) Return(groupResponse dto.GroupResponse, err error) *MockGroupService_SyncMembers_Call {
+	_c.Call.Return(groupResponse, err)
+	return _c
+}
+
+func (_c *MockGroupService_SyncMembers_Call) RunAndReturn(run func(ctx context.Context, req dto.GroupMembersRequest) (dto.GroupResponse, error)) *MockGroupService_SyncMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockGroupService
+func (_mock *MockGroupService) Update(ctx context.Context, req dto.UpdateGroupRequest) (dto.GroupResponse, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 dto.GroupResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.UpdateGroupRequest) (dto.GroupResponse, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.UpdateGroupRequest) dto.GroupResponse); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Get(0).(dto.GroupResponse)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dto.UpdateGroupRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGroupService_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockGroupService_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req dto.UpdateGroupRequest
+func (_e *MockGroupService_Expecter) Update(ctx interface{}, req interface{}) *MockGroupService_Update_Call {
+	return &MockGroupService_Update_Call{Call: _e.mock.On("Update", ctx, req)}
+}
+
+func (_c *MockGroupService_Update_Call) Run(run func(ctx context.Context, req dto.UpdateGroupRequest)) *MockGroupService_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 dto.UpdateGroupRequest
+		if args[1] != nil {
+			arg1 = args[1].(dto.UpdateGroupRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGroupService_Update_Call) Return(groupResponse dto.GroupResponse, err error) *MockGroupService_Update_Call {
+	_c.Call.Return(groupResponse, err)
+	return _c
+}
+
+func (_c *MockGroupService_Update_Call) RunAndReturn(run func(ctx context.Context, req dto.UpdateGroupRequest) (dto.GroupResponse, error)) *MockGroupService_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
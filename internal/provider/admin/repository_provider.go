@@ -7,11 +7,17 @@ import (
 )
 
 type Repositories struct {
-	User crud.Repository[admin.User]
+	Transactor crud.Transactor
+	User       crud.Repository[admin.User]
+	Role       crud.Repository[admin.Role]
+	UserRole   crud.Repository[admin.UserRole]
 }
 
 func ProvideRepositories(db *gorm.DB) *Repositories {
 	return &Repositories{
+		crud.NewTransactor(db),
 		crud.NewRepository[admin.User](db),
+		crud.NewRepository[admin.Role](db),
+		crud.NewRepository[admin.UserRole](db),
 	}
 }
@@ -3,20 +3,26 @@ package admin
 import (
 	adminConfig "github.com/itsLeonB/cashback/internal/core/config/admin"
 	"github.com/itsLeonB/cashback/internal/domain/service/admin"
+	"github.com/itsLeonB/cashback/internal/domain/service/audit"
 	"github.com/itsLeonB/sekure"
 )
 
 type Services struct {
 	Auth admin.AuthService
+	Role admin.RoleService
 }
 
-func ProvideServices(repos *Repositories, cfg *adminConfig.Config) *Services {
+func ProvideServices(repos *Repositories, cfg *adminConfig.Config, auditSvc audit.Service) *Services {
 
 	return &Services{
 		admin.NewAuthService(
 			repos.User,
 			sekure.NewHashService(cfg.HashCost),
 			sekure.NewJwtService(cfg.Issuer, cfg.SecretKey, cfg.TokenDuration),
+			repos.Transactor,
+			repos.Role,
+			repos.UserRole,
 		),
+		admin.NewRoleService(repos.Transactor, repos.Role, repos.UserRole, repos.User, auditSvc),
 	}
 }
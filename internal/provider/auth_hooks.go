@@ -6,7 +6,11 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/itsLeonB/cashback/internal/appconstant"
+	"github.com/itsLeonB/cashback/internal/core/logger"
+	"github.com/itsLeonB/cashback/internal/core/service/queue"
+	"github.com/itsLeonB/cashback/internal/domain/message"
 	"github.com/itsLeonB/cashback/internal/domain/service"
+	"github.com/itsLeonB/cashback/internal/domain/service/auth"
 	"github.com/itsLeonB/ungerr"
 )
 
@@ -17,6 +21,8 @@ func NewAuthHooks(
 	pushNotification service.PushNotificationService,
 	profileService service.ProfileService,
 	friendshipService service.FriendshipService,
+	profileClaimService service.ProfileClaimService,
+	taskQueue queue.TaskQueue,
 ) service.AuthHooks {
 	return service.AuthHooks{
 		BeforeLogout: func(ctx context.Context, sessionID string) error {
@@ -34,12 +40,58 @@ func NewAuthHooks(
 			if err != nil {
 				return err
 			}
+			if claimToken, ok := claims["claimToken"].(string); ok && claimToken != "" {
+				// An expired or already used link must not keep the user from verifying their email.
+				if _, err = profileClaimService.Claim(ctx, pid, claimToken); err != nil {
+					logger.Error(err)
+				}
+				return nil
+			}
 			slug, ok := claims["slug"].(string)
 			if !ok || slug == "" {
 				return nil
 			}
 			return associateBySlug(ctx, profileService, friendshipService, pid, slug)
 		},
+		AfterOAuthLogin: func(ctx context.Context, userID, provider string, isNewUser bool, claims map[string]any) error {
+			claimToken, ok := claims["claimToken"].(string)
+			if !ok || claimToken == "" {
+				return nil
+			}
+			uid, err := uuid.Parse(userID)
+			if err != nil {
+				return err
+			}
+			profileID, err := profileService.GetProfileIDByUserID(ctx, uid)
+			if err != nil {
+				return err
+			}
+			_, err = profileClaimService.Claim(ctx, profileID, claimToken)
+			return err
+		},
+		AfterSessionsRevoked: func(ctx context.Context, userID string, sessions []auth.Session) error {
+			uid, err := uuid.Parse(userID)
+			if err != nil {
+				return err
+			}
+			sessionID, err := uuid.Parse(sessions[0].ID)
+			if err != nil {
+				return err
+			}
+			profileID, err := profileService.GetProfileIDByUserID(ctx, uid)
+			if err != nil {
+				return err
+			}
+			msg := message.SessionsRevoked{
+				ProfileID: profileID,
+				SessionID: sessionID,
+				Count:     len(sessions),
+			}
+			if len(sessions) == 1 {
+				msg.UserAgent = sessions[0].UserAgent
+			}
+			return taskQueue.Enqueue(ctx, msg)
+		},
 		ClaimsBuilder: func(ctx context.Context, userID string, baseClaims map[string]any) (map[string]any, error) {
 			uid, err := uuid.Parse(userID)
 			if err != nil {
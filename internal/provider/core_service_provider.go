@@ -10,10 +10,12 @@ import (
 	"github.com/itsLeonB/cashback/internal/core/service/llm"
 	"github.com/itsLeonB/cashback/internal/core/service/mail"
 	"github.com/itsLeonB/cashback/internal/core/service/ocr"
+	"github.com/itsLeonB/cashback/internal/core/service/prompt"
 	"github.com/itsLeonB/cashback/internal/core/service/queue"
 	"github.com/itsLeonB/cashback/internal/core/service/storage"
 	"github.com/itsLeonB/cashback/internal/core/service/store"
 	"github.com/itsLeonB/cashback/internal/core/service/webpush"
+	"github.com/itsLeonB/cashback/internal/domain/service/expense/billparse"
 	"github.com/itsLeonB/ungerr"
 	"github.com/nats-io/nats.go"
 	"github.com/nats-io/nats.go/jetstream"
@@ -29,6 +31,7 @@ type CoreServices struct {
 	Queue    queue.TaskQueue
 	WebPush  webpush.Client
 	Langfuse langfuse.Client
+	Prompts  prompt.Registry
 
 	NATSConn  *nats.Conn
 	JetStream jetstream.JetStream
@@ -52,16 +55,27 @@ func (cs *CoreServices) Shutdown() error {
 }
 
 func ProvideCoreServices() (*CoreServices, error) {
-	storageRepo, err := storage.NewGCSStorageRepository()
+	storageRepo, err := storage.NewStorageRepository(config.Global.Storage)
 	if err != nil {
 		return nil, err
 	}
 
-	ocrClient, err := ocr.NewOCRClient()
+	ocrClient, err := ocr.NewOCRService(config.Global.OCR, storageRepo)
 	if err != nil {
 		return nil, err
 	}
 
+	llmService, err := llm.NewLLMService(config.Global.LLM)
+	if err != nil {
+		return nil, err
+	}
+
+	langfuseClient := langfuse.NewClient(config.Global.Langfuse)
+	prompts := prompt.NewRegistry(langfuseClient)
+	if err = billparse.ActiveBillParsePrompt.Validate(prompts); err != nil {
+		return nil, err
+	}
+
 	nc, err := nats.Connect(config.Global.Url)
 	if err != nil {
 		return nil, ungerr.Wrap(err, "error connecting to NATS")
@@ -82,7 +96,7 @@ func ProvideCoreServices() (*CoreServices, error) {
 	taskQueue := adapters.NewNATSTaskQueue(js)
 
 	return &CoreServices{
-		LLM:       llm.NewLLMService(config.Global.LLM),
+		LLM:       llmService,
 		Mail:      mail.NewMailService(),
 		Image:     storage.NewImageService(validator.New(), storageRepo),
 		State:     stateStore,
@@ -90,7 +104,8 @@ func ProvideCoreServices() (*CoreServices, error) {
 		Storage:   storageRepo,
 		Queue:     taskQueue,
 		WebPush:   webpush.NewWebPush(config.Global.Push),
-		Langfuse:  langfuse.NewClient(config.Global.Langfuse),
+		Langfuse:  langfuseClient,
+		Prompts:   prompts,
 		NATSConn:  nc,
 		JetStream: js,
 	}, nil
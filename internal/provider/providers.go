@@ -50,12 +50,23 @@ func All() (*Providers, error) {
 		return nil, err
 	}
 
+	services, err := ProvideServices(repos, coreSvcs)
+	if err != nil {
+		if e := dataSources.Shutdown(); e != nil {
+			logger.Error(e)
+		}
+		if e := coreSvcs.Shutdown(); e != nil {
+			logger.Error(e)
+		}
+		return nil, err
+	}
+
 	return &Providers{
 		DataSources:   dataSources,
 		Repositories:  repos,
 		CoreServices:  coreSvcs,
-		Services:      ProvideServices(repos, coreSvcs),
+		Services:      services,
 		AdminRepos:    adminRepos,
-		AdminServices: admin.ProvideServices(adminRepos, adminConfig.Global),
+		AdminServices: admin.ProvideServices(adminRepos, adminConfig.Global, services.Audit),
 	}, nil
 }
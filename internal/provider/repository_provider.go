@@ -3,7 +3,9 @@ package provider
 import (
 	adapters "github.com/itsLeonB/cashback/internal/adapters/repository"
 	monetizationAdapter "github.com/itsLeonB/cashback/internal/adapters/repository/monetization"
+	"github.com/itsLeonB/cashback/internal/domain/entity"
 	"github.com/itsLeonB/cashback/internal/domain/entity/debts"
+	"github.com/itsLeonB/cashback/internal/domain/entity/expenses"
 	"github.com/itsLeonB/cashback/internal/domain/entity/monetization"
 	"github.com/itsLeonB/cashback/internal/domain/entity/users"
 	"github.com/itsLeonB/cashback/internal/domain/repository"
@@ -30,12 +32,22 @@ type Repositories struct {
 	DebtTransaction       repository.DebtTransactionRepository
 	TransferMethod        repository.TransferMethodRepository
 	ProfileTransferMethod crud.Repository[debts.ProfileTransferMethod]
+	Settlement            crud.Repository[debts.Settlement]
 
 	// Expenses
 	GroupExpense repository.GroupExpenseRepository
 	ExpenseItem  repository.ExpenseItemRepository
 	OtherFee     repository.OtherFeeRepository
 	ExpenseBill  repository.ExpenseBillRepository
+	BillPage     crud.Repository[expenses.ExpenseBillPage]
+	BillUpload   crud.Repository[expenses.ExpenseBillUpload]
+
+	RecurringTemplate repository.RecurringTemplateRepository
+	Category          repository.CategoryRepository
+	Group             repository.GroupRepository
+
+	Export crud.Repository[entity.Export]
+	Import crud.Repository[entity.Import]
 
 	// Monetization
 	Plan         crud.Repository[monetization.Plan]
@@ -46,6 +58,8 @@ type Repositories struct {
 	// Infra
 	Notification     repository.NotificationRepository
 	PushSubscription repository.PushSubscriptionRepository
+	FxRate           repository.FxRateRepository
+	AuditLog         repository.AuditLogRepository
 }
 
 func ProvideRepositories(db *gorm.DB) *Repositories {
@@ -65,11 +79,21 @@ func ProvideRepositories(db *gorm.DB) *Repositories {
 		DebtTransaction:       adapters.NewDebtTransactionRepository(db),
 		TransferMethod:        adapters.NewTransferMethodRepository(db),
 		ProfileTransferMethod: crud.NewRepository[debts.ProfileTransferMethod](db),
+		Settlement:            crud.NewRepository[debts.Settlement](db),
 
 		GroupExpense: adapters.NewGroupExpenseRepository(db),
 		ExpenseItem:  adapters.NewExpenseItemRepository(db),
 		OtherFee:     adapters.NewOtherFeeRepository(db),
 		ExpenseBill:  adapters.NewExpenseBillRepository(db),
+		BillPage:     crud.NewRepository[expenses.ExpenseBillPage](db),
+		BillUpload:   crud.NewRepository[expenses.ExpenseBillUpload](db),
+
+		RecurringTemplate: adapters.NewRecurringTemplateRepository(db),
+		Category:          adapters.NewCategoryRepository(db),
+		Group:             adapters.NewGroupRepository(db),
+
+		Export: crud.NewRepository[entity.Export](db),
+		Import: crud.NewRepository[entity.Import](db),
 
 		Plan:         crud.NewRepository[monetization.Plan](db),
 		PlanVersion:  monetizationAdapter.NewPlanVersionRepository(db),
@@ -78,5 +102,7 @@ func ProvideRepositories(db *gorm.DB) *Repositories {
 
 		Notification:     adapters.NewNotificationRepository(db),
 		PushSubscription: adapters.NewPushSubscriptionRepository(db),
+		FxRate:           adapters.NewFxRateRepository(db),
+		AuditLog:         adapters.NewAuditLogRepository(db),
 	}
 }
@@ -9,8 +9,11 @@ import (
 	"github.com/itsLeonB/cashback/internal/core/config"
 	"github.com/itsLeonB/cashback/internal/core/logger"
 	"github.com/itsLeonB/cashback/internal/core/service/cache"
+	"github.com/itsLeonB/cashback/internal/core/service/storage"
 	"github.com/itsLeonB/cashback/internal/domain/service"
+	"github.com/itsLeonB/cashback/internal/domain/service/audit"
 	"github.com/itsLeonB/cashback/internal/domain/service/fee"
+	"github.com/itsLeonB/cashback/internal/domain/service/fx"
 	"github.com/itsLeonB/cashback/internal/domain/service/monetization"
 	"github.com/itsLeonB/cashback/internal/domain/service/monetization/payment"
 	"github.com/itsLeonB/cashback/internal/domain/service/oauth"
@@ -30,17 +33,26 @@ type Services struct {
 	Friendship        service.FriendshipService
 	FriendshipRequest service.FriendshipRequestService
 	FriendDetails     service.FriendDetailsService
+	ProfileClaim      service.ProfileClaimService
 
 	// Debts
 	Debt                  service.DebtService
 	TransferMethod        service.TransferMethodService
 	ProfileTransferMethod service.ProfileTransferMethodService
+	Settlement            service.SettlementService
 
 	// Expenses
 	GroupExpense service.GroupExpenseService
 	ExpenseBill  service.ExpenseBillService
+	InboundMail  service.InboundMailService
 	ExpenseItem  service.ExpenseItemService
 	OtherFee     service.OtherFeeService
+	Recurring    service.RecurringService
+	Export       service.ExportService
+	Import       service.ImportService
+	Category     service.CategoryService
+	Analytics    service.AnalyticsService
+	Group        service.GroupService
 
 	// Monetization
 	Plan         monetization.PlanService
@@ -48,9 +60,14 @@ type Services struct {
 	Subscription monetization.SubscriptionService
 	Payment      monetization.PaymentService
 
+	// Admin
+	Audit audit.Service
+
 	// Infra
 	Notification     service.NotificationService
 	PushNotification service.PushNotificationService
+	FxRate           service.FxRateService
+	Storage          storage.StorageRepository
 }
 
 func (s *Services) Shutdown() error {
@@ -60,7 +77,7 @@ func (s *Services) Shutdown() error {
 func ProvideServices(
 	repos *Repositories,
 	coreSvc *CoreServices,
-) *Services {
+) (*Services, error) {
 	authConfig := config.Global.Auth
 	appConfig := config.Global.App
 	paymentConfig := config.Global.Payment
@@ -70,8 +87,14 @@ func ProvideServices(
 		logger.Error(err)
 	}
 
-	subs := monetization.NewSubscriptionService(repos.Transactor, repos.Subscription, repos.PlanVersion, coreSvc.Queue)
-	payment := monetization.NewPaymentService(paymentGateway, repos.Transactor, repos.Payment, coreSvc.Queue, subs)
+	rateProvider, err := fx.NewRateProvider(config.Global.FX)
+	if err != nil {
+		return nil, err
+	}
+
+	auditSvc := audit.NewService(repos.AuditLog)
+	subs := monetization.NewSubscriptionService(repos.Transactor, repos.Subscription, repos.PlanVersion, coreSvc.Queue, auditSvc)
+	payment := monetization.NewPaymentService(paymentGateway, repos.Transactor, repos.Payment, coreSvc.Queue, subs, auditSvc)
 	subsLimit := service.NewSubscriptionLimitService(subs, repos.ExpenseBill)
 
 	jwt := sekure.NewJwtService(authConfig.Issuer, authConfig.SecretKey, authConfig.TokenDuration)
@@ -80,9 +103,13 @@ func ProvideServices(
 	friendship := service.NewFriendshipService(repos.Transactor, repos.Friendship, profile)
 	pushNotification := service.NewPushNotificationService(repos.PushSubscription, repos.Notification, repos.Transactor, coreSvc.WebPush)
 
+	// Claim links outlive access tokens, so they are signed by their own JWT service.
+	claimJWT := authadapter.NewJWTService(sekure.NewJwtService(authConfig.Issuer, authConfig.SecretKey, authConfig.ClaimLinkDuration))
+	profileClaim := service.NewProfileClaimService(repos.Transactor, claimJWT, friendship, profile, appConfig.ProfileClaimUrl, authConfig.ClaimLinkDuration)
+
 	// hooks assembles the service.AuthHooks{} configuration that wires Cashus
 	// business logic into the generic auth service layer.
-	hooks := NewAuthHooks(pushNotification, profile, friendship)
+	hooks := NewAuthHooks(pushNotification, profile, friendship, profileClaim, coreSvc.Queue)
 
 	// Auth adapters bridge the auth package interfaces to existing repos/infra.
 	jwtAdapter := authadapter.NewJWTService(jwt)
@@ -98,14 +125,22 @@ func ProvideServices(
 	cacheAdapter := authadapter.NewSessionCacheAdapter(sessionCache)
 	stateAdapter := authadapter.NewStateStore(coreSvc.State)
 
-	session := service.NewSessionService(jwtAdapter, userStore, txAdapter, sessionStore, refreshTokenStore, authConfig.RefreshTokenDuration, hooks.ClaimsBuilder)
+	session := service.NewSessionService(jwtAdapter, userStore, txAdapter, sessionStore, refreshTokenStore, authConfig.RefreshTokenDuration, cacheAdapter, hooks)
 
 	friendReq := service.NewFriendshipRequestService(repos.Transactor, friendship, profile, repos.FriendshipRequest, coreSvc.Queue)
 
-	groupExpense := service.NewGroupExpenseService(friendship, repos.GroupExpense, repos.Transactor, fee.NewFeeCalculatorRegistry(), repos.OtherFee, repos.ExpenseBill, coreSvc.LLM, coreSvc.Image, coreSvc.Queue, coreSvc.Langfuse, profile)
+	category := service.NewCategoryService(repos.Transactor, repos.Category)
+	group := service.NewGroupService(repos.Transactor, repos.Group, repos.GroupExpense, repos.DebtTransaction, friendship, profile)
+	groupExpense := service.NewGroupExpenseService(friendship, repos.GroupExpense, repos.DebtTransaction, repos.Transactor, fee.NewFeeCalculatorRegistry(), repos.OtherFee, repos.ExpenseBill, coreSvc.LLM, coreSvc.Image, coreSvc.Queue, coreSvc.Prompts, profile, category, group, auditSvc)
 
 	transferMethod := service.NewTransferMethodService(repos.TransferMethod, coreSvc.Storage, appConfig.BucketNameTransferMethods, appembed.TransferMethodAssets)
-	debt := service.NewDebtService(repos.DebtTransaction, transferMethod, friendship, profile, groupExpense, coreSvc.Queue)
+	fxRate := service.NewFxRateService(repos.FxRate, rateProvider)
+	debt := service.NewDebtService(repos.DebtTransaction, transferMethod, friendship, profile, groupExpense, coreSvc.Queue, fxRate, category, group, repos.Transactor, auditSvc)
+	recurring := service.NewRecurringService(repos.Transactor, repos.RecurringTemplate, groupExpense, debt, friendship, transferMethod, coreSvc.Queue)
+
+	expenseBill := service.NewExpenseBillService(coreSvc.Queue, repos.ExpenseBill, repos.BillPage, repos.BillUpload, repos.Transactor, coreSvc.Image, coreSvc.OCR, groupExpense, subsLimit, category)
+
+	friendDetails := service.NewFriendDetailsService(debt, profile, friendship, fxRate)
 
 	providerSvc := oauth.NewProviderService(config.Global.OAuthProviders)
 
@@ -119,23 +154,36 @@ func ProvideServices(
 		Profile:           profile,
 		Friendship:        friendship,
 		FriendshipRequest: friendReq,
-		FriendDetails:     service.NewFriendDetailsService(debt, profile, friendship),
+		FriendDetails:     friendDetails,
+		ProfileClaim:      profileClaim,
 
 		Debt:                  debt,
 		TransferMethod:        transferMethod,
 		ProfileTransferMethod: service.NewProfileTransferMethodService(profile, repos.ProfileTransferMethod, transferMethod, friendship),
+		Settlement:            service.NewSettlementService(repos.Transactor, repos.Settlement, repos.DebtTransaction, repos.ProfileTransferMethod, transferMethod, friendship, profile, coreSvc.Image, coreSvc.Queue, group, auditSvc),
 
 		GroupExpense: groupExpense,
-		ExpenseBill:  service.NewExpenseBillService(coreSvc.Queue, repos.ExpenseBill, repos.Transactor, coreSvc.Image, coreSvc.OCR, groupExpense, subsLimit),
-		ExpenseItem:  service.NewExpenseItemService(repos.Transactor, repos.ExpenseItem, groupExpense),
+		ExpenseBill:  expenseBill,
+		InboundMail:  service.NewInboundMailService(repos.Transactor, user, groupExpense, expenseBill, config.Global.Mail.InboundAuthservID),
+		ExpenseItem:  service.NewExpenseItemService(repos.Transactor, repos.ExpenseItem, groupExpense, group),
 		OtherFee:     service.NewOtherFeeService(repos.Transactor, repos.GroupExpense, repos.OtherFee, groupExpense),
+		Recurring:    recurring,
+		Export:       service.NewExportService(repos.Transactor, repos.Export, debt, friendDetails, groupExpense, coreSvc.Storage, appConfig.BucketNameExports, coreSvc.Queue),
+		Import:       service.NewImportService(repos.Transactor, repos.Import, repos.DebtTransaction, profile, friendship, groupExpense, transferMethod, coreSvc.Queue, auditSvc),
+		Category:     category,
+		Analytics:    service.NewAnalyticsService(repos.GroupExpense, profile),
+		Group:        group,
 
 		Plan:         monetization.NewPlanService(repos.Transactor, repos.Plan, repos.PlanVersion),
 		PlanVersion:  monetization.NewPlanVersionService(repos.Transactor, repos.PlanVersion),
 		Subscription: subs,
 		Payment:      payment,
 
-		Notification:     service.NewNotificationService(repos.Notification, debt, friendReq, friendship, groupExpense, coreSvc.Queue),
+		Audit: auditSvc,
+
+		Notification:     service.NewNotificationService(repos.Notification, debt, friendReq, friendship, groupExpense, recurring, coreSvc.Queue),
 		PushNotification: pushNotification,
-	}
+		FxRate:           fxRate,
+		Storage:          coreSvc.Storage,
+	}, nil
 }